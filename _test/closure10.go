@@ -0,0 +1,31 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+)
+
+func main() {
+	var (
+		mu  sync.Mutex
+		wg  sync.WaitGroup
+		res []int
+	)
+	for i := 0; i < 5; i++ {
+		i := i
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			mu.Lock()
+			res = append(res, i)
+			mu.Unlock()
+		}()
+	}
+	wg.Wait()
+	sort.Ints(res)
+	fmt.Println(res)
+}
+
+// Output:
+// [0 1 2 3 4]
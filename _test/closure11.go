@@ -0,0 +1,25 @@
+package main
+
+import "fmt"
+
+func main() {
+	var fs []func()
+	for k, v := range []string{"a", "b", "c"} {
+		fs = append(fs, func() { fmt.Println(k, v) })
+	}
+	for i := 0; i < 3; {
+		i++
+		fs = append(fs, func() { fmt.Println(i) })
+	}
+	for _, f := range fs {
+		f()
+	}
+}
+
+// Output:
+// 0 a
+// 1 b
+// 2 c
+// 1
+// 2
+// 3
@@ -0,0 +1,21 @@
+package main
+
+import "fmt"
+
+func main() {
+	var fs []func()
+	for i := 0; i < 3; i++ {
+		a := i
+		b, c := i*10, i*100
+		var d = i * 1000
+		fs = append(fs, func() { fmt.Println(i, a, b, c, d) })
+	}
+	for _, f := range fs {
+		f()
+	}
+}
+
+// Output:
+// 0 0 0 0 0
+// 1 1 10 100 1000
+// 2 2 20 200 2000
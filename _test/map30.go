@@ -0,0 +1,16 @@
+package main
+
+import "fmt"
+
+func main() {
+	m := map[string]int{}
+	for _, w := range []string{"a", "b", "a"} {
+		m[w]++
+	}
+	m["b"] += 2
+	m["c"]--
+	fmt.Println(m["a"], m["b"], m["c"])
+}
+
+// Output:
+// 2 3 -1
@@ -0,0 +1,178 @@
+// Package bridge exposes interpreters to hosts written in other languages,
+// which embed Go as a shared library, such as libyaegi built from
+// cmd/libyaegi. Interpreters are designated by integer handles, and values are
+// exchanged as JSON: the basic types, and structs, maps and slices of them,
+// including the structs declared by interpreted code.
+//
+// Eval and Call return a JSON object, holding the result in "value" and its
+// Go type in "type", or the failure in "error":
+//
+//	{"value": {"X": 1, "Y": 2}, "type": "struct { X int; Y int }"}
+//	{"error": "1:1: undefined: z"}
+package bridge
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/traefik/yaegi/interp"
+)
+
+// A Handle designates an interpreter registered by Register. The zero
+// Handle is invalid.
+type Handle int64
+
+var (
+	mu      sync.Mutex
+	last    Handle
+	interps = map[Handle]*interp.Interpreter{}
+)
+
+// Register returns the handle of the interpreter i, valid until Release.
+func Register(i *interp.Interpreter) Handle {
+	mu.Lock()
+	defer mu.Unlock()
+	last++
+	interps[last] = i
+	return last
+}
+
+// Release releases the handle h, and returns false if it is not registered.
+// The interpreter is not stopped: running calls complete.
+func Release(h Handle) bool {
+	mu.Lock()
+	defer mu.Unlock()
+	_, ok := interps[h]
+	delete(interps, h)
+	return ok
+}
+
+// Interpreter returns the interpreter of the handle h, or nil if it is not
+// registered.
+func Interpreter(h Handle) *interp.Interpreter {
+	mu.Lock()
+	defer mu.Unlock()
+	return interps[h]
+}
+
+// result is the JSON object returned by Eval and Call.
+type result struct {
+	Value json.RawMessage `json:"value,omitempty"`
+	Type  string          `json:"type,omitempty"`
+	Error string          `json:"error,omitempty"`
+}
+
+func (r result) encode() []byte {
+	b, err := json.Marshal(r)
+	if err != nil {
+		b, _ = json.Marshal(result{Error: err.Error()})
+	}
+	return b
+}
+
+func failure(err error) []byte { return result{Error: err.Error()}.encode() }
+
+// Eval evaluates src in the interpreter of handle h, as Interpreter.Eval,
+// and returns the JSON object of its result. A result which has no JSON
+// encoding, such as a channel, is returned as its type only.
+func Eval(h Handle, src string) []byte {
+	i := Interpreter(h)
+	if i == nil {
+		return failure(fmt.Errorf("invalid handle %d", h))
+	}
+	v, err := i.Eval(src)
+	if err != nil {
+		return failure(err)
+	}
+	var r result
+	if v.IsValid() && v.CanInterface() {
+		r.Type = v.Type().String()
+		r.Value, _ = marshal(v)
+	}
+	return r.encode()
+}
+
+// Call calls the function name, such as "main.Add" or a function declared by
+// a previous Eval, in the interpreter of handle h, with the arguments args, a
+// JSON array of the values of its parameters, converted to their types. It
+// returns the JSON object of its results, whose value is an array, and whose
+// type is the signature of the function. The results of type error are their
+// message, or null. A panic of the function is returned as an error.
+func Call(h Handle, name string, args []byte) (res []byte) {
+	i := Interpreter(h)
+	if i == nil {
+		return failure(fmt.Errorf("invalid handle %d", h))
+	}
+	fn, err := i.Eval(name)
+	if err != nil {
+		return failure(err)
+	}
+	if fn.Kind() != reflect.Func || fn.IsNil() {
+		return failure(fmt.Errorf("%s is not a function", name))
+	}
+	in, err := params(fn.Type(), args)
+	if err != nil {
+		return failure(fmt.Errorf("call %s: %v", name, err))
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			res = failure(fmt.Errorf("call %s: panic: %v", name, r))
+		}
+	}()
+	var out []reflect.Value
+	if fn.Type().IsVariadic() {
+		out = fn.CallSlice(in)
+	} else {
+		out = fn.Call(in)
+	}
+
+	values := make([]json.RawMessage, len(out))
+	for k, v := range out {
+		if values[k], err = marshal(v); err != nil {
+			return failure(fmt.Errorf("call %s: result %d: %v", name, k, err))
+		}
+	}
+	b, _ := json.Marshal(values)
+	return result{Value: b, Type: fn.Type().String()}.encode()
+}
+
+// params returns the values of the parameters of a function of type t,
+// decoded from the JSON array args. The variadic parameter is an array.
+func params(t reflect.Type, args []byte) ([]reflect.Value, error) {
+	var raw []json.RawMessage
+	if s := strings.TrimSpace(string(args)); s != "" {
+		if err := json.Unmarshal(args, &raw); err != nil {
+			return nil, fmt.Errorf("invalid arguments: %v", err)
+		}
+	}
+	if len(raw) != t.NumIn() {
+		return nil, fmt.Errorf("got %d arguments, want %d", len(raw), t.NumIn())
+	}
+	in := make([]reflect.Value, len(raw))
+	for k, a := range raw {
+		v := reflect.New(t.In(k))
+		if err := json.Unmarshal(a, v.Interface()); err != nil {
+			return nil, fmt.Errorf("argument %d: %v", k, err)
+		}
+		in[k] = v.Elem()
+	}
+	return in, nil
+}
+
+var errorType = reflect.TypeOf((*error)(nil)).Elem()
+
+// marshal returns the JSON encoding of the value v, or of the message of v
+// if it is an error.
+func marshal(v reflect.Value) (json.RawMessage, error) {
+	if v.Type() == errorType || v.Kind() == reflect.Interface && !v.IsNil() && v.Elem().Type().Implements(errorType) {
+		if v.IsNil() {
+			return json.RawMessage("null"), nil
+		}
+		return json.Marshal(v.Interface().(error).Error())
+	}
+	return json.Marshal(v.Interface())
+}
@@ -0,0 +1,53 @@
+package bridge
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/traefik/yaegi/interp"
+)
+
+func TestBridge(t *testing.T) {
+	h := Register(interp.New(interp.Options{}))
+	defer Release(h)
+
+	for _, test := range []struct{ src, res string }{
+		{src: "type Point struct{ X, Y int }", res: `{}`},
+		{src: "func Add(a, b int) int { return a + b }", res: `{}`},
+		{src: `func Move(p Point, d []int) Point { return Point{p.X + d[0], p.Y + d[1]} }`, res: `{}`},
+		{src: `func Sum(v ...float64) (s float64) { for _, x := range v { s += x }; return s }`, res: `{}`},
+		{src: `type Err string; func (e Err) Error() string { return string(e) }`, res: `{}`},
+		{src: `func Check(n int) (int, error) { if n < 0 { return 0, Err("negative") }; return n, nil }`, res: `{}`},
+		{src: "Point{1, 2}", res: `{"value":{"X":1,"Y":2},"type":"struct { X int; Y int }"}`},
+		{src: `"a" + "b"`, res: `{"value":"ab","type":"string"}`},
+		{src: "make(chan int)", res: `{"type":"chan int"}`},
+		{src: "z", res: `{"error":"1:1: undefined: z"}`},
+	} {
+		if res := string(Eval(h, test.src)); res != test.res {
+			t.Errorf("%s: got %s, want %s", test.src, res, test.res)
+		}
+	}
+
+	for _, test := range []struct{ name, args, res string }{
+		{name: "Add", args: `[1, 2]`, res: `{"value":[3],"type":"func(int, int) int"}`},
+		{name: "main.Add", args: `[1, 2]`, res: `{"value":[3],"type":"func(int, int) int"}`},
+		{name: "Move", args: `[{"X": 1, "Y": 2}, [10, 20]]`, res: `{"value":[{"X":11,"Y":22}],"type":"func(struct { X int; Y int }, []int) struct { X int; Y int }"}`},
+		{name: "Sum", args: `[[1.5, 2]]`, res: `{"value":[3.5],"type":"func(...float64) float64"}`},
+		{name: "Check", args: `[1]`, res: `{"value":[1,null],"type":"func(int) (int, error)"}`},
+		{name: "Check", args: `[-1]`, res: `{"value":[0,"negative"],"type":"func(int) (int, error)"}`},
+		{name: "Add", args: `[1]`, res: `{"error":"call Add: got 1 arguments, want 2"}`},
+		{name: "Add", args: `[1, "a"]`, res: `{"error":"call Add: argument 1: json: cannot unmarshal string into Go value of type int"}`},
+		{name: "Point{}", args: ``, res: `{"error":"Point{} is not a function"}`},
+	} {
+		if res := string(Call(h, test.name, []byte(test.args))); res != test.res {
+			t.Errorf("%s %s: got %s, want %s", test.name, test.args, res, test.res)
+		}
+	}
+
+	if !Release(h) || Release(h) {
+		t.Error("invalid release")
+	}
+	if res, want := string(Eval(h, "1")), fmt.Sprintf(`{"error":"invalid handle %d"}`, h); res != want {
+		t.Errorf("got %s, want %s", res, want)
+	}
+}
@@ -0,0 +1,71 @@
+/*
+Libyaegi is a C shared library embedding the Yaegi interpreter, so that
+programs written in C, or in any language able to call C functions, can
+evaluate Go code. It is built with:
+
+	go build -buildmode=c-shared -o libyaegi.so ./cmd/libyaegi
+
+which also produces the header libyaegi.h, declaring:
+
+	long long yaegi_new(void);
+	int yaegi_close(long long h);
+	char* yaegi_eval(long long h, char* src);
+	char* yaegi_call(long long h, char* name, char* args);
+	void yaegi_free(char* s);
+
+An interpreter, created by yaegi_new with the standard library, is
+designated by its handle until yaegi_close. The strings returned by
+yaegi_eval and yaegi_call are JSON objects, described in the bridge package,
+to be freed by yaegi_free. For example:
+
+	long long h = yaegi_new();
+	yaegi_free(yaegi_eval(h, "func Add(a, b int) int { return a + b }"));
+	char *res = yaegi_call(h, "Add", "[1, 2]"); // {"value":[3],"type":"func(int, int) int"}
+	yaegi_free(res);
+	yaegi_close(h);
+*/
+package main
+
+// #include <stdlib.h>
+import "C"
+
+import (
+	"unsafe"
+
+	"github.com/traefik/yaegi/bridge"
+	"github.com/traefik/yaegi/interp"
+	"github.com/traefik/yaegi/stdlib"
+)
+
+//export yaegi_new
+func yaegi_new() C.longlong {
+	i := interp.New(interp.Options{})
+	i.Use(stdlib.Symbols)
+	i.Use(interp.Symbols)
+	return C.longlong(bridge.Register(i))
+}
+
+//export yaegi_close
+func yaegi_close(h C.longlong) C.int {
+	if bridge.Release(bridge.Handle(h)) {
+		return 1
+	}
+	return 0
+}
+
+//export yaegi_eval
+func yaegi_eval(h C.longlong, src *C.char) *C.char {
+	return C.CString(string(bridge.Eval(bridge.Handle(h), C.GoString(src))))
+}
+
+//export yaegi_call
+func yaegi_call(h C.longlong, name, args *C.char) *C.char {
+	return C.CString(string(bridge.Call(bridge.Handle(h), C.GoString(name), []byte(C.GoString(args)))))
+}
+
+//export yaegi_free
+func yaegi_free(s *C.char) {
+	C.free(unsafe.Pointer(s))
+}
+
+func main() {}
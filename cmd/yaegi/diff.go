@@ -0,0 +1,326 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// inputList is the list of the values of the repeated -input flag.
+type inputList []string
+
+func (l *inputList) String() string { return strings.Join(*l, ",") }
+
+func (l *inputList) Set(s string) error {
+	*l = append(*l, s)
+	return nil
+}
+
+// execution is the result of an execution of a program.
+type execution struct {
+	stdout []byte
+	stderr []byte
+	code   int // exit code
+}
+
+func diffCmd(arg []string) error {
+	var inputs inputList
+	var jsonOutput bool
+	var tags string
+	var timeout time.Duration
+
+	dflag := flag.NewFlagSet("diff", flag.ContinueOnError)
+	dflag.Var(&inputs, "input", "file read as the standard input of an execution, may be repeated")
+	dflag.BoolVar(&jsonOutput, "json", false, "compare the standard output as a sequence of JSON values")
+	dflag.StringVar(&tags, "tags", "", "set a list of build tags")
+	dflag.DurationVar(&timeout, "timeout", time.Minute, "maximum duration of each execution")
+	dflag.Usage = func() {
+		fmt.Println("Usage: yaegi diff [options] path [args]")
+		fmt.Println("Run a main package, a file or a directory, with yaegi run and compiled by go build")
+		fmt.Println("in a temporary module, with each input, and report their divergences.")
+		fmt.Println("The standard output and the exit codes are compared. A panic of the compiled")
+		fmt.Println("program, exiting with code 2, matches a failure of yaegi run, exiting with code 1.")
+		fmt.Println("Options:")
+		dflag.PrintDefaults()
+	}
+	if err := dflag.Parse(arg); err != nil {
+		return err
+	}
+	args := dflag.Args()
+	if len(args) == 0 {
+		return errors.New("missing path")
+	}
+	path, args := args[0], args[1:]
+
+	self, err := os.Executable()
+	if err != nil {
+		return err
+	}
+	tmp, err := ioutil.TempDir("", "yaegi-diff-")
+	if err != nil {
+		return err
+	}
+	defer func() { _ = os.RemoveAll(tmp) }()
+
+	prog, err := buildCompiled(path, tmp, tags)
+	if err != nil {
+		return err
+	}
+	yaegiArgs := []string{"run"}
+	if tags != "" {
+		yaegiArgs = append(yaegiArgs, "-tags", tags)
+	}
+	yaegiArgs = append(append(yaegiArgs, path), args...)
+
+	if len(inputs) == 0 {
+		inputs = inputList{""}
+	}
+	diverged := 0
+	for _, in := range inputs {
+		var stdin []byte
+		name := "(no input)"
+		if in != "" {
+			if stdin, err = ioutil.ReadFile(in); err != nil {
+				return err
+			}
+			name = in
+		}
+		interpreted, err := execute(timeout, stdin, self, yaegiArgs...)
+		if err != nil {
+			return err
+		}
+		compiled, err := execute(timeout, stdin, prog, args...)
+		if err != nil {
+			return err
+		}
+		diffs := compareExecutions(interpreted, compiled, jsonOutput)
+		if len(diffs) == 0 {
+			fmt.Printf("ok\t%s\n", name)
+			continue
+		}
+		diverged++
+		fmt.Printf("DIVERGED\t%s\n", name)
+		for _, d := range diffs {
+			fmt.Printf("\t%s\n", d)
+		}
+		if !bytes.Equal(interpreted.stderr, compiled.stderr) {
+			fmt.Printf("\tinterpreted stderr:\n%s", indent(interpreted.stderr))
+			fmt.Printf("\tcompiled stderr:\n%s", indent(compiled.stderr))
+		}
+	}
+	if diverged > 0 {
+		return fmt.Errorf("%d of %d executions diverged", diverged, len(inputs))
+	}
+	return nil
+}
+
+// buildCompiled builds the main package of path, a file or a directory, in a
+// temporary module in directory tmp, and returns the path of the executable.
+// The go.mod and go.sum files of the module of path, if any, are used for the
+// temporary module, so its requirements are available, except the ones
+// replaced by relative paths. The other packages of the module are not.
+func buildCompiled(path, tmp, tags string) (string, error) {
+	src := filepath.Join(tmp, "src")
+	if err := os.Mkdir(src, 0755); err != nil {
+		return "", err
+	}
+	files := []string{path}
+	if !isFile(path) {
+		var err error
+		if files, err = filepath.Glob(filepath.Join(path, "*.go")); err != nil {
+			return "", err
+		}
+	}
+	for _, f := range files {
+		if strings.HasSuffix(f, "_test.go") {
+			continue
+		}
+		if err := copyFile(f, filepath.Join(src, filepath.Base(f))); err != nil {
+			return "", err
+		}
+	}
+
+	mod, err := findGoMod(path)
+	if err != nil {
+		return "", err
+	}
+	if mod == "" {
+		err = ioutil.WriteFile(filepath.Join(src, "go.mod"), []byte("module main\n"), 0644)
+	} else {
+		if err = copyFile(mod, filepath.Join(src, "go.mod")); err == nil {
+			sum := filepath.Join(filepath.Dir(mod), "go.sum")
+			if isFile(sum) {
+				err = copyFile(sum, filepath.Join(src, "go.sum"))
+			}
+		}
+	}
+	if err != nil {
+		return "", err
+	}
+
+	prog := filepath.Join(tmp, "prog")
+	cmd := exec.Command("go", "build", "-o", prog)
+	if tags != "" {
+		cmd.Args = append(cmd.Args, "-tags", tags)
+	}
+	cmd.Args = append(cmd.Args, ".")
+	cmd.Dir = src
+	if out, err := cmd.CombinedOutput(); err != nil {
+		return "", fmt.Errorf("go build: %v\n%s", err, out)
+	}
+	return prog, nil
+}
+
+// findGoMod returns the go.mod file found in the directory of path or one of
+// its parents, or "" if there is none.
+func findGoMod(path string) (string, error) {
+	dir, err := filepath.Abs(path)
+	if err != nil {
+		return "", err
+	}
+	if isFile(dir) {
+		dir = filepath.Dir(dir)
+	}
+	for {
+		if name := filepath.Join(dir, "go.mod"); isFile(name) {
+			return name, nil
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return "", nil
+		}
+		dir = parent
+	}
+}
+
+func copyFile(src, dst string) error {
+	b, err := ioutil.ReadFile(src)
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(dst, b, 0644)
+}
+
+// execute runs the command name with args and stdin, killed after timeout,
+// and returns its outputs and exit code. The error is the one of starting
+// the command, or of its timeout.
+func execute(timeout time.Duration, stdin []byte, name string, args ...string) (*execution, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, name, args...)
+	cmd.Stdin = bytes.NewReader(stdin)
+	cmd.Stdout, cmd.Stderr = &stdout, &stderr
+	err := cmd.Run()
+	if ctx.Err() != nil {
+		return nil, fmt.Errorf("%s: timeout after %v", filepath.Base(name), timeout)
+	}
+	e := &execution{stdout: stdout.Bytes(), stderr: stderr.Bytes()}
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) {
+		e.code = exitErr.ExitCode()
+	} else if err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+// compareExecutions returns the descriptions of the divergences of the
+// executions of an interpreted and a compiled program. If jsonOutput is set,
+// their standard outputs are compared as sequences of JSON values.
+func compareExecutions(interpreted, compiled *execution, jsonOutput bool) []string {
+	var diffs []string
+	ic, cc := interpreted.code, compiled.code
+	if cc == 2 && ic == 1 {
+		// A panic of the compiled program is a failure of yaegi run.
+		ic = cc
+	}
+	if ic != cc {
+		diffs = append(diffs, fmt.Sprintf("exit code: interpreted %d, compiled %d", interpreted.code, compiled.code))
+	}
+	if jsonOutput {
+		if d := compareJSON(interpreted.stdout, compiled.stdout); d != "" {
+			diffs = append(diffs, d)
+		}
+	} else if d := compareLines(interpreted.stdout, compiled.stdout); d != "" {
+		diffs = append(diffs, d)
+	}
+	return diffs
+}
+
+// compareLines describes the first line differing between the outputs a
+// and b of the interpreted and compiled programs, or returns "".
+func compareLines(a, b []byte) string {
+	if bytes.Equal(a, b) {
+		return ""
+	}
+	la, lb := strings.SplitAfter(string(a), "\n"), strings.SplitAfter(string(b), "\n")
+	for k := 0; ; k++ {
+		var sa, sb string
+		if k < len(la) {
+			sa = la[k]
+		}
+		if k < len(lb) {
+			sb = lb[k]
+		}
+		if sa != sb {
+			return fmt.Sprintf("stdout line %d: interpreted %q, compiled %q", k+1, sa, sb)
+		}
+	}
+}
+
+// compareJSON describes the first JSON value differing between the outputs
+// a and b of the interpreted and compiled programs, or returns "".
+func compareJSON(a, b []byte) string {
+	da, db := json.NewDecoder(bytes.NewReader(a)), json.NewDecoder(bytes.NewReader(b))
+	for k := 1; ; k++ {
+		va, ea := decodeJSON(da)
+		vb, eb := decodeJSON(db)
+		switch {
+		case ea == io.EOF && eb == io.EOF:
+			return ""
+		case ea != nil && ea != io.EOF:
+			return fmt.Sprintf("stdout JSON value %d: interpreted: %v", k, ea)
+		case eb != nil && eb != io.EOF:
+			return fmt.Sprintf("stdout JSON value %d: compiled: %v", k, eb)
+		case ea != nil || eb != nil || !reflect.DeepEqual(va, vb):
+			return fmt.Sprintf("stdout JSON value %d: interpreted %s, compiled %s", k, jsonString(va, ea), jsonString(vb, eb))
+		}
+	}
+}
+
+func decodeJSON(d *json.Decoder) (interface{}, error) {
+	var v interface{}
+	err := d.Decode(&v)
+	return v, err
+}
+
+func jsonString(v interface{}, err error) string {
+	if err == io.EOF {
+		return "none"
+	}
+	b, _ := json.Marshal(v)
+	return string(b)
+}
+
+// indent returns b with its lines indented by two tabs.
+func indent(b []byte) string {
+	var s strings.Builder
+	for _, l := range strings.SplitAfter(string(b), "\n") {
+		if l != "" {
+			s.WriteString("\t\t" + strings.TrimSuffix(l, "\n") + "\n")
+		}
+	}
+	return s.String()
+}
@@ -0,0 +1,75 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCompareExecutions(t *testing.T) {
+	tests := []struct {
+		desc        string
+		interpreted execution
+		compiled    execution
+		json        bool
+		want        []string
+	}{
+		{
+			desc:        "same",
+			interpreted: execution{stdout: []byte("a\nb\n")},
+			compiled:    execution{stdout: []byte("a\nb\n"), stderr: []byte("ignored")},
+		},
+		{
+			desc:        "panic",
+			interpreted: execution{code: 1},
+			compiled:    execution{code: 2},
+		},
+		{
+			desc:        "exit code and output",
+			interpreted: execution{stdout: []byte("a\nb\n"), code: 3},
+			compiled:    execution{stdout: []byte("a\nc\nd\n")},
+			want: []string{
+				"exit code: interpreted 3, compiled 0",
+				`stdout line 2: interpreted "b\n", compiled "c\n"`,
+			},
+		},
+		{
+			desc:        "truncated output",
+			interpreted: execution{stdout: []byte("a\n")},
+			compiled:    execution{stdout: []byte("a\nb")},
+			want:        []string{`stdout line 2: interpreted "", compiled "b"`},
+		},
+		{
+			desc:        "same JSON",
+			interpreted: execution{stdout: []byte(`{"a": 1, "b": [true]} 2`)},
+			compiled:    execution{stdout: []byte("{\"b\":[true],\"a\":1}\n2\n")},
+			json:        true,
+		},
+		{
+			desc:        "different JSON",
+			interpreted: execution{stdout: []byte(`{"a": 1} {"b": 2}`)},
+			compiled:    execution{stdout: []byte(`{"a": 1} {"b": 3}`)},
+			json:        true,
+			want:        []string{`stdout JSON value 2: interpreted {"b":2}, compiled {"b":3}`},
+		},
+		{
+			desc:        "missing JSON",
+			interpreted: execution{stdout: []byte(`1`)},
+			compiled:    execution{stdout: []byte(`1 2`)},
+			json:        true,
+			want:        []string{`stdout JSON value 2: interpreted none, compiled 2`},
+		},
+		{
+			desc:        "invalid JSON",
+			interpreted: execution{stdout: []byte(`{`)},
+			compiled:    execution{stdout: []byte(`{}`)},
+			json:        true,
+			want:        []string{`stdout JSON value 1: interpreted: unexpected EOF`},
+		},
+	}
+	for _, test := range tests {
+		got := compareExecutions(&test.interpreted, &test.compiled, test.json)
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("%s: got %q, want %q", test.desc, got, test.want)
+		}
+	}
+}
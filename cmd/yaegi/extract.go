@@ -10,7 +10,7 @@ import (
 	"path"
 	"strings"
 
-	"github.com/traefik/yaegi/internal/extract"
+	"github.com/traefik/yaegi/extract"
 )
 
 func extractCmd(arg []string) error {
@@ -18,14 +18,17 @@ func extractCmd(arg []string) error {
 	var name string
 	var exclude string
 	var include string
+	var tags string
 
 	eflag := flag.NewFlagSet("run", flag.ContinueOnError)
 	eflag.StringVar(&licensePath, "license", "", "path to a LICENSE file")
 	eflag.StringVar(&name, "name", "", "the namespace for the extracted symbols")
 	eflag.StringVar(&exclude, "exclude", "", "comma separated list of regexp matching symbols to exclude")
 	eflag.StringVar(&include, "include", "", "comma separated list of regexp matching symbols to include")
+	eflag.StringVar(&tags, "tags", "", "comma separated list of build tags of the packages of a module")
 	eflag.Usage = func() {
 		fmt.Println("Usage: yaegi extract [options] packages...")
+		fmt.Println("A package of the form dir/... extracts all the packages of the module in dir to a single file.")
 		fmt.Println("Options:")
 		eflag.PrintDefaults()
 	}
@@ -63,12 +66,22 @@ func extractCmd(arg []string) error {
 	if include != "" {
 		ext.Include = strings.Split(include, ",")
 	}
+	if tags != "" {
+		ext.Tags = strings.Split(tags, ",")
+	}
 
 	r := strings.NewReplacer("/", "-", ".", "_")
 
 	for _, pkgIdent := range args {
 		var buf bytes.Buffer
-		importPath, err := ext.Extract(pkgIdent, name, &buf)
+		var importPath string
+		var err error
+		if dir := strings.TrimSuffix(pkgIdent, "..."); dir != pkgIdent {
+			importPath, err = ext.ExtractModule(dir, &buf)
+			importPath += "-all"
+		} else {
+			importPath, err = ext.Extract(pkgIdent, name, &buf)
+		}
 		if err != nil {
 			fmt.Fprintln(os.Stderr, err)
 			continue
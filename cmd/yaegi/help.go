@@ -10,6 +10,7 @@ Usage:
 
 The commands are:
 
+    diff        compare the interpreted and compiled executions of a program
     extract     generate a wrapper file from a source package
     help        print usage information
     run         execute a Go program from source
@@ -29,6 +30,8 @@ func help(arg []string) error {
 	}
 
 	switch cmd {
+	case Diff:
+		return diffCmd([]string{"-h"})
 	case Extract:
 		return extractCmd([]string{"-h"})
 	case Help, "", "-h", "--help":
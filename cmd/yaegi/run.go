@@ -11,6 +11,7 @@ import (
 
 	"github.com/traefik/yaegi/interp"
 	"github.com/traefik/yaegi/stdlib"
+	"github.com/traefik/yaegi/stdlib/generic"
 	"github.com/traefik/yaegi/stdlib/syscall"
 	"github.com/traefik/yaegi/stdlib/unrestricted"
 	"github.com/traefik/yaegi/stdlib/unsafe"
@@ -18,6 +19,7 @@ import (
 
 func run(arg []string) error {
 	var interactive bool
+	var noInline bool
 	var tags string
 	var cmd string
 	var err error
@@ -29,6 +31,7 @@ func run(arg []string) error {
 
 	rflag := flag.NewFlagSet("run", flag.ContinueOnError)
 	rflag.BoolVar(&interactive, "i", false, "start an interactive REPL")
+	rflag.BoolVar(&noInline, "noinline", false, "disable inlining of small functions, for accurate panic traces")
 	rflag.BoolVar(&useSyscall, "syscall", useSyscall, "include syscall symbols")
 	rflag.BoolVar(&useUnrestricted, "unrestricted", useUnrestricted, "include unrestricted symbols")
 	rflag.StringVar(&tags, "tags", "", "set a list of build tags")
@@ -44,8 +47,23 @@ func run(arg []string) error {
 	}
 	args := rflag.Args()
 
-	i := interp.New(interp.Options{GoPath: build.Default.GOPATH, BuildTags: strings.Split(tags, ",")})
+	modPath := "."
+	if len(args) > 0 {
+		modPath = args[0]
+	}
+	modules, err := findModules(modPath)
+	if err != nil {
+		return err
+	}
+
+	opts := interp.Options{GoPath: build.Default.GOPATH, BuildTags: strings.Split(tags, ","), Workspace: modules, NoInline: noInline}
+	if len(args) > 0 {
+		// The interpreted program parses its own command line.
+		opts.Args = args
+	}
+	i := interp.New(opts)
 	i.Use(stdlib.Symbols)
+	i.Use(generic.Symbols)
 	i.Use(interp.Symbols)
 	if useSyscall {
 		i.Use(syscall.Symbols)
@@ -106,6 +124,17 @@ func isFile(path string) bool {
 	return err == nil && fi.Mode().IsRegular()
 }
 
+// findModules returns the main module and its requirements, read from the
+// go.mod file found in the directory of path or one of its parents, or nil
+// if there is none.
+func findModules(path string) (map[string]string, error) {
+	name, err := findGoMod(path)
+	if err != nil || name == "" {
+		return nil, err
+	}
+	return interp.ReadModule(name)
+}
+
 func runFile(i *interp.Interpreter, path string) error {
 	b, err := ioutil.ReadFile(path)
 	if err != nil {
@@ -12,6 +12,7 @@ import (
 
 	"github.com/traefik/yaegi/interp"
 	"github.com/traefik/yaegi/stdlib"
+	"github.com/traefik/yaegi/stdlib/generic"
 	"github.com/traefik/yaegi/stdlib/syscall"
 	"github.com/traefik/yaegi/stdlib/unrestricted"
 	"github.com/traefik/yaegi/stdlib/unsafe"
@@ -100,8 +101,14 @@ func test(arg []string) (err error) {
 	os.Args = tf
 	flag.Parse()
 
-	i := interp.New(interp.Options{GoPath: build.Default.GOPATH, BuildTags: strings.Split(tags, ",")})
+	modules, err := findModules(path)
+	if err != nil {
+		return err
+	}
+
+	i := interp.New(interp.Options{GoPath: build.Default.GOPATH, BuildTags: strings.Split(tags, ","), Workspace: modules})
 	i.Use(stdlib.Symbols)
+	i.Use(generic.Symbols)
 	i.Use(interp.Symbols)
 	if useSyscall {
 		i.Use(syscall.Symbols)
@@ -131,6 +138,24 @@ func test(arg []string) (err error) {
 		}
 	}
 
+	// Examples are run in the interpreter which evaluated the package, with
+	// their output captured, then reported as regular tests.
+	examples, err := i.Examples(path)
+	if err != nil {
+		return err
+	}
+	for _, e := range examples {
+		e := e
+		tests = append(tests, testing.InternalTest{e.Name, func(t *testing.T) {
+			if e.Err != nil {
+				t.Fatal(e.Err)
+			}
+			if !e.Passed() {
+				t.Errorf("got:\n%s\nwant:\n%s", e.Got, e.Want)
+			}
+		}})
+	}
+
 	testing.Main(regexp.MatchString, tests, benchmarks, nil)
 	return nil
 }
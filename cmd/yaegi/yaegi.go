@@ -54,6 +54,14 @@ The following extract is a valid executable script:
 	http.HandleFunc("/hello", helloHandler)
 	log.Fatal(http.ListenAndServe(":8080", nil))
 
+Imports
+
+Source packages are imported from the module of the program, if a go.mod
+file is found in its directory or a parent one, then from the vendor
+directories and GOPATH. The required modules must be present in the module
+cache, as after "go mod download", and match their go.sum checksums. Replace
+directives are honored.
+
 Example of a one liner:
 
 	$ yaegi -e 'println(reflect.TypeOf(fmt.Print))'
@@ -63,6 +71,8 @@ Options:
 	   evaluate the string and return.
     -i
 	   start an interactive REPL after file execution.
+	-noinline
+	   disable inlining of small functions, for accurate panic traces.
 	-syscall
 	   include syscall symbols.
 	-tags tag,list
@@ -104,6 +114,7 @@ import (
 )
 
 const (
+	Diff    = "diff"
 	Extract = "extract"
 	Help    = "help"
 	Run     = "run"
@@ -125,6 +136,8 @@ func main() {
 	}
 
 	switch cmd {
+	case Diff:
+		err = diffCmd(os.Args[2:])
 	case Extract:
 		err = extractCmd(os.Args[2:])
 	case Help, "-h", "--help":
@@ -1,5 +1,26 @@
 /*
 Package extract generates wrappers of package exported symbols.
+
+The generated code registers the symbols in a Symbols map, to be passed to
+interp.Interpreter.Use. It is the library behind the yaegi extract command,
+allowing embedders to generate the symbols of their own packages at build
+time, such as with a go:generate directive running a small program:
+
+	ext := extract.Extractor{Dest: "symbols"}
+	var buf bytes.Buffer
+	if _, err := ext.Extract("example.com/mylib", "", &buf); err != nil {
+		log.Fatal(err)
+	}
+	if err := ioutil.WriteFile("example_com-mylib.go", buf.Bytes(), 0644); err != nil {
+		log.Fatal(err)
+	}
+
+ExtractModule generates in the same way a single file registering the symbols
+of all the packages of a module.
+
+The destination package must declare the Symbols map:
+
+	var Symbols = map[string]map[string]reflect.Value{}
 */
 package extract
 
@@ -16,7 +37,6 @@ import (
 	"io"
 	"math/big"
 	"os"
-	"path"
 	"path/filepath"
 	"regexp"
 	"runtime"
@@ -44,6 +64,14 @@ import (
 )
 
 func init() {
+	{{- template "symbols" .}}
+}
+{{template "wrappers" .}}
+`
+
+// symbolsModel defines the templates of the registration of the symbols of a
+// package, and of its interface wrappers, shared by model and moduleModel.
+const symbolsModel = `{{define "symbols"}}
 	Symbols["{{.PkgName}}"] = map[string]reflect.Value{
 		{{- if .Val}}
 		// function, constant and variable definitions
@@ -70,8 +98,10 @@ func init() {
 		{{end}}
 		{{- end}}
 	}
-}
-{{range $key, $value := .Wrap -}}
+{{- end}}
+
+{{- define "wrappers"}}
+{{- range $key, $value := .Wrap -}}
 	// {{$value.Name}} is an interface wrapper for {{$key}} type
 	type {{$value.Name}} struct {
 		{{range $m := $value.Method -}}
@@ -82,7 +112,7 @@ func init() {
 		func (W {{$value.Name}}) {{$m.Name}}{{$m.Param}} {{$m.Result}} { {{$m.Ret}} W.W{{$m.Name}}{{$m.Arg}} }
 	{{end}}
 {{end}}
-`
+{{- end}}`
 
 // Val stores the value name and addressable status of symbols.
 type Val struct {
@@ -122,26 +152,10 @@ func matchList(name string, list []string) (match bool, err error) {
 	return
 }
 
-func (e *Extractor) genContent(importPath string, p *types.Package) ([]byte, error) {
-	prefix := "_" + importPath + "_"
-	prefix = strings.NewReplacer("/", "_", "-", "_", ".", "_").Replace(prefix)
-
-	typ := map[string]string{}
-	val := map[string]Val{}
-	wrap := map[string]Wrap{}
-	imports := map[string]bool{}
+// names returns the sorted names of the symbols of p to extract.
+func (e *Extractor) names(p *types.Package) ([]string, error) {
+	var names []string
 	sc := p.Scope()
-
-	for _, pkg := range p.Imports() {
-		imports[pkg.Path()] = false
-	}
-	qualify := func(pkg *types.Package) string {
-		if pkg.Path() != importPath {
-			imports[pkg.Path()] = true
-		}
-		return pkg.Name()
-	}
-
 	for _, name := range sc.Names() {
 		o := sc.Lookup(name)
 		if !o.Exported() {
@@ -167,8 +181,113 @@ func (e *Extractor) genContent(importPath string, p *types.Package) ([]byte, err
 			continue
 		}
 
-		pname := path.Base(importPath) + "." + name
-		if rname := path.Base(importPath) + name; restricted[rname] {
+		if isGeneric(o) {
+			// Generic functions and types can not be taken as values, they
+			// must be instantiated first.
+			continue
+		}
+		names = append(names, name)
+	}
+	return names, nil
+}
+
+func (e *Extractor) genContent(importPath string, p *types.Package) ([]byte, error) {
+	imports := map[string]bool{}
+	for _, pkg := range p.Imports() {
+		imports[pkg.Path()] = false
+	}
+	qualify := func(pkg *types.Package) string {
+		if pkg.Path() != importPath {
+			imports[pkg.Path()] = true
+		}
+		return pkg.Name()
+	}
+
+	data, err := e.pkgSymbols(importPath, p.Name(), p, imports, qualify)
+	if err != nil {
+		return nil, err
+	}
+
+	// Generate buildTags with Go version only for stdlib packages.
+	// Third party packages do not depend on Go compiler version by default.
+	var buildTags string
+	if isInStdlib(importPath) {
+		var err error
+		buildTags, err = genBuildTags()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if importPath == "log/syslog" {
+		buildTags += ",!windows,!nacl,!plan9"
+	}
+
+	if importPath == "syscall" {
+		// As per https://golang.org/cmd/go/#hdr-Build_constraints,
+		// using GOOS=android also matches tags and files for GOOS=linux,
+		// so exclude it explicitly to avoid collisions (issue #843).
+		// Also using GOOS=illumos matches tags and files for GOOS=solaris.
+		switch os.Getenv("GOOS") {
+		case "android":
+			buildTags += ",!linux"
+		case "illumos":
+			buildTags += ",!solaris"
+		}
+	}
+
+	data["Dest"] = e.Dest
+	data["Imports"] = imports
+	data["BuildTags"] = buildTags
+	data["License"] = e.License
+	return render(model, data)
+}
+
+// usesInternal returns true if the exported methods of the interface t use
+// the types of an internal package of the standard library, which can not be
+// imported by the generated wrapper.
+func usesInternal(t *types.Interface) bool {
+	found := false
+	qualify := func(pkg *types.Package) string {
+		p := pkg.Path()
+		std := !strings.Contains(strings.SplitN(p, "/", 2)[0], ".")
+		if std && (p == "internal" || strings.HasPrefix(p, "internal/") || strings.HasSuffix(p, "/internal") || strings.Contains(p, "/internal/")) {
+			found = true
+		}
+		return pkg.Name()
+	}
+	for i := 0; i < t.NumMethods(); i++ {
+		if f := t.Method(i); f.Exported() {
+			types.TypeString(f.Type(), qualify)
+		}
+	}
+	return found
+}
+
+// pkgSymbols returns the template data of the symbols of the package p of
+// path importPath, designated by pkgName in the generated code. The packages
+// used by the symbols are designated by qualify, and the ones used by the
+// conversion of constants are added to imports.
+func (e *Extractor) pkgSymbols(importPath, pkgName string, p *types.Package, imports map[string]bool, qualify func(*types.Package) string) (map[string]interface{}, error) {
+	prefix := "_" + importPath + "_"
+	prefix = strings.NewReplacer("/", "_", "-", "_", ".", "_").Replace(prefix)
+
+	typ := map[string]string{}
+	val := map[string]Val{}
+	wrap := map[string]Wrap{}
+	sc := p.Scope()
+
+	names, err := e.names(p)
+	if err != nil {
+		return nil, err
+	}
+	for _, name := range names {
+		o := sc.Lookup(name)
+
+		// The package name differs from the last element of versioned
+		// import paths, such as "math/rand/v2".
+		pname := pkgName + "." + name
+		if rname := p.Name() + name; restricted[rname] {
 			// Restricted symbol, locally provided by stdlib wrapper.
 			pname = rname
 		}
@@ -187,7 +306,7 @@ func (e *Extractor) genContent(importPath string, p *types.Package) ([]byte, err
 			val[name] = Val{pname, true}
 		case *types.TypeName:
 			typ[name] = pname
-			if t, ok := o.Type().Underlying().(*types.Interface); ok {
+			if t, ok := o.Type().Underlying().(*types.Interface); ok && !usesInternal(t) {
 				var methods []Method
 				for i := 0; i < t.NumMethods(); i++ {
 					f := t.Method(i)
@@ -227,51 +346,29 @@ func (e *Extractor) genContent(importPath string, p *types.Package) ([]byte, err
 		}
 	}
 
-	// Generate buildTags with Go version only for stdlib packages.
-	// Third party packages do not depend on Go compiler version by default.
-	var buildTags string
-	if isInStdlib(importPath) {
-		var err error
-		buildTags, err = genBuildTags()
-		if err != nil {
-			return nil, err
-		}
+	if len(val) == 0 && len(typ) == 0 {
+		return nil, fmt.Errorf("package %s has no non generic symbols to extract", importPath)
 	}
+	return map[string]interface{}{
+		"PkgName": importPath,
+		"Val":     val,
+		"Typ":     typ,
+		"Wrap":    wrap,
+	}, nil
+}
 
-	base := template.New("extract")
-	parse, err := base.Parse(model)
+// render returns the formatted Go source generated by the template tmpl,
+// which may use the templates of symbolsModel, from data.
+func render(tmpl string, data interface{}) ([]byte, error) {
+	parse, err := template.New("extract").Parse(tmpl)
+	if err == nil {
+		parse, err = parse.Parse(symbolsModel)
+	}
 	if err != nil {
 		return nil, fmt.Errorf("template parsing error: %v", err)
 	}
 
-	if importPath == "log/syslog" {
-		buildTags += ",!windows,!nacl,!plan9"
-	}
-
-	if importPath == "syscall" {
-		// As per https://golang.org/cmd/go/#hdr-Build_constraints,
-		// using GOOS=android also matches tags and files for GOOS=linux,
-		// so exclude it explicitly to avoid collisions (issue #843).
-		// Also using GOOS=illumos matches tags and files for GOOS=solaris.
-		switch os.Getenv("GOOS") {
-		case "android":
-			buildTags += ",!linux"
-		case "illumos":
-			buildTags += ",!solaris"
-		}
-	}
-
 	b := new(bytes.Buffer)
-	data := map[string]interface{}{
-		"Dest":      e.Dest,
-		"Imports":   imports,
-		"PkgName":   importPath,
-		"Val":       val,
-		"Typ":       typ,
-		"Wrap":      wrap,
-		"BuildTags": buildTags,
-		"License":   e.License,
-	}
 	err = parse.Execute(b, data)
 	if err != nil {
 		return nil, fmt.Errorf("template error: %v", err)
@@ -326,6 +423,7 @@ type Extractor struct {
 	License string   // License text to be included in the created package, optional.
 	Exclude []string // Comma separated list of regexp matching symbols to exclude.
 	Include []string // Comma separated list of regexp matching symbols to include.
+	Tags    []string // Build tags satisfied by the packages extracted by ExtractModule, optional.
 }
 
 // importPath checks whether pkgIdent is an existing directory relative to
@@ -405,7 +503,7 @@ func (e *Extractor) Extract(pkgIdent, importPath string, rw io.Writer) (string,
 		return "", err
 	}
 
-	pkg, err := importer.ForCompiler(token.NewFileSet(), "source", nil).Import(pkgIdent)
+	pkg, err := importPackage(pkgIdent)
 	if err != nil {
 		return "", err
 	}
@@ -422,6 +520,34 @@ func (e *Extractor) Extract(pkgIdent, importPath string, rw io.Writer) (string,
 	return ipp, nil
 }
 
+// Symbols returns the symbols of the package found at pkgIdent for which
+// Extract generates wrappers, indexed by name: *types.Const, *types.Func,
+// *types.TypeName or *types.Var objects. pkgIdent is an import path or a
+// local path, as for Extract. It allows to inspect the symbols of a package,
+// for example to select the Include and Exclude expressions, without
+// generating code.
+func (e *Extractor) Symbols(pkgIdent string) (map[string]types.Object, error) {
+	pkg, err := importPackage(pkgIdent)
+	if err != nil {
+		return nil, err
+	}
+	names, err := e.names(pkg)
+	if err != nil {
+		return nil, err
+	}
+	syms := make(map[string]types.Object, len(names))
+	for _, name := range names {
+		syms[name] = pkg.Scope().Lookup(name)
+	}
+	return syms, nil
+}
+
+// importPackage returns the type information of the package pkgIdent, from
+// its sources.
+func importPackage(pkgIdent string) (*types.Package, error) {
+	return importer.ForCompiler(token.NewFileSet(), "source", nil).Import(pkgIdent)
+}
+
 // GetMinor returns the minor part of the version number.
 func GetMinor(part string) string {
 	minor := part
@@ -457,4 +583,22 @@ func genBuildTags() (string, error) {
 	return currentGoVersion + ",!" + nextGoVersion, nil
 }
 
+// isGeneric returns true if o is a generic function or type, or a type
+// constraint, which can only be used in generic code.
+func isGeneric(o types.Object) bool {
+	switch o := o.(type) {
+	case *types.Func:
+		return strings.HasPrefix(o.Type().String(), "func[")
+	case *types.TypeName:
+		if strings.HasSuffix(o.Type().String(), "]") {
+			return true
+		}
+		if t, ok := o.Type().Underlying().(*types.Interface); ok {
+			s := t.String()
+			return strings.ContainsAny(s, "~|") || strings.Contains(s, "comparable")
+		}
+	}
+	return false
+}
+
 func isInStdlib(path string) bool { return !strings.Contains(path, ".") }
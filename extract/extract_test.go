@@ -2,6 +2,8 @@ package extract
 
 import (
 	"bytes"
+	"go/build"
+	"go/types"
 	"os"
 	"path"
 	"strings"
@@ -34,7 +36,9 @@ func TestPackages(t *testing.T) {
 		importPath string
 		expected   string
 		contains   string
+		excludes   string
 		dest       string
+		optional   bool // skipped if the package is not in the standard library of the Go version
 	}{
 		{
 			desc: "stdlib math pkg, using go/importer",
@@ -45,6 +49,25 @@ func TestPackages(t *testing.T) {
 			// TODO(mpl): if the ident between key and value becomes annoying, be smarter about it.
 			contains: `"MaxFloat64":             reflect.ValueOf(constant.MakeFromLiteral("179769313486231570814527423731704356798100000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000", token.FLOAT, 0)),`,
 		},
+		{
+			desc: "stdlib versioned pkg with generic functions",
+			dest: "rand",
+			arg:  "math/rand/v2",
+			// The package name differs from the last element of the path, and
+			// generic functions such as N are skipped.
+			contains: `reflect.ValueOf(rand.IntN)`,
+			excludes: `"N":`,
+			optional: true,
+		},
+		{
+			desc: "stdlib pkg with interface using internal types",
+			dest: "json",
+			arg:  "encoding/json",
+			// Since Go 1.25, the Options interface has a method using a type of
+			// an internal package, so no wrapper is generated for it.
+			contains: `"Marshaler":`,
+			excludes: `_encoding_json_Options`,
+		},
 		{
 			desc:     "using relative path, using go.mod",
 			wd:       "./testdata/1/src/guthib.com/bar",
@@ -83,6 +106,12 @@ func TestPackages(t *testing.T) {
 	for _, test := range testCases {
 		test := test
 		t.Run(test.desc, func(t *testing.T) {
+			if test.optional {
+				if _, err := build.Import(test.arg, "", build.FindOnly); err != nil {
+					t.Skip(err)
+				}
+			}
+
 			cwd, err := os.Getwd()
 			if err != nil {
 				t.Fatal(err)
@@ -125,6 +154,87 @@ func TestPackages(t *testing.T) {
 					t.Fatalf("Missing expected part: %s in %s", test.contains, out.String())
 				}
 			}
+
+			if test.excludes != "" {
+				if strings.Contains(out.String(), test.excludes) {
+					t.Fatalf("Unexpected part: %s in %s", test.excludes, out.String())
+				}
+			}
 		})
 	}
 }
+
+func TestSymbols(t *testing.T) {
+	ext := Extractor{Exclude: []string{"^Err"}}
+	syms, err := ext.Symbols("io")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for name, kind := range map[string]string{"Copy": "func", "Reader": "type", "SeekEnd": "const"} {
+		o, ok := syms[name]
+		if !ok {
+			t.Errorf("missing symbol %s", name)
+			continue
+		}
+		var got string
+		switch o.(type) {
+		case *types.Const:
+			got = "const"
+		case *types.Func:
+			got = "func"
+		case *types.TypeName:
+			got = "type"
+		}
+		if got != kind {
+			t.Errorf("%s: got %s, want %s", name, got, kind)
+		}
+	}
+	for name := range syms {
+		if strings.HasPrefix(name, "Err") {
+			t.Errorf("excluded symbol %s", name)
+		}
+	}
+}
+
+func TestExtractModule(t *testing.T) {
+	for _, test := range []struct {
+		tags     []string
+		contains []string
+		excludes []string
+	}{
+		{
+			contains: []string{
+				`util2 "guthib.com/tree/internal/util"`,
+				`Symbols["guthib.com/tree/internal/store"]`,
+				`"Max": reflect.ValueOf(constant.MakeFromLiteral("1099511627776", token.INT, 0)),`,
+				`"Greet": reflect.ValueOf(util.Greet),`,
+				`WGet func(key string) (util.Named, error)`,
+			},
+			excludes: []string{"+build", "tagged", "plan9only", "nested", "skip", "tool"},
+		},
+		{
+			tags:     []string{"extra"},
+			contains: []string{"// +build extra", `"Extra": reflect.ValueOf(tagged.Extra),`},
+		},
+	} {
+		ext := Extractor{Dest: "symbols", Tags: test.tags}
+		var out bytes.Buffer
+		modPath, err := ext.ExtractModule("./testdata/6/src/guthib.com/tree", &out)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if modPath != "guthib.com/tree" {
+			t.Errorf("got module path %s, want guthib.com/tree", modPath)
+		}
+		for _, s := range test.contains {
+			if !strings.Contains(out.String(), s) {
+				t.Errorf("tags %v: missing %s in %s", test.tags, s, out.String())
+			}
+		}
+		for _, s := range test.excludes {
+			if strings.Contains(out.String(), s) {
+				t.Errorf("tags %v: unexpected %s in %s", test.tags, s, out.String())
+			}
+		}
+	}
+}
@@ -0,0 +1,268 @@
+package extract
+
+import (
+	"bufio"
+	"fmt"
+	"go/ast"
+	"go/build"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+const moduleModel = `// Code generated by 'yaegi extract {{.Module}}/...'. DO NOT EDIT.
+
+{{.License}}
+
+{{if .BuildTags}}// +build {{.BuildTags}}{{end}}
+
+package {{.Dest}}
+
+import (
+{{- range $path, $alias := .Imports }}
+	{{if $alias}}{{$alias}} {{end}}"{{$path}}"
+{{- end}}
+	"reflect"
+)
+
+func init() {
+	{{- range $i, $p := .Packages}}
+	{{- if $i}}
+	{{end}}
+	{{- template "symbols" $p}}
+	{{- end}}
+}
+{{range .Packages}}{{template "wrappers" .}}{{end}}
+`
+
+// ExtractModule writes to w a Go file registering in the Symbols map the
+// symbols of all the packages of the module in directory dir, as the
+// pattern ./... of the go command, to expose a whole module tree to
+// interpreted code with a single generated file. It returns the path of the
+// module. The packages are registered in the lexical order of their
+// directories.
+//
+// The packages are the ones of the subdirectories of dir, except the ones of
+// nested modules, vendor and testdata directories, and directories starting
+// with "." or "_". Only the files satisfying the build constraints of the host
+// and e.Tags are extracted, and the generated file is constrained by e.Tags.
+// The main packages, and the ones without files or symbols to extract for
+// these constraints, are skipped. The packages with the same name are
+// imported with distinct names. The packages of other modules imported by
+// the module are resolved from the working directory, as by Extract.
+func (e *Extractor) ExtractModule(dir string, w io.Writer) (string, error) {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return "", err
+	}
+	modPath, err := modulePath(filepath.Join(dir, "go.mod"))
+	if err != nil {
+		return "", err
+	}
+
+	ctxt := build.Default
+	ctxt.BuildTags = append(append([]string(nil), ctxt.BuildTags...), e.Tags...)
+	var dirs []string
+	err = filepath.Walk(dir, func(p string, info os.FileInfo, err error) error {
+		if err != nil || !info.IsDir() {
+			return err
+		}
+		if p != dir {
+			name := info.Name()
+			if name == "vendor" || name == "testdata" || strings.HasPrefix(name, ".") || strings.HasPrefix(name, "_") {
+				return filepath.SkipDir
+			}
+			if _, err := os.Stat(filepath.Join(p, "go.mod")); err == nil {
+				return filepath.SkipDir
+			}
+		}
+		dirs = append(dirs, p)
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+
+	aliases := map[string]string{"go/constant": "constant", "go/token": "token", "reflect": "reflect"}
+	used := map[string]bool{"constant": true, "token": true, "reflect": true}
+	imports := map[string]bool{}
+	names := map[string]string{"go/constant": "constant", "go/token": "token"}
+	qualify := func(pkg *types.Package) string {
+		a, ok := aliases[pkg.Path()]
+		if !ok {
+			a = pkg.Name()
+			for n := 2; used[a]; n++ {
+				a = pkg.Name() + strconv.Itoa(n)
+			}
+			aliases[pkg.Path()], used[a] = a, true
+			names[pkg.Path()] = pkg.Name()
+		}
+		imports[pkg.Path()] = true
+		return a
+	}
+
+	imp := newModuleImporter(&ctxt, dir, modPath)
+	var pkgs []map[string]interface{}
+	for _, d := range dirs {
+		rel, err := filepath.Rel(dir, d)
+		if err != nil {
+			return "", err
+		}
+		importPath := path.Join(modPath, filepath.ToSlash(rel))
+		p, err := imp.checkDir(d, importPath)
+		if err != nil {
+			return "", err
+		}
+		if p == nil || p.Name() == "main" {
+			continue
+		}
+		if n, err := e.names(p); err != nil {
+			return "", err
+		} else if len(n) == 0 {
+			continue
+		}
+		data, err := e.pkgSymbols(importPath, qualify(p), p, imports, qualify)
+		if err != nil {
+			return "", err
+		}
+		pkgs = append(pkgs, data)
+	}
+	if len(pkgs) == 0 {
+		return "", fmt.Errorf("module %s has no packages to extract", modPath)
+	}
+
+	importAliases := map[string]string{}
+	for p := range imports {
+		if a := aliases[p]; a != names[p] || a != path.Base(p) {
+			importAliases[p] = a
+		} else {
+			importAliases[p] = ""
+		}
+	}
+	content, err := render(moduleModel, map[string]interface{}{
+		"Module":    modPath,
+		"Dest":      e.Dest,
+		"License":   e.License,
+		"BuildTags": strings.Join(e.Tags, ","),
+		"Imports":   importAliases,
+		"Packages":  pkgs,
+	})
+	if err != nil {
+		return "", err
+	}
+	if _, err := w.Write(content); err != nil {
+		return "", err
+	}
+	return modPath, nil
+}
+
+// moduleImporter imports the packages of a module from their directories,
+// made of the files satisfying the build constraints of ctxt, and the other
+// packages from their sources, as importPackage.
+type moduleImporter struct {
+	ctxt *build.Context
+	dir  string // directory of the module
+	path string // path of the module
+	fset *token.FileSet
+	src  types.ImporterFrom
+	pkgs map[string]*types.Package // imported packages of the module, nil while importing
+}
+
+func newModuleImporter(ctxt *build.Context, dir, path string) *moduleImporter {
+	fset := token.NewFileSet()
+	return &moduleImporter{
+		ctxt: ctxt,
+		dir:  dir,
+		path: path,
+		fset: fset,
+		src:  importer.ForCompiler(fset, "source", nil).(types.ImporterFrom),
+		pkgs: map[string]*types.Package{},
+	}
+}
+
+func (m *moduleImporter) Import(path string) (*types.Package, error) {
+	return m.ImportFrom(path, "", 0)
+}
+
+func (m *moduleImporter) ImportFrom(path, srcDir string, mode types.ImportMode) (*types.Package, error) {
+	if path != m.path && !strings.HasPrefix(path, m.path+"/") {
+		return m.src.ImportFrom(path, srcDir, mode)
+	}
+	if p, ok := m.pkgs[path]; ok {
+		if p == nil {
+			return nil, fmt.Errorf("import cycle through %s", path)
+		}
+		return p, nil
+	}
+	dir := filepath.Join(m.dir, filepath.FromSlash(strings.TrimPrefix(path, m.path)))
+	p, err := m.checkDir(dir, path)
+	if err != nil {
+		return nil, err
+	}
+	if p == nil {
+		return nil, fmt.Errorf("no buildable Go source files in %s", dir)
+	}
+	return p, nil
+}
+
+// checkDir returns the type information of the package of path importPath
+// in directory dir, or nil if it has no files satisfying the build
+// constraints.
+func (m *moduleImporter) checkDir(dir, importPath string) (*types.Package, error) {
+	if p, ok := m.pkgs[importPath]; ok && p != nil {
+		return p, nil
+	}
+	bp, err := m.ctxt.ImportDir(dir, 0)
+	if err != nil {
+		if _, ok := err.(*build.NoGoError); ok {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var files []*ast.File
+	for _, name := range append(bp.GoFiles, bp.CgoFiles...) {
+		f, err := parser.ParseFile(m.fset, filepath.Join(dir, name), nil, 0)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, f)
+	}
+	m.pkgs[importPath] = nil
+	conf := types.Config{Importer: m, FakeImportC: true}
+	p, err := conf.Check(importPath, m.fset, files, nil)
+	if err != nil {
+		delete(m.pkgs, importPath)
+		return nil, err
+	}
+	m.pkgs[importPath] = p
+	return p, nil
+}
+
+// modulePath returns the module path declared by the go.mod file modFile.
+func modulePath(modFile string) (string, error) {
+	f, err := os.Open(modFile)
+	if err != nil {
+		return "", err
+	}
+	defer func() { _ = f.Close() }()
+
+	sc := bufio.NewScanner(f)
+	for sc.Scan() {
+		fields := strings.Fields(sc.Text())
+		if len(fields) >= 2 && fields[0] == "module" {
+			return strings.Trim(fields[1], `"`), nil
+		}
+	}
+	if err := sc.Err(); err != nil {
+		return "", err
+	}
+	return "", fmt.Errorf("%s: no module directive found", modFile)
+}
@@ -0,0 +1,3 @@
+module guthib.com/tree
+
+go 1.27.1
@@ -0,0 +1,11 @@
+package store
+
+import "guthib.com/tree/util"
+
+// Store holds values.
+type Store interface {
+	Get(key string) (util.Named, error)
+}
+
+// Version is the store version.
+var Version = 2
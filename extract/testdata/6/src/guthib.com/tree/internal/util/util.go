@@ -0,0 +1,4 @@
+package util
+
+// Max is the maximum size.
+const Max = 1 << 40
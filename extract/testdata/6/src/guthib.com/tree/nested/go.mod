@@ -0,0 +1 @@
+module guthib.com/nested
@@ -0,0 +1,6 @@
+// +build plan9
+
+package plan9only
+
+// Only is only for plan9.
+func Only() {}
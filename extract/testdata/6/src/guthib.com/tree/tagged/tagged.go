@@ -0,0 +1,6 @@
+// +build extra
+
+package tagged
+
+// Extra is only for the extra tag.
+func Extra() {}
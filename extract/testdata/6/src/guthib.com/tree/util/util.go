@@ -0,0 +1,7 @@
+package util
+
+// Greet returns a greeting.
+func Greet(name string) string { return "hello " + name }
+
+// Named is a named value.
+type Named struct{ Name string }
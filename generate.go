@@ -1,7 +1,8 @@
 package yaegi
 
-//go:generate go generate github.com/traefik/yaegi/internal/extract
+//go:generate go generate github.com/traefik/yaegi/extract
 //go:generate go generate github.com/traefik/yaegi/interp
 //go:generate go generate github.com/traefik/yaegi/stdlib
+//go:generate go generate github.com/traefik/yaegi/stdlib/generic
 //go:generate go generate github.com/traefik/yaegi/stdlib/syscall
 //go:generate go generate github.com/traefik/yaegi/stdlib/unsafe
@@ -0,0 +1,147 @@
+package interp
+
+import (
+	"reflect"
+	"unsafe"
+)
+
+// rangeHint returns a function computing the number of iterations of the range
+// loop whose body directly contains the append node n, in a statement of the
+// form "s = append(s, v)", or nil otherwise. When s has to grow in the loop,
+// it is grown at once for all the iterations, instead of by successive
+// doublings.
+func rangeHint(n *node) func(*frame) int {
+	a := n.anc
+	if a.kind != assignStmt || len(a.child) != 2 || a.child[1] != n {
+		return nil
+	}
+	s, d := n.child[1], a.child[0]
+	if s.kind != identExpr || d.kind != identExpr || s.ident != d.ident || s.findex != d.findex || s.level != d.level {
+		return nil
+	}
+	body := a.anc
+	if body == nil || body.kind != blockStmt || body.anc == nil || body.anc.kind != rangeStmt || body.anc.lastChild() != body {
+		return nil
+	}
+	r := body.anc
+	x := r.child[len(r.child)-2]
+	if x.typ == nil {
+		return nil
+	}
+	switch t := x.typ.TypeOf(); {
+	case t == nil:
+		return nil
+	case t.Kind() == reflect.Slice, t.Kind() == reflect.Array, t.Kind() == reflect.Map, t.Kind() == reflect.String:
+	case t.Kind() == reflect.Ptr && t.Elem().Kind() == reflect.Array:
+		l := t.Elem().Len()
+		return func(*frame) int { return l }
+	default:
+		return nil
+	}
+	value := genValue(x)
+	return func(f *frame) int { return value(f).Len() }
+}
+
+// growSlice returns s, or a copy of s with room for at least hint more
+// elements if it is full and hint is not nil.
+func growSlice(f *frame, s reflect.Value, hint func(*frame) int) reflect.Value {
+	if hint == nil || s.Len() < s.Cap() {
+		return s
+	}
+	h := hint(f)
+	if h < 1 {
+		return s
+	}
+	ns := reflect.MakeSlice(s.Type(), s.Len(), s.Len()+h)
+	reflect.Copy(ns, s)
+	return ns
+}
+
+// appendOne returns the exec function of the append node n of a single
+// element value0 to the slice value, stored in dest. Slices of the common
+// basic element kinds are appended to directly, without the reflect.Append
+// overhead, when the source and destination are addressable.
+func appendOne(n *node, dest, value, value0 func(*frame) reflect.Value) bltn {
+	next := getExec(n.tnext)
+	hint := rangeHint(n)
+
+	slow := func(f *frame) bltn {
+		dest(f).Set(reflect.Append(growSlice(f, value(f), hint), value0(f)))
+		return next
+	}
+	if n.typ.val.cat == interfaceT || isRecursiveType(n.typ.val, n.typ.val.rtype) {
+		return slow
+	}
+
+	// slices returns pointers to the source and destination slices, or nil.
+	slices := func(f *frame) (src, dst unsafe.Pointer) {
+		s, d := growSlice(f, value(f), hint), dest(f)
+		if !d.CanAddr() {
+			return nil, nil
+		}
+		if !s.CanAddr() {
+			// The grown copy is stored in the destination first.
+			d.Set(s)
+			s = d
+		}
+		return unsafe.Pointer(s.UnsafeAddr()), unsafe.Pointer(d.UnsafeAddr())
+	}
+
+	switch n.typ.TypeOf().Elem().Kind() {
+	case reflect.Int:
+		return func(f *frame) bltn {
+			src, dst := slices(f)
+			if dst == nil {
+				return slow(f)
+			}
+			*(*[]int)(dst) = append(*(*[]int)(src), int(value0(f).Int()))
+			return next
+		}
+	case reflect.Int64:
+		return func(f *frame) bltn {
+			src, dst := slices(f)
+			if dst == nil {
+				return slow(f)
+			}
+			*(*[]int64)(dst) = append(*(*[]int64)(src), value0(f).Int())
+			return next
+		}
+	case reflect.Uint8:
+		return func(f *frame) bltn {
+			src, dst := slices(f)
+			if dst == nil {
+				return slow(f)
+			}
+			*(*[]uint8)(dst) = append(*(*[]uint8)(src), uint8(value0(f).Uint()))
+			return next
+		}
+	case reflect.Float64:
+		return func(f *frame) bltn {
+			src, dst := slices(f)
+			if dst == nil {
+				return slow(f)
+			}
+			*(*[]float64)(dst) = append(*(*[]float64)(src), value0(f).Float())
+			return next
+		}
+	case reflect.String:
+		return func(f *frame) bltn {
+			src, dst := slices(f)
+			if dst == nil {
+				return slow(f)
+			}
+			*(*[]string)(dst) = append(*(*[]string)(src), value0(f).String())
+			return next
+		}
+	case reflect.Bool:
+		return func(f *frame) bltn {
+			src, dst := slices(f)
+			if dst == nil {
+				return slow(f)
+			}
+			*(*[]bool)(dst) = append(*(*[]bool)(src), value0(f).Bool())
+			return next
+		}
+	}
+	return slow
+}
@@ -0,0 +1,100 @@
+package interp_test
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/traefik/yaegi/interp"
+)
+
+const appendSrc = `package main
+
+type ID int
+
+type Point struct{ X, Y int }
+
+func squares(n int) []int {
+	var s []int
+	for i := 0; i < n; i++ {
+		s = append(s, i*i)
+	}
+	return s
+}
+
+func ids(src []int) []ID {
+	s := make([]ID, 0, 1)
+	for _, v := range src {
+		s = append(s, ID(v))
+	}
+	return s
+}
+
+func words(m map[string]bool) (s []string) {
+	for k := range m {
+		s = append(s, k)
+	}
+	return s
+}
+
+func mixed() ([]byte, []float64, []bool, []Point) {
+	b := append([]byte("ab"), 'c')
+	f := append([]float64{1}, 2)
+	t := append([]bool(nil), true)
+	p := append([]Point{}, Point{1, 2})
+	return b, f, t, p
+}
+`
+
+func TestAppend(t *testing.T) {
+	i := interp.New(interp.Options{})
+	i.Use(interp.Exports{"fmt": {"Sprint": reflect.ValueOf(fmt.Sprint)}})
+	eval(t, i, appendSrc)
+	eval(t, i, `import "fmt"`)
+
+	if got := eval(t, i, `squares(5)`).Interface(); !reflect.DeepEqual(got, []int{0, 1, 4, 9, 16}) {
+		t.Errorf("got %v, want squares", got)
+	}
+	got := eval(t, i, `s := ids(squares(100)); len(s)*1000 + cap(s)`).Int()
+	if got != 100101 {
+		t.Errorf("got len*1000+cap %d, want 100101", got)
+	}
+	if got := eval(t, i, `len(words(map[string]bool{"a": true, "b": true}))`).Int(); got != 2 {
+		t.Errorf("got %d words, want 2", got)
+	}
+	if got := eval(t, i, `fmt.Sprint(mixed())`).String(); got != "[97 98 99] [1 2] [true] [{1 2}]" {
+		t.Errorf("got %s", got)
+	}
+
+	// Appends at top level, out of any block.
+	i = interp.New(interp.Options{})
+	eval(t, i, `a := []int{}`)
+	eval(t, i, `a = append(a, 1)`)
+	if got := eval(t, i, `a = append(a, 2); a`).Interface(); !reflect.DeepEqual(got, []int{1, 2}) {
+		t.Errorf("got %v, want [1 2]", got)
+	}
+}
+
+func BenchmarkAppend(b *testing.B) {
+	i := interp.New(interp.Options{})
+	if _, err := i.Eval(appendSrc); err != nil {
+		b.Fatal(err)
+	}
+	b.Run("loop", func(b *testing.B) {
+		for n := 0; n < b.N; n++ {
+			if _, err := i.Eval(`squares(1000)`); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	if _, err := i.Eval(`src := squares(1000)`); err != nil {
+		b.Fatal(err)
+	}
+	b.Run("range", func(b *testing.B) {
+		for n := 0; n < b.N; n++ {
+			if _, err := i.Eval(`ids(src)`); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
@@ -0,0 +1,343 @@
+package interp
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"path"
+	"sort"
+	"strings"
+)
+
+// ArchiveFormat is the format of a source archive, see EvalArchive.
+type ArchiveFormat int
+
+// Source archive formats.
+const (
+	ArchiveDetect ArchiveFormat = iota // detected from the archive content
+	ArchiveZip                         // zip
+	ArchiveTar                         // uncompressed tar
+	ArchiveTarGz                       // gzip compressed tar, as .tar.gz and .tgz files
+)
+
+// archiveRoot is the import path of the program evaluated by EvalArchive.
+const archiveRoot = "/"
+
+// ArchiveOptions are the options of ImportArchive.
+type ArchiveOptions struct {
+	// Format is the format of the archive, detected from its content if
+	// ArchiveDetect.
+	Format ArchiveFormat
+
+	// Name, if not empty, is the package name used by importers which do not
+	// specify one, instead of the name declared by the source files.
+	Name string
+
+	// Digest, if not empty, is the digest of the archive, "sha256:<hex>" or
+	// "sha512:<hex>". The archive is refused if it does not match.
+	Digest string
+
+	// Signature, if not empty, is a detached signature of the archive, a raw
+	// ed25519 signature or a minisign signature file, verified with
+	// PublicKey. The archive is refused if the signature is invalid.
+	Signature []byte
+
+	// PublicKey is the key verifying Signature, a raw ed25519 public key or
+	// a minisign public key, as its file or its base64 encoded line.
+	PublicKey []byte
+
+	// Bundle is what the host provides to the archive, if it is a script
+	// bundle with a manifest (see BundleFile). If nil, the host provides
+	// nothing, and bundles with requirements are refused.
+	Bundle *BundlePolicy
+}
+
+// ImportArchive imports the Go source package contained in the archive read
+// from r, and registers it under importPath, so it can be imported by
+// interpreted code, including other archives, and its symbols retrieved with
+// Symbols. It returns the package name. The package must not be already
+// imported: use Invalidate to replace it.
+//
+// The package is made of the files at the root of the archive. Its
+// subdirectories hold the packages importPath/subdir, or module/subdir if the
+// archive has a go.mod file declaring module, and its vendor directory the
+// packages vendored by them. Their imports are resolved from the archive first,
+// then as usual.
+//
+// An import path with a version suffix, such as "example.com/plugin@v1",
+// lets several versions of a package be imported at once, from different
+// archives. The packages of such an archive, including the ones imported by
+// its module path or the unversioned import path, and the vendored ones, are
+// registered in the namespace of the versioned path, such as
+// "example.com/plugin@v1/sub", so that the globals and types of each version
+// are isolated. Interpreted code imports each version with its versioned
+// path, under distinct package names.
+func (interp *Interpreter) ImportArchive(importPath string, r io.Reader, opts ArchiveOptions) (_ string, err error) {
+	if importPath == "" || importPath == archiveRoot || isPathRelative(importPath) {
+		return "", fmt.Errorf("invalid import path %q", importPath)
+	}
+	interp.evalMutex.Lock()
+	defer interp.evalMutex.Unlock()
+	defer interp.recoverPanic(&err)
+	if interp.binPkg[importPath] != nil {
+		return "", fmt.Errorf("package %s already imported as binary symbols", importPath)
+	}
+	return interp.importSrcArchive(importPath, r, opts, NoTest)
+}
+
+// CompilePackage compiles the Go source package made of files, source codes
+// indexed by file name, and registers it under importPath, as ImportArchive
+// does for the files of an archive, so that hosts storing scripts elsewhere
+// than in files, such as in a database, can make them importable by other
+// interpreted code. It returns the package name. File names with a directory,
+// such as "sub/sub.go", declare the packages under importPath, such as
+// importPath + "/sub". The package must not be already imported: use
+// Invalidate to replace it.
+func (interp *Interpreter) CompilePackage(importPath string, files map[string]string) (_ string, err error) {
+	if importPath == "" || importPath == archiveRoot || isPathRelative(importPath) {
+		return "", fmt.Errorf("invalid import path %q", importPath)
+	}
+	interp.evalMutex.Lock()
+	defer interp.evalMutex.Unlock()
+	defer interp.recoverPanic(&err)
+	if interp.binPkg[importPath] != nil {
+		return "", fmt.Errorf("package %s already imported as binary symbols", importPath)
+	}
+	if interp.srcPkg[importPath] != nil {
+		return "", fmt.Errorf("package %s already imported", importPath)
+	}
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	list := make([]archiveFile, len(names))
+	for i, name := range names {
+		list[i] = archiveFile{name: name, data: []byte(files[name])}
+	}
+	interp.resetQuotas()
+	return interp.importArchivePkg(newArchiveFS(importPath, list), ".", importPath, "", NoTest)
+}
+
+// ArchiveFormatOf returns the format of an archive from its file name
+// extension: .zip, .tar, .tar.gz or .tgz, or ArchiveDetect if unknown.
+func ArchiveFormatOf(name string) ArchiveFormat {
+	switch name = strings.ToLower(name); {
+	case strings.HasSuffix(name, ".zip"):
+		return ArchiveZip
+	case strings.HasSuffix(name, ".tar"):
+		return ArchiveTar
+	case strings.HasSuffix(name, ".tar.gz"), strings.HasSuffix(name, ".tgz"):
+		return ArchiveTarGz
+	}
+	return ArchiveDetect
+}
+
+// archiveFile is a regular file read from a source archive.
+type archiveFile struct {
+	name string
+	data []byte
+}
+
+// readArchive returns the regular files of the archive read from r, in archive
+// order. The format is detected from the first bytes if not specified.
+func readArchive(r io.Reader, format ArchiveFormat) ([]archiveFile, error) {
+	if format == ArchiveDetect {
+		br := bufio.NewReader(r)
+		var err error
+		if format, err = detectArchive(br); err != nil {
+			return nil, err
+		}
+		r = br
+	}
+
+	switch format {
+	case ArchiveZip:
+		return readZip(r)
+	case ArchiveTar:
+		return readTar(r)
+	case ArchiveTarGz:
+		zr, err := gzip.NewReader(r)
+		if err != nil {
+			return nil, err
+		}
+		return readTar(zr)
+	}
+	return nil, fmt.Errorf("invalid archive format %d", format)
+}
+
+// detectArchive returns the format of the archive read from br, from its magic
+// numbers.
+func detectArchive(br *bufio.Reader) (ArchiveFormat, error) {
+	// The tar magic is the largest, at offset 257 of the first header.
+	head, err := br.Peek(263)
+	if err != nil && err != io.EOF {
+		return ArchiveDetect, err
+	}
+	switch {
+	case bytes.HasPrefix(head, []byte("\x1f\x8b")):
+		return ArchiveTarGz, nil
+	case bytes.HasPrefix(head, []byte("PK\x03\x04")), bytes.HasPrefix(head, []byte("PK\x05\x06")):
+		return ArchiveZip, nil
+	case len(head) == 263 && bytes.HasPrefix(head[257:], []byte("ustar")):
+		return ArchiveTar, nil
+	}
+	return ArchiveDetect, errors.New("unknown archive format")
+}
+
+func readTar(r io.Reader) ([]archiveFile, error) {
+	var files []archiveFile
+	tr := tar.NewReader(r)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			return files, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("not a tar file, %v", err)
+		}
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+		data, err := ioutil.ReadAll(tr)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, archiveFile{name: header.Name, data: data})
+	}
+}
+
+func readZip(r io.Reader) ([]archiveFile, error) {
+	// The zip central directory is at the end of the archive, which must be
+	// read entirely.
+	buf, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	zr, err := zip.NewReader(bytes.NewReader(buf), int64(len(buf)))
+	if err != nil {
+		return nil, fmt.Errorf("not a zip file, %v", err)
+	}
+
+	var files []archiveFile
+	for _, f := range zr.File {
+		if !f.Mode().IsRegular() {
+			continue
+		}
+		rc, err := f.Open()
+		if err != nil {
+			return nil, err
+		}
+		data, err := ioutil.ReadAll(rc)
+		rc.Close()
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, archiveFile{name: f.Name, data: data})
+	}
+	return files, nil
+}
+
+// archiveFS is the directory tree of a source archive, whose packages import
+// each other. See ImportArchive.
+type archiveFS struct {
+	mount  string              // import path of the root directory
+	module string              // module path declared by the go.mod file at the root, if any
+	dirs   map[string][]string // file names, in archive order, indexed by directory
+	data   map[string][]byte   // file contents, indexed by slash separated name
+}
+
+// newArchiveFS returns the directory tree of the archive files, whose root
+// package has the import path mount. Files out of the archive root are
+// ignored.
+func newArchiveFS(mount string, files []archiveFile) *archiveFS {
+	a := &archiveFS{mount: mount, dirs: map[string][]string{}, data: map[string][]byte{}}
+	for _, f := range files {
+		name := path.Clean(strings.TrimPrefix(f.name, "/"))
+		if name == ".." || strings.HasPrefix(name, "../") {
+			continue
+		}
+		if _, ok := a.data[name]; !ok {
+			dir := path.Dir(name)
+			a.dirs[dir] = append(a.dirs[dir], path.Base(name))
+		}
+		a.data[name] = f.data
+	}
+	if mod, ok := a.data["go.mod"]; ok {
+		a.module = modulePath(mod)
+	}
+	return a
+}
+
+// pkgDir returns the directory of the archive containing the package
+// importPath, and true, or false if the archive does not contain it.
+func (a *archiveFS) pkgDir(importPath string) (string, bool) {
+	for _, prefix := range []string{a.mount, unversioned(a.mount), a.module} {
+		if prefix == "" || prefix == archiveRoot {
+			continue
+		}
+		if importPath == prefix {
+			return ".", a.hasSources(".")
+		}
+		if strings.HasPrefix(importPath, prefix+"/") {
+			dir := strings.TrimPrefix(importPath, prefix+"/")
+			return dir, a.hasSources(dir)
+		}
+	}
+	dir := path.Join("vendor", importPath)
+	return dir, a.hasSources(dir)
+}
+
+// pkgPath returns the import path registering the package importPath of the
+// archive directory dir: the path in the namespace of the archive if mounted
+// at a versioned import path, or importPath itself.
+func (a *archiveFS) pkgPath(dir, importPath string) string {
+	if unversioned(a.mount) == a.mount {
+		return importPath
+	}
+	if dir == "." {
+		return a.mount
+	}
+	return a.mount + "/" + dir
+}
+
+// unversioned returns importPath without its version suffix, as
+// "example.com/plugin" for "example.com/plugin@v1".
+func unversioned(importPath string) string {
+	i := strings.LastIndex(importPath, "@")
+	if i < 0 || strings.Contains(importPath[i:], "/") {
+		return importPath
+	}
+	return importPath[:i]
+}
+
+// hasSources returns true if the archive directory dir contains Go files.
+func (a *archiveFS) hasSources(dir string) bool {
+	for _, name := range a.dirs[dir] {
+		if strings.HasSuffix(name, ".go") {
+			return true
+		}
+	}
+	return false
+}
+
+// archiveDir returns the archive of the package importer and the directory
+// holding the package importPath in it, or a nil archive if importer was not
+// imported from an archive containing importPath.
+func (interp *Interpreter) archiveDir(importer, importPath string) (*archiveFS, string) {
+	a := interp.archivePkgs[importer]
+	if a == nil {
+		return nil, ""
+	}
+	dir, ok := a.pkgDir(importPath)
+	if !ok {
+		return nil, ""
+	}
+	return a, dir
+}
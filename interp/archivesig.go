@@ -0,0 +1,226 @@
+package interp
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/bits"
+	"strings"
+)
+
+// ErrArchiveIntegrity is wrapped by the errors of archives not matching the
+// digest or signature of their ArchiveOptions.
+var ErrArchiveIntegrity = errors.New("archive integrity check failed")
+
+// verifyArchive checks that the archive content b matches the digest and the
+// signature of opts, if any.
+func verifyArchive(b []byte, opts ArchiveOptions) error {
+	if opts.Digest != "" {
+		h, sum, err := parseDigest(opts.Digest)
+		if err != nil {
+			return err
+		}
+		_, _ = h.Write(b)
+		if got := hex.EncodeToString(h.Sum(nil)); got != sum {
+			return fmt.Errorf("%w: digest mismatch, got %s", ErrArchiveIntegrity, got)
+		}
+	}
+	if len(opts.Signature) == 0 {
+		return nil
+	}
+	if len(opts.PublicKey) == 0 {
+		return errors.New("archive signature without public key")
+	}
+	key, keyID, err := parsePublicKey(opts.PublicKey)
+	if err != nil {
+		return err
+	}
+	if len(opts.Signature) == ed25519.SignatureSize {
+		if !ed25519.Verify(key, b, opts.Signature) {
+			return fmt.Errorf("%w: invalid signature", ErrArchiveIntegrity)
+		}
+		return nil
+	}
+	return verifyMinisign(b, opts.Signature, key, keyID)
+}
+
+// parsePublicKey returns the ed25519 public key of k, a raw key or a minisign
+// public key, as its file or its base64 encoded line, and its minisign key
+// ID, if any.
+func parsePublicKey(k []byte) (ed25519.PublicKey, []byte, error) {
+	if len(k) == ed25519.PublicKeySize {
+		return ed25519.PublicKey(k), nil, nil
+	}
+	lines := minisignLines(k)
+	if len(lines) == 0 {
+		return nil, nil, errors.New("invalid public key")
+	}
+	b, err := base64.StdEncoding.DecodeString(lines[len(lines)-1])
+	if err != nil || len(b) != 2+8+ed25519.PublicKeySize || string(b[:2]) != "Ed" {
+		return nil, nil, errors.New("invalid minisign public key")
+	}
+	return ed25519.PublicKey(b[10:]), b[2:10], nil
+}
+
+// verifyMinisign checks the minisign signature file sig of b with key, whose
+// minisign key ID is keyID if not nil. The signature is over b for the
+// legacy "Ed" algorithm, or over its BLAKE2b-512 hash for the "ED" one. The
+// trusted comment is verified by the global signature.
+func verifyMinisign(b, sig []byte, key ed25519.PublicKey, keyID []byte) error {
+	var lines []string
+	for _, l := range strings.Split(string(sig), "\n") {
+		if l = strings.TrimRight(l, "\r"); l != "" {
+			lines = append(lines, l)
+		}
+	}
+	if len(lines) != 4 || !strings.HasPrefix(lines[0], "untrusted comment:") || !strings.HasPrefix(lines[2], "trusted comment: ") {
+		return errors.New("invalid minisign signature")
+	}
+	s, err := base64.StdEncoding.DecodeString(lines[1])
+	if err != nil || len(s) != 2+8+ed25519.SignatureSize {
+		return errors.New("invalid minisign signature")
+	}
+	global, err := base64.StdEncoding.DecodeString(lines[3])
+	if err != nil || len(global) != ed25519.SignatureSize {
+		return errors.New("invalid minisign global signature")
+	}
+	if keyID != nil && !bytes.Equal(s[2:10], keyID) {
+		return fmt.Errorf("%w: signature key ID %X, want %X", ErrArchiveIntegrity, reverse(s[2:10]), reverse(keyID))
+	}
+
+	msg := b
+	switch string(s[:2]) {
+	case "Ed":
+	case "ED":
+		sum := blake2b512(b)
+		msg = sum[:]
+	default:
+		return fmt.Errorf("unsupported minisign signature algorithm %q", s[:2])
+	}
+	if !ed25519.Verify(key, msg, s[10:]) {
+		return fmt.Errorf("%w: invalid signature", ErrArchiveIntegrity)
+	}
+	comment := strings.TrimPrefix(lines[2], "trusted comment: ")
+	if !ed25519.Verify(key, append(append([]byte{}, s[10:]...), comment...), global) {
+		return fmt.Errorf("%w: invalid trusted comment signature", ErrArchiveIntegrity)
+	}
+	return nil
+}
+
+// minisignLines returns the non empty lines of a minisign key file, without
+// its untrusted comment.
+func minisignLines(b []byte) []string {
+	var lines []string
+	for _, l := range strings.Split(string(b), "\n") {
+		if l = strings.TrimSpace(l); l != "" && !strings.HasPrefix(l, "untrusted comment:") {
+			lines = append(lines, l)
+		}
+	}
+	return lines
+}
+
+// reverse returns the bytes of a little endian minisign key ID, in the
+// order displayed by minisign.
+func reverse(b []byte) []byte {
+	r := make([]byte, len(b))
+	for i, c := range b {
+		r[len(b)-1-i] = c
+	}
+	return r
+}
+
+// blake2bIV is the initialization vector of BLAKE2b, the one of SHA-512.
+var blake2bIV = [8]uint64{
+	0x6a09e667f3bcc908, 0xbb67ae8584caa73b, 0x3c6ef372fe94f82b, 0xa54ff53a5f1d36f1,
+	0x510e527fade682d1, 0x9b05688c2b3e6c1f, 0x1f83d9abfb41bd6b, 0x5be0cd19137e2179,
+}
+
+// blake2bSigma are the message word permutations of the BLAKE2b rounds.
+var blake2bSigma = [10][16]byte{
+	{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15},
+	{14, 10, 4, 8, 9, 15, 13, 6, 1, 12, 0, 2, 11, 7, 5, 3},
+	{11, 8, 12, 0, 5, 2, 15, 13, 10, 14, 3, 6, 7, 1, 9, 4},
+	{7, 9, 3, 1, 13, 12, 11, 14, 2, 6, 5, 10, 4, 0, 15, 8},
+	{9, 0, 5, 7, 2, 4, 10, 15, 14, 1, 11, 12, 6, 8, 3, 13},
+	{2, 12, 6, 10, 0, 11, 8, 3, 4, 13, 7, 5, 15, 14, 1, 9},
+	{12, 5, 1, 15, 14, 13, 4, 10, 0, 7, 6, 3, 9, 2, 8, 11},
+	{13, 11, 7, 14, 12, 1, 3, 9, 5, 0, 15, 4, 8, 6, 2, 10},
+	{6, 15, 14, 9, 11, 3, 0, 8, 12, 2, 13, 7, 1, 4, 10, 5},
+	{10, 2, 8, 4, 7, 6, 1, 5, 15, 11, 9, 14, 3, 12, 13, 0},
+}
+
+// blake2b512 returns the unkeyed BLAKE2b-512 hash of b (RFC 7693), used by
+// minisign signatures, and missing from the standard library.
+func blake2b512(b []byte) [64]byte {
+	h := blake2bIV
+	h[0] ^= 0x01010000 | 64
+
+	var block [128]byte
+	var t uint64
+	for {
+		n := copy(block[:], b)
+		b = b[n:]
+		t += uint64(n)
+		final := len(b) == 0
+		if final {
+			for i := n; i < len(block); i++ {
+				block[i] = 0
+			}
+		}
+		blake2bCompress(&h, &block, t, final)
+		if final {
+			break
+		}
+	}
+
+	var sum [64]byte
+	for i, v := range h {
+		binary.LittleEndian.PutUint64(sum[8*i:], v)
+	}
+	return sum
+}
+
+// blake2bCompress mixes the 128 bytes block into the state h, t being the
+// number of bytes hashed so far, including the block.
+func blake2bCompress(h *[8]uint64, block *[128]byte, t uint64, final bool) {
+	var m [16]uint64
+	for i := range m {
+		m[i] = binary.LittleEndian.Uint64(block[8*i:])
+	}
+	var v [16]uint64
+	copy(v[:8], h[:])
+	copy(v[8:], blake2bIV[:])
+	v[12] ^= t
+	if final {
+		v[14] = ^v[14]
+	}
+
+	g := func(a, b, c, d int, x, y uint64) {
+		v[a] += v[b] + x
+		v[d] = bits.RotateLeft64(v[d]^v[a], -32)
+		v[c] += v[d]
+		v[b] = bits.RotateLeft64(v[b]^v[c], -24)
+		v[a] += v[b] + y
+		v[d] = bits.RotateLeft64(v[d]^v[a], -16)
+		v[c] += v[d]
+		v[b] = bits.RotateLeft64(v[b]^v[c], -63)
+	}
+	for r := 0; r < 12; r++ {
+		s := &blake2bSigma[r%10]
+		g(0, 4, 8, 12, m[s[0]], m[s[1]])
+		g(1, 5, 9, 13, m[s[2]], m[s[3]])
+		g(2, 6, 10, 14, m[s[4]], m[s[5]])
+		g(3, 7, 11, 15, m[s[6]], m[s[7]])
+		g(0, 5, 10, 15, m[s[8]], m[s[9]])
+		g(1, 6, 11, 12, m[s[10]], m[s[11]])
+		g(2, 7, 8, 13, m[s[12]], m[s[13]])
+		g(3, 4, 9, 14, m[s[14]], m[s[15]])
+	}
+	for i := range h {
+		h[i] ^= v[i] ^ v[i+8]
+	}
+}
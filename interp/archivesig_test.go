@@ -0,0 +1,103 @@
+package interp
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestBlake2b512(t *testing.T) {
+	for _, test := range []struct{ in, want string }{
+		{"", "786a02f742015903c6c6fd852552d272912f4740e15847618a86e217f71f5419d25e1031afee585313896444934eb04b903a685b1448b755d56f701afe9be2ce"},
+		{"abc", "ba80a53f981c4d0d6a2797b69f12f6e94c212f14685ac4b74b12bb6fdbffa2d17d87c5392aab792dc252d5de4533cc9518d38aa8dbf1925ab92386edd4009923"},
+	} {
+		if got := blake2b512([]byte(test.in)); hex.EncodeToString(got[:]) != test.want {
+			t.Errorf("blake2b512(%q) = %x, want %s", test.in, got, test.want)
+		}
+	}
+	// Messages of one or several blocks hash consistently with their
+	// boundaries.
+	long := bytes.Repeat([]byte("x"), 256)
+	if blake2b512(long[:128]) == blake2b512(long[:129]) || blake2b512(long) == blake2b512(long[:255]) {
+		t.Error("unexpected collision")
+	}
+}
+
+func TestArchiveVerification(t *testing.T) {
+	_, archive, _ := makeArchives(t, map[string]string{
+		"plugin.go": "package plugin\n\nfunc Hello() string { return \"hello\" }\n",
+	})
+	sum := sha256.Sum256(archive)
+	digest := "sha256:" + hex.EncodeToString(sum[:])
+
+	pub, priv, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	otherPub, _, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyID := []byte{1, 2, 3, 4, 5, 6, 7, 8}
+	minisignKey := func(pub ed25519.PublicKey, id []byte) []byte {
+		b := append(append([]byte("Ed"), id...), pub...)
+		return []byte("untrusted comment: minisign public key\n" + base64.StdEncoding.EncodeToString(b) + "\n")
+	}
+	minisign := func(alg, comment string) []byte {
+		msg := archive
+		if alg == "ED" {
+			h := blake2b512(archive)
+			msg = h[:]
+		}
+		sig := ed25519.Sign(priv, msg)
+		global := ed25519.Sign(priv, append(append([]byte{}, sig...), "timestamp:1"...))
+		s := append(append([]byte(alg), keyID...), sig...)
+		return []byte("untrusted comment: signature\n" + base64.StdEncoding.EncodeToString(s) + "\ntrusted comment: " + comment + "\n" + base64.StdEncoding.EncodeToString(global) + "\n")
+	}
+
+	for _, test := range []struct {
+		desc string
+		opts ArchiveOptions
+		err  string // expected error, wrapping ErrArchiveIntegrity if it contains "check failed"
+	}{
+		{desc: "digest", opts: ArchiveOptions{Digest: digest}},
+		{desc: "digest mismatch", opts: ArchiveOptions{Digest: "sha256:" + strings.Repeat("0", 64)}, err: "check failed: digest mismatch"},
+		{desc: "raw signature", opts: ArchiveOptions{Digest: digest, Signature: ed25519.Sign(priv, archive), PublicKey: pub}},
+		{desc: "raw signature, wrong key", opts: ArchiveOptions{Signature: ed25519.Sign(priv, archive), PublicKey: otherPub}, err: "check failed: invalid signature"},
+		{desc: "no public key", opts: ArchiveOptions{Signature: ed25519.Sign(priv, archive)}, err: "without public key"},
+		{desc: "minisign", opts: ArchiveOptions{Signature: minisign("Ed", "timestamp:1"), PublicKey: minisignKey(pub, keyID)}},
+		{desc: "minisign prehashed", opts: ArchiveOptions{Signature: minisign("ED", "timestamp:1"), PublicKey: minisignKey(pub, keyID)}},
+		{desc: "minisign raw key", opts: ArchiveOptions{Signature: minisign("ED", "timestamp:1"), PublicKey: pub}},
+		{desc: "minisign key ID", opts: ArchiveOptions{Signature: minisign("ED", "timestamp:1"), PublicKey: minisignKey(pub, make([]byte, 8))}, err: "check failed: signature key ID 0807060504030201"},
+		{desc: "minisign trusted comment", opts: ArchiveOptions{Signature: minisign("ED", "timestamp:2"), PublicKey: minisignKey(pub, keyID)}, err: "check failed: invalid trusted comment signature"},
+		{desc: "minisign wrong key", opts: ArchiveOptions{Signature: minisign("Ed", "timestamp:1"), PublicKey: minisignKey(otherPub, keyID)}, err: "check failed: invalid signature"},
+	} {
+		t.Run(test.desc, func(t *testing.T) {
+			i := New(Options{})
+			_, err := i.ImportArchive("example.com/plugin", bytes.NewReader(archive), test.opts)
+			if test.err == "" {
+				if err != nil {
+					t.Fatal(err)
+				}
+				if _, err := i.Eval(`import "example.com/plugin"`); err != nil {
+					t.Fatal(err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), test.err) {
+				t.Fatalf("got error %v, want %q", err, test.err)
+			}
+			if strings.Contains(test.err, "check failed") != errors.Is(err, ErrArchiveIntegrity) {
+				t.Fatalf("unexpected wrapping of ErrArchiveIntegrity by %v", err)
+			}
+			if i.srcPkg["example.com/plugin"] != nil {
+				t.Fatal("refused archive imported")
+			}
+		})
+	}
+}
@@ -60,6 +60,7 @@ const (
 	funcDecl
 	funcLit
 	funcType
+	genericDecl
 	goStmt
 	gotoStmt
 	identExpr
@@ -140,6 +141,7 @@ var kinds = [...]string{
 	funcDecl:          "funcDecl",
 	funcType:          "funcType",
 	funcLit:           "funcLit",
+	genericDecl:       "genericDecl",
 	goStmt:            "goStmt",
 	gotoStmt:          "gotoStmt",
 	identExpr:         "identExpr",
@@ -332,15 +334,6 @@ func isAssignAction(a action) bool {
 	return false
 }
 
-func (interp *Interpreter) firstToken(src string) token.Token {
-	var s scanner.Scanner
-	file := interp.fset.AddFile("", interp.fset.Base(), len(src))
-	s.Init(file, []byte(src), nil, 0)
-
-	_, tok, _ := s.Scan()
-	return tok
-}
-
 func ignoreError(err error, src string) bool {
 	se, ok := err.(scanner.ErrorList)
 	if !ok {
@@ -353,7 +346,15 @@ func ignoreError(err error, src string) bool {
 }
 
 func wrapInMain(src string) string {
-	return fmt.Sprintf("package main; func main() {%s}", src)
+	return fmt.Sprintf("package main; func main() {%s%s}", lineDirective(1), src)
+}
+
+// lineDirective returns a line directive comment which maps the position
+// immediately following it to the given line and to the first column of the
+// current source file. It is inserted after the code added to wrap incremental
+// sources, so positions reported in errors and traces refer to the user input.
+func lineDirective(line int) string {
+	return fmt.Sprintf("/*line :%d:1*/", line)
 }
 
 // Note: no type analysis is performed at this stage, it is done in pre-order
@@ -367,17 +368,30 @@ func (interp *Interpreter) ast(src, name string, inc bool) (string, *node, error
 	var inFunc bool
 	mode := parser.DeclarationErrors
 
+	src, err := interp.rewrite(src)
+	if err != nil {
+		return "", nil, err
+	}
+
 	// Allow incremental parsing of declarations or statements, by inserting
 	// them in a pseudo file package or function. Those statements or
 	// declarations will be always evaluated in the global scope.
 	var tok token.Token
+	var stmts string
 	if inc {
-		tok = interp.firstToken(src)
+		tok = firstToken(interp.fset, src)
+		if tok != token.PACKAGE && interp.wrapStatements {
+			// Declarations mixed with statements, parsed apart.
+			if decls, s := splitScript(src); s != "" {
+				src, stmts = decls, wrapInMain(s)
+				tok = token.IMPORT
+			}
+		}
 		switch tok {
 		case token.PACKAGE:
 			// nothing to do.
 		case token.CONST, token.FUNC, token.IMPORT, token.TYPE, token.VAR:
-			src = "package main;" + src
+			src = "package main;" + lineDirective(1) + src
 		default:
 			inFunc = true
 			src = wrapInMain(src)
@@ -403,19 +417,58 @@ func (interp *Interpreter) ast(src, name string, inc bool) (string, *node, error
 		// do not lose initial error, in case retrying fails.
 		initialError := err
 		// retry with default source code "wrapping", in the main function scope.
-		src := wrapInMain(strings.TrimPrefix(src, "package main;"))
+		src = wrapInMain(strings.TrimPrefix(src, "package main;"+lineDirective(1)))
 		f, err = parser.ParseFile(interp.fset, name, src, mode)
 		if err != nil {
 			return "", nil, initialError
 		}
 	}
+	interp.fileSrc.Store(interp.fset.File(f.Pos()), src)
+
+	if stmts != "" {
+		m, err := parser.ParseFile(interp.fset, name, stmts, mode)
+		if err != nil {
+			return "", nil, err
+		}
+		interp.fileSrc.Store(interp.fset.File(m.Pos()), stmts)
+		f.Decls = append(f.Decls, m.Decls...)
+		inFunc = true
+	}
 
 	setYaegiTags(&interp.context, f.Comments)
+	if interp.coverage != nil && !inc {
+		interp.coverage.addFile(interp.fset, f)
+	}
 
+	pkgName, root, err := interp.astTree(f, nil)
+	if inFunc {
+		// Incremental parsing: statements were inserted in a pseudo function.
+		// Set root to function body so its statements are evaluated in global scope.
+		// The global declarations preceding it, if any, are moved there.
+		decls := root.child[1 : len(root.child)-1]
+		root = root.child[len(root.child)-1].child[3]
+		root.anc = nil
+		for _, d := range decls {
+			d.anc = root
+		}
+		root.child = append(decls, root.child...)
+	}
+	if pkgName == "" {
+		return "", root, errors.New("no package name found")
+	}
+	return pkgName, root, err
+}
+
+// astTree converts the Go AST f into the AST of the interpreter, and returns
+// the package name, if f is a file, and the root node. The nodes of f present
+// in subst are replaced by an identifier of the given name, or skipped if the
+// name is empty, as for the instantiation of generic declarations.
+func (interp *Interpreter) astTree(f ast.Node, subst map[ast.Node]string) (string, *node, error) {
 	var root *node
 	var anc astNode
 	var st nodestack
 	var pkgName string
+	var err error
 
 	addChild := func(root **node, anc astNode, pos token.Pos, kind nkind, act action) *node {
 		var i interface{}
@@ -463,6 +516,12 @@ func (interp *Interpreter) ast(src, name string, inc bool) (string, *node, error
 		if nod != nil {
 			pos = nod.Pos()
 		}
+		if name, ok := subst[nod]; ok {
+			if name != "" {
+				addChild(&root, anc, pos, identExpr, aNop).ident = name
+			}
+			return false
+		}
 		switch a := nod.(type) {
 		case nil:
 			anc = st.pop()
@@ -675,6 +734,11 @@ func (interp *Interpreter) ast(src, name string, inc bool) (string, *node, error
 			st.push(addChild(&root, anc, pos, kind, aNop), nod)
 
 		case *ast.FuncDecl:
+			if subst == nil && isGenericDecl(a) {
+				// Generic declarations are kept as Go AST until instantiated.
+				addChild(&root, anc, pos, genericDecl, aNop).val = &generic{decl: a}
+				return false
+			}
 			n := addChild(&root, anc, pos, funcDecl, aNop)
 			if a.Recv == nil {
 				// function is not a method, create an empty receiver list
@@ -712,7 +776,7 @@ func (interp *Interpreter) ast(src, name string, inc bool) (string, *node, error
 			n := addChild(&root, anc, pos, identExpr, aNop)
 			n.ident = a.Name
 			st.push(n, nod)
-			if n.anc.kind == defineStmt && n.anc.nright == 0 {
+			if n.anc != nil && n.anc.kind == defineStmt && n.anc.nright == 0 {
 				// Implicit assign expression (in a ConstDecl block).
 				// Clone assign source and type from previous
 				a := n.anc
@@ -759,6 +823,11 @@ func (interp *Interpreter) ast(src, name string, inc bool) (string, *node, error
 		case *ast.IndexExpr:
 			st.push(addChild(&root, anc, pos, indexExpr, aGetIndex), nod)
 
+		case *ast.IndexListExpr:
+			// Instantiation of a generic function or type with several type
+			// arguments.
+			st.push(addChild(&root, anc, pos, indexExpr, aGetIndex), nod)
+
 		case *ast.InterfaceType:
 			st.push(addChild(&root, anc, pos, interfaceType, aNop), nod)
 
@@ -821,6 +890,10 @@ func (interp *Interpreter) ast(src, name string, inc bool) (string, *node, error
 			st.push(addChild(&root, anc, pos, typeAssertExpr, aTypeAssert), nod)
 
 		case *ast.TypeSpec:
+			if subst == nil && isGenericDecl(a) {
+				addChild(&root, anc, pos, genericDecl, aNop).val = &generic{decl: a}
+				return false
+			}
 			st.push(addChild(&root, anc, pos, typeSpec, aNop), nod)
 
 		case *ast.TypeSwitchStmt:
@@ -884,15 +957,6 @@ func (interp *Interpreter) ast(src, name string, inc bool) (string, *node, error
 		}
 		return true
 	})
-	if inFunc {
-		// Incremental parsing: statements were inserted in a pseudo function.
-		// Set root to function body so its statements are evaluated in global scope.
-		root = root.child[1].child[3]
-		root.anc = nil
-	}
-	if pkgName == "" {
-		return "", root, errors.New("no package name found")
-	}
 	return pkgName, root, err
 }
 
@@ -0,0 +1,228 @@
+package interp
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// ErrAuditChain is the error wrapped by VerifyAudit for a record which is
+// modified, removed, inserted or reordered in an audit journal.
+var ErrAuditChain = errors.New("audit chain broken")
+
+// Audit is the journaling of the evaluations and source imports of an
+// interpreter, see Options.Audit.
+type Audit struct {
+	// Sink stores the records of the journal.
+	Sink AuditSink
+
+	// Identity is the identity of the caller recorded by default, if not
+	// provided by the context of the evaluation, see AuditIdentity.
+	Identity string
+
+	// Last, if not nil, is the last record of an existing journal, continued
+	// by the records of the interpreter.
+	Last *AuditRecord
+}
+
+// An AuditSink stores the records of an audit journal. Append is called in
+// the order of the records, which are chained by their hash, and never
+// concurrently. An error of Append is returned by the journaled evaluation or
+// import, if it succeeded, as the record is then lost.
+type AuditSink interface {
+	Append(r AuditRecord) error
+}
+
+// AuditRecord is a record of an audit journal: an evaluation, such as a call
+// of Eval or EvalPath, or the import of a source package, including the ones
+// imported by interpreted code. Each record includes the hash of the previous
+// one, so that a modification of the journal is detected by VerifyAudit.
+type AuditRecord struct {
+	Seq        uint64    `json:"seq"`             // sequence number, from 1
+	Op         string    `json:"op"`              // "eval" or "import"
+	Name       string    `json:"name"`            // source name for an evaluation, import path for an import
+	Identity   string    `json:"identity"`        // identity of the caller, see AuditIdentity
+	SourceHash string    `json:"source_hash"`     // hex SHA-256 of the source code, of all the files for an import
+	Start      time.Time `json:"start"`           // start of the operation
+	End        time.Time `json:"end"`             // end of the operation
+	Err        string    `json:"error,omitempty"` // error of the operation, if any
+	Steps      int64     `json:"steps,omitempty"` // executed nodes, counted if Options.MaxSteps or Options.Quotas is set
+	Files      int       `json:"files,omitempty"` // number of source files compiled, for an import
+	Prev       string    `json:"prev"`            // hash of the previous record, empty for the first one
+	Hash       string    `json:"hash"`            // hex SHA-256 of the record, including Prev
+}
+
+// digest returns the hash of the record r, computed from all its fields but
+// Hash.
+func (r *AuditRecord) digest() string {
+	h := sha256.New()
+	fmt.Fprintf(h, "%d\n%q\n%q\n%q\n%q\n%s\n%s\n%q\n%d\n%d\n%q\n", r.Seq, r.Op, r.Name, r.Identity, r.SourceHash,
+		r.Start.UTC().Format(time.RFC3339Nano), r.End.UTC().Format(time.RFC3339Nano), r.Err, r.Steps, r.Files, r.Prev)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// VerifyAudit checks the hash chain of the records of an audit journal, in
+// order, and returns an error wrapping ErrAuditChain for the first record not
+// matching its hash or not following the previous one. The first record may
+// continue an earlier journal.
+func VerifyAudit(records []AuditRecord) error {
+	for i, r := range records {
+		if r.Hash != r.digest() {
+			return fmt.Errorf("record %d: %w: hash mismatch", r.Seq, ErrAuditChain)
+		}
+		if i == 0 {
+			continue
+		}
+		if p := records[i-1]; r.Prev != p.Hash || r.Seq != p.Seq+1 {
+			return fmt.Errorf("record %d: %w: not following record %d", r.Seq, ErrAuditChain, p.Seq)
+		}
+	}
+	return nil
+}
+
+// AuditWriter returns an AuditSink writing the records to w as JSON, one
+// per line, as read by ReadAudit. The writes of w must be appended to the
+// journal, such as by a file opened with os.O_APPEND.
+func AuditWriter(w io.Writer) AuditSink {
+	return auditWriter{w}
+}
+
+type auditWriter struct{ w io.Writer }
+
+func (a auditWriter) Append(r AuditRecord) error {
+	b, err := json.Marshal(r)
+	if err != nil {
+		return err
+	}
+	_, err = a.w.Write(append(b, '\n'))
+	return err
+}
+
+// ReadAudit reads the records of an audit journal written by AuditWriter.
+func ReadAudit(r io.Reader) ([]AuditRecord, error) {
+	var records []AuditRecord
+	s := bufio.NewScanner(r)
+	s.Buffer(nil, 1<<20)
+	for line := 1; s.Scan(); line++ {
+		if len(s.Bytes()) == 0 {
+			continue
+		}
+		var rec AuditRecord
+		if err := json.Unmarshal(s.Bytes(), &rec); err != nil {
+			return nil, fmt.Errorf("audit:%d: %v", line, err)
+		}
+		records = append(records, rec)
+	}
+	return records, s.Err()
+}
+
+type auditIdentityKey struct{}
+
+// AuditIdentity returns a copy of ctx providing the identity of the caller,
+// recorded in the audit journal of the evaluations with this context, such
+// as by EvalWithContext, instead of Audit.Identity.
+func AuditIdentity(ctx context.Context, identity string) context.Context {
+	return context.WithValue(ctx, auditIdentityKey{}, identity)
+}
+
+// auditLog is the state of the audit journal of an interpreter.
+type auditLog struct {
+	sink     AuditSink
+	identity string
+
+	mu   sync.Mutex
+	seq  uint64
+	prev string
+	ctx  string // identity provided by the context of the current evaluation
+}
+
+func newAuditLog(a *Audit) *auditLog {
+	l := &auditLog{sink: a.Sink, identity: a.Identity}
+	if a.Last != nil {
+		l.seq, l.prev = a.Last.Seq, a.Last.Hash
+	}
+	return l
+}
+
+// setContext sets the identity provided by ctx for the next evaluations, and
+// returns a function restoring the previous one.
+func (l *auditLog) setContext(ctx context.Context) func() {
+	id, _ := ctx.Value(auditIdentityKey{}).(string)
+	l.mu.Lock()
+	prev := l.ctx
+	l.ctx = id
+	l.mu.Unlock()
+	return func() {
+		l.mu.Lock()
+		l.ctx = prev
+		l.mu.Unlock()
+	}
+}
+
+// append completes the record r, chains it and appends it to the sink. The
+// error of the sink is returned in *err if no other error occurred.
+func (l *auditLog) append(r AuditRecord, err *error) {
+	r.End = time.Now()
+	if *err != nil {
+		r.Err = (*err).Error()
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	r.Identity = l.identity
+	if l.ctx != "" {
+		r.Identity = l.ctx
+	}
+	r.Seq, r.Prev = l.seq+1, l.prev
+	r.Hash = r.digest()
+	if e := l.sink.Append(r); e != nil {
+		if *err == nil {
+			*err = fmt.Errorf("audit: %w", e)
+		}
+		return
+	}
+	l.seq, l.prev = r.Seq, r.Hash
+}
+
+// auditEval returns the function journaling the evaluation of src, with its
+// error, if Options.Audit is set.
+func (interp *Interpreter) auditEval(src string) func(err *error) {
+	if interp.audit == nil {
+		return func(*error) {}
+	}
+	sum := sha256.Sum256([]byte(src))
+	r := AuditRecord{Op: "eval", Name: interp.name, SourceHash: hex.EncodeToString(sum[:]), Start: time.Now()}
+	return func(err *error) {
+		if q := interp.quotas; q != nil && q.countSteps() {
+			r.Steps = atomic.LoadInt64(&q.steps)
+		}
+		interp.audit.append(r, err)
+	}
+}
+
+// auditImport journals the import of a source package, timed by t.
+func (interp *Interpreter) auditImport(t *importTimer, err *error) {
+	interp.mutex.RLock()
+	sources := append([]srcFile(nil), interp.sources[t.stats.Path]...)
+	interp.mutex.RUnlock()
+	sort.Slice(sources, func(i, j int) bool { return sources[i].name < sources[j].name })
+
+	var sourceHash string
+	if len(sources) > 0 {
+		h := sha256.New()
+		for _, s := range sources {
+			fmt.Fprintf(h, "%q %d\n%s", s.name, len(s.src), s.src)
+		}
+		sourceHash = hex.EncodeToString(h.Sum(nil))
+	}
+	r := AuditRecord{Op: "import", Name: t.stats.Path, SourceHash: sourceHash, Start: t.start, Files: t.stats.Files}
+	interp.audit.append(r, err)
+}
@@ -0,0 +1,86 @@
+package interp
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+)
+
+type failingSink struct{}
+
+func (failingSink) Append(AuditRecord) error { return errors.New("disk full") }
+
+func TestAudit(t *testing.T) {
+	_, zipData, _ := makeArchives(t, map[string]string{
+		"plugin.go": "package plugin\n\nfunc Double(x int) int { return 2 * x }\n",
+	})
+
+	var journal bytes.Buffer
+	i := New(Options{MaxSteps: 1000, Audit: &Audit{Sink: AuditWriter(&journal), Identity: "host"}})
+	if _, err := i.ImportArchive("example.com/plugin", bytes.NewReader(zipData), ArchiveOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := i.Eval(`import "example.com/plugin"`); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := i.EvalWithContext(AuditIdentity(context.Background(), "alice"), "plugin.Double(2)"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := i.Eval("undefined()"); err == nil {
+		t.Fatal("got no error")
+	}
+
+	records, err := ReadAudit(bytes.NewReader(journal.Bytes()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, r := range records {
+		got = append(got, strings.Join([]string{r.Op, r.Name, r.Identity}, " "))
+	}
+	want := "import example.com/plugin host|eval _.go host|eval _.go alice|eval _.go host"
+	if strings.Join(got, "|") != want {
+		t.Fatalf("got %q, want %q", strings.Join(got, "|"), want)
+	}
+	if r := records[2]; r.Seq != 3 || r.Steps == 0 || r.Err != "" || r.End.Before(r.Start) || len(r.SourceHash) != 64 {
+		t.Errorf("unexpected record %+v", r)
+	}
+	if r := records[0]; r.Files != 1 || len(r.SourceHash) != 64 {
+		t.Errorf("unexpected import record %+v", r)
+	}
+	if !strings.Contains(records[3].Err, "undefined: undefined") {
+		t.Errorf("got error %q", records[3].Err)
+	}
+	if err := VerifyAudit(records); err != nil {
+		t.Fatal(err)
+	}
+
+	// The journal is continued by a new interpreter.
+	i = New(Options{Audit: &Audit{Sink: AuditWriter(&journal), Last: &records[len(records)-1]}})
+	if _, err := i.Eval("1"); err != nil {
+		t.Fatal(err)
+	}
+	if records, err = ReadAudit(bytes.NewReader(journal.Bytes())); err != nil {
+		t.Fatal(err)
+	}
+	if err := VerifyAudit(records); err != nil || len(records) != 5 {
+		t.Fatalf("got %d records, error %v", len(records), err)
+	}
+
+	tampered := append([]AuditRecord(nil), records...)
+	tampered[1].Identity = "mallory"
+	if err := VerifyAudit(tampered); !errors.Is(err, ErrAuditChain) || !strings.HasPrefix(err.Error(), "record 2:") {
+		t.Errorf("got error %v for a modified record", err)
+	}
+	removed := append(append([]AuditRecord(nil), records[:2]...), records[3:]...)
+	if err := VerifyAudit(removed); !errors.Is(err, ErrAuditChain) || !strings.HasPrefix(err.Error(), "record 4:") {
+		t.Errorf("got error %v for a removed record", err)
+	}
+
+	i = New(Options{Audit: &Audit{Sink: failingSink{}}})
+	if _, err := i.Eval("1"); err == nil || err.Error() != "audit: disk full" {
+		t.Errorf("got error %v, want the sink error", err)
+	}
+}
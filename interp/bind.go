@@ -0,0 +1,171 @@
+package interp
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"time"
+)
+
+// CallbackPolicy restricts the interpreted functions handed to the host by
+// Bind and EvalInto, so that a plugin can not make the host call functions of
+// unexpected signatures, or block it. See Options.Callbacks.
+type CallbackPolicy struct {
+	// Allow, if not empty, lists the function types which can be bound, such
+	// as reflect.TypeOf((func(context.Context, []byte) error)(nil)) or
+	// reflect.TypeOf(http.HandlerFunc(nil)). Binding a function to another
+	// type fails.
+	Allow []reflect.Type
+
+	// Timeout, if positive, limits the duration of each call of a bound
+	// function. A function whose first parameter is a context.Context
+	// receives a context with this deadline. When it expires, the call
+	// returns zero values and the context error as its last result if it is
+	// an error, or panics with the context error otherwise. The interpreted
+	// function is not interrupted, and runs until it returns, such as when
+	// it observes the end of its context.
+	Timeout time.Duration
+}
+
+var contextType = reflect.TypeOf((*context.Context)(nil)).Elem()
+
+// Bind sets the function pointed to by fnPtr to call the interpreted function
+// name, which can be qualified by the import path of a source package, as in
+// "foo/bar.Handler". The type of *fnPtr must match the signature of the
+// function, or be convertible from it, as http.HandlerFunc from
+// func(http.ResponseWriter, *http.Request). Otherwise, the returned error
+// describes the mismatch, and *fnPtr is not modified. The type of *fnPtr must
+// also be allowed by Options.Callbacks, which may limit the duration of the
+// calls.
+//
+// Function variables are not supported, use EvalInto instead.
+func (interp *Interpreter) Bind(name string, fnPtr interface{}) error {
+	rv := reflect.ValueOf(fnPtr)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() || rv.Elem().Kind() != reflect.Func {
+		return fmt.Errorf("bind %s: invalid destination %T: not a non nil pointer to a function", name, fnPtr)
+	}
+	v := rv.Elem()
+	t := v.Type()
+
+	if err := interp.allowCallback(t); err != nil {
+		return fmt.Errorf("bind %s: %v", name, err)
+	}
+	def, err := interp.lookupFunc(name)
+	if err != nil {
+		return fmt.Errorf("bind %s: %v", name, err)
+	}
+	fn := genFunctionWrapper(def)(interp.frame)
+	switch ft := fn.Type(); {
+	case ft.AssignableTo(t):
+	case ft.ConvertibleTo(t):
+		fn = fn.Convert(t)
+	default:
+		return fmt.Errorf("bind %s: cannot use %v as %v: %s", name, ft, t, funcMismatch(ft, t))
+	}
+	v.Set(interp.wrapCallback(fn))
+	return nil
+}
+
+// allowCallback returns an error if the function type t is not allowed by
+// Options.Callbacks.
+func (interp *Interpreter) allowCallback(t reflect.Type) error {
+	allow := interp.callbacks.Allow
+	if len(allow) == 0 {
+		return nil
+	}
+	for _, a := range allow {
+		if a == t {
+			return nil
+		}
+	}
+	return fmt.Errorf("callback type %v not allowed", t)
+}
+
+// wrapCallback returns the function fn, bound by the host, limited by the
+// timeout of Options.Callbacks if any.
+func (interp *Interpreter) wrapCallback(fn reflect.Value) reflect.Value {
+	timeout := interp.callbacks.Timeout
+	if timeout <= 0 {
+		return fn
+	}
+	t := fn.Type()
+	withContext := t.NumIn() > 0 && t.In(0) == contextType
+	withError := t.NumOut() > 0 && t.Out(t.NumOut()-1) == errorType
+
+	return reflect.MakeFunc(t, func(in []reflect.Value) []reflect.Value {
+		parent := context.Background()
+		if withContext && !in[0].IsNil() {
+			parent = in[0].Interface().(context.Context)
+		}
+		ctx, cancel := context.WithTimeout(parent, timeout)
+		defer cancel()
+		if withContext {
+			in[0] = reflect.ValueOf(&ctx).Elem()
+		}
+
+		type result struct {
+			out      []reflect.Value
+			panicked bool
+			value    interface{}
+		}
+		done := make(chan result, 1)
+		go func() {
+			r := result{panicked: true}
+			defer func() {
+				if r.panicked {
+					r.value = recover()
+				}
+				done <- r
+			}()
+			if t.IsVariadic() {
+				r.out = fn.CallSlice(in)
+			} else {
+				r.out = fn.Call(in)
+			}
+			r.panicked = false
+		}()
+
+		select {
+		case r := <-done:
+			if r.panicked {
+				panic(r.value)
+			}
+			return r.out
+		case <-ctx.Done():
+			err := ctx.Err()
+			if !withError {
+				panic(err)
+			}
+			out := make([]reflect.Value, t.NumOut())
+			for i := range out {
+				out[i] = reflect.Zero(t.Out(i))
+			}
+			out[len(out)-1] = reflect.ValueOf(&err).Elem()
+			return out
+		}
+	})
+}
+
+// funcMismatch returns the first difference between the function types got
+// and want.
+func funcMismatch(got, want reflect.Type) string {
+	switch {
+	case got.NumIn() != want.NumIn():
+		return fmt.Sprintf("got %d arguments, want %d", got.NumIn(), want.NumIn())
+	case got.NumOut() != want.NumOut():
+		return fmt.Sprintf("got %d results, want %d", got.NumOut(), want.NumOut())
+	case got.IsVariadic() != want.IsVariadic():
+		return "variadic mismatch"
+	}
+	for i := 0; i < got.NumIn(); i++ {
+		if got.In(i) != want.In(i) {
+			return fmt.Sprintf("argument %d is %v, want %v", i+1, got.In(i), want.In(i))
+		}
+	}
+	for i := 0; i < got.NumOut(); i++ {
+		if got.Out(i) != want.Out(i) {
+			return fmt.Sprintf("result %d is %v, want %v", i+1, got.Out(i), want.Out(i))
+		}
+	}
+	return "incompatible types"
+}
@@ -0,0 +1,152 @@
+package interp_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/traefik/yaegi/interp"
+)
+
+type binaryOp func(a, b int) int
+
+func TestBind(t *testing.T) {
+	i := interp.New(interp.Options{})
+	if _, err := i.Eval(`
+func add(a, b int) int { return a + b }
+
+func greet(name string, n int) string {
+	s := ""
+	for i := 0; i < n; i++ {
+		s += "hello " + name + " "
+	}
+	return s
+}`); err != nil {
+		t.Fatal(err)
+	}
+
+	var add func(int, int) int
+	if err := i.Bind("add", &add); err != nil {
+		t.Fatal(err)
+	}
+	if r := add(2, 3); r != 5 {
+		t.Errorf("got %d, want 5", r)
+	}
+
+	var op binaryOp
+	if err := i.Bind("main.add", &op); err != nil {
+		t.Fatal(err)
+	}
+	if r := op(4, 3); r != 7 {
+		t.Errorf("got %d, want 7", r)
+	}
+
+	var greet func(string, int) string
+	if err := i.Bind("greet", &greet); err != nil {
+		t.Fatal(err)
+	}
+	if s := greet("bob", 2); s != "hello bob hello bob " {
+		t.Errorf("got %q", s)
+	}
+
+	var n int
+	var fs func(string) int
+	var fi func(int, string) string
+	var fr func(string, int) (string, error)
+	for _, test := range []struct {
+		name string
+		dst  interface{}
+		err  string
+	}{
+		{name: "greet", dst: &fs, err: "bind greet: cannot use func(string, int) string as func(string) int: got 2 arguments, want 1"},
+		{name: "greet", dst: &fi, err: "bind greet: cannot use func(string, int) string as func(int, string) string: argument 1 is string, want int"},
+		{name: "greet", dst: &fr, err: "bind greet: cannot use func(string, int) string as func(string, int) (string, error): got 1 results, want 2"},
+		{name: "greet", dst: fi, err: "bind greet: invalid destination func(int, string) string: not a non nil pointer to a function"},
+		{name: "greet", dst: &n, err: "bind greet: invalid destination *int: not a non nil pointer to a function"},
+		{name: "missing", dst: &fs, err: "bind missing: function not found: missing"},
+		{name: "foo.bar", dst: &fs, err: "bind foo.bar: package not found: foo"},
+	} {
+		if err := i.Bind(test.name, test.dst); err == nil || err.Error() != test.err {
+			t.Errorf("%s: got error %v, want %s", test.name, err, test.err)
+		}
+	}
+	if fs != nil || fi != nil || fr != nil {
+		t.Error("destination modified on error")
+	}
+}
+
+func TestBindCallbackPolicy(t *testing.T) {
+	type work func(context.Context, int) error
+	i := interp.New(interp.Options{Callbacks: interp.CallbackPolicy{
+		Allow: []reflect.Type{
+			reflect.TypeOf(binaryOp(nil)),
+			reflect.TypeOf(work(nil)),
+			reflect.TypeOf((func(context.Context))(nil)),
+		},
+		Timeout: 50 * time.Millisecond,
+	}})
+	i.Use(interp.Exports{
+		"context": {"Context": reflect.ValueOf((*context.Context)(nil))},
+		"host":    {"Wait": reflect.ValueOf(func(ctx context.Context) { <-ctx.Done() })},
+	})
+	if _, err := i.Eval(`
+import (
+	"context"
+	"host"
+)
+
+func add(a, b int) int { return a + b }
+
+func wait(ctx context.Context, n int) error {
+	if n == 0 {
+		return nil
+	}
+	host.Wait(ctx)
+	return nil
+}
+
+func block(ctx context.Context) { host.Wait(ctx) }`); err != nil {
+		t.Fatal(err)
+	}
+
+	var op binaryOp
+	if err := i.Bind("add", &op); err != nil {
+		t.Fatal(err)
+	}
+	if r := op(4, 3); r != 7 {
+		t.Errorf("got %d, want 7", r)
+	}
+	var add func(int, int) int
+	if err := i.Bind("add", &add); err == nil || err.Error() != "bind add: callback type func(int, int) int not allowed" {
+		t.Errorf("unexpected error %v", err)
+	}
+	if err := i.EvalInto("add", &add); err == nil || err.Error() != "callback type func(int, int) int not allowed" {
+		t.Errorf("unexpected error %v", err)
+	}
+	if add != nil {
+		t.Error("destination modified on error")
+	}
+
+	// Calls exceeding the timeout return the context error, or panic with it.
+	var w work
+	if err := i.EvalInto("wait", &w); err != nil {
+		t.Fatal(err)
+	}
+	if err := w(context.Background(), 0); err != nil {
+		t.Fatal(err)
+	}
+	if err := w(context.Background(), 1); err != context.DeadlineExceeded {
+		t.Fatalf("got %v, want %v", err, context.DeadlineExceeded)
+	}
+	var b func(context.Context)
+	if err := i.Bind("block", &b); err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if r := recover(); r != context.DeadlineExceeded {
+			t.Fatalf("got panic %v, want %v", r, context.DeadlineExceeded)
+		}
+	}()
+	b(context.Background())
+}
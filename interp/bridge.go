@@ -0,0 +1,39 @@
+package interp
+
+// importsSource returns true if the package importPath, also available as
+// binary symbols, must be imported from its sources instead.
+func (interp *Interpreter) importsSource(rPath, importPath string) bool {
+	if !interp.preferSource {
+		return false
+	}
+	if interp.srcPkg[importPath] != nil {
+		return true
+	}
+	dir, _, err := interp.srcDir(rPath, importPath)
+	if err != nil {
+		return false
+	}
+	_, err = interp.srcFS.Stat(dir)
+	return err == nil
+}
+
+// bridgeType returns the type to use for the type name declared by the source
+// package importPath, if a binary type of the same name exists for this
+// package, or nil otherwise. Using the binary type instead of the source one
+// gives both a single identity, so values are assignable from one to the other.
+func (interp *Interpreter) bridgeType(importPath, name string) *itype {
+	v, ok := interp.binPkg[importPath][name]
+	if !ok || !isBinType(v) {
+		return nil
+	}
+	return &itype{cat: valueT, rtype: v.Type().Elem(), scope: interp.universe}
+}
+
+// receiverName returns the type name of the receiver rtn of a method.
+func receiverName(rtn *node) string {
+	if rtn.ident == "" && len(rtn.child) > 0 {
+		// The receiver is a pointer.
+		return rtn.child[0].ident
+	}
+	return rtn.ident
+}
@@ -0,0 +1,121 @@
+package interp_test
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/traefik/yaegi/interp"
+	"github.com/traefik/yaegi/stdlib"
+)
+
+const bridgeSrc = `
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+)
+
+type reader struct {
+	chunk []byte
+	n     int
+}
+
+func (r *reader) Read(p []byte) (int, error) {
+	if r.n <= 0 {
+		return 0, io.EOF
+	}
+	n := copy(p, r.chunk)
+	r.n -= n
+	return n, nil
+}
+
+type writer struct {
+	n int
+}
+
+func (w *writer) Write(p []byte) (int, error) {
+	w.n += len(p)
+	return len(p), nil
+}
+
+func copyFrom(size int) int64 {
+	n, _ := io.Copy(ioutil.Discard, &reader{chunk: make([]byte, 64), n: size})
+	return n
+}
+
+func copyTo(size int) int64 {
+	w := &writer{}
+	io.CopyBuffer(w, io.LimitReader(bytes.NewReader(make([]byte, size)), int64(size)), make([]byte, 64))
+	return int64(w.n)
+}
+`
+
+func TestIOBridge(t *testing.T) {
+	i := interp.New(interp.Options{})
+	i.Use(stdlib.Symbols)
+	eval(t, i, bridgeSrc)
+
+	assertEval(t, i, `copyFrom(1000)`, "", "1024")
+	assertEval(t, i, `copyTo(1000)`, "", "1000")
+}
+
+func TestIOBridgeCancel(t *testing.T) {
+	done := make(chan error)
+	i := interp.New(interp.Options{})
+	i.Use(stdlib.Symbols)
+	i.Use(interp.Exports{"host/sink": {
+		"Drain": reflect.ValueOf(func(r io.Reader) {
+			_, err := io.Copy(ioutil.Discard, r)
+			done <- err
+		}),
+	}})
+	eval(t, i, `
+import "host/sink"
+
+type endless struct{}
+
+func (endless) Read(p []byte) (int, error) { return len(p), nil }
+`)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if _, err := i.EvalWithContext(ctx, `sink.Drain(endless{})`); err != context.DeadlineExceeded {
+		t.Fatalf("got %v, want %v", err, context.DeadlineExceeded)
+	}
+
+	// The copy loop must terminate once the evaluation is cancelled.
+	select {
+	case err := <-done:
+		if err != interp.ErrInterrupted {
+			t.Errorf("got %v, want %v", err, interp.ErrInterrupted)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("copy not interrupted")
+	}
+}
+
+func benchmarkIOBridge(b *testing.B, fn string) {
+	i := interp.New(interp.Options{})
+	i.Use(stdlib.Symbols)
+	if _, err := i.Eval(bridgeSrc); err != nil {
+		b.Fatal(err)
+	}
+	v, err := i.Eval(fn)
+	if err != nil {
+		b.Fatal(err)
+	}
+	f := v.Interface().(func(int) int64)
+	b.SetBytes(64 * 1000)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		f(64 * 1000)
+	}
+}
+
+func BenchmarkIOBridgeReader(b *testing.B) { benchmarkIOBridge(b, "copyFrom") }
+func BenchmarkIOBridgeWriter(b *testing.B) { benchmarkIOBridge(b, "copyTo") }
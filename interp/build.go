@@ -1,6 +1,7 @@
 package interp
 
 import (
+	"fmt"
 	"go/ast"
 	"go/build"
 	"go/parser"
@@ -19,6 +20,23 @@ func (interp *Interpreter) buildOk(ctx *build.Context, name, src string) (bool,
 	if err != nil {
 		return false, err
 	}
+	// A //go:build line supersedes the +build lines.
+	for _, g := range f.Comments {
+		for _, c := range g.List {
+			if !strings.HasPrefix(c.Text, "//go:build ") {
+				continue
+			}
+			x := strings.TrimSpace(c.Text[len("//go:build "):])
+			ok, err := buildExprOk(ctx, x)
+			if err != nil {
+				return false, fmt.Errorf("%s: invalid //go:build line: %s", name, x)
+			}
+			if ok {
+				setYaegiTags(ctx, f.Comments)
+			}
+			return ok, nil
+		}
+	}
 	for _, g := range f.Comments {
 		// in file, evaluate the AND of multiple line build constraints
 		for _, line := range strings.Split(strings.TrimSpace(g.Text()), "\n") {
@@ -31,6 +49,102 @@ func (interp *Interpreter) buildOk(ctx *build.Context, name, src string) (bool,
 	return true, nil
 }
 
+// buildExprOk returns true if the expression of a //go:build line, made of
+// build tags, !, &&, || and parentheses, is satisfied.
+func buildExprOk(ctx *build.Context, x string) (bool, error) {
+	p := &buildExprParser{ctx: ctx}
+	for i := 0; i < len(x); {
+		switch c := x[i]; {
+		case c == ' ' || c == '\t':
+			i++
+		case c == '!' || c == '(' || c == ')':
+			p.toks = append(p.toks, x[i:i+1])
+			i++
+		case strings.HasPrefix(x[i:], "&&") || strings.HasPrefix(x[i:], "||"):
+			p.toks = append(p.toks, x[i:i+2])
+			i += 2
+		default:
+			j := i
+			for j < len(x) && isTagChar(x[j]) {
+				j++
+			}
+			if j == i {
+				return false, fmt.Errorf("unexpected character %q", c)
+			}
+			p.toks = append(p.toks, x[i:j])
+			i = j
+		}
+	}
+	ok, err := p.or()
+	if err == nil && p.pos < len(p.toks) {
+		err = fmt.Errorf("unexpected %q", p.toks[p.pos])
+	}
+	return ok, err
+}
+
+func isTagChar(c byte) bool {
+	return 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' || '0' <= c && c <= '9' || c == '_' || c == '.'
+}
+
+// buildExprParser evaluates the tokens of a //go:build expression by
+// recursive descent.
+type buildExprParser struct {
+	ctx  *build.Context
+	toks []string
+	pos  int
+}
+
+func (p *buildExprParser) next() string {
+	if p.pos < len(p.toks) {
+		return p.toks[p.pos]
+	}
+	return ""
+}
+
+func (p *buildExprParser) or() (bool, error) {
+	ok, err := p.and()
+	for err == nil && p.next() == "||" {
+		p.pos++
+		var r bool
+		r, err = p.and()
+		ok = ok || r
+	}
+	return ok, err
+}
+
+func (p *buildExprParser) and() (bool, error) {
+	ok, err := p.not()
+	for err == nil && p.next() == "&&" {
+		p.pos++
+		var r bool
+		r, err = p.not()
+		ok = ok && r
+	}
+	return ok, err
+}
+
+func (p *buildExprParser) not() (bool, error) {
+	switch t := p.next(); t {
+	case "!":
+		p.pos++
+		ok, err := p.not()
+		return !ok, err
+	case "(":
+		p.pos++
+		ok, err := p.or()
+		if err == nil && p.next() != ")" {
+			err = fmt.Errorf("missing )")
+		}
+		p.pos++
+		return ok, err
+	case "", ")", "&&", "||":
+		return false, fmt.Errorf("unexpected %q", t)
+	default:
+		p.pos++
+		return buildTagOk(p.ctx, t), nil
+	}
+}
+
 // buildLineOk returns true if line is not a build constraint or
 // if build constraint is satisfied.
 func buildLineOk(ctx *build.Context, line string) (ok bool) {
@@ -68,8 +182,10 @@ func buildTagOk(ctx *build.Context, s string) (r bool) {
 	switch {
 	case contains(ctx.BuildTags, s):
 		r = true
-	case s == ctx.GOOS:
+	case matchOS(ctx, s):
 		r = true
+	case s == "unix":
+		r = unixOS[ctx.GOOS]
 	case s == ctx.GOARCH:
 		r = true
 	case len(s) > 4 && s[:4] == "go1.":
@@ -129,7 +245,9 @@ func goMinorVersion(ctx *build.Context) int {
 	return m
 }
 
-// skipFile returns true if file should be skipped.
+// skipFile returns true if file should be skipped, according to its name.
+// As in go/build, the _GOOS, _GOARCH and _GOOS_GOARCH suffixes, possibly
+// followed by _test, restrict the file to the corresponding platform.
 func skipFile(ctx *build.Context, p string, skipTest bool) bool {
 	if !strings.HasSuffix(p, ".go") {
 		return true
@@ -138,20 +256,41 @@ func skipFile(ctx *build.Context, p string, skipTest bool) bool {
 	if pp := filepath.Base(p); strings.HasPrefix(pp, "_") || strings.HasPrefix(pp, ".") {
 		return true
 	}
-	if skipTest && strings.HasSuffix(p, "_test") {
-		return true
+	if strings.HasSuffix(p, "_test") {
+		if skipTest {
+			return true
+		}
+		p = strings.TrimSuffix(p, "_test")
 	}
 	i := strings.Index(p, "_")
 	if i < 0 {
 		return false
 	}
 	a := strings.Split(p[i+1:], "_")
-	last := len(a) - 1
-	if last1 := last - 1; last1 >= 0 && a[last1] == ctx.GOOS && a[last] == ctx.GOARCH {
-		return false
+	n := len(a)
+	if n >= 2 && knownOs[a[n-2]] && knownArch[a[n-1]] {
+		return !matchOS(ctx, a[n-2]) || a[n-1] != ctx.GOARCH
 	}
-	if s := a[last]; s != ctx.GOOS && s != ctx.GOARCH && knownOs[s] || knownArch[s] {
+	if s := a[n-1]; knownOs[s] {
+		return !matchOS(ctx, s)
+	} else if knownArch[s] {
+		return s != ctx.GOARCH
+	}
+	return false
+}
+
+// matchOS returns true if the operating system name s is satisfied by the
+// target, where android also satisfies linux, illumos solaris, and ios darwin.
+func matchOS(ctx *build.Context, s string) bool {
+	switch {
+	case s == ctx.GOOS:
 		return true
+	case s == "linux":
+		return ctx.GOOS == "android"
+	case s == "solaris":
+		return ctx.GOOS == "illumos"
+	case s == "darwin":
+		return ctx.GOOS == "ios"
 	}
 	return false
 }
@@ -162,7 +301,9 @@ var knownOs = map[string]bool{
 	"darwin":    true,
 	"dragonfly": true,
 	"freebsd":   true,
+	"hurd":      true,
 	"illumos":   true,
+	"ios":       true,
 	"js":        true,
 	"linux":     true,
 	"nacl":      true,
@@ -170,21 +311,50 @@ var knownOs = map[string]bool{
 	"openbsd":   true,
 	"plan9":     true,
 	"solaris":   true,
+	"wasip1":    true,
 	"windows":   true,
+	"zos":       true,
+}
+
+// unixOS lists the operating systems satisfying the unix build tag.
+var unixOS = map[string]bool{
+	"aix":       true,
+	"android":   true,
+	"darwin":    true,
+	"dragonfly": true,
+	"freebsd":   true,
+	"hurd":      true,
+	"illumos":   true,
+	"ios":       true,
+	"linux":     true,
+	"netbsd":    true,
+	"openbsd":   true,
+	"solaris":   true,
 }
 
 var knownArch = map[string]bool{
-	"386":      true,
-	"amd64":    true,
-	"amd64p32": true,
-	"arm":      true,
-	"arm64":    true,
-	"mips":     true,
-	"mips64":   true,
-	"mips64le": true,
-	"mipsle":   true,
-	"ppc64":    true,
-	"ppc64le":  true,
-	"s390x":    true,
-	"wasm":     true,
+	"386":         true,
+	"amd64":       true,
+	"amd64p32":    true,
+	"arm":         true,
+	"armbe":       true,
+	"arm64":       true,
+	"arm64be":     true,
+	"loong64":     true,
+	"mips":        true,
+	"mipsle":      true,
+	"mips64":      true,
+	"mips64le":    true,
+	"mips64p32":   true,
+	"mips64p32le": true,
+	"ppc":         true,
+	"ppc64":       true,
+	"ppc64le":     true,
+	"riscv":       true,
+	"riscv64":     true,
+	"s390":        true,
+	"s390x":       true,
+	"sparc":       true,
+	"sparc64":     true,
+	"wasm":        true,
 }
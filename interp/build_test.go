@@ -37,6 +37,14 @@ func TestBuildTag(t *testing.T) {
 		{"// +build foo", true},
 		{"// +build !foo", false},
 		{"// +build bar", false},
+		{"// +build unix", true},
+		{"//go:build linux && amd64", true},
+		{"//go:build linux && !amd64", false},
+		{"//go:build (windows || foo) && go1.11", true},
+		{"//go:build !(linux || darwin)", false},
+		{"//go:build unix && !go1.12", true},
+		{"//go:build bar\n// +build linux", false},
+		{"//go:build linux\n// +build bar", true},
 	}
 
 	i := New(Options{})
@@ -69,6 +77,11 @@ func TestBuildFile(t *testing.T) {
 		{"bar_aix_s390x.go", true},
 		{"bar_aix_amd64.go", true},
 		{"bar_linux_arm.go", true},
+		{"bar_amd64.go", false},
+		{"bar_arm64.go", true},
+		{"bar_windows.go", true},
+		{"bar_riscv64.go", true},
+		{"bar_linux_test.go", true},
 	}
 
 	for _, test := range tests {
@@ -79,6 +92,33 @@ func TestBuildFile(t *testing.T) {
 			}
 		})
 	}
+
+	testFiles := []testBuild{
+		{"bar_test.go", false},
+		{"bar_linux_test.go", false},
+		{"bar_windows_test.go", true},
+		{"bar_linux.go", false},
+	}
+
+	for _, test := range testFiles {
+		test := test
+		t.Run("test/"+test.src, func(t *testing.T) {
+			if r := skipFile(&ctx, test.src, Test); r != test.res {
+				t.Errorf("got %v, want %v", r, test.res)
+			}
+		})
+	}
+}
+
+func TestBuildTagErrors(t *testing.T) {
+	ctx := build.Context{GOARCH: "amd64", GOOS: "linux", ReleaseTags: []string{"go1.11"}}
+
+	i := New(Options{})
+	for _, x := range []string{"linux &&", "(linux", "linux)", "linux amd64", "linux | amd64", "!"} {
+		if _, err := i.buildOk(&ctx, "x.go", "//go:build "+x+"\npackage x"); err == nil {
+			t.Errorf("%q: expected an error", x)
+		}
+	}
 }
 
 func Test_goMinorVersion(t *testing.T) {
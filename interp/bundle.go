@@ -0,0 +1,260 @@
+package interp
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"go/token"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// BundleFile is the name of the manifest of a script bundle, at the root of
+// its archive, see ImportArchive. Its syntax is the one of go.mod files, with
+// the following directives, all optional:
+//
+//	bundle example.com/greeter    // name of the bundle
+//	api v1.2                      // minimum version of the host API
+//	entry Handle                  // entry point, a function of the package
+//	capability net                // capability required from the host
+//	resource memory 67108864      // amount of a resource requested to the host
+//
+// The entry, capability and resource directives can be repeated, or used in
+// block form.
+const BundleFile = "yaegi.bundle"
+
+// Bundle is the manifest of a script bundle, see BundleFile.
+type Bundle struct {
+	Name         string
+	APIVersion   string           // minimum version of the host API, such as "v1.2"
+	Entries      []string         // names of the entry point functions
+	Capabilities []string         // capabilities required from the host
+	Resources    map[string]int64 // amounts of resources requested, by name
+}
+
+// BundlePolicy describes what a host provides to the script bundles it
+// imports, see ArchiveOptions.Bundle. A bundle requiring more is refused
+// before its source files are parsed.
+type BundlePolicy struct {
+	// APIVersion is the version of the host API, such as "v1.4", which must
+	// be at least the minimum version of a bundle. Versions are compared by
+	// their dot separated numbers.
+	APIVersion string
+
+	// Capabilities are the capabilities provided by the host.
+	Capabilities []string
+
+	// Resources are the maximum amounts of resources granted to a bundle, by
+	// name. A request of a resource not listed is refused.
+	Resources map[string]int64
+
+	// Require refuses archives without manifest.
+	Require bool
+
+	// Check, if not nil, is called with the manifest of each bundle
+	// satisfying the policy, and refuses the bundle if it returns an error.
+	Check func(*Bundle) error
+}
+
+// bundleVerbs are the directives of a bundle manifest.
+var bundleVerbs = map[string]bool{"bundle": true, "api": true, "entry": true, "capability": true, "resource": true}
+
+// ParseBundle parses the content of a bundle manifest, see BundleFile.
+func ParseBundle(buf []byte) (*Bundle, error) {
+	// Unknown directives are errors, as requirements a host would ignore.
+	inBlock := false
+	s := bufio.NewScanner(bytes.NewReader(buf))
+	for line := 1; s.Scan(); line++ {
+		l := s.Text()
+		if i := strings.Index(l, "//"); i >= 0 {
+			l = l[:i]
+		}
+		switch f := strings.Fields(l); {
+		case len(f) == 0:
+		case inBlock:
+			inBlock = f[0] != ")"
+		case !bundleVerbs[f[0]]:
+			return nil, fmt.Errorf("%d: unknown directive %s", line, f[0])
+		default:
+			inBlock = len(f) == 2 && f[1] == "("
+		}
+	}
+	if err := s.Err(); err != nil {
+		return nil, err
+	}
+
+	b := &Bundle{}
+	args := func(verb string, n int) ([][]string, error) {
+		entries, err := parseDirectives(buf, verb)
+		if err != nil {
+			return nil, err
+		}
+		var res [][]string
+		for _, e := range entries {
+			if len(e.args) != n {
+				return nil, fmt.Errorf("%d: invalid %s directive", e.line, verb)
+			}
+			res = append(res, e.args)
+		}
+		return res, nil
+	}
+	for _, verb := range []string{"bundle", "api"} {
+		a, err := args(verb, 1)
+		if err != nil {
+			return nil, err
+		}
+		if len(a) > 1 {
+			return nil, fmt.Errorf("repeated %s directive", verb)
+		}
+		if len(a) == 0 {
+			continue
+		}
+		if verb == "bundle" {
+			b.Name = a[0][0]
+		} else if b.APIVersion = a[0][0]; !validVersion(b.APIVersion) {
+			return nil, fmt.Errorf("invalid api version %q", b.APIVersion)
+		}
+	}
+
+	entries, err := args("entry", 1)
+	if err != nil {
+		return nil, err
+	}
+	for _, e := range entries {
+		if !token.IsIdentifier(e[0]) {
+			return nil, fmt.Errorf("invalid entry %q", e[0])
+		}
+		b.Entries = append(b.Entries, e[0])
+	}
+	caps, err := args("capability", 1)
+	if err != nil {
+		return nil, err
+	}
+	for _, c := range caps {
+		b.Capabilities = append(b.Capabilities, c[0])
+	}
+	resources, err := args("resource", 2)
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range resources {
+		n, err := strconv.ParseInt(r[1], 10, 64)
+		if err != nil || n < 0 {
+			return nil, fmt.Errorf("invalid amount %q of resource %s", r[1], r[0])
+		}
+		if b.Resources == nil {
+			b.Resources = map[string]int64{}
+		}
+		b.Resources[r[0]] += n
+	}
+	return b, nil
+}
+
+// check returns an error if the bundle b requires more than the policy p. The
+// bundle is nil for an archive without manifest, and the policy nil for a host
+// providing nothing.
+func (p *BundlePolicy) check(b *Bundle) error {
+	if p == nil {
+		p = &BundlePolicy{}
+	}
+	if b == nil {
+		if p.Require {
+			return fmt.Errorf("bundle manifest %s not found", BundleFile)
+		}
+		return nil
+	}
+
+	if b.APIVersion != "" && (p.APIVersion == "" || compareVersions(p.APIVersion, b.APIVersion) < 0) {
+		return fmt.Errorf("bundle requires host API %s, have %q", b.APIVersion, p.APIVersion)
+	}
+	provided := map[string]bool{}
+	for _, c := range p.Capabilities {
+		provided[c] = true
+	}
+	for _, c := range b.Capabilities {
+		if !provided[c] {
+			return fmt.Errorf("bundle requires capability %s, not provided by the host", c)
+		}
+	}
+	names := make([]string, 0, len(b.Resources))
+	for name := range b.Resources {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		max, ok := p.Resources[name]
+		if !ok {
+			return fmt.Errorf("bundle requests resource %s, not granted by the host", name)
+		}
+		if b.Resources[name] > max {
+			return fmt.Errorf("bundle requests %d of resource %s, more than %d", b.Resources[name], name, max)
+		}
+	}
+	if p.Check != nil {
+		return p.Check(b)
+	}
+	return nil
+}
+
+// Bundle returns the manifest of the bundle imported by ImportArchive as the
+// package importPath, or nil if it has none.
+func (interp *Interpreter) Bundle(importPath string) *Bundle {
+	interp.mutex.RLock()
+	defer interp.mutex.RUnlock()
+	return interp.bundles[importPath]
+}
+
+// checkEntries returns an error if an entry point of the bundle b is not a
+// function of the package importPath.
+func (interp *Interpreter) checkEntries(b *Bundle, importPath string) error {
+	if b == nil {
+		return nil
+	}
+	interp.mutex.RLock()
+	defer interp.mutex.RUnlock()
+	sc := interp.scopes[importPath]
+	for _, e := range b.Entries {
+		if sc == nil || sc.sym[e] == nil || sc.sym[e].kind != funcSym {
+			return fmt.Errorf("bundle entry %s: function not found", e)
+		}
+	}
+	return nil
+}
+
+// validVersion returns true if v is a version of the form "v1", "v1.2" or
+// "v1.2.3".
+func validVersion(v string) bool {
+	if !strings.HasPrefix(v, "v") {
+		return false
+	}
+	for _, n := range strings.Split(v[1:], ".") {
+		if _, err := strconv.ParseUint(n, 10, 32); err != nil {
+			return false
+		}
+	}
+	return true
+}
+
+// compareVersions returns -1, 0 or 1 if the version a is respectively lower,
+// equal or greater than b, missing numbers being zero.
+func compareVersions(a, b string) int {
+	as := strings.Split(strings.TrimPrefix(a, "v"), ".")
+	bs := strings.Split(strings.TrimPrefix(b, "v"), ".")
+	for i := 0; i < len(as) || i < len(bs); i++ {
+		var x, y uint64
+		if i < len(as) {
+			x, _ = strconv.ParseUint(as[i], 10, 32)
+		}
+		if i < len(bs) {
+			y, _ = strconv.ParseUint(bs[i], 10, 32)
+		}
+		switch {
+		case x < y:
+			return -1
+		case x > y:
+			return 1
+		}
+	}
+	return 0
+}
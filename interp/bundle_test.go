@@ -0,0 +1,115 @@
+package interp
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseBundle(t *testing.T) {
+	b, err := ParseBundle([]byte(`bundle example.com/greeter // the greeter
+api v1.2
+
+entry Hello
+entry (
+	Init
+	"Stop"
+)
+capability net
+resource memory 1024
+resource (
+	goroutines 10
+	memory 1024
+)
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &Bundle{
+		Name:         "example.com/greeter",
+		APIVersion:   "v1.2",
+		Entries:      []string{"Hello", "Init", "Stop"},
+		Capabilities: []string{"net"},
+		Resources:    map[string]int64{"memory": 2048, "goroutines": 10},
+	}
+	if !reflect.DeepEqual(b, want) {
+		t.Fatalf("got %+v, want %+v", b, want)
+	}
+
+	for src, msg := range map[string]string{
+		"require foo v1":        "1: unknown directive require",
+		"api 1.2":               `invalid api version "1.2"`,
+		"api v1\napi v2":        "repeated api directive",
+		"entry a.b":             `invalid entry "a.b"`,
+		"entry":                 "1: invalid entry directive",
+		"resource memory":       "1: invalid resource directive",
+		"resource memory -1":    `invalid amount "-1" of resource memory`,
+		"capability (\nnet fs)": "2: invalid capability directive",
+	} {
+		if _, err := ParseBundle([]byte(src)); err == nil || err.Error() != msg {
+			t.Errorf("%q: got error %v, want %s", src, err, msg)
+		}
+	}
+}
+
+func TestImportBundle(t *testing.T) {
+	archive := func(manifest string) []byte {
+		files := map[string]string{"plugin.go": "package plugin\n\nfunc Hello() string { return \"hello\" }\n"}
+		if manifest != "" {
+			files[BundleFile] = manifest
+		}
+		_, zipData, _ := makeArchives(t, files)
+		return zipData
+	}
+	host := &BundlePolicy{
+		APIVersion:   "v1.4.2",
+		Capabilities: []string{"net"},
+		Resources:    map[string]int64{"memory": 1 << 20},
+	}
+	errCheck := errors.New("denied")
+
+	for _, test := range []struct {
+		desc     string
+		manifest string
+		policy   *BundlePolicy
+		err      string
+	}{
+		{desc: "no manifest"},
+		{desc: "no manifest, required", policy: &BundlePolicy{Require: true}, err: "bundle manifest yaegi.bundle not found"},
+		{desc: "empty manifest", manifest: "bundle example.com/plugin\n"},
+		{desc: "satisfied", manifest: "api v1.4\nentry Hello\ncapability net\nresource memory 1024\n", policy: host},
+		{desc: "no policy", manifest: "capability net\n", err: "bundle requires capability net, not provided by the host"},
+		{desc: "api", manifest: "api v1.10\n", policy: host, err: `bundle requires host API v1.10, have "v1.4.2"`},
+		{desc: "capability", manifest: "capability fs\n", policy: host, err: "bundle requires capability fs, not provided by the host"},
+		{desc: "resource", manifest: "resource goroutines 2\n", policy: host, err: "bundle requests resource goroutines, not granted by the host"},
+		{desc: "resource amount", manifest: "resource memory 2097152\n", policy: host, err: "bundle requests 2097152 of resource memory, more than 1048576"},
+		{desc: "check", manifest: "entry Hello\n", policy: &BundlePolicy{Check: func(*Bundle) error { return errCheck }}, err: "denied"},
+		{desc: "malformed", manifest: "api\n", err: "yaegi.bundle: 1: invalid api directive"},
+		{desc: "missing entry", manifest: "entry Bye\n", err: "bundle entry Bye: function not found"},
+	} {
+		t.Run(test.desc, func(t *testing.T) {
+			i := New(Options{})
+			_, err := i.ImportArchive("example.com/plugin", bytes.NewReader(archive(test.manifest)), ArchiveOptions{Bundle: test.policy})
+			if test.err != "" {
+				if err == nil || !strings.Contains(err.Error(), test.err) {
+					t.Fatalf("got error %v, want %q", err, test.err)
+				}
+				if i.Bundle("example.com/plugin") != nil || i.srcPkg["example.com/plugin"] != nil {
+					t.Fatal("refused bundle imported")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if b := i.Bundle("example.com/plugin"); (b != nil) != (test.manifest != "") {
+				t.Fatalf("unexpected manifest %+v", b)
+			}
+			if _, err := i.Eval(`import "example.com/plugin"`); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
@@ -0,0 +1,118 @@
+package interp
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"time"
+)
+
+// Origins of the packages known to the interpreter, see PackageInfo.
+const (
+	OriginDir     = "dir"     // source package read from a directory
+	OriginArchive = "archive" // source package read from an archive, see EvalArchive
+	OriginBinary  = "binary"  // binary symbols, see Interpreter.Use
+)
+
+// PackageInfo describes a package loaded in the interpreter.
+type PackageInfo struct {
+	Path   string    // import path
+	Name   string    // package name, empty for binary packages
+	Origin string    // OriginDir, OriginArchive or OriginBinary
+	Dir    string    // directory of the source files, for OriginDir
+	Loaded time.Time // time of the import, or of the last Use for binary packages
+	Hash   string    // hex SHA-256 of the source files, empty for binary packages
+
+	root  string // relative path used to resolve the imports of the package
+	slots [2]int // range of the global frame slots allocated by the import, dependencies included
+}
+
+// Packages returns the packages loaded in the interpreter, sorted by import
+// path. A package available both as binary symbols and source is listed once
+// per origin.
+func (interp *Interpreter) Packages() []PackageInfo {
+	interp.mutex.RLock()
+	defer interp.mutex.RUnlock()
+
+	var pkgs []PackageInfo
+	for _, p := range interp.pkgInfo {
+		pkgs = append(pkgs, *p)
+	}
+	for path := range interp.binPkg {
+		p := PackageInfo{Path: path, Origin: OriginBinary, Loaded: interp.binLoaded[path]}
+		pkgs = append(pkgs, p)
+	}
+	sort.Slice(pkgs, func(i, j int) bool {
+		if pkgs[i].Path != pkgs[j].Path {
+			return pkgs[i].Path < pkgs[j].Path
+		}
+		return pkgs[i].Origin < pkgs[j].Origin
+	})
+	return pkgs
+}
+
+// Invalidate removes the source package importPath from the interpreter
+// caches, so it is read again by the next import. The code already compiled
+// keeps using the previous package. The binary symbols of importPath, provided
+// by Use, are kept, as the interpreter can not read them again: the host
+// replaces them by calling Use. It returns false if no source package
+// importPath is loaded.
+func (interp *Interpreter) Invalidate(importPath string) bool {
+	interp.flushEvalCache()
+	interp.mutex.Lock()
+	_, loaded := interp.pkgInfo[importPath]
+	delete(interp.pkgInfo, importPath)
+	delete(interp.srcPkg, importPath)
+	delete(interp.pkgNames, importPath)
+	delete(interp.sources, importPath)
+	delete(interp.scopes, importPath)
+	delete(interp.roots, importPath)
+	delete(interp.stats, importPath)
+	delete(interp.importErrs, importPath)
+	delete(interp.archivePkgs, importPath)
+	delete(interp.bundles, importPath)
+	delete(interp.provenance, importPath)
+	interp.mutex.Unlock()
+
+	interp.lazyMutex.Lock()
+	for n, f := range interp.lazy {
+		if f.path == importPath {
+			delete(interp.lazy, n)
+		}
+	}
+	interp.lazyMutex.Unlock()
+	return loaded
+}
+
+// addPackageInfo records the origin of the source package importPath, read
+// from dir. It must be called with the interpreter mutex held.
+func (interp *Interpreter) addPackageInfo(importPath, origin, dir string, sources []srcFile) {
+	if interp.pkgInfo == nil {
+		interp.pkgInfo = map[string]*PackageInfo{}
+	}
+	interp.pkgInfo[importPath] = &PackageInfo{
+		Path:   importPath,
+		Name:   interp.pkgNames[importPath],
+		Origin: origin,
+		Dir:    dir,
+		Loaded: time.Now(),
+		Hash:   sourcesHash(sources),
+	}
+}
+
+// sourcesHash returns the hex SHA-256 of a summary listing the SHA-256 of
+// each source file, with its base name, in sorted order.
+func sourcesHash(sources []srcFile) string {
+	lines := make([]string, len(sources))
+	for i, s := range sources {
+		lines[i] = fmt.Sprintf("%x  %s\n", sha256.Sum256([]byte(s.src)), filepath.Base(s.name))
+	}
+	sort.Strings(lines)
+
+	h := sha256.New()
+	for _, l := range lines {
+		fmt.Fprint(h, l)
+	}
+	return fmt.Sprintf("%x", h.Sum(nil))
+}
@@ -0,0 +1,63 @@
+package interp
+
+import "reflect"
+
+// Types of the callbacks commonly passed to binary code, such as the
+// functions and the methods of sort.Interface given to the sort package,
+// which are called without reflect.MakeFunc.
+var (
+	lessFuncType   = reflect.TypeOf((func(int, int) bool)(nil))
+	swapFuncType   = reflect.TypeOf((func(int, int))(nil))
+	lenFuncType    = reflect.TypeOf((func() int)(nil))
+	searchFuncType = reflect.TypeOf((func(int) bool)(nil))
+)
+
+// fastCallback returns a function of type t, calling the interpreted function
+// def in the frame returned by enter, or an invalid value if t is not one of
+// the callback types above. As these functions are called many times, their
+// frames are recycled if Options.PoolFrames is set.
+func fastCallback(t reflect.Type, def *node, enter func() (*frame, []reflect.Value)) reflect.Value {
+	interp := def.interp
+	switch t {
+	case lessFuncType:
+		return reflect.ValueOf(func(i, j int) bool {
+			defer rethrow()
+			fr, d := enter()
+			d[0].SetInt(int64(i))
+			d[1].SetInt(int64(j))
+			runFunc(def, fr, nil)
+			res := fr.data[0].Bool()
+			interp.releaseFrame(fr)
+			return res
+		})
+	case swapFuncType:
+		return reflect.ValueOf(func(i, j int) {
+			defer rethrow()
+			fr, d := enter()
+			d[0].SetInt(int64(i))
+			d[1].SetInt(int64(j))
+			runFunc(def, fr, nil)
+			interp.releaseFrame(fr)
+		})
+	case lenFuncType:
+		return reflect.ValueOf(func() int {
+			defer rethrow()
+			fr, _ := enter()
+			runFunc(def, fr, nil)
+			res := int(fr.data[0].Int())
+			interp.releaseFrame(fr)
+			return res
+		})
+	case searchFuncType:
+		return reflect.ValueOf(func(i int) bool {
+			defer rethrow()
+			fr, d := enter()
+			d[0].SetInt(int64(i))
+			runFunc(def, fr, nil)
+			res := fr.data[0].Bool()
+			interp.releaseFrame(fr)
+			return res
+		})
+	}
+	return reflect.Value{}
+}
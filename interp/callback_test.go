@@ -0,0 +1,117 @@
+package interp
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+)
+
+// sortInterface is the wrapper of sort.Interface, as in the stdlib package.
+type sortInterface struct {
+	WLen  func() int
+	WLess func(i int, j int) bool
+	WSwap func(i int, j int)
+}
+
+func (W sortInterface) Len() int               { return W.WLen() }
+func (W sortInterface) Less(i int, j int) bool { return W.WLess(i, j) }
+func (W sortInterface) Swap(i int, j int)      { W.WSwap(i, j) }
+
+var sortExports = Exports{"sort": {
+	"Reverse":    reflect.ValueOf(sort.Reverse),
+	"Search":     reflect.ValueOf(sort.Search),
+	"Slice":      reflect.ValueOf(sort.Slice),
+	"Sort":       reflect.ValueOf(sort.Sort),
+	"Stable":     reflect.ValueOf(sort.Stable),
+	"Interface":  reflect.ValueOf((*sort.Interface)(nil)),
+	"_Interface": reflect.ValueOf((*sortInterface)(nil)),
+}}
+
+const sortTypes = `
+import "sort"
+
+type H struct{ a []int; n int }
+
+func (h *H) Len() int           { return len(h.a) }
+func (h *H) Less(i, j int) bool { return h.a[i] < h.a[j] }
+func (h *H) Swap(i, j int)      { h.n++; h.a[i], h.a[j] = h.a[j], h.a[i] }
+
+type S []int
+
+func (s S) Len() int           { return len(s) }
+func (s S) Less(i, j int) bool { return s[i] < s[j] }
+func (s S) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+
+type base struct{ a []int }
+
+func (b *base) Len() int      { return len(b.a) }
+func (b *base) Swap(i, j int) { b.a[i], b.a[j] = b.a[j], b.a[i] }
+
+type E struct{ *base }
+
+func (e E) Less(i, j int) bool { return e.a[i] > e.a[j] }
+
+var calls int
+
+func newH() *H { calls++; return &H{a: []int{3, 1, 2}} }
+`
+
+func TestSortCallbacks(t *testing.T) {
+	for _, poolFrames := range []bool{false, true} {
+		i := New(Options{PoolFrames: poolFrames})
+		i.Use(sortExports)
+		if _, err := i.Eval(sortTypes); err != nil {
+			t.Fatal(err)
+		}
+		for src, want := range map[string]interface{}{
+			`s := []int{3, 1, 2}; sort.Slice(s, func(i, j int) bool { return s[i] > s[j] }); s`:  []int{3, 2, 1},
+			`h1 := &H{a: []int{3, 1, 2}}; sort.Sort(h1); append(h1.a, h1.n)`:                     []int{1, 2, 3, 2},
+			`h2 := H{a: []int{3, 1, 2}}; sort.Sort(sort.Reverse(&h2)); h2.a`:                     []int{3, 2, 1},
+			`s := S{3, 1, 2}; sort.Stable(s); []int(s)`:                                          []int{1, 2, 3},
+			`e := E{&base{[]int{1, 3, 2}}}; sort.Sort(e); e.a`:                                   []int{3, 2, 1},
+			`sort.Sort(newH()); calls`:                                                           1,
+			`a := []int{1, 3, 5, 7}; sort.Search(len(a), func(i int) bool { return a[i] >= 5 })`: 2,
+		} {
+			v, err := i.Eval(src)
+			if err != nil {
+				t.Errorf("%s: %v", src, err)
+				continue
+			}
+			if got := v.Interface(); !reflect.DeepEqual(got, want) {
+				t.Errorf("%s: got %v, want %v", src, got, want)
+			}
+		}
+
+		// The methods of H have pointer receivers, so H does not implement
+		// sort.Interface, only *H does.
+		_, err := i.Eval(`h3 := H{a: []int{3, 1, 2}}; sort.Sort(h3)`)
+		if want := "1:39: cannot use type main.H as type sort.Interface (method Len has pointer receiver)"; err == nil || err.Error() != want {
+			t.Errorf("got error %v, want %q", err, want)
+		}
+	}
+}
+
+func BenchmarkSortSlice(b *testing.B) {
+	i := New(Options{})
+	i.Use(sortExports)
+	if _, err := i.Eval(`
+import "sort"
+
+func sortInts(s []int) { sort.Slice(s, func(i, j int) bool { return s[i] < s[j] }) }
+`); err != nil {
+		b.Fatal(err)
+	}
+	v, err := i.Eval("sortInts")
+	if err != nil {
+		b.Fatal(err)
+	}
+	sortInts := v.Interface().(func([]int))
+	s := make([]int, 1000)
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		for k := range s {
+			s[k] = (k * 7919) % len(s)
+		}
+		sortInts(s)
+	}
+}
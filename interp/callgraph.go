@@ -0,0 +1,155 @@
+package interp
+
+import (
+	"go/token"
+	"reflect"
+	"sort"
+)
+
+// A CallEdge is a static call site in interpreted code.
+//
+// Functions are named "path.Func" and methods "(path.Type).Method" or
+// "(*path.Type).Method", where path is the import path of the package.
+// Package level code, such as global variable initializers, is attributed
+// to "path.init".
+type CallEdge struct {
+	Caller string         // calling interpreted function
+	Callee string         // called function or method
+	Binary bool           // true if the callee is a binary symbol, provided by Use
+	Pos    token.Position // position of the call
+}
+
+// CallGraph returns the static call graph of the source code compiled so far
+// by the interpreter, sorted by package then source order.
+// Calls through function values and methods of interpreted interfaces can not
+// be resolved statically and are not reported. Methods of binary interfaces
+// are reported as binary callees. Functions of imported packages which are
+// not compiled yet (see Options.EagerCompile) are not analyzed.
+func (interp *Interpreter) CallGraph() []CallEdge {
+	interp.mutex.RLock()
+	defer interp.mutex.RUnlock()
+
+	pkgs := make([]string, 0, len(interp.roots))
+	for p := range interp.roots {
+		pkgs = append(pkgs, p)
+	}
+	sort.Strings(pkgs)
+
+	// Name all function declarations first, as they can be called before
+	// being declared.
+	names := map[*node]string{}
+	for _, p := range pkgs {
+		for _, root := range interp.roots[p] {
+			for _, c := range root.child {
+				if c.kind == funcDecl {
+					names[c] = funcName(p, c)
+				}
+			}
+		}
+	}
+
+	var edges []CallEdge
+	for _, p := range pkgs {
+		for _, root := range interp.roots[p] {
+			root.Walk(func(n *node) bool {
+				if n.kind != callExpr {
+					return true
+				}
+				callee, binary := interp.callee(n, names)
+				if callee == "" {
+					return true
+				}
+				caller := p + ".init"
+				for a := n.anc; a != nil; a = a.anc {
+					if a.kind == funcDecl {
+						caller = names[a]
+						break
+					}
+				}
+				edges = append(edges, CallEdge{Caller: caller, Callee: callee, Binary: binary, Pos: interp.fset.Position(n.pos)})
+				return true
+			}, nil)
+		}
+	}
+	return edges
+}
+
+// addRoots records the compiled source roots of package path, for later
+// analysis. A root of statements, such as evaluated in a REPL, replaces the
+// previous root of package path if it is also one, so that repeated
+// evaluations of statements do not grow the recorded roots. It must be
+// called with the interpreter mutex locked.
+func (interp *Interpreter) addRoots(path string, roots ...*node) {
+	if interp.roots == nil {
+		interp.roots = map[string][]*node{}
+	}
+	r := interp.roots[path]
+	for _, root := range roots {
+		if n := len(r); n > 0 && root.kind != fileStmt && r[n-1].kind != fileStmt {
+			// The slice may be shared with a checkpoint, see EvalAtomic.
+			r = r[: n-1 : n-1]
+		}
+		r = append(r, root)
+	}
+	interp.roots[path] = r
+}
+
+// callee returns the name of the function statically called by the call
+// expression n, or an empty string if not known. The returned boolean is
+// true if the callee is a binary symbol.
+func (interp *Interpreter) callee(n *node, names map[*node]string) (string, bool) {
+	c0 := n.child[0]
+	for c0.kind == parenExpr {
+		c0 = c0.child[0]
+	}
+	if c0.typ == nil || n.action == aConvert || interp.isBuiltinCall(n) {
+		return "", false
+	}
+	if def, ok := c0.val.(*node); ok {
+		return names[def], false
+	}
+	if !isBinCall(n) || c0.kind != selectorExpr {
+		return "", false
+	}
+	name := c0.child[1].ident
+	switch t := c0.child[0].typ; {
+	case t == nil:
+	case t.cat == binPkgT:
+		return t.path + "." + name, true
+	case c0.action == aGetMethod && t.cat == valueT:
+		return "(" + rtypeName(t.rtype) + ")." + name, true
+	case c0.action == aGetMethod:
+		// Method of a binary type embedded in an interpreted one.
+		return "(" + t.id() + ")." + name, true
+	}
+	return "", false
+}
+
+// funcName returns the qualified name of the function declared by node n in
+// package path.
+func funcName(path string, n *node) string {
+	name := n.child[1].ident
+	if len(n.child[0].child) == 0 {
+		return path + "." + name
+	}
+	// Method: find the receiver type name.
+	recv := n.child[0].child[0].lastChild()
+	ptr := ""
+	if recv.kind == starExpr {
+		ptr = "*"
+		recv = recv.child[0]
+	}
+	return "(" + ptr + path + "." + recv.ident + ")." + name
+}
+
+// rtypeName returns the qualified name of runtime type t, using package
+// import paths.
+func rtypeName(t reflect.Type) string {
+	if t.Kind() == reflect.Ptr && t.Name() == "" {
+		return "*" + rtypeName(t.Elem())
+	}
+	if t.Name() == "" || t.PkgPath() == "" {
+		return t.String()
+	}
+	return t.PkgPath() + "." + t.Name()
+}
@@ -0,0 +1,54 @@
+package interp_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/traefik/yaegi/interp"
+	"github.com/traefik/yaegi/stdlib"
+)
+
+func TestCallGraph(t *testing.T) {
+	i := interp.New(interp.Options{})
+	i.Use(stdlib.Symbols)
+	eval(t, i, `
+import (
+	"bytes"
+	"os"
+	"strings"
+)
+
+type T struct{ buf *bytes.Buffer }
+
+func (t *T) Write(s string) { t.buf.WriteString(strings.ToUpper(s)) }
+
+func run(s string) string {
+	t := &T{buf: &bytes.Buffer{}}
+	t.Write(s)
+	return t.buf.String()
+}
+
+func exit() { os.Exit(1) }
+
+var v = run("a")
+`)
+	eval(t, i, `println(run("b"))`)
+
+	var got []string
+	for _, e := range i.CallGraph() {
+		got = append(got, fmt.Sprintf("%s -> %s %v %d", e.Caller, e.Callee, e.Binary, e.Pos.Line))
+	}
+	want := []string{
+		"(*main.T).Write -> (*bytes.Buffer).WriteString true 10",
+		"(*main.T).Write -> strings.ToUpper true 10",
+		"main.run -> (*main.T).Write false 14",
+		"main.run -> (*bytes.Buffer).String true 15",
+		"main.exit -> os.Exit true 18",
+		"main.init -> main.run false 20",
+		"main.init -> main.run false 1",
+	}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("got:\n%s\nwant:\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+}
@@ -8,7 +8,6 @@ import (
 	"path/filepath"
 	"reflect"
 	"regexp"
-	"strings"
 	"unicode"
 )
 
@@ -20,6 +19,9 @@ type cfgError struct {
 
 func (c *cfgError) Error() string { return c.error.Error() }
 
+// Unwrap returns the *CompileError of c.
+func (c *cfgError) Unwrap() error { return c.error }
+
 var constOp = map[action]func(*node){
 	aAdd:    addConst,
 	aSub:    subConst,
@@ -36,6 +38,13 @@ var constOp = map[action]func(*node){
 	aBitNot: bitNotConst,
 	aNeg:    negConst,
 	aPos:    posConst,
+
+	aEqual:        compareConst,
+	aNotEqual:     compareConst,
+	aGreater:      compareConst,
+	aGreaterEqual: compareConst,
+	aLower:        compareConst,
+	aLowerEqual:   compareConst,
 }
 
 var constBltn = map[string]func(*node){
@@ -46,6 +55,15 @@ var constBltn = map[string]func(*node){
 
 var identifier = regexp.MustCompile(`([\pL_][\pL_\d]*)$`)
 
+var majorVersion = regexp.MustCompile(`/v[0-9]+$`)
+
+// binPkgName returns the default name of the binary package at importPath:
+// its last element, the major version suffix of paths such as "math/rand/v2"
+// excepted.
+func binPkgName(importPath string) string {
+	return identifier.FindString(majorVersion.ReplaceAllString(importPath, ""))
+}
+
 const nilIdent = "nil"
 
 // cfg generates a control flow graph (CFG) from AST (wiring successors in AST)
@@ -240,6 +258,19 @@ func (interp *Interpreter) cfg(root *node, importPath string) ([]*node, error) {
 				assigned.typ = elem
 			}
 
+		case genericDecl:
+			// Compiled for each instantiation.
+			return false
+
+		case indexExpr:
+			if interp.genericOf(sc, n.child[0]) != nil {
+				// Instantiation with explicit type arguments, replaced by the
+				// reference to the instance.
+				if _, err = nodeType(interp, sc, n); err != nil {
+					return false
+				}
+			}
+
 		case compositeLitExpr:
 			if len(n.child) > 0 && n.child[0].isType(sc) {
 				// Get type from 1st child
@@ -302,6 +333,10 @@ func (interp *Interpreter) cfg(root *node, importPath string) ([]*node, error) {
 			fallthrough
 
 		case funcDecl:
+			if interp.isDeferred(n) {
+				// Compiled on demand.
+				return false
+			}
 			n.val = n
 			// Compute function type before entering local scope to avoid
 			// possible collisions with function argument names.
@@ -417,6 +452,9 @@ func (interp *Interpreter) cfg(root *node, importPath string) ([]*node, error) {
 		if err != nil {
 			return
 		}
+		if d := interp.debugger; d != nil {
+			d.setScope(n, sc)
+		}
 
 		defer func() {
 			if r := recover(); r != nil {
@@ -457,6 +495,8 @@ func (interp *Interpreter) cfg(root *node, importPath string) ([]*node, error) {
 			}
 
 			wireChild(n)
+			var fresh []*node
+			var globals []*symbol
 			for i := 0; i < n.nleft; i++ {
 				dest, src := n.child[i], n.child[sbase+i]
 				updateSym := false
@@ -492,6 +532,9 @@ func (interp *Interpreter) cfg(root *node, importPath string) ([]*node, error) {
 					if sym == nil {
 						sym = &symbol{index: sc.add(dest.typ), kind: varSym, typ: dest.typ}
 						sc.sym[dest.ident] = sym
+						if n.kind == defineStmt && sc.loop != nil && isCaptured(sc.loop, dest.ident) {
+							fresh = append(fresh, dest)
+						}
 					}
 					dest.val = src.val
 					dest.recv = src.recv
@@ -501,10 +544,17 @@ func (interp *Interpreter) cfg(root *node, importPath string) ([]*node, error) {
 					sym, level, _ = sc.lookup(dest.ident)
 				}
 
-				err = check.assignExpr(n, dest, src)
-				if err != nil {
+				if m, ok := interp.operatorMethod(n.action, dest, src); ok {
+					n.gen = assignMethod(m)
+				} else if err = check.assignExpr(n, dest, src); err != nil {
 					break
 				}
+				if s := assignedGlobal(dest); s != nil {
+					globals = append(globals, s)
+				} else if n.kind == defineStmt && sym != nil && sym.global && sym.kind == varSym {
+					// Redefinition of a global variable in REPL mode.
+					globals = append(globals, sym)
+				}
 
 				if updateSym {
 					sym.typ = dest.typ
@@ -562,7 +612,11 @@ func (interp *Interpreter) cfg(root *node, importPath string) ([]*node, error) {
 				}
 				n.level = level
 				if isMapEntry(dest) {
-					dest.gen = nop // skip getIndexMap
+					if n.action == aAssign {
+						dest.gen = nop // skip getIndexMap
+					} else {
+						n.gen = storeMapEntry(n.gen)
+					}
 				}
 				if n.anc.kind == constDecl {
 					n.gen = nop
@@ -577,6 +631,12 @@ func (interp *Interpreter) cfg(root *node, importPath string) ([]*node, error) {
 					}
 				}
 			}
+			if len(fresh) > 0 {
+				n.start.gen = freshVars(n.start.gen, fresh)
+			}
+			if len(globals) > 0 {
+				n.gen = notifyWatches(n.gen, globals)
+			}
 
 		case incDecStmt:
 			wireChild(n)
@@ -587,6 +647,12 @@ func (interp *Interpreter) cfg(root *node, importPath string) ([]*node, error) {
 				sym.typ = n.typ
 				n.level = level
 			}
+			if isMapEntry(n.child[0]) {
+				n.gen = storeMapEntry(n.gen)
+			}
+			if s := assignedGlobal(n.child[0]); s != nil {
+				n.gen = notifyWatches(n.gen, []*symbol{s})
+			}
 
 		case assignXStmt:
 			wireChild(n)
@@ -616,6 +682,15 @@ func (interp *Interpreter) cfg(root *node, importPath string) ([]*node, error) {
 					n.gen = nop
 				}
 			}
+			var globals []*symbol
+			for _, c := range n.child[:l] {
+				if s := assignedGlobal(c); s != nil {
+					globals = append(globals, s)
+				}
+			}
+			if len(globals) > 0 {
+				n.gen = notifyWatches(n.gen, globals)
+			}
 
 		case defineXStmt:
 			wireChild(n)
@@ -630,8 +705,9 @@ func (interp *Interpreter) cfg(root *node, importPath string) ([]*node, error) {
 			nilSym := interp.universe.sym[nilIdent]
 			c0, c1 := n.child[0], n.child[1]
 
-			err = check.binaryExpr(n)
-			if err != nil {
+			if m, ok := interp.operatorMethod(n.action, c0, c1); ok {
+				n.gen = binaryMethod(m)
+			} else if err = check.binaryExpr(n); err != nil {
 				break
 			}
 
@@ -794,6 +870,23 @@ func (interp *Interpreter) cfg(root *node, importPath string) ([]*node, error) {
 
 		case callExpr:
 			wireChild(n)
+			if t := n.child[0].typ; t != nil && t.cat == genericT {
+				// Instantiate the generic function for the types of the arguments.
+				args := make([]*itype, len(n.child)-1)
+				for i, c := range n.child[1:] {
+					args[i] = c.typ
+				}
+				var sym *symbol
+				if sym, err = interp.instantiateCall(n, t.node.val.(*generic), args); err != nil {
+					break
+				}
+				c0 := n.child[0]
+				setInstanceName(c0, sym.node.child[1].ident)
+				c0.typ, c0.val = sym.typ, sym.node
+				if c0.kind == selectorExpr {
+					c0.sym = sym
+				}
+			}
 			switch {
 			case interp.isBuiltinCall(n):
 				err = check.builtin(n.child[0].ident, n, n.child[1:], n.action == aCallSlice)
@@ -858,6 +951,12 @@ func (interp *Interpreter) cfg(root *node, importPath string) ([]*node, error) {
 					n.typ = c0.typ
 					n.findex = sc.add(n.typ)
 				}
+			case isGenericCall(n):
+				// Instantiate the generic binary function, then call it as any other.
+				if err = instantiate(n); err != nil {
+					break
+				}
+				fallthrough
 			case isBinCall(n):
 				err = check.arguments(n, n.child[1:], n.child[0], n.action == aCallSlice)
 				if err != nil {
@@ -1036,6 +1135,13 @@ func (interp *Interpreter) cfg(root *node, importPath string) ([]*node, error) {
 			}
 			cond.tnext = body.start
 			setFNext(cond, n)
+			if vars := loopVars(init, body); len(vars) > 0 {
+				if cond.rval.IsValid() {
+					body.start.gen = copyVars(body.start.gen, vars)
+				} else {
+					cond.start.gen = copyVars(cond.start.gen, vars)
+				}
+			}
 			sc = sc.pop()
 
 		case forStmt3: // for ; cond; post {}
@@ -1064,6 +1170,9 @@ func (interp *Interpreter) cfg(root *node, importPath string) ([]*node, error) {
 			init.tnext = body.start
 			body.tnext = post.start
 			post.tnext = body.start
+			if vars := loopVars(init, body); len(vars) > 0 {
+				post.start.gen = copyVars(post.start.gen, vars)
+			}
 			sc = sc.pop()
 
 		case forStmt4: // for init; cond; post {}
@@ -1087,11 +1196,18 @@ func (interp *Interpreter) cfg(root *node, importPath string) ([]*node, error) {
 			cond.tnext = body.start
 			setFNext(cond, n)
 			body.tnext = post.start
+			if vars := loopVars(init, body); len(vars) > 0 {
+				post.start.gen = copyVars(post.start.gen, vars)
+			}
 			sc = sc.pop()
 
 		case forRangeStmt:
-			n.start = n.child[0].start
-			setFNext(n.child[0], n)
+			r := n.child[0]
+			n.start = r.start
+			setFNext(r, n)
+			if vars := loopVars(r, r.lastChild()); len(vars) > 0 {
+				r.gen = copyVars(r.gen, vars)
+			}
 			sc = sc.pop()
 
 		case funcDecl:
@@ -1132,10 +1248,15 @@ func (interp *Interpreter) cfg(root *node, importPath string) ([]*node, error) {
 					break
 				}
 			}
+			if sym.kind == genericSym && (n.anc.kind != callExpr || n.anc.child[0] != n) {
+				err = n.cfgErrorf("cannot use generic %s %s without instantiation", sym.node.val.(*generic).kind(), n.ident)
+				break
+			}
 			// Found symbol, populate node info
 			n.typ, n.findex, n.level = sym.typ, sym.index, level
 			if n.findex < 0 {
 				n.val = sym.node
+				err = interp.compileFunc(sym.node)
 			} else {
 				n.sym = sym
 				switch {
@@ -1251,6 +1372,9 @@ func (interp *Interpreter) cfg(root *node, importPath string) ([]*node, error) {
 			setFNext(n.child[0], n)
 			n.child[1].tnext = n
 			n.typ = n.child[0].typ
+			if logicalConst(n, false) {
+				break
+			}
 			n.findex = sc.add(n.typ)
 			if n.start.action == aNop {
 				n.start.gen = branch
@@ -1262,6 +1386,9 @@ func (interp *Interpreter) cfg(root *node, importPath string) ([]*node, error) {
 			setFNext(n.child[0], n.child[1].start)
 			n.child[1].tnext = n
 			n.typ = n.child[0].typ
+			if logicalConst(n, true) {
+				break
+			}
 			n.findex = sc.add(n.typ)
 			if n.start.action == aNop {
 				n.start.gen = branch
@@ -1414,10 +1541,22 @@ func (interp *Interpreter) cfg(root *node, importPath string) ([]*node, error) {
 						n.typ = &itype{cat: valueT, rtype: s.Type().Elem()}
 					} else {
 						n.typ = &itype{cat: valueT, rtype: s.Type(), untyped: isValueUntyped(s)}
+						if c, ok := s.Interface().(constant.Value); ok {
+							// Untyped constants are typed as their source counterparts.
+							if t := untypedConst(c); t != nil {
+								n.typ = t
+							}
+						}
 						n.rval = s
+						if n.typ.rtype == genericType && (n.anc.kind != callExpr || n.anc.child[0] != n) {
+							err = n.cfgErrorf("cannot use generic function %s.%s without instantiation", n.child[0].ident, name)
+							break
+						}
 					}
 					n.action = aGetSym
 					n.gen = nop
+				} else if rerr := interp.restrictions.allowSymbol(pkg, name); rerr != nil {
+					err = n.cfgErrorf("%v", rerr)
 				} else {
 					err = n.cfgErrorf("package %s \"%s\" has no symbol %s", n.child[0].ident, pkg, name)
 				}
@@ -1425,6 +1564,15 @@ func (interp *Interpreter) cfg(root *node, importPath string) ([]*node, error) {
 				pkg, name := n.child[0].sym.typ.path, n.child[1].ident
 				// Resolve source package symbol
 				if sym, ok := interp.srcPkg[pkg][name]; ok {
+					if sym.kind == genericSym && (n.anc.kind != callExpr || n.anc.child[0] != n) {
+						err = n.cfgErrorf("cannot use generic %s %s.%s without instantiation", sym.node.val.(*generic).kind(), n.child[0].ident, name)
+						break
+					}
+					if sym.kind == funcSym {
+						if err = interp.compileFunc(sym.node); err != nil {
+							break
+						}
+					}
 					n.findex = sym.index
 					n.val = sym.node
 					n.gen = nop
@@ -1790,6 +1938,11 @@ func (interp *Interpreter) cfg(root *node, importPath string) ([]*node, error) {
 		}
 	})
 
+	if err == nil && (root.anc == nil || root.kind == fileStmt || root.kind == funcDecl) {
+		// The declarations of the package are all known, the bodies of the
+		// instances of generics can be compiled.
+		err = interp.compileInstances(importPath)
+	}
 	if sc != interp.universe {
 		sc.pop()
 	}
@@ -1847,11 +2000,18 @@ func compDefineX(sc *scope, n *node) error {
 		return n.cfgErrorf("unsupported assign expression")
 	}
 
+	var fresh []*node
 	for i, t := range types {
 		index := sc.add(t)
 		sc.sym[n.child[i].ident] = &symbol{index: index, kind: varSym, typ: t}
 		n.child[i].typ = t
 		n.child[i].findex = index
+		if sc.loop != nil && isCaptured(sc.loop, n.child[i].ident) {
+			fresh = append(fresh, n.child[i])
+		}
+	}
+	if len(fresh) > 0 {
+		n.start.gen = freshVars(n.start.gen, fresh)
 	}
 
 	return nil
@@ -1876,13 +2036,17 @@ func childPos(n *node) int {
 }
 
 func (n *node) cfgErrorf(format string, a ...interface{}) *cfgError {
-	pos := n.interp.fset.Position(n.pos)
-	posString := n.interp.fset.Position(n.pos).String()
-	if pos.Filename == DefaultSourceName {
-		posString = strings.TrimPrefix(posString, DefaultSourceName+":")
-	}
-	a = append([]interface{}{posString}, a...)
-	return &cfgError{n, fmt.Errorf("%s: "+format, a...)}
+	msg := fmt.Sprintf(format, a...)
+	return &cfgError{n, &CompileError{Pos: n.interp.fset.Position(n.pos), Code: compileErrorCode(msg), Msg: msg}}
+}
+
+// importErrorf returns the compile error of the import node n of the package
+// importPath, which failed with err.
+func (n *node) importErrorf(importPath string, err error) *cfgError {
+	ce := n.cfgErrorf("import %q error: %v", importPath, err)
+	ce.error.(*CompileError).Code = CodeImport
+	ce.error.(*CompileError).Err = err
+	return ce
 }
 
 func genRun(nod *node) error {
@@ -1893,6 +2057,10 @@ func genRun(nod *node) error {
 			return false
 		}
 		switch n.kind {
+		case funcDecl:
+			if n.interp.isDeferred(n) {
+				return false
+			}
 		case funcType:
 			if len(n.anc.child) == 4 {
 				// function body entry point
@@ -1982,7 +2150,7 @@ func getVarDependencies(nod *node, sc *scope) (deps []*node) {
 	nod.Walk(func(n *node) bool {
 		if n.kind == identExpr {
 			if sym, _, ok := sc.lookup(n.ident); ok {
-				if sym.kind != varSym || !sym.global || sym.node == nod {
+				if sym.kind != varSym || !sym.global || sym.node == nod || sym.node == nil {
 					return false
 				}
 				deps = append(deps, sym.node)
@@ -2054,6 +2222,11 @@ func (n *node) isType(sc *scope) bool {
 		}
 	case identExpr:
 		return sc.getType(n.ident) != nil
+	case indexExpr:
+		// Instance of a generic type.
+		if g := n.interp.genericOf(sc, n.child[0]); g != nil {
+			return g.isType()
+		}
 	}
 	return false
 }
@@ -2065,7 +2238,7 @@ func wireChild(n *node, exclude ...nkind) {
 	// Set start node, in subtree (propagated to ancestors by post-order processing)
 	for _, c := range child {
 		switch c.kind {
-		case arrayType, chanType, chanTypeRecv, chanTypeSend, funcDecl, importDecl, mapType, basicLit, identExpr, typeDecl:
+		case arrayType, chanType, chanTypeRecv, chanTypeSend, funcDecl, genericDecl, importDecl, mapType, basicLit, identExpr, typeDecl:
 			continue
 		default:
 			n.start = c.start
@@ -2091,7 +2264,7 @@ func wireChild(n *node, exclude ...nkind) {
 	// Chain subtree next to self
 	for i := len(child) - 1; i >= 0; i-- {
 		switch child[i].kind {
-		case arrayType, chanType, chanTypeRecv, chanTypeSend, importDecl, mapType, funcDecl, basicLit, identExpr, typeDecl:
+		case arrayType, chanType, chanTypeRecv, chanTypeSend, importDecl, mapType, funcDecl, genericDecl, basicLit, identExpr, typeDecl:
 			continue
 		case breakStmt, continueStmt, gotoStmt, returnStmt:
 			// tnext is already computed, no change
@@ -2233,7 +2406,7 @@ func isRecursiveField(n *node) bool {
 // isNewDefine returns true if node refers to a new definition.
 func isNewDefine(n *node, sc *scope) bool {
 	if n.ident == "_" {
-		return true
+		return !isResultRef(n, sc)
 	}
 	if (n.anc.kind == defineXStmt || n.anc.kind == defineStmt || n.anc.kind == valueSpec) && childPos(n) < n.anc.nleft {
 		return true
@@ -2250,6 +2423,24 @@ func isNewDefine(n *node, sc *scope) bool {
 	return false
 }
 
+// isResultRef returns true if the blank identifier n refers to the last result
+// in REPL mode, which is the case when it is used as a value.
+func isResultRef(n *node, sc *scope) bool {
+	if _, _, found := sc.lookup("_"); !found {
+		return false
+	}
+	a := n.anc
+	switch a.kind {
+	case assignStmt, assignXStmt, defineStmt, defineXStmt, valueSpec:
+		return childPos(n) >= a.nleft
+	case rangeStmt:
+		return childPos(n) >= len(a.child)-2
+	case fieldExpr, funcDecl, importSpec, labeledStmt, typeSpec:
+		return false
+	}
+	return true
+}
+
 func isMethod(n *node) bool {
 	return len(n.child[0].child) > 0 // receiver defined
 }
@@ -2341,6 +2532,18 @@ func setExec(n *node) {
 			}
 		}
 		n.gen(n)
+		if n.interp != nil && n.interp.tracing != nil {
+			n.interp.tracing.wrap(n)
+		}
+		if n.interp != nil && n.interp.debugger != nil {
+			n.interp.debugger.wrap(n)
+		}
+		if n.interp != nil && n.interp.callStack {
+			n.interp.wrapCall(n)
+		}
+		if n.interp != nil && n.interp.coverage != nil {
+			n.interp.coverage.wrap(n)
+		}
 	}
 
 	set(n)
@@ -0,0 +1,153 @@
+package interp
+
+import (
+	"reflect"
+)
+
+// EvalAtomic evaluates src as Eval, but if the evaluation fails, at compile
+// time or at run time, the symbols declared or redeclared by src, the
+// packages it imported, and the values of the global variables are restored
+// to their state before the evaluation, so that a partially applied input,
+// such as a REPL line or a configuration script, does not leave the
+// interpreter in an intermediate state.
+//
+// The global variables are restored by assignment of their previous values:
+// the elements of maps and slices, and the values pointed to, modified by
+// src are not restored. The effects of src outside of the interpreter, such
+// as its output or the calls of binary functions, are not undone either.
+//
+// The inputs of REPL are evaluated atomically.
+func (interp *Interpreter) EvalAtomic(src string) (res reflect.Value, err error) {
+	interp.evalMutex.Lock()
+	defer interp.evalMutex.Unlock()
+	return interp.evalAtomic(src)
+}
+
+func (interp *Interpreter) evalAtomic(src string) (res reflect.Value, err error) {
+	cp := interp.checkpoint()
+	if res, err = interp.eval(src, "", true, nil); err != nil {
+		interp.rollback(cp)
+	}
+	return res, err
+}
+
+// checkpoint is the state of an interpreter, restored by rollback.
+type checkpoint struct {
+	scopes   map[string]*scope
+	syms     map[*scope]map[string]*symbol // symbols of the global scopes, including the universe
+	symVals  map[*symbol]symbol            // content of the symbols, modified by redeclarations
+	types    map[*scope][]reflect.Type     // global frame layouts
+	data     []reflect.Value               // copies of the global variables values
+	srcPkg   imports
+	pkgNames map[string]string
+	sources  map[string][]srcFile
+	roots    map[string][]*node
+	pkgInfo  map[string]*PackageInfo
+	nsources int // number of recorded sources, see Snapshot
+}
+
+// checkpoint returns the current state of the interpreter.
+func (interp *Interpreter) checkpoint() *checkpoint {
+	interp.mutex.RLock()
+	defer interp.mutex.RUnlock()
+	cp := &checkpoint{
+		scopes:   make(map[string]*scope, len(interp.scopes)),
+		syms:     map[*scope]map[string]*symbol{},
+		symVals:  map[*symbol]symbol{},
+		types:    map[*scope][]reflect.Type{},
+		srcPkg:   make(imports, len(interp.srcPkg)),
+		pkgNames: make(map[string]string, len(interp.pkgNames)),
+		sources:  make(map[string][]srcFile, len(interp.sources)),
+		roots:    make(map[string][]*node, len(interp.roots)),
+		pkgInfo:  make(map[string]*PackageInfo, len(interp.pkgInfo)),
+		nsources: len(interp.snapshotSources),
+	}
+	save := func(sc *scope) {
+		syms := make(map[string]*symbol, len(sc.sym))
+		for name, sym := range sc.sym {
+			syms[name] = sym
+			cp.symVals[sym] = *sym
+		}
+		cp.syms[sc] = syms
+		cp.types[sc] = sc.types
+	}
+	save(interp.universe)
+	for path, sc := range interp.scopes {
+		cp.scopes[path] = sc
+		save(sc)
+	}
+	for k, v := range interp.srcPkg {
+		cp.srcPkg[k] = v
+	}
+	for k, v := range interp.pkgNames {
+		cp.pkgNames[k] = v
+	}
+	for k, v := range interp.sources {
+		cp.sources[k] = v
+	}
+	for k, v := range interp.roots {
+		cp.roots[k] = v
+	}
+	for k, v := range interp.pkgInfo {
+		cp.pkgInfo[k] = v
+	}
+
+	f := interp.frame
+	f.mutex.RLock()
+	defer f.mutex.RUnlock()
+	cp.data = make([]reflect.Value, len(f.data))
+	for i, v := range f.data {
+		if v.IsValid() {
+			cp.data[i] = reflect.New(v.Type()).Elem()
+			cp.data[i].Set(v)
+		}
+	}
+	return cp
+}
+
+// rollback restores the state of the interpreter saved in cp.
+func (interp *Interpreter) rollback(cp *checkpoint) {
+	interp.flushEvalCache()
+	interp.mutex.Lock()
+	defer interp.mutex.Unlock()
+
+	// The symbol maps are restored in place, as they are shared, for
+	// example between a package scope and its source package symbols.
+	for sc, syms := range cp.syms {
+		for name := range sc.sym {
+			if _, ok := syms[name]; !ok {
+				delete(sc.sym, name)
+			}
+		}
+		for name, sym := range syms {
+			sc.sym[name] = sym
+		}
+		sc.types = cp.types[sc]
+	}
+	for sym, v := range cp.symVals {
+		*sym = v
+	}
+	for path := range interp.scopes {
+		if _, ok := cp.scopes[path]; !ok {
+			delete(interp.scopes, path)
+		}
+	}
+	interp.srcPkg = cp.srcPkg
+	interp.pkgNames = cp.pkgNames
+	interp.sources = cp.sources
+	interp.roots = cp.roots
+	interp.pkgInfo = cp.pkgInfo
+	interp.snapshotSources = interp.snapshotSources[:cp.nsources]
+
+	f := interp.frame
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	if len(f.data) > len(cp.data) {
+		f.data = f.data[:len(cp.data):len(cp.data)]
+	}
+	for i, v := range cp.data {
+		if v.IsValid() && i < len(f.data) && f.data[i].CanSet() {
+			f.data[i].Set(v)
+		}
+	}
+}
@@ -0,0 +1,76 @@
+package interp
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestEvalAtomic(t *testing.T) {
+	i := New(Options{})
+	if _, err := i.Eval(`
+var (
+	count = 1
+	name  = "a"
+)
+
+func get() int { return count }
+`); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, test := range []struct {
+		src, err string
+	}{
+		// Compile error after some declarations.
+		{`var extra = 3; func get() string { return name }; var bad int = "x"`, "cannot convert"},
+		// Run time error after some assignments.
+		{`count = 10; name = "b"; panic("boom")`, "boom"},
+		// Run time error in the initialization of a new global.
+		{`func fail() int { count = 20; panic("init") }; var late = fail()`, "init"},
+	} {
+		if _, err := i.EvalAtomic(test.src); err == nil || !strings.Contains(err.Error(), test.err) {
+			t.Fatalf("%s: got error %v, want %q", test.src, err, test.err)
+		}
+		v, err := i.Eval(`count + get()`)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := v.Interface(); got != 2 {
+			t.Errorf("%s: got %v, want 2", test.src, got)
+		}
+		if v, err := i.Eval(`name`); err != nil || v.String() != "a" {
+			t.Errorf("%s: got name %v, %v, want a", test.src, v, err)
+		}
+		for _, name := range []string{"extra", "fail", "late", "s"} {
+			if _, err := i.Eval(name); err == nil {
+				t.Errorf("%s: %s is declared", test.src, name)
+			}
+		}
+	}
+
+	// A successful evaluation is kept, and the restored symbols are usable.
+	if _, err := i.EvalAtomic(`extra := 3; count = 5`); err != nil {
+		t.Fatal(err)
+	}
+	if v, err := i.Eval(`extra + get()`); err != nil || v.Interface() != 8 {
+		t.Errorf("got %v, %v, want 8", v, err)
+	}
+}
+
+func TestREPLRollback(t *testing.T) {
+	var out, errs strings.Builder
+	i := New(Options{
+		Stdin:  strings.NewReader("a := 1\na = 2; panic(\"boom\")\nprintln(a)\n"),
+		Stdout: &out,
+		Stderr: &errs,
+	})
+	if _, err := i.REPL(); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(errs.String(), "boom") {
+		t.Errorf("got errors %q, want boom", errs.String())
+	}
+	if got := out.String(); got != "1\n" {
+		t.Errorf("got output %q, want %q", got, "1\n")
+	}
+}
@@ -0,0 +1,54 @@
+package interp
+
+// escapes returns false if the function literal n can not outlive the frame
+// where it is evaluated, and so does not need a closure context of its own:
+// when it is called immediately. Functions started in a goroutine, deferred,
+// as they run after the variables they capture may change, or passed to a
+// binary function which may keep them, as errgroup.Group.Go, are considered
+// escaping.
+func escapes(n *node) bool {
+	c := n.anc
+	if c == nil || c.kind != callExpr || c.anc.kind == goStmt || c.anc.kind == deferStmt {
+		return true
+	}
+	return c.child[0] != n
+}
+
+// isCaptured returns true if the identifier name is used in a function
+// literal nested in n. Shadowing is ignored, the result may be a false
+// positive.
+func isCaptured(n *node, name string) bool {
+	captured := false
+	n.Walk(func(n *node) bool {
+		if n.kind != funcLit {
+			return !captured
+		}
+		n.Walk(func(n *node) bool {
+			if n.kind == identExpr && n.ident == name {
+				captured = true
+			}
+			return !captured
+		}, nil)
+		return false
+	}, nil)
+	return captured
+}
+
+// loopVars returns the variables defined by n, the init statement of a for
+// clause or a range clause, which are captured by a function literal in the
+// loop body, and so must be allocated again at each iteration.
+func loopVars(n, body *node) []*node {
+	var vars, captured []*node
+	switch n.kind {
+	case defineStmt:
+		vars = n.child[:n.nleft]
+	case rangeStmt:
+		vars = n.child[:len(n.child)-2]
+	}
+	for _, v := range vars {
+		if v.ident != "_" && isCaptured(body, v.ident) {
+			captured = append(captured, v)
+		}
+	}
+	return captured
+}
@@ -0,0 +1,80 @@
+package interp_test
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/traefik/yaegi/interp"
+)
+
+const closureSrc = `package main
+
+import "sort"
+
+func apply(fs ...func(int) int) func(int) int {
+	return func(i int) int {
+		for _, f := range fs {
+			i = f(i)
+		}
+		return i
+	}
+}
+
+func fib(n int) int {
+	return func() int {
+		if n < 2 {
+			return n
+		}
+		return fib(n-1) + fib(n-2)
+	}()
+}
+
+func run(n int) (s int) {
+	defer func() { s = -s }()
+	ks := []int{}
+	for i := 0; i < n; i++ {
+		s += func(j int) int { return i * j }(2)
+		ks = append(ks, n-i)
+	}
+	sort.Slice(ks, func(i, j int) bool { return ks[i] < ks[j] })
+	inc := func(i int) int { return i + ks[0] }
+	return apply(inc, inc)(s) + fib(10)
+}
+
+func deferLoop() (s []int) {
+	for i := 0; i < 3; i++ {
+		x := i * 10
+		defer func() { s = append(s, x) }()
+	}
+	return s
+}
+`
+
+func TestClosure(t *testing.T) {
+	i := interp.New(interp.Options{})
+	i.Use(interp.Exports{"sort": {"Slice": reflect.ValueOf(sort.Slice)}})
+	eval(t, i, closureSrc)
+	if got := eval(t, i, `run(10)`).Int(); got != -147 {
+		t.Errorf("got %d, want -147", got)
+	}
+	// Deferred function literals capture the variables of each iteration.
+	if got := eval(t, i, `deferLoop()`).Interface(); !reflect.DeepEqual(got, []int{20, 10, 0}) {
+		t.Errorf("got %v, want [20 10 0]", got)
+	}
+}
+
+func BenchmarkClosure(b *testing.B) {
+	i := interp.New(interp.Options{})
+	i.Use(interp.Exports{"sort": {"Slice": reflect.ValueOf(sort.Slice)}})
+	if _, err := i.Eval(closureSrc); err != nil {
+		b.Fatal(err)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		if _, err := i.Eval(`run(100)`); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,155 @@
+package interp
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/gob"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"sort"
+)
+
+// compiledHeader starts the serialized form of Compiled, with its version.
+const compiledHeader = "yaegi compiled 1\n"
+
+// Compiled is the compilation artifact of a source package and of its source
+// dependencies, produced by Interpreter.Compile and loaded by
+// Interpreter.LoadCompiled, to skip the discovery and reading of their
+// source files on the next process start.
+//
+// Compiled code can not be serialized, as it is made of closures and runtime
+// types: the packages are analysed again when imported. The artifact holds
+// the files selected by the build constraints, which are not evaluated again.
+type Compiled struct {
+	Packages []CompiledPackage
+}
+
+// CompiledPackage is a source package of a compilation artifact.
+type CompiledPackage struct {
+	Path  string         // import path
+	Dir   string         // directory of the source files
+	Hash  string         // hex SHA-256 of the source files, as PackageInfo.Hash
+	Files []CompiledFile // source files, in import order
+}
+
+// CompiledFile is a source file of a compiled package.
+type CompiledFile struct {
+	Name string // base name
+	Src  string // content
+}
+
+// Compile returns the compilation artifact of the source package importPath,
+// imported from a directory, and of its source dependencies.
+func (interp *Interpreter) Compile(importPath string) (*Compiled, error) {
+	interp.mutex.RLock()
+	defer interp.mutex.RUnlock()
+
+	c := &Compiled{}
+	done := map[string]bool{}
+	var add func(path string) error
+	add = func(path string) error {
+		if done[path] {
+			return nil
+		}
+		done[path] = true
+		info := interp.pkgInfo[path]
+		if info == nil || info.Origin != OriginDir {
+			return fmt.Errorf("package %s not imported from a directory", path)
+		}
+		var deps []string
+		for _, s := range interp.scopes[path].sym {
+			if s.kind == pkgSym && s.typ != nil && s.typ.cat == srcPkgT {
+				deps = append(deps, s.typ.path)
+			}
+		}
+		sort.Strings(deps)
+		for _, d := range deps {
+			if p := interp.pkgInfo[d]; p != nil && p.Origin == OriginDir {
+				if err := add(d); err != nil {
+					return err
+				}
+			}
+		}
+
+		p := CompiledPackage{Path: path, Dir: info.Dir, Hash: info.Hash}
+		for _, s := range interp.sources[path] {
+			p.Files = append(p.Files, CompiledFile{Name: filepath.Base(s.name), Src: s.src})
+		}
+		c.Packages = append(c.Packages, p)
+		return nil
+	}
+	if err := add(importPath); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// WriteTo writes the compilation artifact c to w, in a format read by
+// Interpreter.LoadCompiled.
+func (c *Compiled) WriteTo(w io.Writer) (int64, error) {
+	var buf bytes.Buffer
+	buf.WriteString(compiledHeader)
+	if err := gob.NewEncoder(&buf).Encode(c); err != nil {
+		return 0, err
+	}
+	return buf.WriteTo(w)
+}
+
+// LoadCompiled reads a compilation artifact written by Compiled.WriteTo. The
+// next imports of its packages, from the same directories, use the source
+// files of the artifact instead of reading them, except for test files.
+// Packages already imported are not affected.
+func (interp *Interpreter) LoadCompiled(r io.Reader) error {
+	br := bufio.NewReader(r)
+	header := make([]byte, len(compiledHeader))
+	if _, err := io.ReadFull(br, header); err != nil || string(header) != compiledHeader {
+		return errors.New("invalid compilation artifact")
+	}
+	var c Compiled
+	if err := gob.NewDecoder(br).Decode(&c); err != nil {
+		return err
+	}
+
+	pkgs := make(map[string]*CompiledPackage, len(c.Packages))
+	for i, p := range c.Packages {
+		files := make([]srcFile, len(p.Files))
+		for j, f := range p.Files {
+			files[j] = srcFile{name: filepath.Join(p.Dir, f.Name), src: f.Src}
+		}
+		if sourcesHash(files) != p.Hash {
+			return fmt.Errorf("compilation artifact of %s: hash mismatch", p.Path)
+		}
+		pkgs[p.Path] = &c.Packages[i]
+	}
+
+	interp.mutex.Lock()
+	defer interp.mutex.Unlock()
+	if interp.compiled == nil {
+		interp.compiled = map[string]*CompiledPackage{}
+	}
+	for path, p := range pkgs {
+		interp.compiled[path] = p
+	}
+	return nil
+}
+
+// compiledFiles returns the source files of the package importPath in dir,
+// from the loaded compilation artifacts, or nil.
+func (interp *Interpreter) compiledFiles(dir, importPath string, skipTest bool) []srcFile {
+	if !skipTest {
+		return nil
+	}
+	interp.mutex.RLock()
+	p := interp.compiled[importPath]
+	interp.mutex.RUnlock()
+	if p == nil || p.Dir != dir {
+		return nil
+	}
+	files := make([]srcFile, len(p.Files))
+	for i, f := range p.Files {
+		files[i] = srcFile{name: filepath.Join(dir, f.Name), src: f.Src}
+	}
+	return files
+}
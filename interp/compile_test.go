@@ -0,0 +1,84 @@
+package interp
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestCompile(t *testing.T) {
+	goPath, err := ioutil.TempDir("", "compile")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		_ = os.RemoveAll(goPath)
+	}()
+
+	files := map[string]string{
+		"dep/dep.go":      "package dep\n\nconst N = 40\n",
+		"app/app.go":      "package app\n\nimport \"dep\"\n\nfunc Value() int { return dep.N + two() }\n",
+		"app/two.go":      "package app\n\nfunc two() int { return 2 }\n",
+		"app/app_test.go": "package app\n",
+	}
+	for name, src := range files {
+		name = filepath.Join(goPath, "src", name)
+		if err := os.MkdirAll(filepath.Dir(name), 0700); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(name, []byte(src), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	i := New(Options{GoPath: goPath})
+	if _, err := i.Eval(`import "app"`); err != nil {
+		t.Fatal(err)
+	}
+	c, err := i.Compile("app")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(c.Packages) != 2 || c.Packages[0].Path != "dep" || c.Packages[1].Path != "app" || len(c.Packages[1].Files) != 2 {
+		t.Fatalf("unexpected artifact %+v", c)
+	}
+	var buf bytes.Buffer
+	if _, err := c.WriteTo(&buf); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := i.Compile("fmt"); err == nil {
+		t.Error("want error on package not imported from a directory")
+	}
+
+	// The sources of the artifact are used instead of the removed files.
+	for name := range files {
+		if err := os.Remove(filepath.Join(goPath, "src", name)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	i = New(Options{GoPath: goPath})
+	if err := i.LoadCompiled(bytes.NewReader(buf.Bytes())); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := i.Eval(`import "app"`); err != nil {
+		t.Fatal(err)
+	}
+	v, err := i.Eval(`app.Value()`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v.Int() != 42 {
+		t.Errorf("got %d, want 42", v.Int())
+	}
+
+	corrupted := bytes.Replace(buf.Bytes(), []byte("return 2"), []byte("return 3"), 1)
+	if err := New(Options{}).LoadCompiled(bytes.NewReader(corrupted)); err == nil || !strings.Contains(err.Error(), "hash mismatch") {
+		t.Errorf("got error %v, want hash mismatch", err)
+	}
+	if err := New(Options{}).LoadCompiled(strings.NewReader("package app")); err == nil {
+		t.Error("want error on invalid artifact")
+	}
+}
@@ -0,0 +1,98 @@
+package interp
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestConcurrentEval(t *testing.T) {
+	i := New(Options{})
+	if _, err := i.Eval(`func add(a, b int) int { return a + b }`); err != nil {
+		t.Fatal(err)
+	}
+	v, err := i.Eval(`add`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	add := v.Interface().(func(int, int) int)
+
+	// Evaluations declaring variables, and calls of interpreted functions,
+	// from several goroutines.
+	var wg sync.WaitGroup
+	errs := make(chan error, 8)
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			for k := 0; k < 20; k++ {
+				v, err := i.Eval(fmt.Sprintf("x%d_%d := add(%d, %d); x%d_%d", g, k, g, k, g, k))
+				if err != nil {
+					errs <- err
+					return
+				}
+				if v.Interface() != g+k {
+					errs <- fmt.Errorf("eval %d, %d: got %v", g, k, v)
+					return
+				}
+				v, err = i.Eval(fmt.Sprintf("x%d_%d", g, k))
+				if err != nil || v.Interface() != g+k {
+					errs <- fmt.Errorf("read %d, %d: got %v, %v", g, k, v, err)
+					return
+				}
+			}
+		}(g)
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		t.Error(err)
+	}
+
+	// Interpreted functions run concurrently, outside of evaluations.
+	var n sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		n.Add(1)
+		go func(g int) {
+			defer n.Done()
+			if r := add(g, 1); r != g+1 {
+				t.Errorf("add(%d, 1): got %d", g, r)
+			}
+		}(g)
+	}
+	n.Wait()
+}
+
+func TestEvalWithContextBlockedCall(t *testing.T) {
+	block := make(chan struct{})
+	defer close(block)
+	i := New(Options{})
+	i.Use(Exports{"host": {"Block": reflect.ValueOf(func() { <-block })}})
+	if _, err := i.Eval(`import "host"`); err != nil {
+		t.Fatal(err)
+	}
+
+	// The evaluation stopped while blocked in a binary call releases the
+	// interpreter.
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if _, err := i.EvalWithContext(ctx, `host.Block()`); err != context.DeadlineExceeded {
+		t.Fatalf("got error %v, want %v", err, context.DeadlineExceeded)
+	}
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		v, err := i.EvalWithContext(context.Background(), "1+1")
+		if err != nil || v.Interface() != 2 {
+			t.Errorf("got %v, %v, want 2", v, err)
+		}
+	}()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("interpreter locked after cancellation")
+	}
+}
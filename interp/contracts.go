@@ -0,0 +1,142 @@
+package interp
+
+import (
+	"fmt"
+	"go/token"
+	"reflect"
+	"strings"
+)
+
+// ContractsPath is the import path of the package of contract assertions of
+// interpreted code, always available. From interpreted code, it is used as
+// follows:
+//
+//	import "yaegi/contracts"
+//
+//	func Div(a, b int) int {
+//		contracts.Require(b != 0, "non zero divisor", a, b)
+//		...
+//	}
+//
+// Require, Ensure and Invariant check respectively a precondition, a
+// postcondition and an invariant. They have the signature
+// func(cond bool, msg string, values ...interface{}) error, and fail if cond
+// is false, with a *ContractError reporting the position of the call, msg and
+// values. What a failure does is set by Options.ContractMode.
+const ContractsPath = "yaegi/contracts"
+
+// ContractMode is the behavior of the failed contracts of interpreted code,
+// see ContractsPath.
+type ContractMode int
+
+// Contract modes.
+const (
+	// ContractPanic makes failed contracts panic with a *ContractError, which
+	// can be recovered by interpreted code. Otherwise, the evaluation returns
+	// a Panic error wrapping the *ContractError.
+	ContractPanic ContractMode = iota
+
+	// ContractReturn makes failed contracts return a *ContractError.
+	ContractReturn
+
+	// ContractLog makes failed contracts write their *ContractError to
+	// Stderr, and return nil.
+	ContractLog
+)
+
+// A ContractError is the failure of a contract of interpreted code.
+type ContractError struct {
+	Kind   string         // "precondition", "postcondition" or "invariant"
+	Pos    token.Position // position of the call, if called directly
+	Msg    string
+	Values []interface{}
+}
+
+func (e *ContractError) Error() string {
+	var sb strings.Builder
+	if e.Pos.IsValid() {
+		sb.WriteString(strings.TrimPrefix(e.Pos.String(), DefaultSourceName+":") + ": ")
+	}
+	sb.WriteString(e.Kind + " failed")
+	if e.Msg != "" {
+		sb.WriteString(": " + e.Msg)
+	}
+	for i, v := range e.Values {
+		if i == 0 {
+			sb.WriteString(" [")
+		} else {
+			sb.WriteString(", ")
+		}
+		fmt.Fprintf(&sb, "%#v", v)
+	}
+	if len(e.Values) > 0 {
+		sb.WriteString("]")
+	}
+	return sb.String()
+}
+
+// contractKinds are the kinds of the contract functions, indexed by name.
+var contractKinds = map[string]string{
+	"Require":   "precondition",
+	"Ensure":    "postcondition",
+	"Invariant": "invariant",
+}
+
+// checkContract returns the failure of the contract of kind, called at pos,
+// if cond is false, as set by Options.ContractMode.
+func (interp *Interpreter) checkContract(kind string, pos token.Position, cond bool, msg string, values []interface{}) error {
+	if cond {
+		return nil
+	}
+	err := &ContractError{Kind: kind, Pos: pos, Msg: msg, Values: values}
+	switch interp.contractMode {
+	case ContractReturn:
+		return err
+	case ContractLog:
+		fmt.Fprintln(interp.stderr, err)
+		return nil
+	}
+	panic(err)
+}
+
+// contractsExports returns the symbols of the contracts package. The
+// functions report no position, which is set by contractCall for direct
+// calls.
+func (interp *Interpreter) contractsExports() Exports {
+	syms := map[string]reflect.Value{}
+	for name, kind := range contractKinds {
+		kind := kind
+		syms[name] = reflect.ValueOf(func(cond bool, msg string, values ...interface{}) error {
+			return interp.checkContract(kind, token.Position{}, cond, msg, values)
+		})
+	}
+	return Exports{ContractsPath: syms}
+}
+
+// contractCall returns the function calling the contract function called by
+// node n with the position of n, or nil if n is not a call of the contracts
+// package.
+func (interp *Interpreter) contractCall(n *node) func(reflect.Value, []reflect.Value) []reflect.Value {
+	c0 := n.child[0]
+	if c0.kind != selectorExpr || c0.child[0].typ == nil || c0.child[0].typ.cat != binPkgT || c0.child[0].typ.path != ContractsPath {
+		return nil
+	}
+	kind, ok := contractKinds[c0.child[1].ident]
+	if !ok {
+		return nil
+	}
+	pos := interp.fset.Position(n.pos)
+	slice := n.action == aCallSlice
+	return func(_ reflect.Value, in []reflect.Value) []reflect.Value {
+		var values []interface{}
+		if slice {
+			values, _ = in[2].Interface().([]interface{})
+		} else {
+			for _, v := range in[2:] {
+				values = append(values, v.Interface())
+			}
+		}
+		err := interp.checkContract(kind, pos, in[0].Bool(), in[1].String(), values)
+		return []reflect.Value{reflect.ValueOf(&err).Elem()}
+	}
+}
@@ -0,0 +1,87 @@
+package interp
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestContracts(t *testing.T) {
+	i := New(Options{})
+	if _, err := i.Eval(`
+import "yaegi/contracts"
+
+func div(a, b int) int {
+	contracts.Require(b != 0, "non zero divisor", a, b)
+	return a / b
+}
+
+func safeDiv(a, b int) (res int, ok bool) {
+	defer func() {
+		if r := recover(); r != nil {
+			ok = false
+		}
+	}()
+	return div(a, b), true
+}`); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err := i.Eval(`div(1, 0)`)
+	var ce *ContractError
+	if !errors.As(err, &ce) {
+		t.Fatalf("got error %v, want contract error", err)
+	}
+	if ce.Kind != "precondition" || ce.Pos.Line != 5 || ce.Msg != "non zero divisor" || !reflect.DeepEqual(ce.Values, []interface{}{1, 0}) {
+		t.Errorf("unexpected error %#v", ce)
+	}
+	if got, want := ce.Error(), "5:2: precondition failed: non zero divisor [1, 0]"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+
+	// The failures can be recovered by interpreted code.
+	res, err := i.Eval(`_, ok := safeDiv(1, 0); ok`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Bool() {
+		t.Error("want recovered failure")
+	}
+}
+
+func TestContractModes(t *testing.T) {
+	var stderr bytes.Buffer
+	i := New(Options{Stderr: &stderr, ContractMode: ContractLog})
+	if _, err := i.Eval(`import "yaegi/contracts"`); err != nil {
+		t.Fatal(err)
+	}
+	res, err := i.Eval(`contracts.Invariant(false, "balance", -10) == nil`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !res.Bool() {
+		t.Error("want nil error in log mode")
+	}
+	if got, want := stderr.String(), "1:1: invariant failed: balance [-10]\n"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+
+	i = New(Options{ContractMode: ContractReturn})
+	if _, err := i.Eval(`import "yaegi/contracts"`); err != nil {
+		t.Fatal(err)
+	}
+	res, err = i.Eval(`contracts.Ensure(true, "ok") == nil`)
+	if err != nil || !res.Bool() {
+		t.Fatalf("got %v %v, want true", res, err)
+	}
+
+	// Indirect calls do not report a position.
+	res, err = i.Eval(`ensure := contracts.Ensure; ensure(false, "sorted", 2, 1).Error()`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := res.String(), "postcondition failed: sorted [2, 1]"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
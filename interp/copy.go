@@ -0,0 +1,138 @@
+package interp
+
+import (
+	"reflect"
+	"strings"
+)
+
+// A CopyMode selects the values deep copied by the calls of a binary
+// function by interpreted code, see Options.CopiedFuncs.
+type CopyMode uint
+
+// Copy modes.
+const (
+	CopyArgs    CopyMode = 1 << iota // arguments passed by interpreted code to the host
+	CopyResults                      // results returned by the host to interpreted code
+	CopyAll     = CopyArgs | CopyResults
+)
+
+// copied returns the binary symbol name of the package importPath, of value
+// v, wrapped to deep copy its arguments or results according to its copy
+// mode, if it is a function of Options.CopiedFuncs.
+func (interp *Interpreter) copied(importPath, name string, v reflect.Value) reflect.Value {
+	mode := interp.copiedFuncs[importPath+"."+name]
+	if v.Kind() != reflect.Func || v.IsNil() || mode == 0 {
+		return v
+	}
+	t := v.Type()
+	return reflect.MakeFunc(t, func(in []reflect.Value) []reflect.Value {
+		if mode&CopyArgs != 0 {
+			seen := map[copyKey]reflect.Value{}
+			for i, a := range in {
+				in[i] = deepCopy(a, seen)
+			}
+		}
+		var out []reflect.Value
+		if t.IsVariadic() {
+			out = v.CallSlice(in)
+		} else {
+			out = v.Call(in)
+		}
+		if mode&CopyResults != 0 {
+			seen := map[copyKey]reflect.Value{}
+			for i, r := range out {
+				out[i] = deepCopy(r, seen)
+			}
+		}
+		return out
+	})
+}
+
+// fixCopied wraps the copied functions of the used values.
+func fixCopied(interp *Interpreter, values Exports) {
+	for s := range interp.copiedFuncs {
+		i := strings.LastIndex(s, ".")
+		if i < 0 || values[s[:i]] == nil {
+			continue
+		}
+		if pkg := interp.binPkg[s[:i]]; pkg != nil {
+			if v, ok := pkg[s[i+1:]]; ok {
+				pkg[s[i+1:]] = interp.copied(s[:i], s[i+1:], v)
+			}
+		}
+	}
+}
+
+// copyKey identifies the pointers and maps already copied by deepCopy.
+type copyKey struct {
+	ptr uintptr
+	typ reflect.Type
+}
+
+// deepCopy returns a copy of v which shares no memory with it, through
+// pointers, slices, maps, arrays, structs and interfaces. The copies of the
+// pointers and maps already in seen are reused, so that cyclic values are
+// copied, and that the sharing of pointers and maps is preserved. Functions,
+// channels, unsafe pointers and unexported struct fields are not copied.
+func deepCopy(v reflect.Value, seen map[copyKey]reflect.Value) reflect.Value {
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() {
+			return v
+		}
+		k := copyKey{v.Pointer(), v.Type()}
+		if c, ok := seen[k]; ok {
+			return c
+		}
+		c := reflect.New(v.Type().Elem())
+		seen[k] = c
+		c.Elem().Set(deepCopy(v.Elem(), seen))
+		return c
+	case reflect.Map:
+		if v.IsNil() {
+			return v
+		}
+		k := copyKey{v.Pointer(), v.Type()}
+		if c, ok := seen[k]; ok {
+			return c
+		}
+		c := reflect.MakeMapWithSize(v.Type(), v.Len())
+		seen[k] = c
+		for it := v.MapRange(); it.Next(); {
+			c.SetMapIndex(deepCopy(it.Key(), seen), deepCopy(it.Value(), seen))
+		}
+		return c
+	case reflect.Slice:
+		if v.IsNil() {
+			return v
+		}
+		c := reflect.MakeSlice(v.Type(), v.Len(), v.Len())
+		for i := 0; i < v.Len(); i++ {
+			c.Index(i).Set(deepCopy(v.Index(i), seen))
+		}
+		return c
+	case reflect.Array:
+		c := reflect.New(v.Type()).Elem()
+		for i := 0; i < v.Len(); i++ {
+			c.Index(i).Set(deepCopy(v.Index(i), seen))
+		}
+		return c
+	case reflect.Struct:
+		c := reflect.New(v.Type()).Elem()
+		c.Set(v)
+		for i := 0; i < v.NumField(); i++ {
+			if f := c.Field(i); f.CanSet() {
+				f.Set(deepCopy(v.Field(i), seen))
+			}
+		}
+		return c
+	case reflect.Interface:
+		if v.IsNil() {
+			return v
+		}
+		c := reflect.New(v.Type()).Elem()
+		c.Set(deepCopy(v.Elem(), seen))
+		return c
+	}
+	return v
+}
@@ -0,0 +1,76 @@
+package interp
+
+import (
+	"reflect"
+	"testing"
+)
+
+type copyNode struct {
+	Next  *copyNode
+	Vals  []int
+	Attrs map[string]interface{}
+}
+
+func TestCopiedFuncs(t *testing.T) {
+	items := []int{1, 2, 3}
+	index := map[string][]int{"a": {1}}
+	var kept []int
+	i := New(Options{CopiedFuncs: map[string]CopyMode{
+		"store.Items": CopyResults,
+		"store.Index": CopyAll,
+		"store.Keep":  CopyArgs,
+	}})
+	i.Use(Exports{"store": {
+		"Items": reflect.ValueOf(func() []int { return items }),
+		"Index": reflect.ValueOf(func() map[string][]int { return index }),
+		"Keep":  reflect.ValueOf(func(s ...int) { kept = s }),
+		"Raw":   reflect.ValueOf(func() []int { return items }),
+	}})
+	if _, err := i.Eval(`import "store"`); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := i.Eval(`
+s := store.Items()
+s[0] = 10
+store.Index()["a"][0] = 10
+k := []int{1, 2}
+store.Keep(k...)
+k[0] = 10
+`); err != nil {
+		t.Fatal(err)
+	}
+	if items[0] != 1 || index["a"][0] != 1 || kept[0] != 1 {
+		t.Errorf("host values changed: %v, %v, %v", items, index, kept)
+	}
+
+	// The functions not listed are not copied, and the overrides are.
+	if _, err := i.Eval(`store.Raw()[0] = 10`); err != nil {
+		t.Fatal(err)
+	}
+	if items[0] != 10 {
+		t.Errorf("got %v, want the value of Raw changed", items)
+	}
+	if err := i.Override("store", "Items", reflect.ValueOf(func() []int { return kept })); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := i.Eval(`store.Items()[0] = 20`); err != nil {
+		t.Fatal(err)
+	}
+	if kept[0] != 1 {
+		t.Errorf("got %v, want the override copied", kept)
+	}
+}
+
+func TestDeepCopy(t *testing.T) {
+	n := &copyNode{Vals: []int{1}, Attrs: map[string]interface{}{"v": []string{"a"}}}
+	n.Next = n
+	c := deepCopy(reflect.ValueOf(n), map[copyKey]reflect.Value{}).Interface().(*copyNode)
+	if c == n || c.Next != c {
+		t.Fatalf("got %p, %p, want a distinct cyclic copy of %p", c, c.Next, n)
+	}
+	c.Vals[0] = 2
+	c.Attrs["v"].([]string)[0] = "b"
+	if n.Vals[0] != 1 || n.Attrs["v"].([]string)[0] != "a" {
+		t.Errorf("original changed: %v, %v", n.Vals, n.Attrs)
+	}
+}
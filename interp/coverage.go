@@ -0,0 +1,342 @@
+package interp
+
+import (
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/token"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+// coverage is the statement coverage of the source files, collected if
+// Options.CollectCoverage is set.
+type coverage struct {
+	mu       sync.Mutex
+	files    map[string][]*coverBlock // blocks, indexed by file name
+	triggers map[coverPos]*coverBlock // blocks, indexed by the position of their statements
+}
+
+// coverBlock is a basic block of source code, as by "go test -cover": a
+// sequence of statements executed together, unless a panic occurs.
+type coverBlock struct {
+	start, end token.Position
+	stmts      int
+	hit        uint32
+}
+
+// coverPos is a position in a file, independent of its file set, so that the
+// coverage can be shared by the interpreter of Test.
+type coverPos struct {
+	file   string
+	offset int
+}
+
+// CoverageProfile writes the statement coverage of the source files
+// evaluated so far, excluding test files and the code evaluated
+// incrementally, to w in the format of the profiles of "go test
+// -coverprofile", in "set" mode, readable by "go tool cover". It requires
+// Options.CollectCoverage. The coverage of the package tested by
+// Interpreter.Test is collected by the interpreter running the test.
+func (interp *Interpreter) CoverageProfile(w io.Writer) error {
+	c := interp.coverage
+	if c == nil {
+		return errors.New("coverage not collected, see Options.CollectCoverage")
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	files := make([]string, 0, len(c.files))
+	for name := range c.files {
+		files = append(files, name)
+	}
+	sort.Strings(files)
+
+	if _, err := io.WriteString(w, "mode: set\n"); err != nil {
+		return err
+	}
+	for _, name := range files {
+		for _, b := range c.files[name] {
+			if _, err := fmt.Fprintf(w, "%s:%d.%d,%d.%d %d %d\n", name, b.start.Line, b.start.Column, b.end.Line, b.end.Column, b.stmts, atomic.LoadUint32(&b.hit)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// addFile records the basic blocks of the source file f, replacing the ones of
+// a previous version of the file.
+func (c *coverage) addFile(fset *token.FileSet, f *ast.File) {
+	name := fset.Position(f.Pos()).Filename
+	if strings.HasSuffix(name, "_test.go") {
+		return
+	}
+	cv := &coverVisitor{fset: fset, triggers: map[coverPos]*coverBlock{}}
+	ast.Walk(cv, f)
+	sort.SliceStable(cv.blocks, func(i, j int) bool { return cv.blocks[i].start.Offset < cv.blocks[j].start.Offset })
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for k := range c.triggers {
+		if k.file == name {
+			delete(c.triggers, k)
+		}
+	}
+	c.files[name] = cv.blocks
+	for k, b := range cv.triggers {
+		c.triggers[k] = b
+	}
+}
+
+// wrap makes the execution of node n, just compiled, mark its block as
+// covered, if it is at the position of a statement.
+func (c *coverage) wrap(n *node) {
+	if n.exec == nil || !n.pos.IsValid() {
+		return
+	}
+	pos := n.interp.fset.Position(n.pos)
+	c.mu.Lock()
+	b := c.triggers[coverPos{pos.Filename, pos.Offset}]
+	c.mu.Unlock()
+	if b == nil {
+		return
+	}
+	exec := n.exec
+	n.exec = func(f *frame) bltn {
+		atomic.StoreUint32(&b.hit, 1)
+		return exec(f)
+	}
+}
+
+// coverVisitor computes the basic blocks of a file, as the cover tool of Go.
+type coverVisitor struct {
+	fset     *token.FileSet
+	blocks   []*coverBlock
+	triggers map[coverPos]*coverBlock
+	elses    map[*ast.BlockStmt]token.Pos // start of else blocks
+}
+
+func (v *coverVisitor) Visit(n ast.Node) ast.Visitor {
+	switch n := n.(type) {
+	case *ast.FuncDecl:
+		// Functions with a blank name can not be executed.
+		if n.Name.Name == "_" || n.Body == nil {
+			return nil
+		}
+	case *ast.BlockStmt:
+		if len(n.List) > 0 {
+			switch n.List[0].(type) {
+			case *ast.CaseClause:
+				for _, s := range n.List {
+					c := s.(*ast.CaseClause)
+					v.addBlocks(c.Colon+1, c.End(), c.Body, false)
+				}
+				return v
+			case *ast.CommClause:
+				for _, s := range n.List {
+					c := s.(*ast.CommClause)
+					v.addBlocks(c.Colon+1, c.End(), c.Body, false)
+				}
+				return v
+			}
+		}
+		start := n.Lbrace
+		if p, ok := v.elses[n]; ok {
+			start = p
+		}
+		v.addBlocks(start, n.Rbrace+1, n.List, true)
+	case *ast.IfStmt:
+		// An else if statement is a block of its own, starting at the end of
+		// the previous body, as the else block.
+		switch s := n.Else.(type) {
+		case *ast.IfStmt:
+			v.addBlocks(n.Body.End(), s.End(), []ast.Stmt{s}, true)
+		case *ast.BlockStmt:
+			if v.elses == nil {
+				v.elses = map[*ast.BlockStmt]token.Pos{}
+			}
+			v.elses[s] = n.Body.End()
+		}
+	}
+	return v
+}
+
+// addBlocks splits the statement list of a block from start to end into basic
+// blocks, as the addCounters function of the cover tool of Go.
+func (v *coverVisitor) addBlocks(start, end token.Pos, list []ast.Stmt, extendToClosingBrace bool) {
+	list = append([]ast.Stmt(nil), list...)
+	for len(list) > 0 {
+		var last int
+		blockEnd := end
+		for last = 0; last < len(list); last++ {
+			s := list[last]
+			blockEnd = statementBoundary(s)
+			if endsBasicBlock(s) {
+				// A labeled statement may be the target of a goto, starting a
+				// new block, unless it is a control statement.
+				if l, ok := s.(*ast.LabeledStmt); ok && !isControl(l.Stmt) {
+					label := *l
+					label.Stmt = &ast.EmptyStmt{Semicolon: l.Stmt.Pos(), Implicit: true}
+					blockEnd = l.Pos()
+					list[last] = &label
+					list = append(list, nil)
+					copy(list[last+1:], list[last:])
+					list[last+1] = l.Stmt
+				}
+				last++
+				extendToClosingBrace = false
+				break
+			}
+		}
+		if extendToClosingBrace {
+			blockEnd = end
+		}
+		if start != blockEnd {
+			b := &coverBlock{start: v.fset.Position(start), end: v.fset.Position(blockEnd), stmts: last}
+			v.blocks = append(v.blocks, b)
+			for _, s := range list[:last] {
+				for _, p := range statementPos(s) {
+					if p.IsValid() {
+						pos := v.fset.Position(p)
+						v.triggers[coverPos{pos.Filename, pos.Offset}] = b
+					}
+				}
+			}
+		}
+		list = list[last:]
+		if len(list) > 0 {
+			start = list[0].Pos()
+		}
+	}
+}
+
+// statementPos returns the positions of the nodes executed when entering the
+// statement s: its own and the ones of its header, for control statements.
+func statementPos(s ast.Stmt) []token.Pos {
+	pos := []token.Pos{s.Pos()}
+	switch s := s.(type) {
+	case *ast.IfStmt:
+		if s.Init != nil {
+			pos = append(pos, s.Init.Pos())
+		}
+		pos = append(pos, s.Cond.Pos())
+	case *ast.ForStmt:
+		if s.Init != nil {
+			pos = append(pos, s.Init.Pos())
+		}
+		if s.Cond != nil {
+			pos = append(pos, s.Cond.Pos())
+		}
+	case *ast.RangeStmt:
+		pos = append(pos, s.X.Pos())
+	case *ast.SwitchStmt:
+		if s.Init != nil {
+			pos = append(pos, s.Init.Pos())
+		}
+		if s.Tag != nil {
+			pos = append(pos, s.Tag.Pos())
+		}
+	case *ast.TypeSwitchStmt:
+		if s.Init != nil {
+			pos = append(pos, s.Init.Pos())
+		}
+		pos = append(pos, s.Assign.Pos())
+	case *ast.LabeledStmt:
+		pos = append(pos, statementPos(s.Stmt)...)
+	}
+	return pos
+}
+
+// statementBoundary returns the end of the block containing statement s: the
+// start of its body for control statements, or of its first function literal.
+func statementBoundary(s ast.Stmt) token.Pos {
+	switch s := s.(type) {
+	case *ast.BlockStmt:
+		return s.Lbrace
+	case *ast.IfStmt:
+		if p := funcLitPos(s.Init, s.Cond); p.IsValid() {
+			return p
+		}
+		return s.Body.Lbrace
+	case *ast.ForStmt:
+		if p := funcLitPos(s.Init, s.Cond, s.Post); p.IsValid() {
+			return p
+		}
+		return s.Body.Lbrace
+	case *ast.LabeledStmt:
+		return statementBoundary(s.Stmt)
+	case *ast.RangeStmt:
+		if p := funcLitPos(s.X); p.IsValid() {
+			return p
+		}
+		return s.Body.Lbrace
+	case *ast.SwitchStmt:
+		if p := funcLitPos(s.Init, s.Tag); p.IsValid() {
+			return p
+		}
+		return s.Body.Lbrace
+	case *ast.SelectStmt:
+		return s.Body.Lbrace
+	case *ast.TypeSwitchStmt:
+		if p := funcLitPos(s.Init); p.IsValid() {
+			return p
+		}
+		return s.Body.Lbrace
+	}
+	if p := funcLitPos(s); p.IsValid() {
+		return p
+	}
+	return s.End()
+}
+
+// endsBasicBlock returns true if statement s ends a basic block: a control
+// statement, a panic, or a statement containing a function literal.
+func endsBasicBlock(s ast.Stmt) bool {
+	switch s := s.(type) {
+	case *ast.BlockStmt, *ast.BranchStmt, *ast.ForStmt, *ast.IfStmt, *ast.RangeStmt,
+		*ast.SwitchStmt, *ast.SelectStmt, *ast.TypeSwitchStmt, *ast.CaseClause, *ast.CommClause:
+		return true
+	case *ast.LabeledStmt:
+		// A goto may branch here, starting a new block.
+		return true
+	case *ast.ExprStmt:
+		if call, ok := s.X.(*ast.CallExpr); ok {
+			if id, ok := call.Fun.(*ast.Ident); ok && id.Name == "panic" && len(call.Args) == 1 {
+				return true
+			}
+		}
+	}
+	return funcLitPos(s).IsValid()
+}
+
+// isControl returns true if statement s is a control statement, which may be
+// labeled without starting a new block.
+func isControl(s ast.Stmt) bool {
+	switch s.(type) {
+	case *ast.ForStmt, *ast.RangeStmt, *ast.SwitchStmt, *ast.SelectStmt, *ast.TypeSwitchStmt:
+		return true
+	}
+	return false
+}
+
+// funcLitPos returns the position of the first function literal in nodes, or
+// token.NoPos.
+func funcLitPos(nodes ...ast.Node) token.Pos {
+	pos := token.NoPos
+	for _, n := range nodes {
+		if n == nil || pos.IsValid() {
+			continue
+		}
+		ast.Inspect(n, func(n ast.Node) bool {
+			if l, ok := n.(*ast.FuncLit); ok && !pos.IsValid() {
+				pos = l.Pos()
+			}
+			return !pos.IsValid()
+		})
+	}
+	return pos
+}
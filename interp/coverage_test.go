@@ -0,0 +1,109 @@
+package interp
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestCoverageProfile(t *testing.T) {
+	goPath, err := ioutil.TempDir("", "cover")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		_ = os.RemoveAll(goPath)
+	}()
+
+	files := map[string]string{
+		"p/p.go": `package p
+
+func Sign(x int) int {
+	if x > 0 {
+		return 1
+	} else if x < 0 {
+		return -1
+	}
+	return 0
+}
+
+func Sum(s []int) (n int) {
+	for _, v := range s {
+		n += v
+	}
+	return n
+}
+
+func Kind(x interface{}) string {
+	switch x.(type) {
+	case int:
+		return "int"
+	default:
+		return "other"
+	}
+}
+
+func Apply(f func(int) int) int {
+	g := func() int { return f(1) }
+	return g()
+}
+`,
+		"p/p_test.go": `package p
+
+import "testing"
+
+func TestP(t *testing.T) {
+	if Sign(2) != 1 || Sum([]int{1, 2}) != 3 || Kind(1) != "int" {
+		t.Fatal("wrong result")
+	}
+}
+`,
+	}
+	for name, src := range files {
+		name = filepath.Join(goPath, "src", name)
+		if err := os.MkdirAll(filepath.Dir(name), 0700); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(name, []byte(src), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var out bytes.Buffer
+	if err := New(Options{}).CoverageProfile(&out); err == nil {
+		t.Fatal("got no error without Options.CollectCoverage")
+	}
+
+	i := New(Options{GoPath: goPath, CollectCoverage: true})
+	if err := i.Test("p", &out); err != nil {
+		t.Fatalf("%v\n%s", err, out.String())
+	}
+	out.Reset()
+	if err := i.CoverageProfile(&out); err != nil {
+		t.Fatal(err)
+	}
+	name := filepath.Join(goPath, "src", "p", "p.go")
+	got := strings.ReplaceAll(out.String(), name, "p.go")
+	want := `mode: set
+p.go:3.22,4.11 1 1
+p.go:4.11,6.3 1 1
+p.go:6.3,6.18 1 0
+p.go:6.18,8.3 1 0
+p.go:9.2,9.10 1 0
+p.go:12.27,13.22 1 1
+p.go:13.22,15.3 1 1
+p.go:16.2,16.10 1 1
+p.go:19.33,20.18 1 1
+p.go:21.11,22.15 1 1
+p.go:23.10,24.17 1 0
+p.go:28.33,29.7 1 0
+p.go:29.18,29.33 1 0
+p.go:30.2,30.12 1 0
+`
+	if got != want {
+		t.Errorf("got:\n%s\nwant:\n%s", got, want)
+	}
+}
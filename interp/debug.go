@@ -0,0 +1,288 @@
+package interp
+
+import (
+	"go/token"
+	"reflect"
+	"sort"
+	"sync"
+)
+
+// DebugAction is the way execution resumes after a stop of a Debugger.
+type DebugAction int
+
+// Debugger actions.
+const (
+	DebugContinue DebugAction = iota // run until the next breakpoint
+	DebugStepInto                    // stop at the next node, including in called functions
+	DebugStepOver                    // stop at the next node of the current function or of its callers
+	DebugStepOut                     // stop at the next node of a caller of the current function
+)
+
+// Reasons of a stop of a Debugger.
+const (
+	DebugBreakpoint = "breakpoint" // a breakpoint is reached
+	DebugStep       = "step"       // a step is completed
+	DebugPause      = "pause"      // Debugger.Pause was called
+)
+
+// Debugger controls the execution of interpreted code, if set in
+// Options.Debugger. Execution stops at breakpoints, set by file and line,
+// after the steps requested on each stop, and on Pause. On a stop, the
+// function given to NewDebugger is called in the stopped goroutine, which
+// resumes when it returns, with the returned action. Stops of concurrent
+// goroutines are serialized.
+//
+// Steps are at the granularity of the nodes of the compiled code, several of
+// which are usually executed per line. Function inlining is disabled, so that
+// breakpoints can be set in any function.
+//
+// A Debugger is meant to build interactive debuggers, such as Debug Adapter
+// Protocol servers, on top of the interpreter.
+type Debugger struct {
+	onStop func(*DebugStop) DebugAction
+	stopMu sync.Mutex // serializes the calls to onStop
+
+	mu          sync.Mutex
+	breakpoints map[string]map[int]bool // lines of breakpoints, by file name
+	action      DebugAction             // action returned by the last stop
+	frame       *frame                  // frame of the last stop
+	pause       bool                    // stop at the next node
+	scopes      map[*node]*scope        // innermost scope of compiled nodes
+}
+
+// NewDebugger returns a debugger calling onStop on each stop of execution.
+func NewDebugger(onStop func(*DebugStop) DebugAction) *Debugger {
+	return &Debugger{
+		onStop:      onStop,
+		breakpoints: map[string]map[int]bool{},
+		scopes:      map[*node]*scope{},
+	}
+}
+
+// SetBreakpoint sets a breakpoint at line of file, the name of a source file
+// as reported in positions: the path of the files of imported packages, or
+// DefaultSourceName for the code given to Eval.
+func (d *Debugger) SetBreakpoint(file string, line int) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.breakpoints[file] == nil {
+		d.breakpoints[file] = map[int]bool{}
+	}
+	d.breakpoints[file][line] = true
+}
+
+// ClearBreakpoint removes the breakpoint at line of file. A line of 0 removes
+// all the breakpoints of file.
+func (d *Debugger) ClearBreakpoint(file string, line int) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if line == 0 {
+		delete(d.breakpoints, file)
+		return
+	}
+	delete(d.breakpoints[file], line)
+}
+
+// Pause stops the execution at the next node, in any goroutine.
+func (d *Debugger) Pause() {
+	d.mu.Lock()
+	d.pause = true
+	d.mu.Unlock()
+}
+
+// DebugStop is the state of a goroutine stopped by a Debugger. It is valid
+// during the call of the stop function only.
+type DebugStop struct {
+	Reason string         // DebugBreakpoint, DebugStep or DebugPause
+	Pos    token.Position // position of the next node to execute
+
+	d     *Debugger
+	node  *node
+	frame *frame
+}
+
+// DebugVar is a variable of a stopped goroutine. A value with redacted parts,
+// see Options.Redaction, is replaced by its redacted formatted string.
+type DebugVar struct {
+	Name  string
+	Value reflect.Value
+}
+
+// DebugFrame is a function call of a stopped goroutine.
+type DebugFrame struct {
+	Func string         // qualified function name, empty for package level code
+	Pos  token.Position // position of the node being executed
+}
+
+// Locals returns the local variables in scope of the stopped function,
+// including the ones captured by a closure, sorted by name. Variables
+// declared later in the scope are included, with their zero value.
+func (s *DebugStop) Locals() []DebugVar {
+	s.d.mu.Lock()
+	sc := s.d.scopes[s.node]
+	s.d.mu.Unlock()
+
+	var vars []DebugVar
+	seen := map[string]bool{}
+	level := s.d.scopeLevel(s.node)
+	for ; sc != nil && !sc.global; sc = sc.anc {
+		f := s.frame
+		for l := level; l > sc.level && f != nil; l-- {
+			f = f.anc
+		}
+		if f == nil {
+			break
+		}
+		for name, sym := range sc.sym {
+			if sym.kind != varSym || sym.index < 0 || sym.index >= len(f.data) || seen[name] || name == "_" {
+				continue
+			}
+			// Inner scopes shadow outer ones.
+			seen[name] = true
+			v := f.data[sym.index]
+			if v.IsValid() && v.CanInterface() {
+				switch x := v.Interface().(type) {
+				case valueInterface:
+					v = x.value
+				case *node:
+					// Interpreted function value.
+					v = genFunctionWrapper(x)(f)
+				}
+			}
+			if r := s.node.interp.redaction; r != nil {
+				if str, ok := r.format(v); ok {
+					v = reflect.ValueOf(str)
+				}
+			}
+			vars = append(vars, DebugVar{Name: name, Value: v})
+		}
+	}
+	sort.Slice(vars, func(i, j int) bool { return vars[i].Name < vars[j].Name })
+	return vars
+}
+
+// Stack returns the calls of the stopped goroutine, innermost first, up to
+// the first call from binary code or the start of the goroutine.
+func (s *DebugStop) Stack() []DebugFrame {
+	var stack []DebugFrame
+	for f := s.frame; f != nil; {
+		fd := f.debug
+		if fd == nil {
+			break
+		}
+		df := DebugFrame{Pos: fd.pos}
+		if def := fd.def; def != nil {
+			df.Func = "func literal"
+			if def.kind == funcDecl {
+				df.Func = funcName(s.d.pkgID(def), def)
+			}
+		}
+		stack = append(stack, df)
+		f = fd.caller
+	}
+	return stack
+}
+
+// frameDebug is the debugging state of a frame.
+type frameDebug struct {
+	caller *frame         // calling frame, nil if called from binary code or in a new goroutine
+	def    *node          // definition of the called function, nil for package level code
+	pos    token.Position // position of the node being executed
+}
+
+// setScope records sc as the innermost scope of node n, at compilation.
+func (d *Debugger) setScope(n *node, sc *scope) {
+	d.mu.Lock()
+	d.scopes[n] = sc
+	d.mu.Unlock()
+}
+
+// scopeLevel returns the frame level of node n.
+func (d *Debugger) scopeLevel(n *node) int {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if sc := d.scopes[n]; sc != nil {
+		return sc.level
+	}
+	return 0
+}
+
+// pkgID returns the package of node n.
+func (d *Debugger) pkgID(n *node) string {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if sc := d.scopes[n]; sc != nil {
+		return sc.pkgID
+	}
+	return ""
+}
+
+// wrap makes the execution of node n, just compiled, stop when required.
+func (d *Debugger) wrap(n *node) {
+	if n.exec == nil || !n.pos.IsValid() {
+		return
+	}
+	exec := n.exec
+	pos := n.interp.fset.Position(n.pos)
+	n.exec = func(f *frame) bltn {
+		d.step(n, pos, f)
+		return exec(f)
+	}
+}
+
+// step is called before the execution of node n at pos, in frame f, and
+// calls the stop function if required.
+func (d *Debugger) step(n *node, pos token.Position, f *frame) {
+	if f.debug == nil {
+		f.debug = &frameDebug{}
+	}
+	fd := f.debug
+	newLine := fd.pos.Line != pos.Line || fd.pos.Filename != pos.Filename
+	fd.pos = pos
+
+	reason := ""
+	d.mu.Lock()
+	switch {
+	case d.pause:
+		reason = DebugPause
+		d.pause = false
+	case d.action == DebugStepInto,
+		d.action == DebugStepOver && isCaller(f, d.frame, true),
+		d.action == DebugStepOut && isCaller(f, d.frame, false):
+		reason = DebugStep
+	case newLine && d.breakpoints[pos.Filename][pos.Line]:
+		reason = DebugBreakpoint
+	}
+	d.mu.Unlock()
+	if reason == "" {
+		return
+	}
+
+	d.stopMu.Lock()
+	action := d.onStop(&DebugStop{Reason: reason, Pos: pos, d: d, node: n, frame: f})
+	d.mu.Lock()
+	d.action, d.frame = action, f
+	d.mu.Unlock()
+	d.stopMu.Unlock()
+}
+
+// isCaller returns true if frame f is a caller of frame g, or g itself if self
+// is true.
+func isCaller(f, g *frame, self bool) bool {
+	if !self {
+		if g == nil || g.debug == nil {
+			return false
+		}
+		g = g.debug.caller
+	}
+	for g != nil {
+		if f == g {
+			return true
+		}
+		if g.debug == nil {
+			return false
+		}
+		g = g.debug.caller
+	}
+	return false
+}
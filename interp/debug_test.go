@@ -0,0 +1,83 @@
+package interp
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestDebugger(t *testing.T) {
+	src := `package main
+
+func add(a, b int) int {
+	c := a + b
+	return c
+}
+
+func main() {
+	x := 1
+	f := func() int { return add(x, 2) }
+	y := f()
+	for i := 0; i < 3; i++ {
+		y += i
+	}
+}
+`
+	// debug evaluates src with a breakpoint at line, and returns the stops
+	// as "reason line stack locals", resumed with the actions in order.
+	debug := func(line int, actions ...DebugAction) []string {
+		var stops []string
+		var d *Debugger
+		d = NewDebugger(func(s *DebugStop) DebugAction {
+			var funcs, locals []string
+			for _, f := range s.Stack() {
+				funcs = append(funcs, f.Func)
+			}
+			for _, v := range s.Locals() {
+				if v.Value.Kind() != reflect.Func {
+					locals = append(locals, fmt.Sprintf("%s=%v", v.Name, v.Value))
+				}
+			}
+			stops = append(stops, fmt.Sprintf("%s %d %s %s", s.Reason, s.Pos.Line, strings.Join(funcs, "<"), strings.Join(locals, ",")))
+			if len(stops) > len(actions) {
+				d.ClearBreakpoint(DefaultSourceName, 0)
+				return DebugContinue
+			}
+			return actions[len(stops)-1]
+		})
+		d.SetBreakpoint(DefaultSourceName, line)
+		i := New(Options{Debugger: d})
+		if _, err := i.Eval(src); err != nil {
+			t.Fatal(err)
+		}
+		return stops
+	}
+
+	stops := debug(4, DebugStepOver, DebugStepOut)
+	want := []string{
+		"breakpoint 4 main.add<func literal<main.main a=1,b=2,c=0",
+		"step 4 main.add<func literal<main.main a=1,b=2,c=0",
+		"step 11 main.main x=1,y=3",
+	}
+	if !reflect.DeepEqual(stops, want) {
+		t.Errorf("got %q, want %q", stops, want)
+	}
+
+	// Stepping into the call of the function literal.
+	stops = debug(11, DebugStepInto, DebugStepInto)
+	if len(stops) != 3 || !strings.HasPrefix(stops[1], "step 10 func literal<main.main x=1") || !strings.HasPrefix(stops[2], "step 4 main.add<") {
+		t.Errorf("unexpected stops %q", stops)
+	}
+
+	// A breakpoint in a loop stops at each iteration.
+	stops = debug(13, DebugContinue, DebugContinue)
+	want = []string{
+		"breakpoint 13 main.main i=0,x=1,y=3",
+		"breakpoint 13 main.main i=1,x=1,y=3",
+		"breakpoint 13 main.main i=2,x=1,y=4",
+	}
+	if !reflect.DeepEqual(stops, want) {
+		t.Errorf("got %q, want %q", stops, want)
+	}
+}
@@ -0,0 +1,141 @@
+package interp
+
+import (
+	"go/token"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// DiagnosticCode classifies the diagnostics reported by
+// Interpreter.Diagnostics.
+type DiagnosticCode string
+
+// Diagnostic codes.
+const (
+	DiagEmptyInterface DiagnosticCode = "empty interface" // use of the interface{} type
+	DiagTypeAssertion  DiagnosticCode = "type assertion"  // type assertion or switch on an interface{} value
+	DiagReflection     DiagnosticCode = "reflection"      // call to the reflect package
+)
+
+// A Diagnostic is a pattern of interpreted source code which is valid, but
+// executed much slower by the interpreter than a typed alternative.
+type Diagnostic struct {
+	Pos        token.Position
+	Code       DiagnosticCode
+	Message    string
+	Suggestion string // typed alternative to the reported pattern
+}
+
+func (d Diagnostic) String() string {
+	return d.Pos.String() + ": " + d.Message + " (" + d.Suggestion + ")"
+}
+
+// Diagnostics returns the diagnostics of the source code compiled so far by
+// the interpreter, sorted by package then source order, for the analyses
+// enabled by Options.TypingDiagnostics. Functions of imported packages which
+// are not compiled yet (see Options.EagerCompile) are not analyzed.
+func (interp *Interpreter) Diagnostics() []Diagnostic {
+	if !interp.opt.typingDiagnostics {
+		return nil
+	}
+	interp.mutex.RLock()
+	defer interp.mutex.RUnlock()
+
+	pkgs := make([]string, 0, len(interp.roots))
+	for p := range interp.roots {
+		pkgs = append(pkgs, p)
+	}
+	sort.Strings(pkgs)
+
+	var diags []Diagnostic
+	for _, p := range pkgs {
+		for _, root := range interp.roots[p] {
+			root.Walk(func(n *node) bool {
+				if d, ok := interp.typingDiagnostic(n); ok {
+					d.Pos = interp.fset.Position(n.pos)
+					diags = append(diags, d)
+				}
+				return true
+			}, nil)
+		}
+	}
+	return diags
+}
+
+// typingDiagnostic returns the diagnostic of node n, if it uses the empty
+// interface or reflection, which block the static typing of the values
+// they handle.
+func (interp *Interpreter) typingDiagnostic(n *node) (Diagnostic, bool) {
+	switch n.kind {
+	case interfaceType:
+		if len(n.child) > 0 && len(n.child[0].child) > 0 {
+			return Diagnostic{}, false
+		}
+		return Diagnostic{
+			Code:       DiagEmptyInterface,
+			Message:    "interface{} used in " + emptyInterfaceContext(n),
+			Suggestion: "use a concrete type, or an interface with methods",
+		}, true
+
+	case typeAssertExpr:
+		if t := n.child[0].typ; t == nil || !isEmptyInterface(t) {
+			return Diagnostic{}, false
+		}
+		msg := "type assertion on an interface{} value"
+		if len(n.child) == 1 {
+			msg = "type switch on an interface{} value"
+		}
+		return Diagnostic{
+			Code:       DiagTypeAssertion,
+			Message:    msg,
+			Suggestion: "use a concrete type, or an interface with methods to check types statically",
+		}, true
+
+	case callExpr:
+		callee, binary := interp.callee(n, nil)
+		if !binary || !strings.HasPrefix(strings.TrimLeft(callee, "(*"), "reflect.") {
+			return Diagnostic{}, false
+		}
+		return Diagnostic{
+			Code:       DiagReflection,
+			Message:    "call to " + callee,
+			Suggestion: "use static types, reflection on interpreted values is slow and does not see their methods",
+		}, true
+	}
+	return Diagnostic{}, false
+}
+
+// emptyInterfaceContext describes where the interface type node n is used.
+func emptyInterfaceContext(n *node) string {
+	for a := n.anc; a != nil; a = a.anc {
+		switch a.kind {
+		case funcType:
+			return "function signature"
+		case structType:
+			return "struct field"
+		case compositeLitExpr:
+			return "composite literal"
+		case valueSpec, defineStmt:
+			return "variable declaration"
+		case typeSpec:
+			return "type declaration"
+		case funcDecl, funcLit, fileStmt:
+			return "expression"
+		}
+	}
+	return "expression"
+}
+
+// isEmptyInterface returns true if t is an interface without methods.
+func isEmptyInterface(t *itype) bool {
+	switch t.cat {
+	case interfaceT:
+		return len(t.field) == 0
+	case aliasT:
+		return isEmptyInterface(t.val)
+	case valueT:
+		return t.rtype.Kind() == reflect.Interface && t.rtype.NumMethod() == 0
+	}
+	return false
+}
@@ -0,0 +1,66 @@
+package interp
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestDiagnostics(t *testing.T) {
+	i := New(Options{TypingDiagnostics: true})
+	i.Use(Exports{"reflect": {
+		"Kind":    reflect.ValueOf((*reflect.Kind)(nil)),
+		"Value":   reflect.ValueOf((*reflect.Value)(nil)),
+		"ValueOf": reflect.ValueOf(reflect.ValueOf),
+	}})
+	if _, err := i.Eval(`
+import "reflect"
+
+type N int
+
+func (N) String() string { return "n" }
+
+type Stringer interface{ String() string }
+
+type T struct{ v interface{} }
+
+func get(m map[string]interface{}, k string) int {
+	switch m[k].(type) {
+	case int:
+		return m[k].(int)
+	}
+	return 0
+}
+
+func isN(s Stringer) bool { _, ok := s.(N); return ok }
+
+func kind(v int) string { return reflect.ValueOf(v).Kind().String() }
+
+var v = []interface{}{1, "a"}
+`); err != nil {
+		t.Fatal(err)
+	}
+
+	var got []string
+	for _, d := range i.Diagnostics() {
+		got = append(got, fmt.Sprintf("%d %s: %s", d.Pos.Line, d.Code, d.Message))
+	}
+	want := []string{
+		"10 empty interface: interface{} used in struct field",
+		"12 empty interface: interface{} used in function signature",
+		"13 type assertion: type switch on an interface{} value",
+		"15 type assertion: type assertion on an interface{} value",
+		"22 reflection: call to (reflect.Kind).String",
+		"22 reflection: call to (reflect.Value).Kind",
+		"22 reflection: call to reflect.ValueOf",
+		"24 empty interface: interface{} used in composite literal",
+	}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("got:\n%s\nwant:\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+
+	if d := New(Options{}).Diagnostics(); d != nil {
+		t.Errorf("got %v, want no diagnostics when disabled", d)
+	}
+}
@@ -0,0 +1,60 @@
+package interp
+
+import (
+	"fmt"
+	"strings"
+)
+
+// A SourceMap maps the positions of a source rewritten by Options.Dialect to
+// the ones of the original text. Each mapping starts a span of the rewritten
+// source whose positions are the ones of the original text from the origin
+// of the mapping, until the next mapping: a span is either copied from the
+// original text, or generated for the construct at its origin. A span
+// continued after a line break keeps the columns of the rewritten source.
+// The mappings must be sorted by offset, and start at token boundaries. The
+// positions of the rewritten source before the first mapping, or of all of
+// it if the map is empty, are not mapped.
+type SourceMap []SourceMapping
+
+// A SourceMapping is the start of a span of a SourceMap.
+type SourceMapping struct {
+	Offset int // byte offset of the span in the rewritten source
+	Orig   int // byte offset of its origin in the original text
+}
+
+// rewrite returns src rewritten by the dialect of the interpreter, if any,
+// with the line directives mapping its positions to the ones of src.
+func (interp *Interpreter) rewrite(src string) (string, error) {
+	if interp.dialect == nil {
+		return src, nil
+	}
+	out, m, err := interp.dialect(src)
+	if err != nil || len(m) == 0 {
+		return out, err
+	}
+
+	// Offsets of the line starts of src.
+	lines := []int{0}
+	for i := 0; i < len(src); i++ {
+		if src[i] == '\n' {
+			lines = append(lines, i+1)
+		}
+	}
+
+	var b strings.Builder
+	prev := 0
+	for _, s := range m {
+		if s.Offset < prev || s.Offset > len(out) || s.Orig < 0 || s.Orig > len(src) {
+			return "", fmt.Errorf("invalid source map: mapping %d:%d out of order or range", s.Offset, s.Orig)
+		}
+		l := len(lines) - 1
+		for lines[l] > s.Orig {
+			l--
+		}
+		b.WriteString(out[prev:s.Offset])
+		fmt.Fprintf(&b, "/*line :%d:%d*/", l+1, s.Orig-lines[l]+1)
+		prev = s.Offset
+	}
+	b.WriteString(out[prev:])
+	return b.String(), nil
+}
@@ -0,0 +1,75 @@
+package interp
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+// pipelines rewrites the lines of the form [name :=] x |> f |> g into
+// [name :=] g(f(x)).
+func pipelines(src string) (string, SourceMap, error) {
+	if strings.Contains(src, "|>|>") {
+		return "", nil, errors.New("empty pipeline stage")
+	}
+	var out strings.Builder
+	var m SourceMap
+	off := 0
+	for _, line := range strings.SplitAfter(src, "\n") {
+		body := strings.TrimSuffix(line, "\n")
+		parts := strings.Split(body, "|>")
+		if len(parts) == 1 {
+			m = append(m, SourceMapping{out.Len(), off})
+			out.WriteString(line)
+			off += len(line)
+			continue
+		}
+		starts := make([]int, len(parts))
+		for k, pos := 0, off; k < len(parts); k++ {
+			starts[k] = pos + len(parts[k]) - len(strings.TrimLeft(parts[k], " "))
+			pos += len(parts[k]) + 2
+		}
+		arg := parts[0]
+		if i := strings.Index(arg, ":="); i >= 0 {
+			m = append(m, SourceMapping{out.Len(), off})
+			out.WriteString(arg[:i+3])
+			arg = arg[i+3:]
+			starts[0] = off + i + 3
+		}
+		for k := len(parts) - 1; k > 0; k-- {
+			m = append(m, SourceMapping{out.Len(), starts[k]})
+			out.WriteString(strings.TrimSpace(parts[k]) + "(")
+		}
+		m = append(m, SourceMapping{out.Len(), starts[0]})
+		out.WriteString(strings.TrimSpace(arg) + strings.Repeat(")", len(parts)-1))
+		out.WriteString(line[len(body):])
+		off += len(line)
+	}
+	return out.String(), m, nil
+}
+
+func TestDialect(t *testing.T) {
+	i := New(Options{Dialect: pipelines})
+	if _, err := i.Eval(`
+func double(x int) int { return 2 * x }
+func inc(x int) int { return x + 1 }
+`); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := i.Eval(`r := 3 |> double |> inc`); err != nil {
+		t.Fatal(err)
+	}
+	if v, err := i.Eval(`r`); err != nil || v.Interface() != 7 {
+		t.Errorf("got %v, %v, want 7", v, err)
+	}
+
+	// Positions refer to the original text.
+	_, err := i.Eval("s := r |> inc\ns |> double |> missing")
+	if err == nil || !strings.Contains(err.Error(), "2:16: undefined: missing") {
+		t.Errorf("got error %v, want undefined missing at 2:16", err)
+	}
+
+	if _, err := i.Eval(`r |>|> inc`); err == nil || err.Error() != "empty pipeline stage" {
+		t.Errorf("got error %v, want empty pipeline stage", err)
+	}
+}
@@ -1,6 +1,7 @@
 package interp
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"io/ioutil"
@@ -60,6 +61,69 @@ func (n *node) cfgDot(out io.Writer) {
 	fmt.Fprintf(out, "}\n")
 }
 
+type dotWritersKey struct{}
+
+// dotWriters are the writers of the AST and CFG graphs of an evaluation.
+type dotWriters struct{ ast, cfg io.Writer }
+
+// DotWriters returns a copy of ctx providing the writers receiving the AST
+// and CFG graphs of the evaluations with this context, such as by
+// EvalWithContext, instead of Options.ASTDotWriter and Options.CFGDotWriter.
+// A nil writer keeps the one of the options.
+func DotWriters(ctx context.Context, ast, cfg io.Writer) context.Context {
+	return context.WithValue(ctx, dotWritersKey{}, dotWriters{ast, cfg})
+}
+
+// dotContext returns the dot writers provided by the context of the
+// evaluation, if any.
+func (interp *Interpreter) dotContext() dotWriters {
+	if e := interp.contextEval(); e != nil {
+		return e.dot
+	}
+	return dotWriters{}
+}
+
+// writeASTDot writes the AST of root, parsed from the file name, to the AST
+// dot writer or command, if enabled, and returns true if it did.
+func (interp *Interpreter) writeASTDot(root *node, name string) bool {
+	out := interp.dotOutput(interp.dotContext().ast, interp.astDotWriter, interp.astDot, name, "yaegi-ast-")
+	if out == nil {
+		return false
+	}
+	root.astDot(out, name)
+	out.Close()
+	return true
+}
+
+// writeCFGDot writes the CFG of root, parsed from the file name, to the CFG
+// dot writer or command, if enabled.
+func (interp *Interpreter) writeCFGDot(root *node, name string) {
+	out := interp.dotOutput(interp.dotContext().cfg, interp.cfgDotWriter, interp.cfgDot, name, "yaegi-cfg-")
+	if out == nil {
+		return
+	}
+	root.cfgDot(out)
+	out.Close()
+}
+
+// dotOutput returns the output of a graph, the writer of the evaluation if
+// any, then the one of the options, then the dot command if enabled, or nil.
+func (interp *Interpreter) dotOutput(ctxWriter, optWriter io.Writer, enabled bool, name, prefix string) io.WriteCloser {
+	switch {
+	case ctxWriter != nil:
+		return nopCloser{ctxWriter}
+	case optWriter != nil:
+		return nopCloser{optWriter}
+	case !enabled:
+		return nil
+	}
+	dotCmd := interp.dotCmd
+	if dotCmd == "" {
+		dotCmd = defaultDotCmd(name, prefix)
+	}
+	return dotWriter(dotCmd)
+}
+
 type nopCloser struct {
 	io.Writer
 }
@@ -0,0 +1,43 @@
+package interp
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestDotWriters(t *testing.T) {
+	var ast, cfg bytes.Buffer
+	i := New(Options{ASTDotWriter: &ast, CFGDotWriter: &cfg})
+	if _, err := i.Eval(`a := 1 + 2`); err != nil {
+		t.Fatal(err)
+	}
+	if s := ast.String(); !strings.HasPrefix(s, "digraph ast {\n") || !strings.Contains(s, `label="_.go"`) {
+		t.Errorf("unexpected AST graph:\n%s", s)
+	}
+	if s := cfg.String(); !strings.HasPrefix(s, "digraph cfg {\n") || !strings.Contains(s, "->") {
+		t.Errorf("unexpected CFG graph:\n%s", s)
+	}
+
+	// The writers of the context replace the ones of the options.
+	ast.Reset()
+	cfg.Reset()
+	var ast2 bytes.Buffer
+	ctx := DotWriters(context.Background(), &ast2, nil)
+	if _, err := i.EvalWithContext(ctx, `b := a`); err != nil {
+		t.Fatal(err)
+	}
+	if ast.Len() != 0 || ast2.Len() == 0 || cfg.Len() == 0 {
+		t.Errorf("got AST graphs of %d and %d bytes, CFG graph of %d bytes", ast.Len(), ast2.Len(), cfg.Len())
+	}
+
+	// They are only used by the evaluation.
+	ast2.Reset()
+	if _, err := i.Eval(`c := b`); err != nil {
+		t.Fatal(err)
+	}
+	if ast.Len() == 0 || ast2.Len() != 0 {
+		t.Errorf("got AST graphs of %d and %d bytes", ast.Len(), ast2.Len())
+	}
+}
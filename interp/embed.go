@@ -0,0 +1,437 @@
+package interp
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"path"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// embedFSType is the type embed.FS, and newEmbedFS returns an embed.FS holding
+// files, if supported by the Go version, see embed_go116.go.
+var (
+	embedFSType reflect.Type
+	newEmbedFS  func(files []embedFile) (reflect.Value, error)
+)
+
+// embedFile is a file, or a directory if its name ends with "/", of an
+// embed.FS, laid out as in the embed package.
+type embedFile struct {
+	name string
+	data string
+	hash [16]byte
+}
+
+// embedVar is a package variable declared with go:embed directives.
+type embedVar struct {
+	name     string
+	pos      token.Position
+	patterns []string
+}
+
+// embedEntry is an entry of a directory of an embedTree.
+type embedEntry struct {
+	name string
+	dir  bool
+}
+
+// embedTree is the file tree of a package directory, where the patterns of
+// go:embed directives are resolved. Names are slash separated and relative
+// to the package directory.
+type embedTree interface {
+	list(dir string) ([]embedEntry, error)
+	read(name string) ([]byte, error)
+}
+
+// dirTree is the tree of the directory root of a filesystem.
+type dirTree struct {
+	fs   filesystem
+	root string
+}
+
+func (t dirTree) list(dir string) ([]embedEntry, error) {
+	infos, err := t.fs.ReadDir(filepath.Join(t.root, filepath.FromSlash(dir)))
+	if err != nil {
+		return nil, err
+	}
+	entries := make([]embedEntry, 0, len(infos))
+	for _, fi := range infos {
+		if fi.IsDir() || fi.Mode().IsRegular() {
+			entries = append(entries, embedEntry{name: fi.Name(), dir: fi.IsDir()})
+		}
+	}
+	sort.Slice(entries, func(i, j int) bool { return entries[i].name < entries[j].name })
+	return entries, nil
+}
+
+func (t dirTree) read(name string) ([]byte, error) {
+	return t.fs.ReadFile(filepath.Join(t.root, filepath.FromSlash(name)))
+}
+
+// archiveTree is the tree of the directory root of a source archive.
+type archiveTree struct {
+	a    *archiveFS
+	root string
+}
+
+func (t archiveTree) list(dir string) ([]embedEntry, error) {
+	prefix := path.Join(t.root, dir) + "/"
+	if prefix == "./" {
+		prefix = ""
+	}
+	seen := map[string]bool{}
+	var entries []embedEntry
+	for name := range t.a.data {
+		if !strings.HasPrefix(name, prefix) {
+			continue
+		}
+		elem := strings.TrimPrefix(name, prefix)
+		isDir := false
+		if i := strings.Index(elem, "/"); i >= 0 {
+			elem, isDir = elem[:i], true
+		}
+		if !seen[elem] {
+			seen[elem] = true
+			entries = append(entries, embedEntry{name: elem, dir: isDir})
+		}
+	}
+	if len(entries) == 0 {
+		return nil, fmt.Errorf("%s: no such directory in archive", dir)
+	}
+	sort.Slice(entries, func(i, j int) bool { return entries[i].name < entries[j].name })
+	return entries, nil
+}
+
+func (t archiveTree) read(name string) ([]byte, error) {
+	b, ok := t.a.data[path.Join(t.root, name)]
+	if !ok {
+		return nil, fmt.Errorf("%s: no such file in archive", name)
+	}
+	return b, nil
+}
+
+// embedFiles sets the package variables of pkgName declared with go:embed
+// directives in sources, to the files of tree matching their patterns. It
+// must be called before the generation of the initialization of the package
+// variables, as the embedded ones are set in place of their zero value.
+func (interp *Interpreter) embedFiles(pkgName string, sources []srcFile, tree embedTree) error {
+	var vars []embedVar
+	for _, s := range sources {
+		if !strings.Contains(s.src, "//go:embed") {
+			continue
+		}
+		v, err := embedVars(s.name, s.src)
+		if err != nil {
+			return err
+		}
+		vars = append(vars, v...)
+	}
+	if len(vars) == 0 {
+		return nil
+	}
+
+	gs := interp.scopes[pkgName]
+	for _, v := range vars {
+		sym := gs.sym[v.name]
+		if sym == nil || sym.kind != varSym || sym.node == nil || sym.node.kind != valueSpec {
+			return fmt.Errorf("%s: go:embed cannot apply to %s", v.pos, v.name)
+		}
+		val, err := embedValue(sym.typ, v, tree)
+		if err != nil {
+			return err
+		}
+		sym.node.gen = embedInit(val)
+	}
+	return nil
+}
+
+// embedInit returns the generator of the declaration of an embedded
+// variable, which sets it to v instead of its zero value.
+func embedInit(v reflect.Value) bltnGenerator {
+	return func(n *node) {
+		next := getExec(n.tnext)
+		typ := n.child[0].typ.frameType()
+		i := n.child[0].findex
+		n.exec = func(f *frame) bltn {
+			f.data[i] = reflect.New(typ).Elem()
+			f.data[i].Set(v)
+			return next
+		}
+	}
+}
+
+// embedVars returns the variables declared with go:embed directives in the
+// source file src.
+func embedVars(name, src string) ([]embedVar, error) {
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, name, src, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+	importsEmbed := false
+	for _, spec := range f.Imports {
+		if p, _ := strconv.Unquote(spec.Path.Value); p == "embed" {
+			importsEmbed = true
+		}
+	}
+
+	var vars []embedVar
+	for _, decl := range f.Decls {
+		d, ok := decl.(*ast.GenDecl)
+		if !ok || d.Tok != token.VAR {
+			continue
+		}
+		for _, spec := range d.Specs {
+			vs := spec.(*ast.ValueSpec)
+			doc := vs.Doc
+			if doc == nil && !d.Lparen.IsValid() {
+				doc = d.Doc
+			}
+			if doc == nil {
+				continue
+			}
+			var patterns []string
+			var pos token.Position
+			for _, c := range doc.List {
+				args, ok := embedDirective(c.Text)
+				if !ok {
+					continue
+				}
+				pos = fset.Position(c.Slash)
+				p, err := embedPatterns(args)
+				if err != nil {
+					return nil, fmt.Errorf("%s: invalid go:embed: %v", pos, err)
+				}
+				patterns = append(patterns, p...)
+			}
+			switch {
+			case pos.Line == 0:
+				continue
+			case !importsEmbed:
+				return nil, fmt.Errorf(`%s: go:embed only allowed in Go files that import "embed"`, pos)
+			case len(vs.Names) != 1:
+				return nil, fmt.Errorf("%s: go:embed cannot apply to multiple vars", pos)
+			case len(vs.Values) > 0:
+				return nil, fmt.Errorf("%s: go:embed cannot apply to var with initializer", pos)
+			case len(patterns) == 0:
+				return nil, fmt.Errorf("%s: usage: //go:embed pattern...", pos)
+			}
+			vars = append(vars, embedVar{name: vs.Names[0].Name, pos: pos, patterns: patterns})
+		}
+	}
+	return vars, nil
+}
+
+// embedDirective returns the arguments of the comment text if it is a
+// go:embed directive.
+func embedDirective(text string) (string, bool) {
+	const prefix = "//go:embed"
+	if !strings.HasPrefix(text, prefix) {
+		return "", false
+	}
+	args := text[len(prefix):]
+	if args != "" && args[0] != ' ' && args[0] != '\t' {
+		return "", false
+	}
+	return strings.TrimSpace(args), true
+}
+
+// embedPatterns splits the arguments of a go:embed directive into patterns,
+// which are separated by spaces, and may be quoted as Go strings.
+func embedPatterns(args string) ([]string, error) {
+	var patterns []string
+	for args = strings.TrimLeftFunc(args, unicode.IsSpace); args != ""; args = strings.TrimLeftFunc(args, unicode.IsSpace) {
+		var p string
+		switch args[0] {
+		case '"', '`':
+			q, err := strconv.QuotedPrefix(args)
+			if err != nil {
+				return nil, fmt.Errorf("invalid quoted string in %s", args)
+			}
+			p, _ = strconv.Unquote(q)
+			args = args[len(q):]
+			if args != "" && !unicode.IsSpace(rune(args[0])) {
+				return nil, fmt.Errorf("invalid quoted string in %s", q+args)
+			}
+		default:
+			i := strings.IndexFunc(args, unicode.IsSpace)
+			if i < 0 {
+				i = len(args)
+			}
+			p, args = args[:i], args[i:]
+		}
+		patterns = append(patterns, p)
+	}
+	return patterns, nil
+}
+
+// embedValue returns the value of the variable v of type t, made of the files
+// of tree matching its patterns.
+func embedValue(t *itype, v embedVar, tree embedTree) (reflect.Value, error) {
+	var isFS bool
+	switch {
+	case t.cat == stringT && t.name == "string":
+	case t.cat == arrayT && !t.sizedef && t.name == "" && t.val.cat == uint8T:
+	case t.cat == valueT && embedFSType != nil && t.rtype == embedFSType:
+		isFS = true
+	default:
+		return reflect.Value{}, fmt.Errorf("%s: go:embed cannot apply to var of type %s", v.pos, t.id())
+	}
+
+	files := map[string]bool{}
+	for _, p := range v.patterns {
+		if err := globEmbed(tree, p, files); err != nil {
+			return reflect.Value{}, fmt.Errorf("%s: %v", v.pos, err)
+		}
+	}
+
+	if !isFS {
+		if len(v.patterns) != 1 || len(files) != 1 {
+			return reflect.Value{}, fmt.Errorf("%s: invalid go:embed: multiple files for type %s", v.pos, t.id())
+		}
+		for name := range files {
+			b, err := tree.read(name)
+			if err != nil {
+				return reflect.Value{}, fmt.Errorf("%s: %v", v.pos, err)
+			}
+			if t.cat == stringT {
+				return reflect.ValueOf(string(b)), nil
+			}
+			return reflect.ValueOf(b), nil
+		}
+	}
+
+	// The files of an embed.FS include their parent directories, and are
+	// sorted by directory, then by name, as expected by the embed package.
+	dirs := map[string]bool{}
+	var list []embedFile
+	for name := range files {
+		b, err := tree.read(name)
+		if err != nil {
+			return reflect.Value{}, fmt.Errorf("%s: %v", v.pos, err)
+		}
+		list = append(list, embedFile{name: name, data: string(b), hash: embedHash(b)})
+		for dir := path.Dir(name); dir != "." && !dirs[dir]; dir = path.Dir(dir) {
+			dirs[dir] = true
+			list = append(list, embedFile{name: dir + "/"})
+		}
+	}
+	sort.Slice(list, func(i, j int) bool {
+		di, ei := splitEmbedName(list[i].name)
+		dj, ej := splitEmbedName(list[j].name)
+		return di < dj || di == dj && ei < ej
+	})
+	return newEmbedFS(list)
+}
+
+// splitEmbedName splits the name of an embedFile into its directory and
+// element, as the embed package.
+func splitEmbedName(name string) (dir, elem string) {
+	name = strings.TrimSuffix(name, "/")
+	i := strings.LastIndex(name, "/")
+	if i < 0 {
+		return ".", name
+	}
+	return name[:i], name[i+1:]
+}
+
+func embedHash(b []byte) (h [16]byte) {
+	sum := sha256.Sum256(b)
+	copy(h[:], sum[:])
+	return h
+}
+
+// globEmbed adds to files the names of the files of tree matching the
+// pattern of a go:embed directive. The files of a matching directory are
+// added recursively, except for the ones whose name starts with "." or "_",
+// unless the pattern is prefixed with "all:", and the ones of other modules.
+func globEmbed(tree embedTree, pattern string, files map[string]bool) error {
+	all := strings.HasPrefix(pattern, "all:")
+	p := strings.TrimPrefix(pattern, "all:")
+	if _, err := path.Match(p, ""); err != nil || !validEmbedPattern(p) {
+		return fmt.Errorf("pattern %s: invalid pattern syntax", pattern)
+	}
+
+	matches := []embedEntry{{name: ".", dir: true}}
+	for _, elem := range strings.Split(p, "/") {
+		var next []embedEntry
+		for _, m := range matches {
+			if !m.dir {
+				continue
+			}
+			entries, _ := tree.list(m.name)
+			for _, e := range entries {
+				if ok, _ := path.Match(elem, e.name); ok {
+					next = append(next, embedEntry{name: path.Join(m.name, e.name), dir: e.dir})
+				}
+			}
+		}
+		matches = next
+	}
+	if len(matches) == 0 {
+		return fmt.Errorf("pattern %s: no matching files found", pattern)
+	}
+
+	for _, m := range matches {
+		if !m.dir {
+			files[m.name] = true
+			continue
+		}
+		n := len(files)
+		if err := walkEmbed(tree, m.name, all, files); err != nil {
+			return fmt.Errorf("pattern %s: %v", pattern, err)
+		}
+		if len(files) == n {
+			return fmt.Errorf("pattern %s: cannot embed directory %s: contains no embeddable files", pattern, m.name)
+		}
+	}
+	return nil
+}
+
+// walkEmbed adds to files the files of the directory dir of tree, and of its
+// subdirectories, as described in globEmbed.
+func walkEmbed(tree embedTree, dir string, all bool, files map[string]bool) error {
+	entries, err := tree.list(dir)
+	if err != nil {
+		return err
+	}
+	for _, e := range entries {
+		if !all && (strings.HasPrefix(e.name, ".") || strings.HasPrefix(e.name, "_")) {
+			continue
+		}
+		name := path.Join(dir, e.name)
+		if !e.dir {
+			files[name] = true
+			continue
+		}
+		if _, err := tree.read(path.Join(name, "go.mod")); err == nil {
+			continue // in another module
+		}
+		if err := walkEmbed(tree, name, all, files); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validEmbedPattern returns true if the pattern p designates files of the
+// package directory or of its subdirectories.
+func validEmbedPattern(p string) bool {
+	if p == "" || p == "." || path.Clean(p) != p || path.IsAbs(p) || strings.Contains(p, `\`) {
+		return false
+	}
+	for _, elem := range strings.Split(p, "/") {
+		if elem == "." || elem == ".." {
+			return false
+		}
+	}
+	return true
+}
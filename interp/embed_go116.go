@@ -0,0 +1,34 @@
+// +build go1.16
+
+package interp
+
+import (
+	"embed"
+	"errors"
+	"reflect"
+	"unsafe"
+)
+
+func init() {
+	embedFSType = reflect.TypeOf(embed.FS{})
+	newEmbedFS = embedFS
+}
+
+// embedFS returns an embed.FS holding files, built as the compiler does, so
+// that it behaves as an embed.FS of a compiled program. Its layout is checked
+// against the one of embedFile, in case the embed package changes.
+func embedFS(files []embedFile) (reflect.Value, error) {
+	t := embedFSType
+	if t.NumField() != 1 || t.Field(0).Type.Kind() != reflect.Ptr || t.Field(0).Type.Elem().Kind() != reflect.Slice {
+		return reflect.Value{}, errors.New("go:embed: unsupported layout of embed.FS")
+	}
+	ft := t.Field(0).Type.Elem().Elem()
+	if ft.Size() != unsafe.Sizeof(embedFile{}) || ft.NumField() != 3 ||
+		ft.Field(0).Type.Kind() != reflect.String || ft.Field(1).Type.Kind() != reflect.String ||
+		ft.Field(2).Type != reflect.TypeOf([16]byte{}) {
+		return reflect.Value{}, errors.New("go:embed: unsupported layout of embed.FS files")
+	}
+	var fsys embed.FS
+	*(**[]embedFile)(unsafe.Pointer(&fsys)) = &files
+	return reflect.ValueOf(fsys), nil
+}
@@ -0,0 +1,189 @@
+// +build go1.16
+
+package interp
+
+import (
+	"bytes"
+	"embed"
+	"io/fs"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"testing/fstest"
+)
+
+var embedExports = Exports{"embed": {"FS": reflect.ValueOf((*embed.FS)(nil))}}
+
+const embedSrc = `package assets
+
+import "embed"
+
+//go:embed hello.txt
+var Hello string
+
+//go:embed hello.txt
+var Data []byte
+
+var (
+	//go:embed templates "static/*.css"
+	Files embed.FS
+
+	Size = len(Hello)
+)
+`
+
+var embedFiles = map[string]string{
+	"hello.txt":               "hello",
+	"templates/page.html":     "<p>{{.}}</p>",
+	"templates/.hidden":       "hidden",
+	"templates/_draft.html":   "draft",
+	"templates/mod/go.mod":    "module other",
+	"templates/mod/index.txt": "other module",
+	"static/site.css":         "body {}",
+	"static/site.js":          "",
+}
+
+func checkEmbed(t *testing.T, i *Interpreter, path string) {
+	t.Helper()
+	sym := i.Symbols(path)[path]
+	if got := sym["Hello"].String(); got != "hello" {
+		t.Errorf("got Hello %q, want hello", got)
+	}
+	if got := sym["Data"].Bytes(); string(got) != "hello" {
+		t.Errorf("got Data %q, want hello", got)
+	}
+	if got := sym["Size"].Int(); got != 5 {
+		t.Errorf("got Size %d, want 5", got)
+	}
+
+	fsys := sym["Files"].Interface().(embed.FS)
+	var names []string
+	if err := fs.WalkDir(fsys, ".", func(name string, d fs.DirEntry, err error) error {
+		if err == nil && !d.IsDir() {
+			names = append(names, name)
+		}
+		return err
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := strings.Join(names, " "), "static/site.css templates/page.html"; got != want {
+		t.Errorf("got files %s, want %s", got, want)
+	}
+	if b, err := fsys.ReadFile("templates/page.html"); err != nil || string(b) != "<p>{{.}}</p>" {
+		t.Errorf("got %q, %v", b, err)
+	}
+}
+
+func TestEmbedFilesystem(t *testing.T) {
+	fsys := fstest.MapFS{"src/assets/assets.go": {Data: []byte(embedSrc)}}
+	for name, data := range embedFiles {
+		fsys["src/assets/"+name] = &fstest.MapFile{Data: []byte(data)}
+	}
+
+	i := New(Options{GoPath: "/"})
+	i.UseFilesystem(fsys)
+	i.Use(embedExports)
+	if _, err := i.Eval(`import "assets"`); err != nil {
+		t.Fatal(err)
+	}
+	checkEmbed(t, i, "assets")
+}
+
+func TestEmbedArchive(t *testing.T) {
+	files := map[string]string{"assets.go": embedSrc}
+	for name, data := range embedFiles {
+		files[name] = data
+	}
+	_, zipData, _ := makeArchives(t, files)
+
+	i := New(Options{})
+	i.Use(embedExports)
+	if _, err := i.ImportArchive("example.com/assets", bytes.NewReader(zipData), ArchiveOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	checkEmbed(t, i, "example.com/assets")
+}
+
+func TestEmbedEvalPath(t *testing.T) {
+	dir, err := ioutil.TempDir("", "yaegi-embed")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	src := "package main\n\nimport (\n\t_ \"embed\"\n\n\t\"host\"\n)\n\n//go:embed msg.txt\nvar msg string\n\nfunc main() { host.Set(msg) }\n"
+	if err := ioutil.WriteFile(filepath.Join(dir, "main.go"), []byte(src), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, "msg.txt"), []byte("embedded"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	var got string
+	i := New(Options{})
+	i.Use(embedExports)
+	i.Use(Exports{"host": {"Set": reflect.ValueOf(func(s string) { got = s })}})
+	if _, err := i.EvalPath(filepath.Join(dir, "main.go")); err != nil {
+		t.Fatal(err)
+	}
+	if got != "embedded" {
+		t.Errorf("got %q, want embedded", got)
+	}
+}
+
+func TestEmbedErrors(t *testing.T) {
+	for _, test := range []struct{ desc, src, err string }{
+		{
+			desc: "no import",
+			src:  "package p\n\n//go:embed a.txt\nvar s string\n",
+			err:  `3:1: go:embed only allowed in Go files that import "embed"`,
+		},
+		{
+			desc: "no match",
+			src:  "package p\n\nimport _ \"embed\"\n\n//go:embed b.txt\nvar s string\n",
+			err:  "5:1: pattern b.txt: no matching files found",
+		},
+		{
+			desc: "invalid pattern",
+			src:  "package p\n\nimport _ \"embed\"\n\n//go:embed ../a.txt\nvar s string\n",
+			err:  "pattern ../a.txt: invalid pattern syntax",
+		},
+		{
+			desc: "multiple files",
+			src:  "package p\n\nimport _ \"embed\"\n\n//go:embed *.txt\nvar s string\n",
+			err:  "invalid go:embed: multiple files for type string",
+		},
+		{
+			desc: "type",
+			src:  "package p\n\nimport _ \"embed\"\n\n//go:embed a.txt\nvar s int\n",
+			err:  "go:embed cannot apply to var of type int",
+		},
+		{
+			desc: "initializer",
+			src:  "package p\n\nimport _ \"embed\"\n\n//go:embed a.txt\nvar s = \"a\"\n",
+			err:  "go:embed cannot apply to var with initializer",
+		},
+		{
+			desc: "empty directory",
+			src:  "package p\n\nimport _ \"embed\"\n\n//go:embed dir\nvar s string\n",
+			err:  "pattern dir: cannot embed directory dir: contains no embeddable files",
+		},
+	} {
+		t.Run(test.desc, func(t *testing.T) {
+			i := New(Options{GoPath: "/"})
+			i.UseFilesystem(fstest.MapFS{
+				"src/p/p.go":        {Data: []byte(test.src)},
+				"src/p/a.txt":       {Data: []byte("a")},
+				"src/p/c.txt":       {Data: []byte("c")},
+				"src/p/dir/.hidden": {Data: []byte("h")},
+			})
+			i.Use(embedExports)
+			_, err := i.Eval(`import "p"`)
+			if err == nil || !strings.Contains(err.Error(), test.err) {
+				t.Errorf("got error %v, want %q", err, test.err)
+			}
+		})
+	}
+}
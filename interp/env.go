@@ -0,0 +1,107 @@
+package interp
+
+import (
+	"os"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"syscall"
+)
+
+// environ holds the environment variables of interpreted code, which is
+// isolated from the process environment.
+type environ struct {
+	mu sync.RWMutex
+	m  map[string]string
+}
+
+// newEnviron returns an environment initialized from kv, a list of strings in
+// the form "key=value", as returned by os.Environ.
+func newEnviron(kv []string) *environ {
+	e := &environ{m: make(map[string]string, len(kv))}
+	for _, s := range kv {
+		if i := strings.Index(s, "="); i > 0 {
+			e.m[s[:i]] = s[i+1:]
+		}
+	}
+	return e
+}
+
+func (e *environ) lookup(key string) (string, bool) {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	v, ok := e.m[key]
+	return v, ok
+}
+
+func (e *environ) get(key string) string {
+	v, _ := e.lookup(key)
+	return v
+}
+
+func (e *environ) set(key, value string) error {
+	if key == "" || strings.Contains(key, "=") {
+		return os.NewSyscallError("setenv", syscall.EINVAL)
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.m[key] = value
+	return nil
+}
+
+func (e *environ) unset(key string) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	delete(e.m, key)
+	return nil
+}
+
+func (e *environ) clear() {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.m = map[string]string{}
+}
+
+func (e *environ) list() []string {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	kv := make([]string, 0, len(e.m))
+	for k, v := range e.m {
+		kv = append(kv, k+"="+v)
+	}
+	sort.Strings(kv)
+	return kv
+}
+
+// SetEnv sets the value of the environment variable named by key, as seen by
+// interpreted code. The process environment is not changed.
+func (interp *Interpreter) SetEnv(key, value string) error {
+	return interp.env.set(key, value)
+}
+
+// LookupEnv retrieves the value of the environment variable named by key, as
+// seen by interpreted code. The returned boolean is false if the variable is
+// not set.
+func (interp *Interpreter) LookupEnv(key string) (string, bool) {
+	return interp.env.lookup(key)
+}
+
+// fixEnv redefines the interpreter stdlib symbols accessing environment
+// variables, so they operate on the interpreter environment instead of the
+// process one.
+func fixEnv(interp *Interpreter) {
+	p := interp.binPkg["os"]
+	if p == nil {
+		return
+	}
+
+	env := interp.env
+	p["Clearenv"] = reflect.ValueOf(env.clear)
+	p["Environ"] = reflect.ValueOf(env.list)
+	p["ExpandEnv"] = reflect.ValueOf(func(s string) string { return os.Expand(s, env.get) })
+	p["Getenv"] = reflect.ValueOf(env.get)
+	p["LookupEnv"] = reflect.ValueOf(env.lookup)
+	p["Setenv"] = reflect.ValueOf(env.set)
+	p["Unsetenv"] = reflect.ValueOf(env.unset)
+}
@@ -0,0 +1,58 @@
+package interp_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/traefik/yaegi/interp"
+	"github.com/traefik/yaegi/stdlib"
+)
+
+func TestEnv(t *testing.T) {
+	const key = "YAEGI_TEST_ENV"
+	if err := os.Setenv(key, "process"); err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = os.Unsetenv(key) }()
+
+	// By default, the environment is a copy of the process one.
+	i1 := interp.New(interp.Options{})
+	i1.Use(stdlib.Symbols)
+	eval(t, i1, `import "os"`)
+	if v := eval(t, i1, `os.Getenv("`+key+`")`); v.String() != "process" {
+		t.Errorf("got %q, want process", v)
+	}
+
+	i2 := interp.New(interp.Options{Env: []string{"A=1"}})
+	i2.Use(stdlib.Symbols)
+	eval(t, i2, `import "os"`)
+	if v := eval(t, i2, `os.Environ()`); len(v.Interface().([]string)) != 1 {
+		t.Errorf("got %v, want [A=1]", v)
+	}
+
+	// Changes made by scripts are isolated.
+	eval(t, i1, `os.Setenv("`+key+`", "i1")`)
+	if v, _ := i1.LookupEnv(key); v != "i1" {
+		t.Errorf("got %q, want i1", v)
+	}
+	if _, ok := i2.LookupEnv(key); ok {
+		t.Errorf("unexpected %s in i2 environment", key)
+	}
+	if v := os.Getenv(key); v != "process" {
+		t.Errorf("got %q, want process", v)
+	}
+
+	if err := i2.SetEnv("B", "2"); err != nil {
+		t.Fatal(err)
+	}
+	if v := eval(t, i2, `os.ExpandEnv("$A-$B")`); v.String() != "1-2" {
+		t.Errorf("got %q, want 1-2", v)
+	}
+	if err := i2.SetEnv("C=", "3"); err == nil {
+		t.Error("expected error")
+	}
+	eval(t, i2, `os.Unsetenv("A")`)
+	if v := eval(t, i2, `_, ok := os.LookupEnv("A"); ok`); v.Bool() {
+		t.Error("A should be unset")
+	}
+}
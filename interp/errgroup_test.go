@@ -0,0 +1,162 @@
+package interp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+	"testing"
+)
+
+// group is a minimal errgroup.Group: the first error cancels the context, and
+// a panic in a function is propagated by Wait.
+type group struct {
+	cancel func()
+	wg     sync.WaitGroup
+	once   sync.Once
+	err    error
+	mu     sync.Mutex
+	panic  interface{}
+}
+
+func groupWithContext(ctx context.Context) (*group, context.Context) {
+	ctx, cancel := context.WithCancel(ctx)
+	return &group{cancel: cancel}, ctx
+}
+
+func (g *group) Go(f func() error) {
+	g.wg.Add(1)
+	go func() {
+		defer g.wg.Done()
+		defer func() {
+			if r := recover(); r != nil {
+				g.mu.Lock()
+				if g.panic == nil {
+					g.panic = r
+				}
+				g.mu.Unlock()
+				g.cancel()
+			}
+		}()
+		if err := f(); err != nil {
+			g.once.Do(func() {
+				g.err = err
+				g.cancel()
+			})
+		}
+	}()
+}
+
+func (g *group) Wait() error {
+	g.wg.Wait()
+	g.cancel()
+	if g.panic != nil {
+		panic(g.panic)
+	}
+	return g.err
+}
+
+func TestErrGroup(t *testing.T) {
+	i := New(Options{})
+	i.Use(syncExports)
+	i.Use(Exports{
+		"context": {
+			"Background": reflect.ValueOf(context.Background),
+			"Context":    reflect.ValueOf((*context.Context)(nil)),
+		},
+		"errgroup": {
+			"Group":       reflect.ValueOf((*group)(nil)),
+			"WithContext": reflect.ValueOf(groupWithContext),
+		},
+		"errors": {"New": reflect.ValueOf(errors.New)},
+		"fmt":    {"Sprint": reflect.ValueOf(fmt.Sprint)},
+	})
+	if _, err := i.Eval(`
+import (
+	"context"
+	"errgroup"
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// cancel returns the first error, after all the other functions observed the
+// cancellation of the context.
+func cancel() error {
+	g, ctx := errgroup.WithContext(context.Background())
+	for i := 0; i < 5; i++ {
+		i := i
+		g.Go(func() error {
+			if i == 2 {
+				return errors.New(fmt.Sprint("failed ", i))
+			}
+			<-ctx.Done()
+			return ctx.Err()
+		})
+	}
+	return g.Wait()
+}
+
+// collect gathers results computed concurrently from per iteration variables.
+func collect() []int {
+	g, ctx := errgroup.WithContext(context.Background())
+	var mu sync.Mutex
+	res := make([]int, 10)
+	for i := 0; i < 10; i++ {
+		i := i
+		sq := i * i
+		g.Go(func() error {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			default:
+			}
+			mu.Lock()
+			res[i] = sq
+			mu.Unlock()
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return nil
+	}
+	return res
+}
+
+// recovered returns the panic of a function, propagated by Wait.
+func recovered() (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = errors.New(fmt.Sprint("recovered: ", r))
+		}
+	}()
+	g, _ := errgroup.WithContext(context.Background())
+	g.Go(func() error { return nil })
+	g.Go(func() error { panic("boom") })
+	return g.Wait()
+}
+`); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		src  string
+		want string
+	}{
+		{src: "cancel()", want: "failed 2"},
+		{src: "collect()", want: "[0 1 4 9 16 25 36 49 64 81]"},
+		{src: "recovered()", want: "recovered: boom"},
+	}
+	for _, test := range tests {
+		for k := 0; k < 5; k++ {
+			res, err := i.Eval(test.src)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := fmt.Sprint(res); got != test.want {
+				t.Fatalf("%s: got %s, want %s", test.src, got, test.want)
+			}
+		}
+	}
+}
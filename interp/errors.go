@@ -0,0 +1,248 @@
+package interp
+
+import (
+	"errors"
+	"fmt"
+	"go/scanner"
+	"go/token"
+	"strings"
+)
+
+// ErrorCode classifies the errors reported by CompileError and ImportError.
+type ErrorCode string
+
+// Error codes.
+const (
+	CodeSyntax           ErrorCode = "syntax"            // source files which can not be parsed
+	CodeCompile          ErrorCode = "compile"           // invalid source code, such as a type mismatch
+	CodeUndefined        ErrorCode = "undefined"         // undefined identifier
+	CodeNotAllowed       ErrorCode = "not allowed"       // import or symbol denied by Options.Restrictions
+	CodeImport           ErrorCode = "import"            // failed import of a dependency
+	CodeImportNotFound   ErrorCode = "import not found"  // package sources not found
+	CodeImportCycle      ErrorCode = "import cycle"      // package importing itself, directly or not
+	CodeMultiplePackages ErrorCode = "multiple packages" // files of several packages in the same directory
+	CodeUnsupported      ErrorCode = "unsupported"       // standard package not supported by the interpreter
+	CodeTypeCycle        ErrorCode = "type cycle"        // type declarations referring to themselves, see TypeCycleError
+)
+
+// A CompileError is an error of interpreted source code, found at compile
+// time.
+type CompileError struct {
+	Pos  token.Position // position of the error in the source
+	Code ErrorCode      // kind of error
+	Msg  string         // description of the error, without position
+	Err  error          // underlying error, such as the *ImportError of an import, or nil
+}
+
+func (e *CompileError) Error() string {
+	return posString(e.Pos) + ": " + e.Msg
+}
+
+// posString returns the position pos as a string, without the file name of
+// the sources evaluated by Eval.
+func posString(pos token.Position) string {
+	if pos.Filename == DefaultSourceName {
+		return strings.TrimPrefix(pos.String(), DefaultSourceName+":")
+	}
+	return pos.String()
+}
+
+// Unwrap returns the underlying error.
+func (e *CompileError) Unwrap() error { return e.Err }
+
+// A TypeCycleError is the error of type declarations referring to themselves
+// through composite types, such as type T []T, which the interpreter can not
+// represent. It is wrapped by the *CompileError located at the declaration.
+type TypeCycleError struct {
+	Chain []string // names of the types of the cycle, starting and ending with the same
+}
+
+func (e *TypeCycleError) Error() string {
+	return "recursive type " + e.Chain[0] + " not supported: " + strings.Join(e.Chain, " refers to ")
+}
+
+// An UnresolvedError lists the references to undefined identifiers which
+// prevent the resolution of global declarations, such as the types of
+// variables or the signatures of functions. It is wrapped by the
+// *CompileError located at the first reference, of code CodeUndefined.
+type UnresolvedError struct {
+	Refs []UnresolvedRef // unresolved references, in source order
+}
+
+// An UnresolvedRef is a reference to an undefined identifier.
+type UnresolvedRef struct {
+	Pos    token.Position // position of the reference
+	Name   string         // undefined identifier
+	Import string         // import path of a package of this name, likely missing, if any
+}
+
+func (r UnresolvedRef) String() string {
+	s := "undefined: " + r.Name
+	if r.Import != "" {
+		s += fmt.Sprintf(" (missing import %q?)", r.Import)
+	}
+	return s
+}
+
+func (e *UnresolvedError) Error() string {
+	s := make([]string, len(e.Refs))
+	for i, r := range e.Refs {
+		s[i] = posString(r.Pos) + ": " + r.String()
+	}
+	return strings.Join(s, "\n")
+}
+
+// compileErrorCode returns the code of the compile error message msg.
+func compileErrorCode(msg string) ErrorCode {
+	switch {
+	case strings.HasPrefix(msg, "undefined"):
+		return CodeUndefined
+	case strings.HasSuffix(msg, " not allowed"):
+		return CodeNotAllowed
+	}
+	return CodeCompile
+}
+
+// importErrorCode returns the code of the import error caused by err.
+func importErrorCode(err error) ErrorCode {
+	switch err := err.(type) {
+	case scanner.ErrorList, scanner.Error, *scanner.Error:
+		return CodeSyntax
+	case *cfgError:
+		if ce, ok := err.error.(*CompileError); ok {
+			return ce.Code
+		}
+	case *ImportError:
+		return err.Code
+	}
+	return CodeCompile
+}
+
+// importError returns err, the error of the import of importPath, as an
+// *ImportError. Interruptions of the import, by a cancellation or an exceeded
+// quota, are returned unchanged.
+func (interp *Interpreter) importError(importPath string, err error) error {
+	if err == nil || err == interp.runErr() {
+		return err
+	}
+	if ie, ok := err.(*ImportError); ok && ie.Path == importPath {
+		return err
+	}
+	return &ImportError{Path: importPath, Code: importErrorCode(err), Errs: []error{err}}
+}
+
+// importing is a source package being imported.
+type importing struct {
+	path string // import path
+	name string // package name, once the first file is parsed
+	lazy bool   // bound to imports of a cycle, see lazyImport
+}
+
+// importCycle returns an import cycle error if the package importPath is
+// being imported.
+func (interp *Interpreter) importCycle(importPath string) error {
+	for i, p := range interp.importStack {
+		if p.path != importPath {
+			continue
+		}
+		chain := make([]string, 0, len(interp.importStack)-i+1)
+		for _, c := range interp.importStack[i:] {
+			chain = append(chain, c.path)
+		}
+		chain = append(chain, importPath)
+		msg := "import cycle not allowed"
+		for _, c := range chain {
+			msg += "\n\timports " + c
+		}
+		return &ImportError{Path: importPath, Code: CodeImportCycle, Chain: chain, Errs: []error{errors.New(msg)}}
+	}
+	return nil
+}
+
+// lazyImport returns the name of the package importPath, being imported,
+// and binds its import to the symbols declared so far, if the cycle err
+// can be broken per Options.LazyImportCycles.
+func (interp *Interpreter) lazyImport(importPath string, err error) (string, bool) {
+	if !interp.lazyImportCycles {
+		return "", false
+	}
+	var cycle *ImportError
+	if !errors.As(err, &cycle) || cycle.Code != CodeImportCycle {
+		return "", false
+	}
+	var p *importing
+	for i := range interp.importStack {
+		if interp.importStack[i].path == importPath {
+			p = &interp.importStack[i]
+			break
+		}
+	}
+	interp.mutex.Lock()
+	defer interp.mutex.Unlock()
+	sc := interp.scopes[importPath]
+	if p == nil || p.name == "" || sc == nil {
+		return "", false
+	}
+	p.lazy = true
+	interp.srcPkg[importPath] = sc.sym
+	interp.pkgNames[importPath] = p.name
+	return p.name, true
+}
+
+// pushImport records that the package importPath is being imported. The
+// returned function ends the import, and forgets a lazy binding of the
+// package if it failed.
+func (interp *Interpreter) pushImport(importPath string) func(err error) {
+	interp.importStack = append(interp.importStack, importing{path: importPath})
+	return func(err error) {
+		last := len(interp.importStack) - 1
+		if interp.importStack[last].lazy && err != nil {
+			interp.mutex.Lock()
+			delete(interp.srcPkg, importPath)
+			delete(interp.pkgNames, importPath)
+			interp.mutex.Unlock()
+		}
+		interp.importStack = interp.importStack[:last]
+	}
+}
+
+// setImportName records the package name of the package being imported.
+func (interp *Interpreter) setImportName(name string) {
+	interp.importStack[len(interp.importStack)-1].name = name
+}
+
+// errorList accumulates the errors of an import, up to Options.MaxErrors, so
+// they are reported at once.
+type errorList struct {
+	max  int
+	errs []error
+}
+
+// add records err, and returns true if the import goes on to find more
+// errors, false if errors are not accumulated or their maximum is reached.
+func (l *errorList) add(err error) bool {
+	if l.max <= 0 {
+		return false
+	}
+	if el, ok := err.(scanner.ErrorList); ok {
+		for _, e := range el {
+			l.errs = append(l.errs, e)
+		}
+	} else {
+		l.errs = append(l.errs, err)
+	}
+	if len(l.errs) >= l.max {
+		l.errs = l.errs[:l.max]
+		return false
+	}
+	return true
+}
+
+// error returns the accumulated errors of the import of importPath as an
+// *ImportError, or err if none.
+func (l *errorList) error(importPath string, err error) error {
+	if len(l.errs) == 0 {
+		return err
+	}
+	return &ImportError{Path: importPath, Code: importErrorCode(l.errs[0]), Errs: l.errs}
+}
@@ -0,0 +1,321 @@
+package interp
+
+import (
+	"errors"
+	"fmt"
+	"go/scanner"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestStructuredErrors(t *testing.T) {
+	goPath, err := ioutil.TempDir("", "errors")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		_ = os.RemoveAll(goPath)
+	}()
+
+	files := map[string]string{
+		"a/a.go":   "package a\n\nimport \"b\"\n\nvar A = b.B\n",
+		"b/b.go":   "package b\n\nimport \"a\"\n\nvar B = a.A\n",
+		"c/c.go":   "package c\n\nvar C int = \"c\"\n",
+		"d/d1.go":  "package d\n",
+		"d/d2.go":  "package e\n",
+		"app/a.go": "package app\n\nimport \"c\"\n\nvar X = c.C\n",
+	}
+	for name, src := range files {
+		name = filepath.Join(goPath, "src", name)
+		if err := os.MkdirAll(filepath.Dir(name), 0700); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(name, []byte(src), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// importErrors returns the import errors wrapped by err, outermost first.
+	importErrors := func(err error) (ies []*ImportError) {
+		for ; err != nil; err = errors.Unwrap(err) {
+			if ie, ok := err.(*ImportError); ok {
+				ies = append(ies, ie)
+			}
+		}
+		return ies
+	}
+
+	i := New(Options{GoPath: goPath})
+	_, err = i.Eval(`import "a"`)
+	ies := importErrors(err)
+	if len(ies) != 3 || ies[2].Code != CodeImportCycle || !reflect.DeepEqual(ies[2].Chain, []string{"a", "b", "a"}) {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if !strings.Contains(err.Error(), "import cycle not allowed\n\timports a\n\timports b\n\timports a") {
+		t.Errorf("unexpected message %q", err)
+	}
+
+	// The compile error of a dependency is located in its source, and
+	// wrapped by the error of the import declaration.
+	i = New(Options{GoPath: goPath})
+	_, err = i.Eval(`import "app"`)
+	var ce *CompileError
+	if !errors.As(err, &ce) || ce.Code != CodeImport || ce.Pos.Line != 1 || ce.Pos.Column != 8 {
+		t.Fatalf("unexpected error %v", err)
+	}
+	ies = importErrors(err)
+	if len(ies) != 2 || ies[0].Path != "app" || ies[0].Code != CodeImport || ies[1].Path != "c" || ies[1].Code != CodeCompile {
+		t.Fatalf("unexpected import errors %v", ies)
+	}
+	if !errors.As(ies[1], &ce) || ce.Pos.Filename != filepath.Join(goPath, "src", "c", "c.go") || ce.Pos.Line != 3 {
+		t.Errorf("unexpected compile error %+v", ce)
+	}
+
+	for path, code := range map[string]ErrorCode{"d": CodeMultiplePackages, "missing": CodeImportNotFound} {
+		i = New(Options{GoPath: goPath})
+		_, err = i.Eval(`import "` + path + `"`)
+		if ies := importErrors(err); len(ies) != 1 || ies[0].Path != path || ies[0].Code != code {
+			t.Errorf("%s: unexpected error %v", path, err)
+		}
+	}
+
+	_, err = i.Eval(`undefinedVar + 1`)
+	if !errors.As(err, &ce) || ce.Code != CodeUndefined || ce.Pos.Line != 1 || ce.Error() != "1:1: undefined: undefinedVar" {
+		t.Errorf("unexpected error %v", err)
+	}
+}
+
+func TestMaxErrors(t *testing.T) {
+	goPath, err := ioutil.TempDir("", "maxerrors")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		_ = os.RemoveAll(goPath)
+	}()
+
+	files := map[string]string{
+		"syntax/a.go": "package syntax\n\nfunc A( {}\n",
+		"syntax/b.go": "package syntax\n\nvar B = \n",
+		"syntax/c.go": "package syntax\n\nvar C = 1\n",
+		"funcs/a.go":  "package funcs\n\nfunc A() { var a int = \"a\"; _ = a }\n",
+		"funcs/b.go":  "package funcs\n\nfunc B() { D() }\n\nfunc C() int { return 1 + \"c\" }\n",
+	}
+	for name, src := range files {
+		name = filepath.Join(goPath, "src", name)
+		if err := os.MkdirAll(filepath.Dir(name), 0700); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(name, []byte(src), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tests := []struct {
+		path      string
+		maxErrors int
+		code      ErrorCode
+		lines     []int
+	}{
+		{path: "syntax", maxErrors: 10, code: CodeSyntax, lines: []int{3, 3}},
+		{path: "syntax", maxErrors: 1, code: CodeSyntax, lines: []int{3}},
+		{path: "funcs", maxErrors: 10, code: CodeCompile, lines: []int{3, 3, 5}},
+		{path: "funcs", maxErrors: 2, code: CodeCompile, lines: []int{3, 3}},
+	}
+	for _, test := range tests {
+		i := New(Options{GoPath: goPath, MaxErrors: test.maxErrors})
+		_, err := i.Eval(`import "` + test.path + `"`)
+		var ie *ImportError
+		if !errors.As(err, &ie) {
+			t.Fatalf("%s: unexpected error %v", test.path, err)
+		}
+		if ie.Path != test.path || ie.Code != test.code || len(ie.Errs) != len(test.lines) {
+			t.Errorf("%s, %d: unexpected error %v", test.path, test.maxErrors, ie)
+			continue
+		}
+		for k, e := range ie.Errs {
+			var line int
+			var se *scanner.Error
+			var ce *CompileError
+			switch {
+			case errors.As(e, &se):
+				line = se.Pos.Line
+			case errors.As(e, &ce):
+				line = ce.Pos.Line
+			}
+			if line != test.lines[k] {
+				t.Errorf("%s, %d: error %d at line %d, want %d: %v", test.path, test.maxErrors, k, line, test.lines[k], e)
+			}
+		}
+	}
+
+	// Without MaxErrors, only the first error is reported, and the functions
+	// of imported packages are compiled on first use.
+	i := New(Options{GoPath: goPath})
+	_, err = i.Eval(`import "syntax"`)
+	var ie *ImportError
+	if !errors.As(err, &ie) || len(ie.Errs) != 1 {
+		t.Errorf("unexpected error %v", err)
+	}
+	i = New(Options{GoPath: goPath})
+	if _, err = i.Eval(`import "funcs"`); err != nil {
+		t.Errorf("unexpected error %v", err)
+	}
+}
+
+func TestUnsupportedPackages(t *testing.T) {
+	for _, path := range []string{"plugin", "unsafe", "internal/cpu"} {
+		i := New(Options{GoPath: "/nonexistent"})
+		_, err := i.Eval(`import "` + path + `"`)
+		var ie *ImportError
+		if !errors.As(err, &ie) || ie.Path != path || ie.Code != CodeUnsupported {
+			t.Errorf("%s: unexpected error %v", path, err)
+			continue
+		}
+		if !strings.Contains(err.Error(), "package "+path+" is not supported: ") {
+			t.Errorf("%s: unexpected message %q", path, err)
+		}
+	}
+
+	// Packages provided by the host as binary symbols are imported.
+	i := New(Options{GoPath: "/nonexistent"})
+	i.Use(Exports{"unsafe": {"Sizeof": reflect.ValueOf(func(interface{}) uintptr { return 0 })}})
+	if _, err := i.Eval(`import "unsafe"`); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestLazyImportCycles(t *testing.T) {
+	goPath, err := ioutil.TempDir("", "cycles")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		_ = os.RemoveAll(goPath)
+	}()
+
+	files := map[string]string{
+		"even/even.go": "package even\n\nimport \"odd\"\n\nfunc Even(n int) bool {\n\tif n == 0 {\n\t\treturn true\n\t}\n\treturn odd.Odd(n - 1)\n}\n",
+		"odd/odd.go":   "package odd\n\nimport \"even\"\n\nfunc Odd(n int) bool {\n\tif n == 0 {\n\t\treturn false\n\t}\n\treturn even.Even(n - 1)\n}\n",
+		"a/a.go":       "package a\n\nimport \"b\"\n\nvar A = b.B\n",
+		"b/b.go":       "package b\n\nimport \"a\"\n\nvar B = a.A\n",
+	}
+	for name, src := range files {
+		name = filepath.Join(goPath, "src", name)
+		if err := os.MkdirAll(filepath.Dir(name), 0700); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(name, []byte(src), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if _, err := New(Options{GoPath: goPath}).Eval(`import "even"`); err == nil || !strings.Contains(err.Error(), "import cycle not allowed") {
+		t.Fatalf("got error %v, want import cycle", err)
+	}
+
+	i := New(Options{GoPath: goPath, LazyImportCycles: true})
+	if _, err := i.Eval(`import "even"`); err != nil {
+		t.Fatal(err)
+	}
+	res, err := i.Eval(`even.Even(7)`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Bool() {
+		t.Error("got even 7")
+	}
+
+	// Cycles needed to initialize the packages still fail, and the partial
+	// imports are forgotten.
+	if _, err := i.Eval(`import "a"`); err == nil || !strings.Contains(err.Error(), "undefined selector a.A") {
+		t.Fatalf("got error %v, want undefined selector", err)
+	}
+	if i.srcPkg["a"] != nil || i.srcPkg["b"] != nil {
+		t.Error("partial import not forgotten")
+	}
+}
+
+func TestTypeCycles(t *testing.T) {
+	for _, test := range []struct {
+		src   string
+		chain []string
+	}{
+		{src: "type T []T", chain: []string{"T", "T"}},
+		{src: "type T *T", chain: []string{"T", "T"}},
+		{src: "type A map[string]B\ntype B func(A)", chain: []string{"A", "B", "A"}},
+	} {
+		_, err := New(Options{}).Eval(test.src)
+		var ce *CompileError
+		var tc *TypeCycleError
+		if !errors.As(err, &ce) || ce.Code != CodeTypeCycle || ce.Pos.Line != 1 || !errors.As(err, &tc) {
+			t.Errorf("%s: got error %v, want type cycle", test.src, err)
+			continue
+		}
+		if !reflect.DeepEqual(tc.Chain, test.chain) {
+			t.Errorf("%s: got chain %q, want %q", test.src, tc.Chain, test.chain)
+		}
+	}
+
+	// Cycles through structs are supported.
+	if _, err := New(Options{}).Eval("type T struct{ next []T }\nvar t = T{next: []T{{}}}"); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestMaxTypeDepth(t *testing.T) {
+	src := "type T [][][]int"
+	if _, err := New(Options{MaxTypeDepth: 4}).Eval(src); err != nil {
+		t.Fatal(err)
+	}
+	_, err := New(Options{MaxTypeDepth: 3}).Eval(src)
+	var ce *CompileError
+	if !errors.As(err, &ce) || ce.Msg != "type nested too deeply: more than 3 levels" {
+		t.Errorf("got error %v, want too deep", err)
+	}
+}
+
+func TestUnresolvedErrors(t *testing.T) {
+	i := New(Options{})
+	i.Use(Exports{"example.com/text/v2": {"Upper": reflect.ValueOf(strings.ToUpper)}})
+	_, err := i.Eval(`package main
+
+type S struct {
+	name text.Name
+	next *Node
+}
+
+var count Counter
+
+func handle(s S, w Writer) {}
+
+const limit = size + 1
+
+func main() {}
+`)
+	var ce *CompileError
+	var ue *UnresolvedError
+	if !errors.As(err, &ce) || ce.Code != CodeUndefined || !errors.As(err, &ue) {
+		t.Fatalf("got error %v, want unresolved references", err)
+	}
+	var got []string
+	for _, r := range ue.Refs {
+		got = append(got, fmt.Sprintf("%d:%d %s %s", r.Pos.Line, r.Pos.Column, r.Name, r.Import))
+	}
+	want := []string{"4:7 text example.com/text/v2", "5:8 Node ", "8:11 Counter ", "10:20 Writer ", "12:15 size "}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got references %q, want %q", got, want)
+	}
+	if !strings.HasPrefix(err.Error(), `4:7: undefined: text (missing import "example.com/text/v2"?)`+"\n\t5:8: undefined: Node") {
+		t.Errorf("unexpected error message %q", err)
+	}
+
+	// Declarations only referring to each other are reported as a loop.
+	if _, err := New(Options{}).Eval("const a = b\nconst b = a"); err == nil || !strings.Contains(err.Error(), "constant definition loop") {
+		t.Errorf("got error %v, want constant definition loop", err)
+	}
+}
@@ -0,0 +1,145 @@
+package interp
+
+import (
+	"container/list"
+	"crypto/sha256"
+	"go/parser"
+	"reflect"
+	"sync"
+)
+
+// EvalCacheStats are the statistics of the cache of compiled expressions,
+// see Options.EvalCacheSize.
+type EvalCacheStats struct {
+	Hits      uint64 // evaluations of cached expressions
+	Misses    uint64 // evaluations of expressions not cached
+	Evictions uint64 // expressions evicted to make room for others
+	Flushes   uint64 // flushes of the cache by changes of declarations
+	Len       int    // number of cached expressions
+}
+
+// evalCache is a LRU cache of compiled expressions.
+type evalCache struct {
+	size int
+
+	mu      sync.Mutex
+	entries map[evalKey]*list.Element
+	lru     *list.List // of *evalEntry, most recently used first
+	stats   EvalCacheStats
+}
+
+// evalKey identifies a compiled expression by the digest of its source and
+// its expected result type.
+type evalKey struct {
+	digest [sha256.Size]byte
+	want   reflect.Type
+}
+
+type evalEntry struct {
+	key    evalKey
+	root   *node
+	result func(*frame) reflect.Value
+}
+
+func newEvalCache(size int) *evalCache {
+	return &evalCache{size: size, entries: map[evalKey]*list.Element{}, lru: list.New()}
+}
+
+func (c *evalCache) get(key evalKey) *evalEntry {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, ok := c.entries[key]
+	if !ok {
+		return nil
+	}
+	c.stats.Hits++
+	c.lru.MoveToFront(e)
+	return e.Value.(*evalEntry)
+}
+
+func (c *evalCache) put(e *evalEntry) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries[e.key] = c.lru.PushFront(e)
+	for c.lru.Len() > c.size {
+		last := c.lru.Back()
+		c.lru.Remove(last)
+		delete(c.entries, last.Value.(*evalEntry).key)
+		c.stats.Evictions++
+	}
+}
+
+// flush empties the cache, as the declarations which the compiled
+// expressions refer to may have changed.
+func (c *evalCache) flush() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.lru.Len() == 0 {
+		return
+	}
+	c.entries = map[evalKey]*list.Element{}
+	c.lru.Init()
+	c.stats.Flushes++
+}
+
+// EvalCacheStats returns the statistics of the cache of compiled
+// expressions, or zero statistics if Options.EvalCacheSize is not set.
+func (interp *Interpreter) EvalCacheStats() EvalCacheStats {
+	c := interp.evalCache
+	if c == nil {
+		return EvalCacheStats{}
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	s := c.stats
+	s.Len = c.lru.Len()
+	return s
+}
+
+// flushEvalCache empties the cache of compiled expressions, if any.
+func (interp *Interpreter) flushEvalCache() {
+	if c := interp.evalCache; c != nil {
+		c.flush()
+	}
+}
+
+// cachedEval returns the cache key of the source src evaluated with the
+// expected result type want, and its cached entry if any. It returns false
+// if src is not a cacheable expression, in which case the cache is flushed.
+func (interp *Interpreter) cachedEval(src string, want reflect.Type) (evalKey, *evalEntry, bool) {
+	c := interp.evalCache
+	key := evalKey{digest: sha256.Sum256([]byte(src)), want: want}
+	if e := c.get(key); e != nil {
+		return key, e, true
+	}
+	if _, err := parser.ParseExpr(src); err != nil {
+		c.flush()
+		return key, nil, false
+	}
+	c.mu.Lock()
+	c.stats.Misses++
+	c.mu.Unlock()
+	return key, nil, true
+}
+
+// runCached runs the cached compiled expression e, as eval does.
+func (interp *Interpreter) runCached(e *evalEntry) (reflect.Value, error) {
+	if interp.startRun() != interp.runid() {
+		return reflect.Value{}, interp.runErr()
+	}
+	interp.frame.mutex.Lock()
+	interp.resizeFrame()
+	interp.frame.mutex.Unlock()
+
+	interp.run(e.root, nil)
+	res := e.result(interp.frame)
+	if err := interp.abortErr(); err != nil {
+		return reflect.Value{}, err
+	}
+	if res.IsValid() {
+		if n, ok := res.Interface().(*node); ok {
+			res = genFunctionWrapper(n)(interp.frame)
+		}
+	}
+	return interp.truncateResult(res), nil
+}
@@ -0,0 +1,71 @@
+package interp
+
+import (
+	"testing"
+)
+
+func TestEvalCache(t *testing.T) {
+	i := New(Options{EvalCacheSize: 2})
+	if _, err := i.Eval(`
+var n int
+
+func inc() int { n++; return n }
+
+func f(x int) int { return 2 * x }`); err != nil {
+		t.Fatal(err)
+	}
+
+	evalInt := func(src string) int {
+		t.Helper()
+		res, err := i.Eval(src)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return int(res.Int())
+	}
+
+	// A cached expression is not compiled again, but executed again.
+	for want := 1; want <= 3; want++ {
+		if got := evalInt("inc()"); got != want {
+			t.Fatalf("got %d, want %d", got, want)
+		}
+	}
+	size := len(i.frame.data)
+	if got := evalInt("inc()"); got != 4 || len(i.frame.data) != size {
+		t.Fatalf("got %d and frame size %d, want 4 and %d", got, len(i.frame.data), size)
+	}
+	if s := i.EvalCacheStats(); s.Hits != 3 || s.Misses != 1 || s.Len != 1 {
+		t.Fatalf("unexpected stats %+v", s)
+	}
+
+	// Expressions are cached per expected result type.
+	var x float64
+	if err := i.EvalInto("2 * 2", &x); err != nil || x != 4 {
+		t.Fatalf("got %v %v, want 4", x, err)
+	}
+	if got := evalInt("2 * 2"); got != 4 {
+		t.Fatalf("got %d, want 4", got)
+	}
+	if s := i.EvalCacheStats(); s.Misses != 3 || s.Evictions != 1 || s.Len != 2 {
+		t.Fatalf("unexpected stats %+v", s)
+	}
+
+	// Declarations flush the cache.
+	if _, err := i.Eval(`func f(x int) int { return 3 * x }`); err != nil {
+		t.Fatal(err)
+	}
+	if got := evalInt("f(2)"); got != 6 {
+		t.Fatalf("got %d, want 6", got)
+	}
+	if s := i.EvalCacheStats(); s.Flushes != 1 || s.Len != 1 {
+		t.Fatalf("unexpected stats %+v", s)
+	}
+
+	// A failing expression is not cached.
+	if _, err := i.Eval(`1 / (n - n)`); err == nil {
+		t.Fatal("expected a panic")
+	}
+	if s := i.EvalCacheStats(); s.Len != 1 {
+		t.Fatalf("unexpected stats %+v", s)
+	}
+}
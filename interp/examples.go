@@ -0,0 +1,131 @@
+package interp
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/doc"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"sort"
+	"strings"
+)
+
+// ExampleResult is the outcome of running an Example function of an interpreted package.
+type ExampleResult struct {
+	Name      string // name of the example function, i.e. "ExampleXxx"
+	Got       string // captured standard output, with spaces trimmed
+	Want      string // expected output from the "Output:" comment, with spaces trimmed
+	Unordered bool   // true if the expected output is declared as "Unordered output:"
+	Err       error  // non nil if the example panicked
+}
+
+// Passed returns true if the example ran without panic and produced the expected output.
+func (r ExampleResult) Passed() bool {
+	if r.Err != nil {
+		return false
+	}
+	if r.Unordered {
+		return sortLines(r.Got) == sortLines(r.Want)
+	}
+	return r.Got == r.Want
+}
+
+func sortLines(s string) string {
+	lines := strings.Split(s, "\n")
+	sort.Strings(lines)
+	return strings.Join(lines, "\n")
+}
+
+// RunExamples evaluates the package located at path, including its test files,
+// then executes its Example functions and compares their standard output to the
+// expected one, as specified in their "Output:" or "Unordered output:" comments.
+// Examples without output comment are compiled but not run, as with "go test".
+//
+// The package is evaluated in a new interpreter using the same settings and
+// binary symbols as interp, but with its standard output discarded, so the
+// state of interp is not modified. See Examples to run the examples of a
+// package already evaluated by interp.
+func (interp *Interpreter) RunExamples(path string) ([]ExampleResult, error) {
+	i := New(Options{
+		GoPath:    interp.context.GOPATH,
+		BuildTags: interp.context.BuildTags,
+		Stdin:     interp.stdin,
+		Stdout:    ioutil.Discard,
+		Stderr:    interp.stderr,
+	})
+	i.Use(interp.binPkg)
+
+	if err := i.EvalTest(path); err != nil {
+		return nil, err
+	}
+	return i.Examples(path)
+}
+
+// Examples executes the Example functions of the package located at path,
+// already evaluated by interp with its test files, as by EvalTest, and
+// compares their standard output to the expected one, as RunExamples does.
+// The package is not evaluated again: the standard output of interp is
+// redirected while the examples run.
+func (interp *Interpreter) Examples(path string) ([]ExampleResult, error) {
+	interp.mutex.RLock()
+	sources := interp.sources[path]
+	interp.mutex.RUnlock()
+	if len(sources) == 0 {
+		return nil, fmt.Errorf("package not evaluated: %s", path)
+	}
+
+	fset := token.NewFileSet()
+	var files []*ast.File
+	for _, s := range sources {
+		f, err := parser.ParseFile(fset, s.name, s.src, parser.ParseComments)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, f)
+	}
+	examples := doc.Examples(files...)
+	sort.Slice(examples, func(a, b int) bool { return examples[a].Order < examples[b].Order })
+
+	var out bytes.Buffer
+	interp.mutex.Lock()
+	stdout := interp.opt.stdout
+	interp.opt.stdout = &out
+	interp.mutex.Unlock()
+	defer func() {
+		interp.mutex.Lock()
+		interp.opt.stdout = stdout
+		interp.mutex.Unlock()
+	}()
+
+	syms := interp.Symbols(path)[path]
+	var res []ExampleResult
+	for _, e := range examples {
+		if e.Output == "" && !e.EmptyOutput {
+			continue
+		}
+		name := "Example" + e.Name
+		fn, ok := syms[name].Interface().(func())
+		if !ok {
+			return res, fmt.Errorf("example function not found: %s", name)
+		}
+		out.Reset()
+		r := ExampleResult{Name: name, Want: strings.TrimSpace(e.Output), Unordered: e.Unordered}
+		r.Err = runExample(fn)
+		r.Got = strings.TrimSpace(out.String())
+		res = append(res, r)
+	}
+	return res, nil
+}
+
+// runExample calls an example function, converting a panic to an error.
+func runExample(fn func()) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v", r)
+		}
+	}()
+	fn()
+	return nil
+}
@@ -0,0 +1,74 @@
+package interp_test
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"github.com/traefik/yaegi/interp"
+	"github.com/traefik/yaegi/stdlib"
+)
+
+func TestRunExamples(t *testing.T) {
+	i := interp.New(interp.Options{GoPath: "./testdata"})
+	i.Use(stdlib.Symbols)
+
+	res, err := i.RunExamples("guthib.com/example")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []struct {
+		name   string
+		passed bool
+	}{
+		{"ExampleHello", true},
+		{"ExampleHello_unordered", true},
+		{"ExampleHello_wrong", false},
+	}
+	if len(res) != len(want) {
+		t.Fatalf("got %d results, want %d: %v", len(res), len(want), res)
+	}
+	for k, w := range want {
+		if res[k].Name != w.name {
+			t.Errorf("got example %s, want %s", res[k].Name, w.name)
+		}
+		if res[k].Passed() != w.passed {
+			t.Errorf("%s: got passed %v, want %v (got %q, want %q)", w.name, res[k].Passed(), w.passed, res[k].Got, res[k].Want)
+		}
+	}
+
+	if _, ok := i.Symbols("guthib.com/example")["guthib.com/example"]; ok {
+		t.Error("examples should not be evaluated in the calling interpreter")
+	}
+}
+
+func TestExamples(t *testing.T) {
+	var out bytes.Buffer
+	i := interp.New(interp.Options{GoPath: "./testdata", Stdout: &out})
+	i.Use(stdlib.Symbols)
+	runs := 0
+	i.Use(interp.Exports{"guthib.com/host": {"Init": reflect.ValueOf(func() int { runs++; return runs })}})
+
+	if err := i.EvalTest("guthib.com/once"); err != nil {
+		t.Fatal(err)
+	}
+	res, err := i.Examples("guthib.com/once")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res) != 1 || !res[0].Passed() {
+		t.Fatalf("got %v, want a passed example", res)
+	}
+	// The package is not evaluated again, and the output is captured.
+	if runs != 1 {
+		t.Errorf("got %d evaluations, want 1", runs)
+	}
+	if out.Len() != 0 {
+		t.Errorf("unexpected output %q", out.String())
+	}
+
+	if _, err := i.Examples("guthib.com/example"); err == nil {
+		t.Error("expected an error for a package not evaluated")
+	}
+}
@@ -1,5 +1,7 @@
 package interp
 
+import "time"
+
 func (interp *Interpreter) Scopes() map[string]map[string]struct{} {
 	scopes := make(map[string]map[string]struct{})
 	for k, v := range interp.scopes {
@@ -12,6 +14,8 @@ func (interp *Interpreter) Scopes() map[string]map[string]struct{} {
 	return scopes
 }
 
-func (interp *Interpreter) Packages() map[string]string {
+func (interp *Interpreter) PackageNames() map[string]string {
 	return interp.pkgNames
 }
+
+func (m *MemStore) SetClock(now func() time.Time) { m.now = now }
@@ -0,0 +1,132 @@
+package interp
+
+import (
+	"reflect"
+	"sync"
+	"time"
+)
+
+// Fault is a failure injected in the calls of a binary function, see Faults.
+type Fault struct {
+	// Delay is the latency added before each call.
+	Delay time.Duration
+
+	// Err, if not nil, makes the calls fail without calling the function.
+	// If the last result of the function is an error, Err is returned with
+	// zero values for the other results. Otherwise, the call panics with Err.
+	Err error
+
+	// Count, if positive, is the number of the next calls affected, after
+	// which the fault is cleared.
+	Count int
+}
+
+// Faults injects failures, on demand, in the functions of binary symbols
+// given to Interpreter.Use, to test the error handling of interpreted code
+// against a host API:
+//
+//	faults := interp.NewFaults()
+//	i.Use(faults.Wrap(hostSymbols))
+//	faults.Set("example.com/host.Fetch", interp.Fault{Err: errors.New("unavailable")})
+//
+// Functions are designated by their package import path and name, as
+// "example.com/host.Fetch". Faults can be set and cleared at any time, also
+// during the execution of interpreted code.
+type Faults struct {
+	mu     sync.Mutex
+	faults map[string]*Fault
+}
+
+// NewFaults returns a set of faults, initially empty.
+func NewFaults() *Faults {
+	return &Faults{faults: map[string]*Fault{}}
+}
+
+// Set injects the fault f in the calls of the function symbol, replacing the
+// previous fault of symbol if any.
+func (fs *Faults) Set(symbol string, f Fault) {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+	fs.faults[symbol] = &f
+}
+
+// Clear removes the fault of the function symbol, or all faults if symbol is
+// empty.
+func (fs *Faults) Clear(symbol string) {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+	if symbol == "" {
+		fs.faults = map[string]*Fault{}
+		return
+	}
+	delete(fs.faults, symbol)
+}
+
+// next returns the fault to apply to the current call of the function
+// symbol, or nil.
+func (fs *Faults) next(symbol string) *Fault {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+	f := fs.faults[symbol]
+	if f == nil || f.Count <= 0 {
+		return f
+	}
+	if f.Count--; f.Count == 0 {
+		delete(fs.faults, symbol)
+	}
+	return f
+}
+
+// Wrap returns a copy of values where the functions call the original ones,
+// unless a fault is set for them. Other symbols, such as types, variables
+// and constants, are unchanged.
+func (fs *Faults) Wrap(values Exports) Exports {
+	res := make(Exports, len(values))
+	for path, syms := range values {
+		m := make(map[string]reflect.Value, len(syms))
+		for name, v := range syms {
+			if path == selfPrefix || v.Kind() != reflect.Func {
+				m[name] = v
+				continue
+			}
+			m[name] = fs.wrapFunc(path+"."+name, v)
+		}
+		res[path] = m
+	}
+	return res
+}
+
+// wrapFunc returns the function fn of the symbol, with the faults set for it.
+func (fs *Faults) wrapFunc(symbol string, fn reflect.Value) reflect.Value {
+	t := fn.Type()
+	call := fn.Call
+	if t.IsVariadic() {
+		call = fn.CallSlice
+	}
+	errIndex := -1
+	if n := t.NumOut(); n > 0 && t.Out(n-1) == reflect.TypeOf((*error)(nil)).Elem() {
+		errIndex = n - 1
+	}
+
+	return reflect.MakeFunc(t, func(in []reflect.Value) []reflect.Value {
+		f := fs.next(symbol)
+		if f == nil {
+			return call(in)
+		}
+		if f.Delay > 0 {
+			time.Sleep(f.Delay)
+		}
+		if f.Err == nil {
+			return call(in)
+		}
+		if errIndex < 0 {
+			panic(f.Err)
+		}
+		out := make([]reflect.Value, t.NumOut())
+		for i := range out {
+			out[i] = reflect.Zero(t.Out(i))
+		}
+		out[errIndex] = reflect.ValueOf(&f.Err).Elem()
+		return out
+	})
+}
@@ -0,0 +1,54 @@
+package interp_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/traefik/yaegi/interp"
+)
+
+func TestFaults(t *testing.T) {
+	faults := interp.NewFaults()
+	i := interp.New(interp.Options{})
+	i.Use(faults.Wrap(interp.Exports{
+		"host": {
+			"Fetch": reflect.ValueOf(func(key string) (string, error) { return "value of " + key, nil }),
+			"Len":   reflect.ValueOf(func(s string) int { return len(s) }),
+		},
+	}))
+	eval(t, i, `import "host"`)
+	eval(t, i, `func fetch(key string) string {
+	v, err := host.Fetch(key)
+	if err != nil {
+		return "error: " + err.Error()
+	}
+	return v
+}`)
+
+	assertEval(t, i, `fetch("a")`, "", "value of a")
+
+	// A fault with a count applies to the next calls only.
+	faults.Set("host.Fetch", interp.Fault{Err: errors.New("unavailable"), Count: 2})
+	assertEval(t, i, `fetch("a")`, "", "error: unavailable")
+	assertEval(t, i, `fetch("b")`, "", "error: unavailable")
+	assertEval(t, i, `fetch("c")`, "", "value of c")
+
+	const delay = 20 * time.Millisecond
+	faults.Set("host.Fetch", interp.Fault{Delay: delay})
+	start := time.Now()
+	assertEval(t, i, `fetch("d")`, "", "value of d")
+	if d := time.Since(start); d < delay {
+		t.Errorf("got call duration %v, want at least %v", d, delay)
+	}
+	faults.Clear("host.Fetch")
+
+	// Functions without error result panic with the injected error.
+	faults.Set("host.Len", interp.Fault{Err: errors.New("broken")})
+	if _, err := i.Eval(`host.Len("abc")`); err == nil {
+		t.Error("want error")
+	}
+	faults.Clear("")
+	assertEval(t, i, `host.Len("abc")`, "", "3")
+}
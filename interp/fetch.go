@@ -0,0 +1,391 @@
+package interp
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// ErrRemoteDisabled is returned by Interpreter.ImportRemote when remote
+// imports are disabled, Options.Fetcher being nil.
+var ErrRemoteDisabled = errors.New("remote imports are disabled")
+
+// defaultProxy is the module proxy used by a Fetcher without Proxy.
+const defaultProxy = "https://proxy.golang.org"
+
+// Fetcher downloads the source modules imported by Interpreter.ImportRemote,
+// from a module proxy or from their git repository, verifies them, and caches
+// them in a local directory, as the go command does for its module cache.
+type Fetcher struct {
+	// Proxy is the base URL of the module proxy, implementing the GOPROXY
+	// protocol, "https://proxy.golang.org" if empty. If "direct", modules
+	// are cloned with git from https://<module path>, at the tag or branch
+	// named by their version.
+	Proxy string
+
+	// CacheDir is the directory caching the extracted modules, in the layout
+	// of the module cache. It is required.
+	CacheDir string
+
+	// Sums holds the hashes of the module contents, in the "h1:" form of
+	// go.sum files, indexed by "module@version". A fetched or cached module
+	// must match its hash, if any.
+	Sums map[string]string
+
+	// Header holds the headers added to proxy requests, such as
+	// Authorization.
+	Header http.Header
+
+	// Client is the HTTP client, http.DefaultClient if nil.
+	Client *http.Client
+
+	// MaxSize, if positive, is the maximum size of a module zip file in
+	// bytes.
+	MaxSize int64
+}
+
+// ImportRemote downloads with Options.Fetcher the module of the package
+// spec, of the form "path@version", such as "github.com/foo/bar@v1.2.3",
+// then imports the package as source, and returns its name. The version
+// "latest", or no version, selects the latest version known by the proxy.
+//
+// The packages of the module are then resolved from its cache directory, as
+// the ones of Options.Workspace, including from interpreted code. Imports of
+// other modules are resolved as usual: they must be fetched first, or
+// available otherwise. A module can be fetched at a single version per
+// interpreter. ImportRemote returns ErrRemoteDisabled if Options.Fetcher is
+// nil, and requires the default file system (see UseFilesystem).
+func (interp *Interpreter) ImportRemote(ctx context.Context, spec string) (_ string, err error) {
+	f := interp.fetcher
+	if f == nil {
+		return "", ErrRemoteDisabled
+	}
+	pkgPath, version := spec, "latest"
+	if i := strings.Index(spec, "@"); i >= 0 {
+		pkgPath, version = spec[:i], spec[i+1:]
+	}
+	if pkgPath == "" || isPathRelative(pkgPath) || version == "" {
+		return "", fmt.Errorf("invalid module spec %q", spec)
+	}
+
+	interp.mutex.RLock()
+	mod, ver := "", ""
+	for m, v := range interp.remote {
+		if (pkgPath == m || strings.HasPrefix(pkgPath, m+"/")) && len(m) > len(mod) {
+			mod, ver = m, v
+		}
+	}
+	dir := interp.workspace[mod]
+	interp.mutex.RUnlock()
+	if mod != "" && version != ver && version != "latest" {
+		return "", fmt.Errorf("fetch %s: module %s already fetched at version %s", spec, mod, ver)
+	}
+	if mod == "" {
+		if mod, ver, dir, err = f.fetch(ctx, pkgPath, version); err != nil {
+			return "", fmt.Errorf("fetch %s: %w", spec, err)
+		}
+	}
+
+	interp.evalMutex.Lock()
+	defer interp.evalMutex.Unlock()
+	defer interp.recoverPanic(&err)
+	interp.mutex.Lock()
+	if v, ok := interp.remote[mod]; ok && v != ver {
+		interp.mutex.Unlock()
+		return "", fmt.Errorf("fetch %s: module %s already fetched at version %s", spec, mod, v)
+	}
+	if interp.remote == nil {
+		interp.remote = map[string]string{}
+	}
+	interp.remote[mod] = ver
+	// The workspace may be shared with the caller: copy it.
+	ws := make(map[string]string, len(interp.workspace)+1)
+	for k, v := range interp.workspace {
+		ws[k] = v
+	}
+	ws[mod] = dir
+	interp.workspace = ws
+	interp.mutex.Unlock()
+
+	return interp.importSrc(mainID, pkgPath, NoTest)
+}
+
+// fetch returns the path, version and cache directory of the module of the
+// package pkgPath, at version, fetched if not already cached.
+func (f *Fetcher) fetch(ctx context.Context, pkgPath, version string) (mod, ver, dir string, err error) {
+	if f.CacheDir == "" {
+		return "", "", "", errors.New("no cache directory")
+	}
+	direct := f.Proxy == "direct"
+	if direct && version == "latest" {
+		return "", "", "", errors.New("a version is required to fetch from git")
+	}
+
+	// The module path is the longest prefix of pkgPath known to the proxy or
+	// hosting a repository.
+	notFound := errNotFound
+	for mod = pkgPath; mod != "." && mod != "/"; mod = path.Dir(mod) {
+		ver = version
+		if ver == "latest" {
+			if ver, err = f.latest(ctx, mod); isNotFound(err) {
+				notFound = err
+				continue
+			} else if err != nil {
+				return "", "", "", err
+			}
+		}
+		dir, err = f.fetchVersion(ctx, mod, ver)
+		if isNotFound(err) {
+			notFound = err
+			continue
+		}
+		return mod, ver, dir, err
+	}
+	return "", "", "", fmt.Errorf("no module found for %s: %w", pkgPath, notFound)
+}
+
+// fetchVersion returns the cache directory of the module mod at version ver,
+// fetched if not already cached.
+func (f *Fetcher) fetchVersion(ctx context.Context, mod, ver string) (string, error) {
+	dir, err := (module{mod, ver}).dir(f.CacheDir)
+	if err != nil {
+		return "", err
+	}
+	if _, err := os.Stat(dir); err == nil {
+		return dir, f.verify(dir, mod, ver)
+	}
+	if f.Proxy == "direct" {
+		return dir, f.clone(ctx, mod, ver, dir)
+	}
+	return dir, f.download(ctx, mod, ver, dir)
+}
+
+// ReadModule reads the go.mod file at path, as the ReadModule function, and
+// fetches to CacheDir the required modules missing from the module cache, so
+// that the returned workspace (see Options.Workspace) holds all of them, as
+// to run the main package of a module with its dependencies:
+//
+//	ws, err := f.ReadModule(ctx, "app/go.mod")
+//	...
+//	i := interp.New(interp.Options{GoPath: build.Default.GOPATH, Workspace: ws})
+//	_, err = i.EvalPath("app")
+//
+// The fetched modules are verified against the go.sum file next to go.mod.
+func (f *Fetcher) ReadModule(ctx context.Context, path string) (map[string]string, error) {
+	if f.CacheDir == "" {
+		return nil, errors.New("no cache directory")
+	}
+	return readModule(path, func(mod module, sum string) (string, error) {
+		g := *f
+		g.Sums = map[string]string{mod.path + "@" + mod.version: sum}
+		return g.fetchVersion(ctx, mod.path, mod.version)
+	})
+}
+
+// errNotFound is the error of a module absent from its source.
+var errNotFound = errors.New("not found")
+
+// isNotFound returns true if err reports a module absent from the proxy or
+// the repository, so that a shorter module path may be tried.
+func isNotFound(err error) bool {
+	var se *statusError
+	if errors.As(err, &se) {
+		return se.code == http.StatusNotFound || se.code == http.StatusGone
+	}
+	return errors.Is(err, errNotFound)
+}
+
+// proxyURL returns the URL of the proxy endpoint for the module mod and the
+// suffix, such as "@latest" or "@v/<version>.zip", escaped.
+func (f *Fetcher) proxyURL(mod, suffix string) (string, error) {
+	p, err := escapePath(mod)
+	if err != nil {
+		return "", err
+	}
+	base := f.Proxy
+	if base == "" {
+		base = defaultProxy
+	}
+	return strings.TrimSuffix(base, "/") + "/" + p + "/" + suffix, nil
+}
+
+// get returns the body of the proxy response to the request of url, limited to
+// MaxSize bytes if positive.
+func (f *Fetcher) get(ctx context.Context, url string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range f.Header {
+		req.Header[k] = v
+	}
+	client := f.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode != http.StatusOK {
+		return nil, &statusError{url: url, code: resp.StatusCode}
+	}
+
+	body := io.Reader(resp.Body)
+	if f.MaxSize > 0 {
+		body = io.LimitReader(body, f.MaxSize+1)
+	}
+	b, err := ioutil.ReadAll(body)
+	if err != nil {
+		return nil, err
+	}
+	if f.MaxSize > 0 && int64(len(b)) > f.MaxSize {
+		return nil, fmt.Errorf("%s: size exceeds %d bytes", url, f.MaxSize)
+	}
+	return b, nil
+}
+
+// latest returns the latest version of the module mod known by the proxy.
+func (f *Fetcher) latest(ctx context.Context, mod string) (string, error) {
+	url, err := f.proxyURL(mod, "@latest")
+	if err != nil {
+		return "", err
+	}
+	b, err := f.get(ctx, url)
+	if err != nil {
+		return "", err
+	}
+	var info struct{ Version string }
+	if err := json.Unmarshal(b, &info); err != nil {
+		return "", fmt.Errorf("%s: %w", url, err)
+	}
+	if info.Version == "" {
+		return "", fmt.Errorf("%s: no version", url)
+	}
+	return info.Version, nil
+}
+
+// download fetches the zip file of the module mod at version ver from the
+// proxy, and extracts it to dir.
+func (f *Fetcher) download(ctx context.Context, mod, ver, dir string) error {
+	v, err := escapePath(ver)
+	if err != nil {
+		return err
+	}
+	url, err := f.proxyURL(mod, "@v/"+v+".zip")
+	if err != nil {
+		return err
+	}
+	b, err := f.get(ctx, url)
+	if err != nil {
+		return err
+	}
+	files, err := readZip(bytes.NewReader(b))
+	if err != nil {
+		return fmt.Errorf("%s: %w", url, err)
+	}
+
+	// Files are extracted to a temporary directory, renamed once verified,
+	// so that the cache never holds a partial module.
+	return f.install(dir, mod, ver, func(tmp string) error {
+		prefix := mod + "@" + ver + "/"
+		for _, file := range files {
+			name := strings.TrimPrefix(file.name, prefix)
+			if name == file.name || !isLocalName(name) {
+				return fmt.Errorf("%s: invalid file name %q", url, file.name)
+			}
+			if err := writeFileAtomic(filepath.Join(tmp, filepath.FromSlash(name)), file.data); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// clone fetches the module mod at version ver from its git repository, cloned
+// to dir.
+func (f *Fetcher) clone(ctx context.Context, mod, ver, dir string) error {
+	return f.install(dir, mod, ver, func(tmp string) error {
+		cmd := exec.CommandContext(ctx, "git", "clone", "--quiet", "--depth", "1", "--branch", ver, "https://"+mod, tmp)
+		cmd.Env = append(os.Environ(), "GIT_TERMINAL_PROMPT=0")
+		if out, err := cmd.CombinedOutput(); err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			return fmt.Errorf("git clone %s: %w: %s: %v", mod, errNotFound, bytes.TrimSpace(out), err)
+		}
+		if b, err := ioutil.ReadFile(filepath.Join(tmp, "go.mod")); err == nil && modulePath(b) != mod {
+			return fmt.Errorf("%s: module %s: %w", mod, modulePath(b), errNotFound)
+		}
+		return os.RemoveAll(filepath.Join(tmp, ".git"))
+	})
+}
+
+// install fills with fill a temporary directory, verified then renamed to
+// dir.
+func (f *Fetcher) install(dir, mod, ver string, fill func(tmp string) error) error {
+	if err := os.MkdirAll(filepath.Dir(dir), 0700); err != nil {
+		return err
+	}
+	tmp, err := ioutil.TempDir(filepath.Dir(dir), filepath.Base(dir)+".tmp*")
+	if err != nil {
+		return err
+	}
+	defer func() { _ = os.RemoveAll(tmp) }()
+	if err := fill(tmp); err != nil {
+		return err
+	}
+	if err := f.verify(tmp, mod, ver); err != nil {
+		return err
+	}
+	if err := os.Rename(tmp, dir); err != nil {
+		if _, e := os.Stat(dir); e == nil {
+			// Fetched concurrently by another interpreter.
+			return f.verify(dir, mod, ver)
+		}
+		return err
+	}
+	return nil
+}
+
+// verify checks that the content of dir matches the hash of the module mod
+// at version ver in Sums, if any.
+func (f *Fetcher) verify(dir, mod, ver string) error {
+	want, ok := f.Sums[mod+"@"+ver]
+	if !ok {
+		return nil
+	}
+	got, err := hashDir(dir, mod+"@"+ver)
+	if err != nil {
+		return err
+	}
+	if got != want {
+		return fmt.Errorf("%s@%s: checksum mismatch, got %s, want %s", mod, ver, got, want)
+	}
+	return nil
+}
+
+// isLocalName returns true if the slash separated file name is relative and
+// stays within its directory.
+func isLocalName(name string) bool {
+	if name == "" || path.IsAbs(name) || strings.Contains(name, `\`) {
+		return false
+	}
+	for _, elem := range strings.Split(name, "/") {
+		if elem == "" || elem == "." || elem == ".." {
+			return false
+		}
+	}
+	return true
+}
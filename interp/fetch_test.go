@@ -0,0 +1,150 @@
+package interp
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+)
+
+func TestImportRemote(t *testing.T) {
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for name, src := range map[string]string{
+		"go.mod":     "module example.com/greet\n",
+		"greet.go":   "package greet\n\nimport \"example.com/greet/sub\"\n\nfunc Hello() string { return sub.Prefix + \"world\" }\n",
+		"sub/sub.go": "package sub\n\nconst Prefix = \"hello \"\n",
+	} {
+		w, err := zw.Create("example.com/greet@v1.0.0/" + name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := w.Write([]byte(src)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	var zips int32
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/example.com/greet/@latest":
+			_, _ = w.Write([]byte(`{"Version":"v1.0.0"}`))
+		case "/example.com/greet/@v/v1.0.0.zip":
+			atomic.AddInt32(&zips, 1)
+			_, _ = w.Write(buf.Bytes())
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer proxy.Close()
+
+	ctx := context.Background()
+	if _, err := New(Options{}).ImportRemote(ctx, "example.com/greet@v1.0.0"); !errors.Is(err, ErrRemoteDisabled) {
+		t.Fatalf("got %v, want ErrRemoteDisabled", err)
+	}
+
+	f := &Fetcher{Proxy: proxy.URL, CacheDir: t.TempDir()}
+	i := New(Options{Fetcher: f})
+	name, err := i.ImportRemote(ctx, "example.com/greet/sub@v1.0.0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if name != "sub" {
+		t.Fatalf("got package %s, want sub", name)
+	}
+	if _, err := i.Eval(`import "example.com/greet"`); err != nil {
+		t.Fatal(err)
+	}
+	res, err := i.Eval(`greet.Hello()`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s := res.String(); s != "hello world" {
+		t.Fatalf("got %q, want %q", s, "hello world")
+	}
+	if _, err := i.ImportRemote(ctx, "example.com/greet@v1.1.0"); err == nil || !strings.Contains(err.Error(), "already fetched at version v1.0.0") {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	// Another interpreter uses the cached module, verified by its hash.
+	dir, err := (module{"example.com/greet", "v1.0.0"}).dir(f.CacheDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sum, err := hashDir(dir, "example.com/greet@v1.0.0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	f.Sums = map[string]string{"example.com/greet@v1.0.0": sum}
+	if _, err := New(Options{Fetcher: f}).ImportRemote(ctx, "example.com/greet"); err != nil {
+		t.Fatal(err)
+	}
+	if n := atomic.LoadInt32(&zips); n != 1 {
+		t.Fatalf("got %d downloads, want 1", n)
+	}
+
+	f2 := &Fetcher{Proxy: proxy.URL, CacheDir: t.TempDir(), Sums: map[string]string{"example.com/greet@v1.0.0": "h1:bad"}}
+	if _, err := New(Options{Fetcher: f2}).ImportRemote(ctx, "example.com/greet@v1.0.0"); err == nil || !strings.Contains(err.Error(), "checksum mismatch") {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if _, err := New(Options{Fetcher: f2}).ImportRemote(ctx, "example.com/missing@v1.0.0"); err == nil || !strings.Contains(err.Error(), "no module found") {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	// The dependencies of a module missing from the module cache are fetched.
+	tmp := t.TempDir()
+	defer os.Setenv("GOMODCACHE", os.Getenv("GOMODCACHE"))
+	if err := os.Setenv("GOMODCACHE", filepath.Join(tmp, "cache")); err != nil {
+		t.Fatal(err)
+	}
+	app := filepath.Join(tmp, "app")
+	if err := os.MkdirAll(app, 0700); err != nil {
+		t.Fatal(err)
+	}
+	for name, src := range map[string]string{
+		"go.mod": "module example.com/app\n\nrequire example.com/greet v1.0.0\n",
+		"go.sum": "example.com/greet v1.0.0 " + sum + "\n",
+		"app.go": "package app\n\nimport \"example.com/greet\"\n\nvar Msg = greet.Hello()\n",
+	} {
+		if err := ioutil.WriteFile(filepath.Join(app, name), []byte(src), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	f3 := &Fetcher{Proxy: proxy.URL, CacheDir: t.TempDir()}
+	ws, err := f3.ReadModule(ctx, filepath.Join(app, "go.mod"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ws["example.com/app"] != app || !strings.HasPrefix(ws["example.com/greet"], f3.CacheDir) {
+		t.Fatalf("unexpected workspace %v", ws)
+	}
+	i = New(Options{Workspace: ws})
+	if _, err := i.Eval(`import "example.com/app"`); err != nil {
+		t.Fatal(err)
+	}
+	if res, err = i.Eval(`app.Msg`); err != nil || res.String() != "hello world" {
+		t.Fatalf("got %v, %v, want %q", res, err, "hello world")
+	}
+	if n := atomic.LoadInt32(&zips); n != 3 {
+		t.Fatalf("got %d downloads, want 3", n)
+	}
+
+	if err := ioutil.WriteFile(filepath.Join(app, "go.sum"), []byte("example.com/greet v1.0.0 h1:bad\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	f3.CacheDir = t.TempDir()
+	if _, err := f3.ReadModule(ctx, filepath.Join(app, "go.mod")); err == nil || !strings.Contains(err.Error(), "checksum mismatch") {
+		t.Fatalf("unexpected error %v", err)
+	}
+}
@@ -0,0 +1,180 @@
+package interp
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"os"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// FilesPath is the import path of the package giving interpreted code access
+// to the virtual files set in Options.
+const FilesPath = "yaegi/files"
+
+// ErrFilesFull is returned when writing a file would exceed Files.MaxSize.
+var ErrFilesFull = errors.New("virtual files size limit exceeded")
+
+// Files is a set of virtual files held in memory, which interpreted code
+// writes, for example to generate reports or configurations, without access
+// to the file system. The host reads them back after execution, directly or
+// as an fs.FS returned by FS. The zero value is ready to use.
+//
+// File names are slash separated paths as in io/fs, such as "out/report.txt".
+// Directories are implied by the names of the files they contain.
+//
+// From interpreted code, it is used as follows:
+//
+//	import "yaegi/files"
+//
+//	err := files.WriteFile("out/config.json", data)
+//	w, err := files.Create("out/report.txt") // io.WriteCloser, written on Close
+//	data, err := files.ReadFile("out/config.json")
+//	names := files.Names()
+//	err = files.Remove("out/config.json")
+type Files struct {
+	// MaxSize, if positive, is the maximum total size in bytes of the files.
+	MaxSize int64
+
+	mu    sync.Mutex
+	files map[string]memFile
+	size  int64
+}
+
+type memFile struct {
+	data    []byte
+	modTime time.Time
+}
+
+// filesExports returns the symbols of the files package bound to f.
+func filesExports(f *Files) Exports {
+	return Exports{
+		FilesPath: {
+			"Create":       reflect.ValueOf(f.Create),
+			"Names":        reflect.ValueOf(f.Names),
+			"ReadFile":     reflect.ValueOf(f.ReadFile),
+			"Remove":       reflect.ValueOf(f.Remove),
+			"WriteFile":    reflect.ValueOf(f.WriteFile),
+			"ErrFilesFull": reflect.ValueOf(ErrFilesFull),
+		},
+	}
+}
+
+// validFileName reports whether name is a valid file name, as fs.ValidPath
+// but excluding the root.
+func validFileName(name string) bool {
+	if name == "" || name == "." {
+		return false
+	}
+	for _, elem := range strings.Split(name, "/") {
+		if elem == "" || elem == "." || elem == ".." {
+			return false
+		}
+	}
+	return true
+}
+
+// WriteFile creates or replaces the file name with data.
+func (f *Files) WriteFile(name string, data []byte) error {
+	if !validFileName(name) {
+		return &os.PathError{Op: "write", Path: name, Err: os.ErrInvalid}
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	// A name can not be both a file and a directory.
+	for n := range f.files {
+		if strings.HasPrefix(name, n+"/") || strings.HasPrefix(n, name+"/") {
+			return &os.PathError{Op: "write", Path: name, Err: os.ErrExist}
+		}
+	}
+	size := f.size - int64(len(f.files[name].data)) + int64(len(data))
+	if f.MaxSize > 0 && size > f.MaxSize {
+		return &os.PathError{Op: "write", Path: name, Err: ErrFilesFull}
+	}
+	if f.files == nil {
+		f.files = map[string]memFile{}
+	}
+	f.files[name] = memFile{data: append([]byte(nil), data...), modTime: time.Now()}
+	f.size = size
+	return nil
+}
+
+// ReadFile returns the content of the file name.
+func (f *Files) ReadFile(name string) ([]byte, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	file, ok := f.files[name]
+	if !ok {
+		return nil, &os.PathError{Op: "open", Path: name, Err: os.ErrNotExist}
+	}
+	return append([]byte(nil), file.data...), nil
+}
+
+// Remove removes the file name.
+func (f *Files) Remove(name string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	file, ok := f.files[name]
+	if !ok {
+		return &os.PathError{Op: "remove", Path: name, Err: os.ErrNotExist}
+	}
+	delete(f.files, name)
+	f.size -= int64(len(file.data))
+	return nil
+}
+
+// Names returns the sorted names of the files.
+func (f *Files) Names() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	names := make([]string, 0, len(f.files))
+	for n := range f.files {
+		names = append(names, n)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Create returns a writer to the file name, which is created or replaced
+// with the written data when the writer is closed.
+func (f *Files) Create(name string) (io.WriteCloser, error) {
+	if !validFileName(name) {
+		return nil, &os.PathError{Op: "create", Path: name, Err: os.ErrInvalid}
+	}
+	return &fileWriter{files: f, name: name}, nil
+}
+
+// fileWriter buffers the content of a file until closed.
+type fileWriter struct {
+	files  *Files
+	name   string
+	buf    bytes.Buffer
+	closed bool
+}
+
+func (w *fileWriter) Write(p []byte) (int, error) {
+	if w.closed {
+		return 0, &os.PathError{Op: "write", Path: w.name, Err: os.ErrClosed}
+	}
+	if max := w.files.MaxSize; max > 0 && int64(w.buf.Len()+len(p)) > max {
+		return 0, &os.PathError{Op: "write", Path: w.name, Err: ErrFilesFull}
+	}
+	return w.buf.Write(p)
+}
+
+func (w *fileWriter) Close() error {
+	if w.closed {
+		return &os.PathError{Op: "close", Path: w.name, Err: os.ErrClosed}
+	}
+	w.closed = true
+	return w.files.WriteFile(w.name, w.buf.Bytes())
+}
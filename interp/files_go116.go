@@ -0,0 +1,126 @@
+// +build go1.16
+
+package interp
+
+import (
+	"bytes"
+	"io"
+	"io/fs"
+	"path"
+	"sort"
+	"time"
+)
+
+// FS returns a read-only snapshot of the files, which is not affected by
+// later writes.
+func (f *Files) FS() fs.FS {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	fsys := filesFS{files: make(map[string]memFile, len(f.files)), dirs: map[string][]fs.DirEntry{".": nil}}
+	for name, file := range f.files {
+		fsys.files[name] = file
+		var entry fs.DirEntry = fileInfo{name: path.Base(name), size: int64(len(file.data)), modTime: file.modTime}
+		for dir := path.Dir(name); ; dir = path.Dir(dir) {
+			_, seen := fsys.dirs[dir]
+			fsys.dirs[dir] = append(fsys.dirs[dir], entry)
+			if seen || dir == "." {
+				break
+			}
+			entry = fileInfo{name: path.Base(dir), dir: true}
+		}
+	}
+	for _, entries := range fsys.dirs {
+		sort.Slice(entries, func(i, j int) bool { return entries[i].Name() < entries[j].Name() })
+	}
+	return fsys
+}
+
+// filesFS is a snapshot of Files.
+type filesFS struct {
+	files map[string]memFile
+	dirs  map[string][]fs.DirEntry // sorted entries of directories
+}
+
+func (fsys filesFS) Open(name string) (fs.File, error) {
+	if !fs.ValidPath(name) {
+		return nil, &fs.PathError{Op: "open", Path: name, Err: fs.ErrInvalid}
+	}
+	if file, ok := fsys.files[name]; ok {
+		info := fileInfo{name: path.Base(name), size: int64(len(file.data)), modTime: file.modTime}
+		return &openFile{info: info, Reader: bytes.NewReader(file.data)}, nil
+	}
+	if entries, ok := fsys.dirs[name]; ok {
+		return &openDir{info: fileInfo{name: path.Base(name), dir: true}, entries: entries}, nil
+	}
+	return nil, &fs.PathError{Op: "open", Path: name, Err: fs.ErrNotExist}
+}
+
+func (fsys filesFS) ReadFile(name string) ([]byte, error) {
+	if !fs.ValidPath(name) {
+		return nil, &fs.PathError{Op: "read", Path: name, Err: fs.ErrInvalid}
+	}
+	if file, ok := fsys.files[name]; ok {
+		return append([]byte(nil), file.data...), nil
+	}
+	if _, ok := fsys.dirs[name]; ok {
+		return nil, &fs.PathError{Op: "read", Path: name, Err: fs.ErrInvalid}
+	}
+	return nil, &fs.PathError{Op: "read", Path: name, Err: fs.ErrNotExist}
+}
+
+// fileInfo implements both fs.FileInfo and fs.DirEntry.
+type fileInfo struct {
+	name    string
+	size    int64
+	modTime time.Time
+	dir     bool
+}
+
+func (fi fileInfo) Name() string               { return fi.name }
+func (fi fileInfo) Size() int64                { return fi.size }
+func (fi fileInfo) ModTime() time.Time         { return fi.modTime }
+func (fi fileInfo) IsDir() bool                { return fi.dir }
+func (fi fileInfo) Sys() interface{}           { return nil }
+func (fi fileInfo) Type() fs.FileMode          { return fi.Mode().Type() }
+func (fi fileInfo) Info() (fs.FileInfo, error) { return fi, nil }
+
+func (fi fileInfo) Mode() fs.FileMode {
+	if fi.dir {
+		return fs.ModeDir | 0555
+	}
+	return 0444
+}
+
+type openFile struct {
+	info fileInfo
+	*bytes.Reader
+}
+
+func (f *openFile) Stat() (fs.FileInfo, error) { return f.info, nil }
+func (f *openFile) Close() error               { return nil }
+
+type openDir struct {
+	info    fileInfo
+	entries []fs.DirEntry
+	offset  int
+}
+
+func (d *openDir) Stat() (fs.FileInfo, error) { return d.info, nil }
+func (d *openDir) Close() error               { return nil }
+
+func (d *openDir) Read([]byte) (int, error) {
+	return 0, &fs.PathError{Op: "read", Path: d.info.name, Err: fs.ErrInvalid}
+}
+
+func (d *openDir) ReadDir(n int) ([]fs.DirEntry, error) {
+	entries := d.entries[d.offset:]
+	if n > 0 && len(entries) == 0 {
+		return nil, io.EOF
+	}
+	if n > 0 && n < len(entries) {
+		entries = entries[:n]
+	}
+	d.offset += len(entries)
+	return append([]fs.DirEntry(nil), entries...), nil
+}
@@ -0,0 +1,98 @@
+// +build go1.16
+
+package interp_test
+
+import (
+	"errors"
+	"io/fs"
+	"testing"
+	"testing/fstest"
+
+	"github.com/traefik/yaegi/interp"
+)
+
+func TestFiles(t *testing.T) {
+	files := &interp.Files{}
+	i := interp.New(interp.Options{Files: files})
+
+	if _, err := i.Eval(`import "yaegi/files"`); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := i.Eval(`
+func generate() error {
+	if err := files.WriteFile("out/config.txt", []byte("debug=true")); err != nil {
+		return err
+	}
+	w, err := files.Create("out/reports/daily.txt")
+	if err != nil {
+		return err
+	}
+	w.Write([]byte("ok\n"))
+	if err := w.Close(); err != nil {
+		return err
+	}
+	return files.WriteFile("tmp.txt", nil)
+}`); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := i.Eval(`generate()`); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := i.Eval(`files.Remove("tmp.txt")`); err != nil {
+		t.Fatal(err)
+	}
+
+	fsys := files.FS()
+	if err := fstest.TestFS(fsys, "out/config.txt", "out/reports/daily.txt"); err != nil {
+		t.Fatal(err)
+	}
+	if b, err := fs.ReadFile(fsys, "out/reports/daily.txt"); err != nil || string(b) != "ok\n" {
+		t.Fatalf("got %q %v, want %q", b, err, "ok\n")
+	}
+	if _, err := fs.Stat(fsys, "tmp.txt"); !errors.Is(err, fs.ErrNotExist) {
+		t.Fatalf("got %v, want %v", err, fs.ErrNotExist)
+	}
+
+	// The snapshot is not affected by later writes.
+	if err := files.WriteFile("late.txt", []byte("x")); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := fs.Stat(fsys, "late.txt"); !errors.Is(err, fs.ErrNotExist) {
+		t.Fatalf("got %v, want %v", err, fs.ErrNotExist)
+	}
+
+	// The files package is not available if no files are set.
+	if _, err := interp.New(interp.Options{}).Eval(`import "yaegi/files"`); err == nil {
+		t.Fatal("expected import error")
+	}
+}
+
+func TestFilesErrors(t *testing.T) {
+	files := &interp.Files{MaxSize: 8}
+
+	for _, name := range []string{"", ".", "/abs", "a/../b", "a//b"} {
+		if err := files.WriteFile(name, nil); !errors.Is(err, fs.ErrInvalid) {
+			t.Errorf("%q: got %v, want %v", name, err, fs.ErrInvalid)
+		}
+	}
+
+	if err := files.WriteFile("a", []byte("12345")); err != nil {
+		t.Fatal(err)
+	}
+	if err := files.WriteFile("a/b", nil); !errors.Is(err, fs.ErrExist) {
+		t.Errorf("got %v, want %v", err, fs.ErrExist)
+	}
+	if err := files.WriteFile("b", []byte("12345")); !errors.Is(err, interp.ErrFilesFull) {
+		t.Errorf("got %v, want %v", err, interp.ErrFilesFull)
+	}
+	// Replacing a file only counts the difference of size.
+	if err := files.WriteFile("a", []byte("12345678")); err != nil {
+		t.Fatal(err)
+	}
+	if err := files.Remove("a"); err != nil {
+		t.Fatal(err)
+	}
+	if err := files.Remove("a"); !errors.Is(err, fs.ErrNotExist) {
+		t.Errorf("got %v, want %v", err, fs.ErrNotExist)
+	}
+}
@@ -0,0 +1,107 @@
+package interp_test
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/traefik/yaegi/interp"
+	"github.com/traefik/yaegi/stdlib"
+)
+
+// floatCompatTests checks that floating point values computed by the
+// interpreter are formatted exactly as their compiled counterparts. Each src
+// is a list of statements followed by a string expression, and want is the
+// result of the same code compiled with gc.
+var floatCompatTests = []struct{ src, want string }{
+	{`x := 0.0; fmt.Sprint(-x)`, func() string { x := 0.0; return fmt.Sprint(-x) }()},
+	{`var x float64; y := -x; fmt.Sprint(y, 1/y)`, func() string { var x float64; y := -x; return fmt.Sprint(y, 1/y) }()},
+	{`var x float32; y := -x; fmt.Sprint(y, 1/y)`, func() string { var x float32; y := -x; return fmt.Sprint(y, 1/y) }()},
+	{`x := 0.0; x = -x; fmt.Sprint(math.Signbit(x))`, func() string { x := 0.0; x = -x; return fmt.Sprint(math.Signbit(x)) }()},
+	{`x := 0.0; y := x * -1; fmt.Sprint(math.Signbit(y))`, func() string { x := 0.0; y := x * -1; return fmt.Sprint(math.Signbit(y)) }()},
+	{`x := -0.0; fmt.Sprint(math.Signbit(x))`, func() string { x := -0.0; return fmt.Sprint(math.Signbit(x)) }()},
+	{`x := math.Copysign(0, -1); fmt.Sprint(x, x*1, x+0, 0-x, math.Signbit(x*-1))`, func() string {
+		x := math.Copysign(0, -1)
+		return fmt.Sprint(x, x*1, x+0, 0-x, math.Signbit(x*-1))
+	}()},
+	{`x := math.NaN(); fmt.Sprint(-x, math.Signbit(-x))`, func() string { x := math.NaN(); return fmt.Sprint(-x, math.Signbit(-x)) }()},
+	{`x := math.Inf(1); y := x - x; fmt.Sprint(y, math.Float64bits(y))`, func() string { x := math.Inf(1); y := x - x; return fmt.Sprint(y, math.Float64bits(y)) }()},
+	{`x := 0.0; fmt.Sprint(1/x, -1/x, x/x)`, func() string { x := 0.0; return fmt.Sprint(1/x, -1/x, x/x) }()},
+	{`x := 1e308; fmt.Sprint(x*10, -x*10)`, func() string { x := 1e308; return fmt.Sprint(x*10, -x*10) }()},
+	{`x := 0.1; y := 0.2; fmt.Sprint(x + y)`, func() string { x := 0.1; y := 0.2; return fmt.Sprint(x + y) }()},
+	{`a := 0.1; fmt.Sprint(a*0.1, a/0.3)`, func() string { a := 0.1; return fmt.Sprint(a*0.1, a/0.3) }()},
+	{`x := 3.0; y := x / 0.1; fmt.Sprint(y, int(y))`, func() string { x := 3.0; y := x / 0.1; return fmt.Sprint(y, int(y)) }()},
+	{`x := 5.5; fmt.Sprint(math.Mod(x, 2), math.Mod(-x, 2))`, func() string { x := 5.5; return fmt.Sprint(math.Mod(x, 2), math.Mod(-x, 2)) }()},
+	{`x := 1.0 / 3; fmt.Sprint(float32(x), strconv.FormatFloat(x, 'g', -1, 32))`, func() string {
+		x := 1.0 / 3
+		return fmt.Sprint(float32(x), strconv.FormatFloat(x, 'g', -1, 32))
+	}()},
+	{`var a float32 = 1; b := a / 3; fmt.Sprint(b, b*3, float64(b))`, func() string { var a float32 = 1; b := a / 3; return fmt.Sprint(b, b*3, float64(b)) }()},
+	{`var a, b float32 = 1.1, 2.2; fmt.Sprint(a*b, a+b, a-b, a/b)`, func() string { var a, b float32 = 1.1, 2.2; return fmt.Sprint(a*b, a+b, a-b, a/b) }()},
+	{`var a float32 = 0.1; b := a*a*a + a; fmt.Sprint(b)`, func() string { var a float32 = 0.1; b := a*a*a + a; return fmt.Sprint(b) }()},
+	{`var a float32 = 0.1; fmt.Sprint(a*0.1, 0.3-a)`, func() string { var a float32 = 0.1; return fmt.Sprint(a*0.1, 0.3-a) }()},
+	{`var a float32 = 1.1; a *= 2.2; a += 1e-8; a /= 3; fmt.Sprint(a)`, func() string { var a float32 = 1.1; a *= 2.2; a += 1e-8; a /= 3; return fmt.Sprint(a) }()},
+	{`var a float32 = 0.1; var b float32 = 0.2; fmt.Sprint(a+b == 0.3, a+b)`, func() string { var a float32 = 0.1; var b float32 = 0.2; return fmt.Sprint(a+b == 0.3, a+b) }()},
+	{`a := float32(16777216); fmt.Sprint(a+1, a+3)`, func() string { a := float32(16777216); return fmt.Sprint(a+1, a+3) }()},
+	{`var f float32 = 0.1; fmt.Sprint(f, float64(f), float64(f)-0.1)`, func() string { var f float32 = 0.1; return fmt.Sprint(f, float64(f), float64(f)-0.1) }()},
+	{`var f float32 = 1.00000017881393432617187499; fmt.Sprint(f)`, func() string { var f float32 = 1.00000017881393432617187499; return fmt.Sprint(f) }()},
+	{`var f float32 = 3.4e38; fmt.Sprint(f*10)`, func() string { var f float32 = 3.4e38; return fmt.Sprint(f * 10) }()},
+	{`var f float32 = math.SmallestNonzeroFloat32; fmt.Sprint(f, f/2)`, func() string { var f float32 = math.SmallestNonzeroFloat32; return fmt.Sprint(f, f/2) }()},
+	{`var f float32 = 0.3; fmt.Sprintf("%v %g %e %.20f %T", f, f, f, f, f*3)`, func() string { var f float32 = 0.3; return fmt.Sprintf("%v %g %e %.20f %T", f, f, f, f, f*3) }()},
+	{`var f float32 = 0.1; var e interface{} = f * 3; fmt.Sprint(e)`, func() string { var f float32 = 0.1; var e interface{} = f * 3; return fmt.Sprint(e) }()},
+	{`a := []float32{0.1, 0.2}; s := float32(0); for _, v := range a { s += v }; fmt.Sprint(s)`, func() string {
+		a := []float32{0.1, 0.2}
+		s := float32(0)
+		for _, v := range a {
+			s += v
+		}
+		return fmt.Sprint(s)
+	}()},
+	{`var i int64 = 1<<53 + 1; fmt.Sprint(float64(i), float32(i))`, func() string { var i int64 = 1<<53 + 1; return fmt.Sprint(float64(i), float32(i)) }()},
+	{`var i uint64 = 1<<64 - 1; fmt.Sprint(float64(i), float32(i))`, func() string { var i uint64 = 1<<64 - 1; return fmt.Sprint(float64(i), float32(i)) }()},
+	{`var f float64 = 16777217; fmt.Sprint(float32(f))`, func() string { var f float64 = 16777217; return fmt.Sprint(float32(f)) }()},
+	{`var c complex64 = complex(float32(1.1), float32(2.2)); d := c * c; fmt.Sprint(d, real(d))`, func() string {
+		var c complex64 = complex(float32(1.1), float32(2.2))
+		d := c * c
+		return fmt.Sprint(d, real(d))
+	}()},
+	{`c := complex(1.1, 2.2); fmt.Sprint(c / complex(0.3, 0.7))`, func() string { c := complex(1.1, 2.2); return fmt.Sprint(c / complex(0.3, 0.7)) }()},
+	{`const c float32 = 0.1; x := c * 3; fmt.Sprint(x, c*c)`, func() string { const c float32 = 0.1; x := c * 3; return fmt.Sprint(x, c*c) }()},
+	{`fmt.Sprint(float32(0.1)+float32(0.2), float32(1)/3)`, fmt.Sprint(float32(0.1)+float32(0.2), float32(1)/3)},
+	{`const c = 1 << 62; fmt.Sprint(float32(c), float64(c)/3)`, func() string { const c = 1 << 62; return fmt.Sprint(float32(c), float64(c)/3) }()},
+	{`x := 2.0; fmt.Sprint(math.Sqrt(x), math.Pow(x, 0.5), math.Floor(-0.5))`, func() string { x := 2.0; return fmt.Sprint(math.Sqrt(x), math.Pow(x, 0.5), math.Floor(-0.5)) }()},
+	{`var f float32 = 0.1; fmt.Sprint(strconv.FormatFloat(float64(f), 'g', -1, 32), strconv.FormatFloat(float64(f), 'g', -1, 64))`, func() string {
+		var f float32 = 0.1
+		return fmt.Sprint(strconv.FormatFloat(float64(f), 'g', -1, 32), strconv.FormatFloat(float64(f), 'g', -1, 64))
+	}()},
+	{`fmt.Sprint(math.Pi, math.MaxFloat32, math.SmallestNonzeroFloat64)`, fmt.Sprint(math.Pi, math.MaxFloat32, math.SmallestNonzeroFloat64)},
+	{`x := math.Pi; fmt.Sprint(x, float32(math.Pi), math.E*2)`, func() string { x := math.Pi; return fmt.Sprint(x, float32(math.Pi), math.E*2) }()},
+}
+
+func TestFloatCompat(t *testing.T) {
+	i := interp.New(interp.Options{})
+	i.Use(stdlib.Symbols)
+	eval(t, i, `import ("fmt"; "math"; "strconv")`)
+
+	for k, test := range floatCompatTests {
+		body, expr := "", test.src
+		if j := strings.LastIndex(test.src, "; "); j >= 0 {
+			body, expr = test.src[:j+2], test.src[j+2:]
+		}
+		name := "compat" + strconv.Itoa(k)
+		if _, err := i.Eval("func " + name + "() string { " + body + "return " + expr + " }"); err != nil {
+			t.Errorf("%s: %v", test.src, err)
+			continue
+		}
+		res, err := i.Eval(name + "()")
+		if err != nil {
+			t.Errorf("%s: %v", test.src, err)
+			continue
+		}
+		if got := res.String(); got != test.want {
+			t.Errorf("%s: got %q, want %q", test.src, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,99 @@
+package interp
+
+import (
+	"fmt"
+	"go/token"
+	"reflect"
+)
+
+// A FrameError is an inconsistency between the compiled code and the frame
+// of values it accesses at run time, which denotes a bug of the interpreter.
+// Frame accesses are checked in the debug mode enabled by the yaegidebug
+// build tag, as in:
+//
+//	go test -tags yaegidebug ./...
+//
+// where a FrameError is panicked at the first invalid access, instead of a
+// later index out of range or reflect panic far from its cause.
+type FrameError struct {
+	Pos   token.Position // position of the node accessing the frame
+	Node  string         // kind of the node
+	Name  string         // name of the accessed variable, if any
+	Level int            // number of frame indirections of the access
+	Index int            // index of the accessed value in the frame
+	Msg   string         // description of the inconsistency
+}
+
+func (e *FrameError) Error() string {
+	s := fmt.Sprintf("%s: frame error: %s node", e.Pos, e.Node)
+	if e.Name != "" {
+		s += " " + e.Name
+	}
+	return s + fmt.Sprintf(" at level %d, index %d: %s", e.Level, e.Index, e.Msg)
+}
+
+// frameErrorf returns the frame error of the access of node n to the value at
+// index i of its frame.
+func frameErrorf(n *node, i int, format string, a ...interface{}) *FrameError {
+	return &FrameError{
+		Pos:   n.interp.fset.Position(n.pos),
+		Node:  n.kind.String(),
+		Name:  n.ident,
+		Level: n.level,
+		Index: i,
+		Msg:   fmt.Sprintf(format, a...),
+	}
+}
+
+// slotType returns the type of the frame value of node n, or nil if unknown.
+func slotType(n *node) (t reflect.Type) {
+	if n.typ == nil {
+		return nil
+	}
+	defer func() {
+		if recover() != nil {
+			t = nil
+		}
+	}()
+	return n.typ.frameType()
+}
+
+// checkedValue returns the function getting the value at index i of the
+// frame of node n, as valueGenerator, checking that the frame exists and
+// holds a value of the type of n at index i.
+func checkedValue(n *node, i int) func(*frame) reflect.Value {
+	want := slotType(n)
+	return func(f *frame) reflect.Value {
+		for l := n.level; l > 0; l-- {
+			if f.anc == nil {
+				panic(frameErrorf(n, i, "missing frame %d levels up", n.level-l+1))
+			}
+			f = f.anc
+		}
+		if n.level == 0 && i >= len(f.data) {
+			// As valueOf, the frames of level 0 may be shorter than the
+			// indexes of unused values.
+			return reflect.Value{}
+		}
+		return checkSlot(n, f.data, i, want)
+	}
+}
+
+// checkSlot returns the value at index i of frame data accessed by node n,
+// checking that it exists and is of the kind of want, if not nil. The values
+// not set yet, by a cancelled execution, and the interface values, which may
+// be wrapped or not, are not checked.
+func checkSlot(n *node, data []reflect.Value, i int, want reflect.Type) reflect.Value {
+	if i < 0 || i >= len(data) {
+		panic(frameErrorf(n, i, "index out of frame of length %d", len(data)))
+	}
+	v := data[i]
+	if want != nil && v.IsValid() && v.Kind() != want.Kind() && !isInterfaceSlot(want) && !isInterfaceSlot(v.Type()) {
+		panic(frameErrorf(n, i, "value of type %s, want %s", v.Type(), want))
+	}
+	return v
+}
+
+func isInterfaceSlot(t reflect.Type) bool {
+	return t.Kind() == reflect.Interface || t == valueInterfaceType
+}
@@ -0,0 +1,6 @@
+// +build yaegidebug
+
+package interp
+
+// frameChecks enables the checks of frame accesses, see FrameError.
+const frameChecks = true
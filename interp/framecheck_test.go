@@ -0,0 +1,43 @@
+package interp
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestFrameChecks(t *testing.T) {
+	i := New(Options{})
+	n := &node{interp: i, kind: identExpr, ident: "x", level: 1, typ: &itype{cat: intT}}
+	want := reflect.TypeOf(0)
+
+	frameError := func(fn func()) (err *FrameError) {
+		defer func() { err, _ = recover().(*FrameError) }()
+		fn()
+		return nil
+	}
+
+	for _, test := range []struct {
+		desc string
+		fn   func()
+		msg  string
+	}{
+		{"valid", func() { checkSlot(n, []reflect.Value{reflect.ValueOf(1)}, 0, want) }, ""},
+		{"unset", func() { checkSlot(n, []reflect.Value{{}}, 0, want) }, ""},
+		{"interface", func() { checkSlot(n, []reflect.Value{reflect.ValueOf(valueInterface{})}, 0, want) }, ""},
+		{"out of range", func() { checkSlot(n, []reflect.Value{reflect.ValueOf(1)}, 3, want) }, "index out of frame of length 1"},
+		{"kind", func() { checkSlot(n, []reflect.Value{reflect.ValueOf("a")}, 0, want) }, "value of type string, want int"},
+		{"missing frame", func() { checkedValue(n, 0)(&frame{}) }, "missing frame 1 levels up"},
+	} {
+		err := frameError(test.fn)
+		if test.msg == "" {
+			if err != nil {
+				t.Errorf("%s: unexpected error %v", test.desc, err)
+			}
+			continue
+		}
+		if err == nil || !strings.Contains(err.Error(), "frame error: identExpr node x at level 1") || err.Msg != test.msg {
+			t.Errorf("%s: got error %v, want %q", test.desc, err, test.msg)
+		}
+	}
+}
@@ -0,0 +1,136 @@
+package interp
+
+import (
+	"math/bits"
+	"reflect"
+	"sync"
+	"sync/atomic"
+	"unsafe"
+)
+
+// framePoolClasses is the number of size classes of the recycled frames, see
+// Options.PoolFrames: class c holds the frames of up to 1<<c values. Larger
+// frames are not recycled.
+const framePoolClasses = 11
+
+// framePools are the recycled frames, indexed by size class. They are shared
+// by the interpreters, as frames do not refer to their interpreter once
+// released.
+var framePools [framePoolClasses]sync.Pool
+
+// FrameStats reports the memory of the frames holding the values of
+// interpreted code, estimated from the sizes of the values, not including the
+// memory they refer to.
+type FrameStats struct {
+	Frames int64 // frames of the interpreted function calls running
+	Memory int64 // memory in bytes of these frames
+	Peak   int64 // maximum of Memory since the creation of the interpreter
+	Global int64 // memory in bytes of the global frame, holding the package variables
+	Reused int64 // frames reused from the pool, if Options.PoolFrames is set
+}
+
+// frameCounters are the counters of the frames of an interpreter, updated
+// atomically.
+type frameCounters struct {
+	frames int64
+	memory int64
+	peak   int64
+	reused int64
+}
+
+// enter counts the frame f of a starting function call, and returns its
+// size, to be released by leave.
+func (c *frameCounters) enter(f *frame) int64 {
+	size := int64(unsafe.Sizeof(*f)) + int64(cap(f.data))*int64(unsafe.Sizeof(reflect.Value{})) + f.size
+	atomic.AddInt64(&c.frames, 1)
+	m := atomic.AddInt64(&c.memory, size)
+	for p := atomic.LoadInt64(&c.peak); m > p; p = atomic.LoadInt64(&c.peak) {
+		if atomic.CompareAndSwapInt64(&c.peak, p, m) {
+			break
+		}
+	}
+	return size
+}
+
+// leave releases a frame counted by enter.
+func (c *frameCounters) leave(size int64) {
+	atomic.AddInt64(&c.frames, -1)
+	atomic.AddInt64(&c.memory, -size)
+}
+
+// FrameStats returns the statistics of the frames of the interpreter, which
+// can be exposed as metrics by long running hosts. It may be called at any
+// time, including during an evaluation.
+func (interp *Interpreter) FrameStats() FrameStats {
+	c := &interp.frames
+	s := FrameStats{
+		Frames: atomic.LoadInt64(&c.frames),
+		Memory: atomic.LoadInt64(&c.memory),
+		Peak:   atomic.LoadInt64(&c.peak),
+		Reused: atomic.LoadInt64(&c.reused),
+	}
+	interp.mutex.RLock()
+	f := interp.frame
+	interp.mutex.RUnlock()
+	if f != nil {
+		s.Global = frameSize(f)
+	}
+	return s
+}
+
+// frameClass returns the size class of the frames of n values, or -1 if
+// they are too large to be recycled.
+func frameClass(n int) int {
+	c := 0
+	if n > 1 {
+		c = bits.Len(uint(n - 1))
+	}
+	if c >= framePoolClasses {
+		return -1
+	}
+	return c
+}
+
+// allocFrame returns a frame of n values for a function call, as newFrame,
+// recycled if Options.PoolFrames is set. The frame must be released by
+// releaseFrame when the call returns.
+func (interp *Interpreter) allocFrame(anc *frame, n int, id uint64) *frame {
+	c := frameClass(n)
+	if !interp.poolFrames || c < 0 {
+		return newFrame(anc, n, id)
+	}
+	f, _ := framePools[c].Get().(*frame)
+	if f == nil {
+		f = &frame{data: make([]reflect.Value, n, 1<<uint(c)), pooled: true}
+	} else {
+		f.data = f.data[:n]
+		atomic.AddInt64(&interp.frames.reused, 1)
+	}
+	f.anc, f.id = anc, id
+	if anc != nil {
+		f.done = anc.done
+	}
+	return f
+}
+
+// releaseFrame recycles the frame f of a returned function call, obtained
+// from allocFrame, unless it may still be referred to, see escape.
+func (interp *Interpreter) releaseFrame(f *frame) {
+	if !f.pooled || atomic.LoadUint32(&f.escaped) != 0 {
+		return
+	}
+	for i := range f.data {
+		f.data[i] = reflect.Value{}
+	}
+	*f = frame{data: f.data[:0], pooled: true}
+	framePools[frameClass(cap(f.data))].Put(f)
+}
+
+// escape marks the frame f, and its ancestors, as possibly referred to after
+// the return of their call, such as by a closure, a function passed to binary
+// code or a goroutine, so that they are not recycled.
+func (f *frame) escape() {
+	for ; f != nil && atomic.LoadUint32(&f.escaped) == 0; f = f.anc {
+		atomic.StoreUint32(&f.escaped, 1)
+	}
+}
@@ -0,0 +1,63 @@
+package interp
+
+import "testing"
+
+func TestPoolFrames(t *testing.T) {
+	i := New(Options{PoolFrames: true})
+	if _, err := i.Eval(`package main
+
+func fib(n int) int {
+	if n < 2 {
+		return n
+	}
+	return fib(n-1) + fib(n-2)
+}
+
+func counters() (r []func() int) {
+	for k := 0; k < 3; k++ {
+		x := fib(k + 5)
+		r = append(r, func() int { return x })
+	}
+	return r
+}
+
+func spawn() int {
+	c := make(chan int)
+	for k := 0; k < 10; k++ {
+		go func(k int) { c <- fib(k) }(k)
+	}
+	s := 0
+	for k := 0; k < 10; k++ {
+		s += <-c
+	}
+	return s
+}
+`); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, test := range []struct {
+		src string
+		res int64
+	}{
+		{src: `fib(20)`, res: 6765},
+		{src: `c := counters(); fib(10); c[0]() + c[1]() + c[2]()`, res: 26},
+		{src: `spawn()`, res: 88},
+	} {
+		v, err := i.Eval(test.src)
+		if err != nil {
+			t.Fatalf("%s: %v", test.src, err)
+		}
+		if v.Int() != test.res {
+			t.Errorf("%s: got %d, want %d", test.src, v.Int(), test.res)
+		}
+	}
+
+	s := i.FrameStats()
+	if s.Reused == 0 || s.Peak == 0 || s.Global == 0 {
+		t.Errorf("unexpected frame stats %+v", s)
+	}
+	if s := New(Options{}).FrameStats(); s.Reused != 0 || s.Frames != 0 || s.Memory != 0 {
+		t.Errorf("unexpected frame stats %+v", s)
+	}
+}
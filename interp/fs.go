@@ -0,0 +1,22 @@
+package interp
+
+import (
+	"io/ioutil"
+	"os"
+)
+
+// filesystem gives access to the source files of imported packages.
+type filesystem interface {
+	ReadDir(dir string) ([]os.FileInfo, error)
+	ReadFile(name string) ([]byte, error)
+	Stat(name string) (os.FileInfo, error)
+	Lstat(name string) (os.FileInfo, error)
+}
+
+// osFS is the filesystem of the operating system, used by default.
+type osFS struct{}
+
+func (osFS) ReadDir(dir string) ([]os.FileInfo, error) { return ioutil.ReadDir(dir) }
+func (osFS) ReadFile(name string) ([]byte, error)      { return ioutil.ReadFile(name) }
+func (osFS) Stat(name string) (os.FileInfo, error)     { return os.Stat(name) }
+func (osFS) Lstat(name string) (os.FileInfo, error)    { return os.Lstat(name) }
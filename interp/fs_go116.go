@@ -0,0 +1,61 @@
+// +build go1.16
+
+package interp
+
+import (
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// UseFilesystem makes the interpreter import source packages from fsys, such
+// as an embed.FS, a zip archive or an in-memory file system, instead of the
+// operating system one. GOPATH, workspace directories and file names are then
+// paths in fsys, where "/" and "." both refer to its root.
+func (interp *Interpreter) UseFilesystem(fsys fs.FS) {
+	interp.srcFS = interp.mountRoots(ioFS{fsys})
+}
+
+// SourceRootFS returns a source root whose packages are read from fsys,
+// mounted at the directory dir, as for an embed.FS of builtin scripts. See
+// Options.SourceRoots.
+func SourceRootFS(dir string, fsys fs.FS) SourceRoot {
+	return SourceRoot{Dir: dir, fsys: ioFS{fsys}}
+}
+
+// ioFS adapts an io/fs file system to the interpreter.
+type ioFS struct{ fsys fs.FS }
+
+func (f ioFS) ReadDir(dir string) ([]os.FileInfo, error) {
+	entries, err := fs.ReadDir(f.fsys, fsPath(dir))
+	if err != nil {
+		return nil, err
+	}
+	infos := make([]os.FileInfo, 0, len(entries))
+	for _, e := range entries {
+		fi, err := e.Info()
+		if err != nil {
+			return nil, err
+		}
+		infos = append(infos, fi)
+	}
+	return infos, nil
+}
+
+func (f ioFS) ReadFile(name string) ([]byte, error) { return fs.ReadFile(f.fsys, fsPath(name)) }
+
+func (f ioFS) Stat(name string) (os.FileInfo, error) { return fs.Stat(f.fsys, fsPath(name)) }
+
+// Lstat is implemented by Stat, as symbolic links are not part of io/fs.
+func (f ioFS) Lstat(name string) (os.FileInfo, error) { return f.Stat(name) }
+
+// fsPath converts a file name to a valid io/fs path, relative to the root.
+func fsPath(name string) string {
+	p := strings.TrimPrefix(path.Clean(filepath.ToSlash(name)), "/")
+	if p == "" {
+		return "."
+	}
+	return p
+}
@@ -0,0 +1,67 @@
+// +build go1.16
+
+package interp_test
+
+import (
+	"testing"
+	"testing/fstest"
+
+	"github.com/traefik/yaegi/interp"
+)
+
+func TestUseFilesystem(t *testing.T) {
+	fsys := fstest.MapFS{
+		"src/foo/foo.go":                   {Data: []byte("package foo\n\nimport \"bar\"\n\nfunc Hello() string { return bar.Hello + \" from foo\" }\n")},
+		"src/foo/vendor/bar/bar.go":        {Data: []byte("package bar\n\nconst Hello = \"vendored hello\"\n")},
+		"src/bar/bar.go":                   {Data: []byte("package bar\n\nconst Hello = \"hello\"\n")},
+		"src/foo/example_test.go":          {Data: []byte("package foo_test\n\nfunc ExampleHello() {}\n")},
+		"src/foo/testdata/ignored/main.go": {Data: []byte("package main\n")},
+	}
+
+	i := interp.New(interp.Options{GoPath: "/"})
+	i.UseFilesystem(fsys)
+	eval(t, i, `import "foo"`)
+	if v := eval(t, i, `foo.Hello()`); v.String() != "vendored hello from foo" {
+		t.Errorf("got %q, want %q", v, "vendored hello from foo")
+	}
+
+	d, err := i.Doc("foo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(d.Funcs) != 1 || len(d.Funcs[0].Examples) != 1 {
+		t.Errorf("unexpected documentation %+v", d.Funcs)
+	}
+
+	if _, err := i.Eval(`import "baz"`); err == nil {
+		t.Error("expected error")
+	}
+}
+
+func TestSourceRoots(t *testing.T) {
+	builtin := fstest.MapFS{
+		"lib/lib.go": {Data: []byte("package lib\n\nconst Name = \"builtin\"\n")},
+	}
+	fsys := fstest.MapFS{
+		"scripts/lib/lib.go": {Data: []byte("package lib\n\nconst Name = \"user\"\n")},
+		"scripts/app/app.go": {Data: []byte("package app\n\nimport (\n\t\"dep\"\n\t\"lib\"\n)\n\nfunc Run() string { return lib.Name + \"+\" + dep.Name }\n")},
+		"src/dep/dep.go":     {Data: []byte("package dep\n\nconst Name = \"dep\"\n")},
+		"src/lib/lib.go":     {Data: []byte("package lib\n\nconst Name = \"gopath\"\n")},
+	}
+
+	root := interp.SourceRootFS("/builtin", builtin)
+	root.ReadOnly = true
+	i := interp.New(interp.WithGoPath("/"), interp.WithSourceRoot(root), interp.WithSourceRoot(interp.SourceRoot{Dir: "/scripts"}))
+	i.UseFilesystem(fsys)
+	eval(t, i, `import "app"`)
+	if v := eval(t, i, `app.Run()`); v.String() != "builtin+dep" {
+		t.Errorf("got %q, want %q", v, "builtin+dep")
+	}
+
+	if err := i.ReloadPackage("lib"); err == nil || err.Error() != "package lib is in a read-only source root" {
+		t.Errorf("got error %v", err)
+	}
+	if err := i.ReloadPackage("app"); err != nil {
+		t.Error(err)
+	}
+}
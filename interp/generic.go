@@ -0,0 +1,49 @@
+package interp
+
+import "reflect"
+
+// Generic is the type of the binary symbols of generic functions, which can
+// not be exported as values before instantiation. A call of such a symbol in
+// interpreted code instantiates it at compilation, with the types of the call
+// arguments, untyped constants taking their default type. The function
+// returns the instantiated function, or an error if the argument types are
+// not valid for it.
+//
+// Only calls are supported: a generic symbol can not be used as a value, nor
+// be instantiated with explicit type arguments.
+type Generic func(args []reflect.Type) (reflect.Value, error)
+
+var genericType = reflect.TypeOf((*Generic)(nil)).Elem()
+
+// isGenericCall returns true if n is the call of a Generic binary symbol.
+func isGenericCall(n *node) bool {
+	c0 := n.child[0]
+	return n.kind == callExpr && c0.typ.cat == valueT && c0.typ.rtype == genericType && c0.rval.IsValid()
+}
+
+// instantiate replaces the Generic binary symbol called by n with its
+// instantiation for the call arguments.
+func instantiate(n *node) error {
+	c0 := n.child[0]
+	args := make([]reflect.Type, len(n.child)-1)
+	for i, c := range n.child[1:] {
+		if c.typ.cat == nilT {
+			return n.cfgErrorf("use of untyped nil in call of generic function %s", c0.name())
+		}
+		args[i] = c.typ.defaultType().TypeOf()
+	}
+	g := c0.rval.Interface().(Generic)
+	if g == nil {
+		return n.cfgErrorf("call of nil generic function %s", c0.name())
+	}
+	f, err := g(args)
+	if err != nil {
+		return n.cfgErrorf("cannot instantiate %s: %v", c0.name(), err)
+	}
+	if !f.IsValid() || f.Kind() != reflect.Func {
+		return n.cfgErrorf("cannot instantiate %s: not a function", c0.name())
+	}
+	c0.rval = f
+	c0.typ = &itype{cat: valueT, rtype: f.Type()}
+	return nil
+}
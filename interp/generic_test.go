@@ -0,0 +1,57 @@
+package interp
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestGenericCall(t *testing.T) {
+	// double instantiates func Double[T int | float64](x T) T.
+	var instances []string
+	double := Generic(func(args []reflect.Type) (reflect.Value, error) {
+		if len(args) != 1 || (args[0].Kind() != reflect.Int && args[0].Kind() != reflect.Float64) {
+			return reflect.Value{}, errors.New("invalid arguments")
+		}
+		t := args[0]
+		instances = append(instances, t.String())
+		fn := reflect.FuncOf([]reflect.Type{t}, []reflect.Type{t}, false)
+		return reflect.MakeFunc(fn, func(in []reflect.Value) []reflect.Value {
+			r := reflect.New(t).Elem()
+			if t.Kind() == reflect.Int {
+				r.SetInt(2 * in[0].Int())
+			} else {
+				r.SetFloat(2 * in[0].Float())
+			}
+			return []reflect.Value{r}
+		}), nil
+	})
+
+	i := New(Options{})
+	i.Use(Exports{"example.com/g/v2": {"Double": reflect.ValueOf(double)}})
+	if _, err := i.Eval(`import "example.com/g/v2"`); err != nil {
+		t.Fatal(err)
+	}
+	// The package is named after the path element before the major version.
+	v, err := i.Eval(`g.Double(2) + int(g.Double(1.5))`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v.Interface() != 7 {
+		t.Errorf("got %v, want 7", v)
+	}
+	if want := []string{"int", "float64"}; !reflect.DeepEqual(instances, want) {
+		t.Errorf("got instances %v, want %v", instances, want)
+	}
+
+	for _, test := range []struct{ src, err string }{
+		{src: `g.Double("a")`, err: "cannot instantiate g.Double: invalid arguments"},
+		{src: `g.Double(nil)`, err: "use of untyped nil"},
+		{src: `f := g.Double`, err: "cannot use generic function g.Double without instantiation"},
+	} {
+		if _, err := i.Eval(test.src); err == nil || !strings.Contains(err.Error(), test.err) {
+			t.Errorf("%s: got error %v, want %q", test.src, err, test.err)
+		}
+	}
+}
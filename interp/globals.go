@@ -0,0 +1,125 @@
+package interp
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"unicode/utf8"
+)
+
+// maxSummary is the maximum length of the value summaries of GlobalChange.
+const maxSummary = 80
+
+// GlobalChange describes a global variable of the main package created or
+// modified by an evaluation, see EvalDiff.
+type GlobalChange struct {
+	Name    string // variable name
+	Type    string // variable type
+	Old     string // summary of the previous value, empty if Created
+	New     string // summary of the new value
+	Created bool   // true if the variable is declared by the evaluation
+}
+
+// global is the state of a global variable before or after an evaluation.
+type global struct {
+	typ     string
+	key     string // formatted value, to detect changes
+	summary string
+}
+
+var funcNodeType = reflect.TypeOf((*node)(nil))
+
+// EvalDiff evaluates src as Eval, and also returns the global variables of
+// the main package which the evaluation created or modified, sorted by name,
+// for example to show users what their script changed in a shared session.
+// The changes are returned even if the evaluation fails, as it may fail after
+// modifying variables.
+//
+// A modification is detected by comparing the formatted values before and
+// after the evaluation, so the changes of values referred to by nested
+// pointers are not reported, nor the changes made by goroutines still running
+// after the evaluation.
+func (interp *Interpreter) EvalDiff(src string) (res reflect.Value, changes []GlobalChange, err error) {
+	interp.evalMutex.Lock()
+	defer interp.evalMutex.Unlock()
+
+	before := interp.globals()
+	res, err = interp.eval(src, "", true, nil)
+	for name, g := range interp.globals() {
+		old, ok := before[name]
+		switch {
+		case !ok:
+			changes = append(changes, GlobalChange{Name: name, Type: g.typ, New: g.summary, Created: true})
+		case old.key != g.key || old.typ != g.typ:
+			changes = append(changes, GlobalChange{Name: name, Type: g.typ, Old: old.summary, New: g.summary})
+		}
+	}
+	sort.Slice(changes, func(i, j int) bool { return changes[i].Name < changes[j].Name })
+	return res, changes, err
+}
+
+// globals returns the state of the global variables of the main package.
+func (interp *Interpreter) globals() map[string]global {
+	res := map[string]global{}
+	interp.mutex.RLock()
+	sc := interp.scopes[mainID]
+	interp.mutex.RUnlock()
+	if sc == nil {
+		return res
+	}
+
+	f := interp.frame
+	f.mutex.RLock()
+	defer f.mutex.RUnlock()
+	for name, s := range sc.sym {
+		if s.kind != varSym || s.index < 0 || s.index >= len(f.data) || name == "_" {
+			continue
+		}
+		key, summary := summarize(f.data[s.index])
+		if r := interp.redaction; r != nil {
+			// The changes of redacted parts are detected, not shown.
+			if str, ok := r.format(f.data[s.index]); ok {
+				summary = shorten(str)
+			}
+		}
+		g := global{key: key, summary: summary}
+		if s.typ != nil {
+			g.typ = s.typ.id()
+		}
+		res[name] = g
+	}
+	return res
+}
+
+// summarize returns the formatted value of v, and its summary, shortened to
+// maxSummary bytes. Interpreted functions are summarized as "func".
+func summarize(v reflect.Value) (key, summary string) {
+	if v.IsValid() && v.Type() == valueInterfaceType {
+		v = v.Interface().(valueInterface).value
+	}
+	switch {
+	case !v.IsValid():
+		return "<nil>", "<nil>"
+	case v.Type() == funcNodeType:
+		if v.IsNil() {
+			return "<nil>", "<nil>"
+		}
+		return fmt.Sprintf("func %p", v.Interface()), "func"
+	case !v.CanInterface():
+		return v.String(), v.String()
+	}
+	key = fmt.Sprintf("%v", v)
+	return key, shorten(key)
+}
+
+// shorten returns s, shortened to maxSummary bytes.
+func shorten(s string) string {
+	if len(s) <= maxSummary {
+		return s
+	}
+	n := maxSummary - 3
+	for n > 0 && !utf8.RuneStart(s[n]) {
+		n--
+	}
+	return s[:n] + "..."
+}
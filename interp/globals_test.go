@@ -0,0 +1,61 @@
+package interp
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestEvalDiff(t *testing.T) {
+	i := New(Options{})
+	if _, err := i.Eval(`
+type T struct{ A int }
+
+var (
+	a = 1
+	s = T{A: 2}
+	m = map[string]int{"x": 1}
+	f = func() int { return 1 }
+	e interface{}
+	u = "unchanged"
+)`); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		src     string
+		err     bool
+		changes []GlobalChange
+	}{
+		{src: `u = "unchanged"; a = 2; a = 1`},
+		{
+			src: `a++; s.A = 5; m["y"] = 2; f = func() int { return 2 }; e = T{3}; b := "hello"`,
+			changes: []GlobalChange{
+				{Name: "a", Type: "int", Old: "1", New: "2"},
+				{Name: "b", Type: "string", New: "hello", Created: true},
+				{Name: "e", Type: "interface{}", Old: "<nil>", New: "{3}"},
+				{Name: "f", Type: "func()(int)", Old: "func", New: "func"},
+				{Name: "m", Type: "map[string]int", Old: "map[x:1]", New: "map[x:1 y:2]"},
+				{Name: "s", Type: "main.T", Old: "{2}", New: "{5}"},
+			},
+		},
+		{
+			src:     `a = 3; panic("failed")`,
+			err:     true,
+			changes: []GlobalChange{{Name: "a", Type: "int", Old: "2", New: "3"}},
+		},
+		{
+			src:     `l := "` + strings.Repeat("é", 50) + `"`,
+			changes: []GlobalChange{{Name: "l", Type: "string", New: strings.Repeat("é", 38) + "...", Created: true}},
+		},
+	}
+	for _, test := range tests {
+		_, changes, err := i.EvalDiff(test.src)
+		if (err != nil) != test.err {
+			t.Errorf("%s: got error %v", test.src, err)
+		}
+		if !reflect.DeepEqual(changes, test.changes) {
+			t.Errorf("%s: got %+v, want %+v", test.src, changes, test.changes)
+		}
+	}
+}
@@ -0,0 +1,75 @@
+package interp
+
+// tracedPanic is a panic propagating through interpreted functions, which
+// records the trace of traversed functions. The original panic value is
+// restored before leaving the interpreter.
+type tracedPanic struct {
+	value interface{}
+	trace []string
+}
+
+// untrace returns the original panic value and the interpreted trace of r.
+func untrace(r interface{}) (interface{}, []string) {
+	if p, ok := r.(*tracedPanic); ok {
+		return p.value, p.trace
+	}
+	return r, nil
+}
+
+// rethrow restores the original value of a panic leaving the interpreter.
+// It must be deferred at each point where interpreted code returns to binary code.
+func rethrow() {
+	if r := recover(); r != nil {
+		v, _ := untrace(r)
+		panic(v)
+	}
+}
+
+// traceString returns the trace entry of the function containing node n,
+// in the form "name file:line:col".
+func (n *node) traceString() string {
+	pos := n.interp.fset.Position(n.pos).String()
+	for a := n; a != nil; a = a.anc {
+		switch a.kind {
+		case funcDecl:
+			return a.child[1].ident + " " + pos
+		case funcLit:
+			return "func literal " + pos
+		}
+	}
+	return pos
+}
+
+// goroutine runs fn in a new goroutine, on behalf of an interpreted go statement.
+// If set, the OnGoroutinePanic option is called on panic, instead of crashing
+// the program.
+func (interp *Interpreter) goroutine(fn func()) {
+	onPanic := interp.opt.onGoroutinePanic
+	if interp.vos != nil {
+		f := fn
+		fn = func() {
+			defer interp.catchExit()
+			f()
+		}
+	}
+	q := interp.quotas
+	if q != nil && !q.startGoroutine(interp) {
+		return
+	}
+	go func() {
+		if q != nil {
+			defer q.endGoroutine()
+		}
+		if onPanic == nil {
+			defer rethrow()
+			fn()
+			return
+		}
+		defer func() {
+			if r := recover(); r != nil {
+				onPanic(interp.newPanic(r))
+			}
+		}()
+		fn()
+	}()
+}
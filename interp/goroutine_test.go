@@ -0,0 +1,80 @@
+package interp_test
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/traefik/yaegi/interp"
+)
+
+func TestGoroutinePanic(t *testing.T) {
+	panics := make(chan interp.Panic, 1)
+	i := interp.New(interp.Options{OnGoroutinePanic: func(p interp.Panic) { panics <- p }})
+
+	eval(t, i, `
+func fail(n int) {
+	if n == 0 {
+		panic("boom")
+	}
+	fail(n - 1)
+}
+
+func worker() { fail(2) }
+`)
+	eval(t, i, `go worker()`)
+
+	select {
+	case p := <-panics:
+		if v, ok := p.Value.(reflect.Value); !ok || v.Interface() != "boom" {
+			t.Errorf("got panic value %v, want boom", p.Value)
+		}
+		if len(p.Trace) != 4 || !strings.HasPrefix(p.Trace[0], "fail ") || !strings.HasPrefix(p.Trace[3], "worker ") {
+			t.Errorf("unexpected trace %q", p.Trace)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("panic not reported")
+	}
+
+	// The interpreter remains usable.
+	assertEval(t, i, `1 + 2`, "", "3")
+
+	// Panics recovered by interpreted code are not reported.
+	eval(t, i, `
+func safe() {
+	defer func() { recover() }()
+	fail(1)
+}
+`)
+	eval(t, i, `go safe()`)
+	select {
+	case p := <-panics:
+		t.Errorf("unexpected panic %v", p)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+func TestPanicTrace(t *testing.T) {
+	i := interp.New(interp.Options{})
+	eval(t, i, `func f() { panic("x") }`)
+	_, err := i.Eval(`f()`)
+	p, ok := err.(interp.Panic)
+	if !ok {
+		t.Fatalf("got %v, want panic", err)
+	}
+	if len(p.Trace) == 0 || !strings.HasPrefix(p.Trace[0], "f ") {
+		t.Errorf("unexpected trace %q", p.Trace)
+	}
+
+	// Binary callers of interpreted functions see the original panic value.
+	v := eval(t, i, `f`)
+	func() {
+		defer func() {
+			if r := recover(); r == nil || r.(reflect.Value).Interface() != "x" {
+				t.Errorf("got %v, want x", r)
+			}
+		}()
+		v.Interface().(func())()
+	}()
+}
@@ -1,8 +1,10 @@
 package interp
 
 import (
+	"go/token"
 	"path/filepath"
 	"reflect"
+	"sort"
 )
 
 // gta performs a global types analysis on the AST, registering types,
@@ -139,6 +141,11 @@ func (interp *Interpreter) gta(root *node, rpath, importPath string) ([]*node, e
 				rcvr := n.child[0].child[0]
 				rtn := rcvr.lastChild()
 				typeName := rtn.ident
+				if interp.bridgeType(importPath, receiverName(rtn)) != nil {
+					// The method is provided by the binary type.
+					interp.skipFunc(n, importPath)
+					return false
+				}
 				if typeName == "" {
 					// The receiver is a pointer, retrieve typeName from indirection
 					typeName = rtn.child[0].ident
@@ -177,6 +184,13 @@ func (interp *Interpreter) gta(root *node, rpath, importPath string) ([]*node, e
 			}
 			return false
 
+		case genericDecl:
+			var declared bool
+			if declared, err = n.val.(*generic).declare(sc, n, rpath, importPath); err == nil && !declared {
+				revisit = append(revisit, n)
+			}
+			return false
+
 		case importSpec:
 			var name, ipath string
 			if len(n.child) == 2 {
@@ -185,9 +199,29 @@ func (interp *Interpreter) gta(root *node, rpath, importPath string) ([]*node, e
 			} else {
 				ipath = constToString(n.child[0].rval)
 			}
-			// Try to import a binary package first, or a source package
+			if err = interp.restrictions.allowImport(ipath); err != nil {
+				err = n.cfgErrorf("%v", err)
+				return false
+			}
+			// Try to import a package of the same archive first, then a binary
+			// package, or a source package
 			var pkgName string
-			if interp.binPkg[ipath] != nil {
+			interp.checkAmbiguity(rpath, ipath)
+			inArchive, adir := interp.archiveDir(importPath, ipath)
+			if inArchive != nil {
+				// The packages of a versioned archive are isolated in its
+				// namespace.
+				ipath = inArchive.pkgPath(adir, ipath)
+			}
+			spec := ipath
+			if inArchive == nil && isPathRelative(ipath) {
+				// A package out of GOPATH, imported by its relative path,
+				// is registered under the anonymous path of its directory,
+				// so that a same relative path from different directories
+				// designates different packages.
+				ipath = interp.localImportPath(importPath, rpath, ipath)
+			}
+			if inArchive == nil && interp.binPkg[ipath] != nil && !interp.importsSource(rpath, ipath) {
 				switch name {
 				case "_": // no import of symbols
 				case ".": // import symbols in current scope
@@ -200,7 +234,7 @@ func (interp *Interpreter) gta(root *node, rpath, importPath string) ([]*node, e
 					}
 				default: // import symbols in package namespace
 					if name == "" {
-						name = identifier.FindString(ipath)
+						name = binPkgName(ipath)
 					}
 					// imports of a same package are all mapped in the same scope, so we cannot just
 					// map them by their names, otherwise we could have collisions from same-name
@@ -216,7 +250,13 @@ func (interp *Interpreter) gta(root *node, rpath, importPath string) ([]*node, e
 					err = n.cfgErrorf("%s redeclared in this block", name)
 					return false
 				}
-			} else if pkgName, err = interp.importSrc(rpath, ipath, NoTest); err == nil {
+			} else if pkgName, err = interp.importSrcOf(importPath, rpath, ipath); err == nil {
+				if interp.eagerCompile && name != "_" {
+					if err = interp.compileImport(ipath); err != nil {
+						err = n.importErrorf(spec, err)
+						return false
+					}
+				}
 				sc.types = interp.universe.types
 				switch name {
 				case "_": // no import of symbols
@@ -241,13 +281,21 @@ func (interp *Interpreter) gta(root *node, rpath, importPath string) ([]*node, e
 					return false
 				}
 			} else {
-				err = n.cfgErrorf("import %q error: %v", ipath, err)
+				err = n.importErrorf(spec, err)
 			}
 
 		case typeSpec:
 			typeName := n.child[0].ident
+			if t := interp.bridgeType(importPath, typeName); t != nil {
+				n.typ = t
+				sc.sym[typeName] = &symbol{kind: typeSym, typ: t}
+				return false
+			}
 			var typ *itype
 			if typ, err = nodeType(interp, sc, n.child[1]); err != nil {
+				if isTypeResolutionError(err) {
+					return false
+				}
 				err = nil
 				revisit = append(revisit, n)
 				return false
@@ -317,11 +365,133 @@ func (interp *Interpreter) gtaRetry(nodes []*node, importPath string) error {
 	}
 
 	if len(revisit) > 0 {
-		return revisit[0].cfgErrorf("constant definition loop")
+		return interp.unresolvedError(revisit, importPath)
 	}
 	return nil
 }
 
+// unresolvedError returns the error of the global declarations nodes of the
+// package importPath which can not be resolved: an *UnresolvedError, wrapped
+// in a compile error, listing the references to identifiers neither defined
+// in the package scope nor declared by nodes, or else a loop error, if they
+// only refer to each other.
+func (interp *Interpreter) unresolvedError(nodes []*node, importPath string) error {
+	sc := interp.initScopePkg(importPath)
+	declared := map[string]bool{}
+	for _, n := range nodes {
+		for _, c := range declNames(n) {
+			declared[c.ident] = true
+		}
+	}
+
+	var refs []UnresolvedRef
+	seen := map[token.Pos]bool{}
+	for _, n := range nodes {
+		baseName := filepath.Base(interp.fset.Position(n.pos).Filename)
+		n.Walk(func(c *node) bool {
+			switch c.kind {
+			case blockStmt, genericDecl:
+				return false // function bodies are not resolved by gta
+			case identExpr:
+				if c.ident == "_" || declared[c.ident] || seen[c.pos] || isDeclName(c) {
+					return false
+				}
+				if sym, _, ok := sc.lookup(c.ident); ok && !(sym.kind == typeSym && sym.typ != nil && sym.typ.incomplete) {
+					// Forward references are defined as incomplete types.
+					return false
+				}
+				if _, ok := sc.sym[filepath.Join(c.ident, baseName)]; ok {
+					return false // imported package
+				}
+				seen[c.pos] = true
+				ref := UnresolvedRef{Pos: interp.fset.Position(c.pos), Name: c.ident}
+				if c.anc.kind == selectorExpr && c == c.anc.child[0] {
+					ref.Import = interp.missingImport(c.ident)
+				}
+				refs = append(refs, ref)
+			}
+			return true
+		}, nil)
+	}
+	if len(refs) == 0 {
+		return nodes[0].cfgErrorf("constant definition loop")
+	}
+
+	sort.SliceStable(refs, func(i, j int) bool {
+		a, b := refs[i].Pos, refs[j].Pos
+		if a.Filename != b.Filename {
+			return a.Filename < b.Filename
+		}
+		return a.Offset < b.Offset
+	})
+	msg := refs[0].String()
+	for _, r := range refs[1:] {
+		msg += "\n\t" + posString(r.Pos) + ": " + r.String()
+	}
+	return &cfgError{nodes[0], &CompileError{Pos: refs[0].Pos, Code: CodeUndefined, Msg: msg, Err: &UnresolvedError{Refs: refs}}}
+}
+
+// declNames returns the identifier nodes of the package symbols declared by
+// the global declaration n.
+func declNames(n *node) []*node {
+	switch n.kind {
+	case defineStmt:
+		return n.child[:n.nleft]
+	case valueSpec:
+		return n.child[:len(n.child)-1]
+	case typeSpec:
+		return n.child[:1]
+	case funcDecl:
+		if len(n.child[0].child) == 0 {
+			return n.child[1:2] // not a method
+		}
+	}
+	return nil
+}
+
+// isDeclName returns true if the identifier node n is a name declared by its
+// parent, such as a variable, a field, a parameter or a method, or the
+// selected name of a selector expression, rather than a reference.
+func isDeclName(n *node) bool {
+	a := n.anc
+	if a == nil {
+		return false
+	}
+	switch a.kind {
+	case selectorExpr, funcDecl:
+		return n == a.child[1]
+	case keyValueExpr, typeSpec:
+		return n == a.child[0]
+	case fieldExpr, valueSpec:
+		return len(a.child) > 1 && n != a.child[len(a.child)-1]
+	case defineStmt:
+		return childPos(n) < a.nleft
+	}
+	return false
+}
+
+// missingImport returns the import path of a binary package of name, likely
+// missing in the source referring to name, or "" if none.
+func (interp *Interpreter) missingImport(name string) string {
+	var paths []string
+	for p := range interp.binPkg {
+		if p != "" && binPkgName(p) == name {
+			paths = append(paths, p)
+		}
+	}
+	if len(paths) == 0 {
+		return ""
+	}
+	// Prefer the shortest path, such as a standard package.
+	sort.Slice(paths, func(i, j int) bool {
+		if len(paths[i]) != len(paths[j]) {
+			return len(paths[i]) < len(paths[j])
+		}
+		return paths[i] < paths[j]
+	})
+	return paths[0]
+}
+
 // equalNodes returns true if two slices of nodes are identical.
 func equalNodes(a, b []*node) bool {
 	if len(a) != len(b) {
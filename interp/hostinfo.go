@@ -0,0 +1,150 @@
+package interp
+
+import (
+	"fmt"
+	"reflect"
+	"runtime/debug"
+)
+
+// HostInfoPath is the import path of the package describing the interpreter
+// and its host to interpreted code, always available, so that plugins can
+// adapt to the host, or refuse to run with a clear message:
+//
+//	import "yaegi/hostinfo"
+//
+//	func init() {
+//		if err := hostinfo.RequireAPI("v1.2"); err != nil {
+//			panic(err)
+//		}
+//		if err := hostinfo.Require("net"); err != nil {
+//			panic(err)
+//		}
+//		if hostinfo.Info().MaxSteps > 0 {
+//			useSmallBatches()
+//		}
+//	}
+//
+// It exports the string values Version, APIVersion, GOOS and GOARCH of
+// HostInfo, the functions Info, returning the HostInfo of the interpreter,
+// Has, Require and RequireAPI, and the HostInfo type.
+const HostInfoPath = "yaegi/hostinfo"
+
+// HostInfo describes the interpreter and its host, see HostInfoPath.
+type HostInfo struct {
+	Version      string   // version of the interpreter module, or "devel"
+	APIVersion   string   // version of the host API, see Options.HostAPIVersion
+	GOOS, GOARCH string   // platform seen by interpreted code
+	Capabilities []string // capabilities of the host, see Options.Capabilities
+	Restricted   bool     // imports restricted by Options.Restrictions
+	VirtualOS    bool     // virtualized process state, see Options.VirtualOS
+
+	// Budgets of the evaluations, zero if not limited. See the options of
+	// the same name, and OutputLimit.MaxBytes for MaxOutput.
+	MaxSteps             int64
+	MaxGoroutines        int
+	MaxSpawnedGoroutines int64
+	MaxFrameMemory       int64
+	MaxOutput            int64
+}
+
+// interpVersion returns the version of the interpreter module in the build
+// information of the host, or "devel" if unknown, such as in its own tests.
+func interpVersion() string {
+	bi, ok := debug.ReadBuildInfo()
+	if !ok {
+		return "devel"
+	}
+	const path = "github.com/traefik/yaegi"
+	if bi.Main.Path != path {
+		for _, m := range bi.Deps {
+			if m.Path == path {
+				if m.Replace != nil && m.Replace.Version != "" {
+					return m.Replace.Version
+				}
+				return m.Version
+			}
+		}
+	}
+	if v := bi.Main.Version; bi.Main.Path == path && v != "" && v != "(devel)" {
+		return v
+	}
+	return "devel"
+}
+
+// newHostInfo returns the description of the interpreter built with options.
+func (interp *Interpreter) newHostInfo(options Options) HostInfo {
+	info := HostInfo{
+		Version:              interpVersion(),
+		APIVersion:           options.HostAPIVersion,
+		GOOS:                 interp.context.GOOS,
+		GOARCH:               interp.context.GOARCH,
+		Capabilities:         append([]string(nil), options.Capabilities...),
+		Restricted:           options.Restrictions != nil,
+		VirtualOS:            options.VirtualOS,
+		MaxSteps:             options.MaxSteps,
+		MaxGoroutines:        options.MaxGoroutines,
+		MaxSpawnedGoroutines: options.MaxSpawnedGoroutines,
+		MaxFrameMemory:       options.MaxFrameMemory,
+	}
+	if options.OutputLimit != nil {
+		info.MaxOutput = options.OutputLimit.MaxBytes
+	}
+	return info
+}
+
+// HostInfo returns the description of the interpreter and its host given to
+// interpreted code by the "yaegi/hostinfo" package.
+func (interp *Interpreter) HostInfo() HostInfo {
+	info := interp.hostInfo
+	info.Capabilities = append([]string(nil), info.Capabilities...)
+	return info
+}
+
+// hostInfoExports returns the symbols of the hostinfo package.
+func (interp *Interpreter) hostInfoExports() Exports {
+	info := interp.hostInfo
+	return Exports{HostInfoPath: {
+		"APIVersion": reflect.ValueOf(info.APIVersion),
+		"GOARCH":     reflect.ValueOf(info.GOARCH),
+		"GOOS":       reflect.ValueOf(info.GOOS),
+		"Has":        reflect.ValueOf(interp.hasCapability),
+		"Info":       reflect.ValueOf(interp.HostInfo),
+		"Require":    reflect.ValueOf(interp.requireCapabilities),
+		"RequireAPI": reflect.ValueOf(interp.requireAPI),
+		"Version":    reflect.ValueOf(info.Version),
+
+		"HostInfo": reflect.ValueOf((*HostInfo)(nil)),
+	}}
+}
+
+func (interp *Interpreter) hasCapability(c string) bool {
+	for _, s := range interp.hostInfo.Capabilities {
+		if s == c {
+			return true
+		}
+	}
+	return false
+}
+
+// requireCapabilities returns an error naming the first of the capabilities
+// not provided by the host, if any.
+func (interp *Interpreter) requireCapabilities(capabilities ...string) error {
+	for _, c := range capabilities {
+		if !interp.hasCapability(c) {
+			return fmt.Errorf("hostinfo: capability %s required, not provided by the host", c)
+		}
+	}
+	return nil
+}
+
+// requireAPI returns an error if the version of the host API is lower than
+// min, or unknown.
+func (interp *Interpreter) requireAPI(min string) error {
+	if !validVersion(min) {
+		return fmt.Errorf("hostinfo: invalid api version %q", min)
+	}
+	if v := interp.hostInfo.APIVersion; v == "" || compareVersions(v, min) < 0 {
+		return fmt.Errorf("hostinfo: host API %s required, have %q", min, v)
+	}
+	return nil
+}
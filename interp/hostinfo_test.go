@@ -0,0 +1,59 @@
+package interp
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestHostInfo(t *testing.T) {
+	i := New(Options{
+		HostAPIVersion: "v1.4",
+		Capabilities:   []string{"net", "kv"},
+		MaxSteps:       1000000,
+		GOOS:           "plan9",
+		GOARCH:         "arm",
+	})
+	if _, err := i.Eval(`import "yaegi/hostinfo"`); err != nil {
+		t.Fatal(err)
+	}
+
+	for src, want := range map[string]interface{}{
+		`hostinfo.GOOS + "/" + hostinfo.GOARCH`: "plan9/arm",
+		`hostinfo.APIVersion`:                   "v1.4",
+		`hostinfo.Version`:                      "devel",
+		`hostinfo.Info().MaxSteps`:              int64(1000000),
+		`hostinfo.Info().MaxGoroutines`:         0,
+		`hostinfo.Has("net")`:                   true,
+		`hostinfo.Has("exec")`:                  false,
+		`hostinfo.Require("kv", "net") == nil`:  true,
+		`hostinfo.RequireAPI("v1.2") == nil`:    true,
+	} {
+		v, err := i.Eval(src)
+		if err != nil {
+			t.Errorf("%s: %v", src, err)
+			continue
+		}
+		if got := v.Interface(); got != want {
+			t.Errorf("%s: got %v, want %v", src, got, want)
+		}
+	}
+
+	for src, want := range map[string]string{
+		`hostinfo.Require("net", "exec")`: "capability exec required",
+		`hostinfo.RequireAPI("v1.10")`:    `host API v1.10 required, have "v1.4"`,
+		`hostinfo.RequireAPI("1.2")`:      "invalid api version",
+	} {
+		v, err := i.Eval(src)
+		if err != nil {
+			t.Errorf("%s: %v", src, err)
+			continue
+		}
+		if err, _ := v.Interface().(error); err == nil || !strings.Contains(err.Error(), want) {
+			t.Errorf("%s: got %v, want %s", src, v, want)
+		}
+	}
+
+	if info := i.HostInfo(); info.APIVersion != "v1.4" || len(info.Capabilities) != 2 || info.Restricted {
+		t.Errorf("unexpected host info %+v", info)
+	}
+}
@@ -0,0 +1,87 @@
+package interp
+
+import "time"
+
+// ImportEventKind is the kind of an ImportEvent.
+type ImportEventKind int
+
+// Kinds of import events, in the order they occur for a package. The events
+// of the dependencies of a package occur between its ImportStart and
+// ImportCFGDone events, as they are imported while parsing it.
+const (
+	ImportStart     ImportEventKind = iota // the package is about to be read
+	ImportParseFile                        // a source file was parsed
+	ImportCFGDone                          // the package was compiled
+	ImportInitRun                          // package variables and init functions were run
+	ImportDone                             // the import completed, successfully or not
+)
+
+var importEventNames = [...]string{"resolve-start", "parse-file", "cfg-done", "init-run", "done"}
+
+func (k ImportEventKind) String() string {
+	if k < 0 || int(k) >= len(importEventNames) {
+		return "unknown"
+	}
+	return importEventNames[k]
+}
+
+// ImportEvent reports the progress of the import of a source package, see
+// Options.ImportHook. Durations exclude the import of dependencies, as in
+// PackageStats.
+type ImportEvent struct {
+	Kind ImportEventKind
+	Path string // import path of the package
+	File string // path of the parsed file, for ImportParseFile
+
+	// Duration is the duration of parsing the file for ImportParseFile, of
+	// the type analysis and compilation for ImportCFGDone, of the
+	// initialization for ImportInitRun, and of the whole import for
+	// ImportDone.
+	Duration time.Duration
+
+	Err error // error of the import, for ImportDone
+
+	// NumFiles is the number of source files of the package, known from its
+	// first ImportParseFile event, to report the progress of its parsing.
+	NumFiles int
+
+	Progress ImportProgress
+}
+
+// ImportProgress is the progress of an import, dependencies included, such
+// as an import declaration or an ImportArchive, at the time of an ImportEvent.
+// A frontend can report it, for example as a progress bar, as imports of big
+// packages may take several seconds.
+type ImportProgress struct {
+	Depth    int // depth of the package in the import, 0 for the imported package, 1 for its dependencies, ...
+	Packages int // number of packages whose import started
+	Done     int // number of packages whose import completed
+	Files    int // number of source files parsed
+}
+
+// event reports an event of the import to the import hook, if any.
+func (t *importTimer) event(kind ImportEventKind, file string, d time.Duration, err error) {
+	p := &t.interp.importProgress
+	switch kind {
+	case ImportParseFile:
+		p.Files++
+	case ImportDone:
+		p.Done++
+	}
+	if hook := t.interp.importHook; hook != nil {
+		progress := *p
+		progress.Depth = t.depth
+		hook(ImportEvent{Kind: kind, Path: t.stats.Path, File: file, Duration: d, Err: err, NumFiles: t.files, Progress: progress})
+	}
+}
+
+// startProgress counts the start of the import of a package, and resets the
+// progress first if it is not a dependency of a package being imported.
+func (t *importTimer) startProgress() {
+	t.depth = len(t.interp.importStack) - 1
+	if t.depth <= 0 {
+		t.depth = 0
+		t.interp.importProgress = ImportProgress{}
+	}
+	t.interp.importProgress.Packages++
+}
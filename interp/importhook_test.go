@@ -0,0 +1,81 @@
+package interp
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestImportHook(t *testing.T) {
+	goPath, err := ioutil.TempDir("", "importhook")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		_ = os.RemoveAll(goPath)
+	}()
+
+	files := map[string]string{
+		"dep/dep.go": "package dep\n\nconst N = 40\n",
+		"app/app.go": "package app\n\nimport \"dep\"\n\nfunc Value() int { return dep.N + two() }\n",
+		"app/two.go": "package app\n\nfunc two() int { return 2 }\n",
+		"bad/bad.go": "package bad\n\nvar X int = \"x\"\n",
+	}
+	for name, src := range files {
+		name = filepath.Join(goPath, "src", name)
+		if err := os.MkdirAll(filepath.Dir(name), 0700); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(name, []byte(src), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var events []string
+	var last ImportEvent
+	i := New(Options{GoPath: goPath, ImportHook: func(e ImportEvent) {
+		s := e.Path + " " + e.Kind.String()
+		if e.File != "" {
+			s += fmt.Sprintf(" %s %d/%d", filepath.Base(e.File), e.Progress.Files, e.NumFiles)
+		}
+		p := e.Progress
+		s += fmt.Sprintf(" [%d %d/%d]", p.Depth, p.Done, p.Packages)
+		events = append(events, s)
+		if e.Duration < 0 {
+			t.Errorf("negative duration in %+v", e)
+		}
+		last = e
+	}})
+	if _, err := i.Eval(`import "app"`); err != nil {
+		t.Fatal(err)
+	}
+	want := []string{
+		"app resolve-start [0 0/1]",
+		"app parse-file app.go 1/2 [0 0/1]",
+		"app parse-file two.go 2/2 [0 0/1]",
+		"dep resolve-start [1 0/2]",
+		"dep parse-file dep.go 3/1 [1 0/2]",
+		"dep cfg-done [1 0/2]",
+		"dep init-run [1 0/2]",
+		"dep done [1 1/2]",
+		"app cfg-done [0 1/2]",
+		"app init-run [0 1/2]",
+		"app done [0 2/2]",
+	}
+	if !reflect.DeepEqual(events, want) {
+		t.Fatalf("got events %q, want %q", events, want)
+	}
+	if last.Err != nil || last.Duration == 0 {
+		t.Errorf("unexpected last event %+v", last)
+	}
+
+	if _, err := i.Eval(`import "bad"`); err == nil {
+		t.Fatal("want import error")
+	}
+	if last.Kind != ImportDone || last.Path != "bad" || last.Err == nil || last.Progress != (ImportProgress{Packages: 1, Done: 1, Files: 1}) {
+		t.Errorf("got last event %+v, want done with error", last)
+	}
+}
@@ -0,0 +1,174 @@
+package interp
+
+import "reflect"
+
+// inlineMaxNodes is the maximum number of nodes of the returned expression of
+// an inlined function.
+const inlineMaxNodes = 8
+
+// inlineCall returns a function computing the result of the call node n from
+// its argument values, receiver first, if the call can be inlined, or nil
+// otherwise. Inlined functions are getters and wrappers whose body is a single
+// return statement of a parameter or a field of one, with no interface values
+// involved.
+func (interp *Interpreter) inlineCall(n *node, values []func(*frame) reflect.Value) func(*frame) reflect.Value {
+	if interp.noInline || n.anc.kind == goStmt || n.anc.kind == deferStmt || variadicPos(n) >= 0 {
+		return nil
+	}
+	c := n.child[0]
+	def, ok := c.val.(*node)
+	if !ok || def.kind != funcDecl || len(def.child) < 4 {
+		return nil
+	}
+	switch {
+	case c.recv != nil:
+		if !isMethod(def) || isRecursiveType(c.recv.node.typ, c.recv.node.typ.rtype) {
+			return nil
+		}
+	case c.kind != identExpr || c.findex >= 0 || isMethod(def):
+		return nil
+	}
+
+	body := def.child[3]
+	if len(def.typ.ret) != 1 || len(body.child) != 1 || body.child[0].kind != returnStmt || len(body.child[0].child) != 1 {
+		return nil
+	}
+	ret := def.typ.ret[0]
+	expr := body.child[0].child[0]
+	if ret.cat == interfaceT || ret.cat == valueT || countNodes(expr) > inlineMaxNodes {
+		return nil
+	}
+
+	e, t := compileInline(expr, inlineParams(def), values)
+	if e == nil || t != ret.TypeOf() {
+		return nil
+	}
+	if c.recv != nil {
+		return e
+	}
+	return func(f *frame) reflect.Value {
+		if memo := def.rval; memo.IsValid() {
+			// The function is memoized, see Memoize.
+			in := make([]reflect.Value, len(values))
+			for i, v := range values {
+				in[i] = v(f)
+			}
+			return memo.Call(in)[0]
+		}
+		return e(f)
+	}
+}
+
+// inlineParam is a parameter of an inlined function.
+type inlineParam struct {
+	index int          // index in call arguments
+	typ   reflect.Type // type in the callee frame
+	recv  bool         // true for a method receiver
+}
+
+// inlineParams returns the parameters of the function declaration def,
+// receiver included, indexed by name.
+func inlineParams(def *node) map[string]inlineParam {
+	params := map[string]inlineParam{}
+	index := 0
+	if isMethod(def) {
+		if field := def.child[0].child[0]; len(field.child) > 1 && field.lastChild().typ != nil {
+			params[field.child[0].ident] = inlineParam{typ: field.lastChild().typ.TypeOf(), recv: true}
+		}
+		index++
+	}
+	arg := 0
+	for _, field := range def.child[2].child[0].child {
+		names := field.child[:len(field.child)-1]
+		if len(names) == 0 {
+			arg++
+			continue
+		}
+		for _, name := range names {
+			if arg < len(def.typ.arg) {
+				params[name.ident] = inlineParam{index: index + arg, typ: def.typ.arg[arg].TypeOf()}
+			}
+			arg++
+		}
+	}
+	return params
+}
+
+// compileInline returns the compiled expression n of an inlined function,
+// computed from the call argument values, and its type, or nil if the
+// expression is not supported.
+func compileInline(n *node, params map[string]inlineParam, values []func(*frame) reflect.Value) (func(*frame) reflect.Value, reflect.Type) {
+	if n.typ == nil || n.typ.cat == interfaceT || n.typ.cat == funcT || n.rval.IsValid() {
+		return nil, nil
+	}
+	switch n.kind {
+	case identExpr:
+		p, ok := params[n.ident]
+		if !ok || p.index >= len(values) || p.typ == nil || p.typ != n.typ.TypeOf() {
+			return nil, nil
+		}
+		value, typ := values[p.index], p.typ
+		if !p.recv {
+			return value, typ
+		}
+		// Accommodate the receiver value to the receiver type, as call does.
+		return func(f *frame) reflect.Value {
+			v := value(f)
+			if v.Kind() != typ.Kind() {
+				if typ.Kind() == reflect.Ptr {
+					return v.Addr()
+				}
+				return v.Elem()
+			}
+			return v
+		}, typ
+
+	case selectorExpr:
+		x, xt := compileInline(n.child[0], params, values)
+		if x == nil {
+			return nil, nil
+		}
+		ptr := xt.Kind() == reflect.Ptr
+		st := xt
+		if ptr {
+			st = xt.Elem()
+		}
+		ti := n.child[0].typ.lookupField(n.child[1].ident)
+		if st.Kind() != reflect.Struct || len(ti) == 0 || isInterfaceSrc(n.child[0].typ) {
+			return nil, nil
+		}
+		typ := st.FieldByIndex(ti).Type
+		if typ != n.typ.TypeOf() {
+			return nil, nil
+		}
+		if ptr {
+			return func(f *frame) reflect.Value { return x(f).Elem().FieldByIndex(ti) }, typ
+		}
+		return func(f *frame) reflect.Value { return x(f).FieldByIndex(ti) }, typ
+	}
+	return nil, nil
+}
+
+// countNodes returns the number of nodes of the tree rooted at n.
+func countNodes(n *node) int {
+	count := 0
+	n.Walk(func(*node) bool { count++; return true }, nil)
+	return count
+}
+
+// callInline returns the execution function of a call of an inlined function,
+// whose result is computed by value without creating a frame.
+func callInline(value func(*frame) reflect.Value, rvalues []func(*frame) reflect.Value, tnext, fnext bltn) bltn {
+	rv := rvalues[0]
+
+	return func(f *frame) bltn {
+		res := value(f)
+		if rv != nil {
+			rv(f).Set(res)
+		}
+		if fnext != nil && !res.Bool() {
+			return fnext
+		}
+		return tnext
+	}
+}
@@ -0,0 +1,75 @@
+package interp_test
+
+import (
+	"testing"
+
+	"github.com/traefik/yaegi/interp"
+)
+
+const inlineSrc = `package main
+
+type Point struct{ X, Y int }
+
+type Named struct {
+	Point
+	name string
+}
+
+func (p Point) GetX() int     { return p.X }
+func (p *Point) GetY() int    { return p.Y }
+func (n *Named) Name() string { return n.name }
+func (n Named) Pos() Point    { return n.Point }
+func (n *Named) Self() *Named { return n }
+
+func id(i int) int       { return i }
+func second(_, b int) int { return b }
+func isSet(b bool) bool  { return b }
+
+func sum(ps []Named) (s int) {
+	for i := range ps {
+		p := &ps[i]
+		s += p.GetX() + p.GetY() + p.Pos().X + id(i) + second(i, 1)
+		if isSet(p.Self().Name() == "a") {
+			s++
+		}
+	}
+	return s
+}
+`
+
+func TestInline(t *testing.T) {
+	for _, noInline := range []bool{false, true} {
+		i := interp.New(interp.Options{NoInline: noInline})
+		eval(t, i, inlineSrc)
+		eval(t, i, `ps := []Named{{Point{1, 2}, "a"}, {Point{3, 4}, "b"}}`)
+		if got := eval(t, i, `sum(ps)`).Int(); got != 18 {
+			t.Errorf("noInline %v: got %d, want 18", noInline, got)
+		}
+	}
+}
+
+func BenchmarkInline(b *testing.B) {
+	for _, bench := range []struct {
+		name     string
+		noInline bool
+	}{
+		{"inline", false},
+		{"noinline", true},
+	} {
+		b.Run(bench.name, func(b *testing.B) {
+			i := interp.New(interp.Options{NoInline: bench.noInline})
+			if _, err := i.Eval(inlineSrc); err != nil {
+				b.Fatal(err)
+			}
+			if _, err := i.Eval(`ps := make([]Named, 1000)`); err != nil {
+				b.Fatal(err)
+			}
+			b.ResetTimer()
+			for n := 0; n < b.N; n++ {
+				if _, err := i.Eval(`sum(ps)`); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
@@ -14,13 +14,13 @@ import (
 	"log"
 	"os"
 	"os/signal"
+	"path/filepath"
 	"reflect"
-	"runtime"
-	"runtime/debug"
 	"strconv"
 	"strings"
 	"sync"
 	"sync/atomic"
+	"time"
 )
 
 // Interpreter node structure for AST and CFG.
@@ -71,7 +71,13 @@ type frame struct {
 	mutex     sync.RWMutex
 	deferred  [][]reflect.Value  // defer stack
 	recovered interface{}        // to handle panic recover
+	deferrer  *frame             // frame deferring the function literal of this closure context, or nil
 	done      reflect.SelectCase // for cancellation of channel operations
+	debug     *frameDebug        // debugging state, if Options.Debugger or Options.CallStack is set
+	trace     *TraceCall         // traced call, if Options.Tracer is set
+	size      int64              // memory of the values allocated by initFrame, see FrameStats
+	escaped   uint32             // set atomically if the frame may outlive its call, see escape
+	pooled    bool               // recycled by releaseFrame, see Options.PoolFrames
 }
 
 func newFrame(anc *frame, len int, id uint64) *frame {
@@ -86,14 +92,59 @@ func newFrame(anc *frame, len int, id uint64) *frame {
 	return f
 }
 
+// initFrame sets the values of frame f to the zero values of the frame types of
+// function definition def. The values are allocated in a single block, to limit
+// the cost of function calls.
+func (interp *Interpreter) initFrame(f *frame, def *node) {
+	if len(def.types) == 0 {
+		return
+	}
+	initFrameType(f, interp.frameType(def), len(def.types))
+}
+
+// frameType returns the struct type holding the frame values of function
+// definition def, or nil if the frame is empty.
+func (interp *Interpreter) frameType(def *node) reflect.Type {
+	if len(def.types) == 0 {
+		return nil
+	}
+	if v, ok := interp.frameTypes.Load(def); ok {
+		return v.(reflect.Type)
+	}
+	fields := make([]reflect.StructField, len(def.types))
+	for i, t := range def.types {
+		fields[i] = reflect.StructField{Name: "F" + strconv.Itoa(i), Type: t}
+	}
+	st := reflect.StructOf(fields)
+	interp.frameTypes.Store(def, st)
+	return st
+}
+
+// initFrameType sets the n first values of frame f to the fields of a new
+// value of struct type st.
+func initFrameType(f *frame, st reflect.Type, n int) {
+	v := reflect.New(st).Elem()
+	f.size = int64(st.Size())
+	for i := 0; i < n; i++ {
+		f.data[i] = v.Field(i)
+	}
+}
+
 func (f *frame) runid() uint64      { return atomic.LoadUint64(&f.id) }
 func (f *frame) setrunid(id uint64) { atomic.StoreUint64(&f.id, id) }
+
+// clone returns a copy of frame f, for the closure context of a function
+// literal. The data slice is copied: the values are shared, but the variables
+// allocated again afterward in f, as in each iteration of a loop, are not.
 func (f *frame) clone() *frame {
+	f.anc.escape()
 	f.mutex.RLock()
 	defer f.mutex.RUnlock()
+	data := make([]reflect.Value, len(f.data))
+	copy(data, f.data)
 	return &frame{
 		anc:       f.anc,
-		data:      f.data,
+		data:      data,
 		deferred:  f.deferred,
 		recovered: f.recovered,
 		id:        f.runid(),
@@ -113,16 +164,77 @@ type opt struct {
 	cfgDot bool // display CFG graph (debug)
 	// dotCmd is the command to process the dot graph produced when astDot and/or
 	// cfgDot is enabled. It defaults to 'dot -Tdot -o <filename>.dot'.
-	dotCmd   string
+	dotCmd string
+	// astDotWriter and cfgDotWriter receive the AST and CFG graphs instead
+	// of dotCmd, if not nil.
+	astDotWriter, cfgDotWriter io.Writer
+
 	noRun    bool          // compile, but do not run
 	fastChan bool          // disable cancellable chan operations
 	context  build.Context // build context: GOPATH, build constraints
 	stdin    io.Reader     // standard input
 	stdout   io.Writer     // standard output
 	stderr   io.Writer     // standard error
+
+	onGoroutinePanic func(Panic)   // called on panic in interpreted goroutines
+	bestEffort       bool          // skip source files failing to parse at import
+	maxErrors        int           // number of errors accumulated by a failing import
+	lazyImportCycles bool          // bind cyclic imports to the partial import of packages
+	maxTypeDepth     int           // maximum nesting of type expressions and declarations
+	timeouts         Timeouts      // limits of blocking stdlib calls
+	restrictions     *Restrictions // packages and symbols denied to interpreted code
+	eagerCompile     bool          // compile all functions of imported packages at import
+	target           *target       // platform seen by interpreted code, if not the host
+	targetErr        error         // invalid target platform, returned by the evaluations
+	replHistory      int           // number of REPL results bound to _1, _2, ...
+	wrapStatements   bool          // allow declarations mixed with statements in sources without package clause
+	operatorMethods  bool          // apply operators to binary types with methods, see Options.OperatorMethods
+	pinnedFuncs      []string      // binary functions run on a locked OS thread, see Options.PinnedFuncs
+	sharedGlobals    bool          // use the default logger and command line flags of the host
+	contractMode     ContractMode  // behavior of failed contracts of the "yaegi/contracts" package
+
+	callbacks CallbackPolicy // restrictions of the functions bound by the host
+
+	dialect     func(string) (string, SourceMap, error) // rewrite of the sources before parsing, see Options.Dialect
+	copiedFuncs map[string]CopyMode                     // binary functions whose values are deep copied, see Options.CopiedFuncs
+	hostInfo    HostInfo                                // description of the interpreter given to interpreted code
+
+	workspace   map[string]string // module directories, indexed by module path
+	sourceRoots []SourceRoot      // directories of package sources searched before GOPATH
+	fetcher     *Fetcher          // downloads the modules of ImportRemote, or nil
+	srcFS       filesystem        // source files of imported packages
+
+	preferSource      bool                  // import source packages also available as binary symbols
+	onAmbiguousImport func(AmbiguousImport) // called on imports resolving to several candidates
+	importHook        func(ImportEvent)     // called on the progress of source imports
+	audit             *auditLog             // journal of evaluations and imports, or nil
+
+	collectProfile    bool      // count executions of call sites, see Profile
+	collectCallStats  bool      // measure calls to binary functions, see CallStats
+	collectProvenance bool      // record origins of source packages, see Provenance
+	callStack         bool      // record the calls of interpreted functions, see Stack
+	coverage          *coverage // statement coverage, see CoverageProfile
+	typingDiagnostics bool      // report untyped patterns, see Diagnostics
+	profile           *Profile  // profile guiding the compilation
+	noInline          bool      // disable inlining of small functions
+	poolFrames        bool      // recycle the frames of function calls
+	debugger          *Debugger // stops execution at breakpoints and steps
+	tracing           *tracing  // calls reported to Options.Tracer
 }
 
 // Interpreter contains global resources and state.
+//
+// An Interpreter is safe for concurrent use. Its evaluations, by the Eval
+// methods, ImportArchive, ReloadPackage and UnloadPackage, are serialized: a
+// call blocks until the running evaluation completes, or is cancelled, so an
+// evaluation must not call another one of the same interpreter, such as from
+// a binary function it calls. Use must not be called during an evaluation.
+// To run interpreted code concurrently, such as in the handlers of a server,
+// declare it in a first evaluation, then call the functions obtained with
+// Eval, Symbols or Program from several goroutines: these calls are not
+// serialized, and share the package variables as in compiled Go code. They
+// must not overlap an evaluation, which may grow the storage of package
+// variables.
 type Interpreter struct {
 	// id is an atomic counter counter used for run cancellation,
 	// only accessed via runid/stop
@@ -135,23 +247,88 @@ type Interpreter struct {
 	// incremented, keep it aligned on 64 bits boundary.
 	nindex int64
 
+	// frames counts the frames of the running calls, see FrameStats. As its
+	// counters are atomically updated, keep it aligned on 64 bits boundary.
+	frames frameCounters
+
 	name string // name of the input source file (or main)
 
-	opt                        // user settable options
-	cancelChan bool            // enables cancellable chan operations
-	fset       *token.FileSet  // fileset to locate node in source code
-	binPkg     Exports         // binary packages used in interpreter, indexed by path
-	rdir       map[string]bool // for src import cycle detection
+	opt                       // user settable options
+	cancelChan bool           // enables cancellable chan operations
+	fset       *token.FileSet // fileset to locate node in source code
+	binPkg     Exports        // binary packages used in interpreter, indexed by path
 
-	mutex    sync.RWMutex
-	frame    *frame            // program data storage during execution
-	universe *scope            // interpreter global level scope
-	scopes   map[string]*scope // package level scopes, indexed by import path
-	srcPkg   imports           // source packages used in interpreter, indexed by path
-	pkgNames map[string]string // package names, indexed by import path
-	done     chan struct{}     // for cancellation of channel operations
+	evalMutex sync.Mutex // serializes evaluations
 
-	hooks *hooks // symbol hooks
+	mutex    sync.RWMutex
+	frame    *frame               // program data storage during execution
+	universe *scope               // interpreter global level scope
+	scopes   map[string]*scope    // package level scopes, indexed by import path
+	srcPkg   imports              // source packages used in interpreter, indexed by path
+	pkgNames map[string]string    // package names, indexed by import path
+	sources  map[string][]srcFile // package source files, indexed by import path
+	done     chan struct{}        // for cancellation of channel operations
+	ctxEvals map[uint64]*ctxEval  // evaluations in EvalWithContext, indexed by goroutine id
+
+	hooks      *hooks       // symbol hooks
+	yield      atomic.Value // func(interface{}) error, set during EvalStream
+	frameTypes sync.Map     // frame block types, indexed by function definition node
+	stacks     sync.Map     // frames calling binary functions, indexed by goroutine id, see Stack
+
+	importErrs map[string]*ImportError // errors of best effort imports, indexed by import path
+	env        *environ                // environment variables of interpreted code
+	args       []string                // command line arguments of interpreted code, or nil
+	vos        *vos                    // virtualized process state, or nil
+	redaction  *Redaction              // rules of redacted values, or nil
+	registries *registries             // process-wide registries as seen by interpreted code
+	roots      map[string][]*node      // compiled source roots, indexed by package path
+	remote     map[string]string       // versions of the modules fetched by ImportRemote, indexed by path
+
+	wrappers    map[reflect.Type]reflect.Type // interface wrappers, indexed by interface type
+	outputLimit *OutputLimit                  // limits of output and results, or nil
+	evalCache   *evalCache                    // compiled expressions, or nil
+
+	stats          map[string]*PackageStats // import statistics, indexed by import path
+	callStats      map[string]*callStats    // binary call statistics, indexed by function name
+	importTime     time.Duration            // total duration of imports, see importTimer
+	importProgress ImportProgress           // progress of the current import, see importTimer
+	ambiguous      map[string]bool          // reported ambiguous imports, see checkAmbiguity
+
+	pkgInfo   map[string]*PackageInfo // origins of source packages, indexed by import path
+	binLoaded map[string]time.Time    // time of last Use of binary packages, indexed by import path
+
+	importStack []importing                 // source packages being imported, for cycle detection
+	archivePkgs map[string]*archiveFS       // archives of the packages imported from them, indexed by import path
+	bundles     map[string]*Bundle          // manifests of the bundles imported by ImportArchive, indexed by import path
+	compiled    map[string]*CompiledPackage // loaded compilation artifacts, indexed by import path
+	provenance  map[string]*Provenance      // origins of source packages, indexed by import path
+	rebound     map[*node][]*node           // previous declarations of reloaded functions and methods, indexed by current one
+	free        map[int]bool                // released global frame slots, see UnloadPackage
+
+	overrides map[string]map[string]reflect.Value // binary symbols replaced by Override, indexed by path and name
+
+	lazyMutex sync.Mutex
+	lazy      map[*node]*lazyFunc // function declarations compiled on demand
+	instances []lazyInstance      // instances of generic functions and methods to compile
+
+	profileMutex sync.Mutex
+	counters     map[string]*int64 // executions of call sites, indexed by position
+
+	quotas *quotas // resource quotas of interpreted code, or nil
+	timers *timers // timers bound to evaluations, or nil
+
+	snapshotSources []snapshotSource // declarations of the evaluated sources, see Snapshot
+	restoring       bool             // replaying the sources of a snapshot, see RestoreSnapshot
+
+	fileSrc     sync.Map // sources of the parsed files, indexed by *token.File, see Program.Specialize
+	specialized int      // number of specialized programs, see Program.Specialize
+
+	thread *osThread // thread of the pinned functions, see Options.PinnedFuncs
+
+	subs subscriptions // subscriptions to the buses of the host, see UseTopics
+
+	watchMutex sync.Mutex
+	watches    atomic.Value // map[*symbol][]*watch, watches of global variables, see Watch
 }
 
 const (
@@ -172,11 +349,130 @@ const (
 // Symbols exposes interpreter values.
 var Symbols = Exports{
 	selfPath: map[string]reflect.Value{
-		"New": reflect.ValueOf(New),
-
-		"Interpreter": reflect.ValueOf((*Interpreter)(nil)),
-		"Options":     reflect.ValueOf((*Options)(nil)),
-		"Panic":       reflect.ValueOf((*Panic)(nil)),
+		"AuditIdentity":       reflect.ValueOf(AuditIdentity),
+		"DotWriters":          reflect.ValueOf(DotWriters),
+		"AuditWriter":         reflect.ValueOf(AuditWriter),
+		"ErrArchiveIntegrity": reflect.ValueOf(ErrArchiveIntegrity),
+		"ErrAuditChain":       reflect.ValueOf(ErrAuditChain),
+		"ErrFilesFull":        reflect.ValueOf(ErrFilesFull),
+		"ErrInterrupted":      reflect.ValueOf(ErrInterrupted),
+		"ErrLimitExceeded":    reflect.ValueOf(ErrLimitExceeded),
+		"ErrNoStream":         reflect.ValueOf(ErrNoStream),
+		"ErrRemoteDisabled":   reflect.ValueOf(ErrRemoteDisabled),
+		"ErrSecretDenied":     reflect.ValueOf(ErrSecretDenied),
+		"ErrTestFailed":       reflect.ValueOf(ErrTestFailed),
+		"Limit":               reflect.ValueOf(Limit),
+		"New":                 reflect.ValueOf(New),
+		"NewCPUProfile":       reflect.ValueOf(NewCPUProfile),
+		"NewDebugger":         reflect.ValueOf(NewDebugger),
+		"NewFaults":           reflect.ValueOf(NewFaults),
+		"PinThread":           reflect.ValueOf(PinThread),
+		"ArchiveFormatOf":     reflect.ValueOf(ArchiveFormatOf),
+		"ParseBundle":         reflect.ValueOf(ParseBundle),
+		"ReadAudit":           reflect.ValueOf(ReadAudit),
+		"ReadModule":          reflect.ValueOf(ReadModule),
+		"ReadProfile":         reflect.ValueOf(ReadProfile),
+		"ReadWorkspace":       reflect.ValueOf(ReadWorkspace),
+		"Restrict":            reflect.ValueOf(Restrict),
+		"RestrictSecrets":     reflect.ValueOf(RestrictSecrets),
+		"SafeRestrictions":    reflect.ValueOf(SafeRestrictions),
+		"VerifyAudit":         reflect.ValueOf(VerifyAudit),
+		"WithBuildTags":       reflect.ValueOf(WithBuildTags),
+		"WithEnv":             reflect.ValueOf(WithEnv),
+		"WithGoPath":          reflect.ValueOf(WithGoPath),
+		"WithPriority":        reflect.ValueOf(WithPriority),
+		"WithRestrictions":    reflect.ValueOf(WithRestrictions),
+		"WithStderr":          reflect.ValueOf(WithStderr),
+		"WithStdin":           reflect.ValueOf(WithStdin),
+		"WithStdout":          reflect.ValueOf(WithStdout),
+		"WithTarget":          reflect.ValueOf(WithTarget),
+		"WithTimeouts":        reflect.ValueOf(WithTimeouts),
+		"WithSourceRoot":      reflect.ValueOf(WithSourceRoot),
+		"WithWorkspace":       reflect.ValueOf(WithWorkspace),
+
+		"AmbiguousImport": reflect.ValueOf((*AmbiguousImport)(nil)),
+		"ArchiveFormat":   reflect.ValueOf((*ArchiveFormat)(nil)),
+		"ArchiveOptions":  reflect.ValueOf((*ArchiveOptions)(nil)),
+		"Audit":           reflect.ValueOf((*Audit)(nil)),
+		"AuditRecord":     reflect.ValueOf((*AuditRecord)(nil)),
+		"AuditSink":       reflect.ValueOf((*AuditSink)(nil)),
+		"Budget":          reflect.ValueOf((*Budget)(nil)),
+		"Bus":             reflect.ValueOf((*Bus)(nil)),
+		"Bundle":          reflect.ValueOf((*Bundle)(nil)),
+		"BundlePolicy":    reflect.ValueOf((*BundlePolicy)(nil)),
+		"CallEdge":        reflect.ValueOf((*CallEdge)(nil)),
+		"CallbackPolicy":  reflect.ValueOf((*CallbackPolicy)(nil)),
+		"CallStats":       reflect.ValueOf((*CallStats)(nil)),
+		"CPUProfile":      reflect.ValueOf((*CPUProfile)(nil)),
+		"CompileError":    reflect.ValueOf((*CompileError)(nil)),
+		"Compiled":        reflect.ValueOf((*Compiled)(nil)),
+		"CompiledFile":    reflect.ValueOf((*CompiledFile)(nil)),
+		"CompiledPackage": reflect.ValueOf((*CompiledPackage)(nil)),
+		"ContractError":   reflect.ValueOf((*ContractError)(nil)),
+		"ContractMode":    reflect.ValueOf((*ContractMode)(nil)),
+		"CopyMode":        reflect.ValueOf((*CopyMode)(nil)),
+		"DebugAction":     reflect.ValueOf((*DebugAction)(nil)),
+		"DebugFrame":      reflect.ValueOf((*DebugFrame)(nil)),
+		"DebugStop":       reflect.ValueOf((*DebugStop)(nil)),
+		"DebugVar":        reflect.ValueOf((*DebugVar)(nil)),
+		"Debugger":        reflect.ValueOf((*Debugger)(nil)),
+		"Diagnostic":      reflect.ValueOf((*Diagnostic)(nil)),
+		"DiagnosticCode":  reflect.ValueOf((*DiagnosticCode)(nil)),
+		"ErrorCode":       reflect.ValueOf((*ErrorCode)(nil)),
+		"Fetcher":         reflect.ValueOf((*Fetcher)(nil)),
+		"EvalCacheStats":  reflect.ValueOf((*EvalCacheStats)(nil)),
+		"ExitError":       reflect.ValueOf((*ExitError)(nil)),
+		"Fault":           reflect.ValueOf((*Fault)(nil)),
+		"Files":           reflect.ValueOf((*Files)(nil)),
+		"Faults":          reflect.ValueOf((*Faults)(nil)),
+		"FrameError":      reflect.ValueOf((*FrameError)(nil)),
+		"Generic":         reflect.ValueOf((*Generic)(nil)),
+		"GlobalChange":    reflect.ValueOf((*GlobalChange)(nil)),
+		"HostInfo":        reflect.ValueOf((*HostInfo)(nil)),
+		"ImportError":     reflect.ValueOf((*ImportError)(nil)),
+		"ImportEvent":     reflect.ValueOf((*ImportEvent)(nil)),
+		"ImportEventKind": reflect.ValueOf((*ImportEventKind)(nil)),
+		"ImportProgress":  reflect.ValueOf((*ImportProgress)(nil)),
+		"License":         reflect.ValueOf((*License)(nil)),
+		"Interpreter":     reflect.ValueOf((*Interpreter)(nil)),
+		"LimitError":      reflect.ValueOf((*LimitError)(nil)),
+		"Limits":          reflect.ValueOf((*Limits)(nil)),
+		"MemStore":        reflect.ValueOf((*MemStore)(nil)),
+		"NodeTracer":      reflect.ValueOf((*NodeTracer)(nil)),
+		"Options":         reflect.ValueOf((*Options)(nil)),
+		"OutputLimit":     reflect.ValueOf((*OutputLimit)(nil)),
+		"PackageInfo":     reflect.ValueOf((*PackageInfo)(nil)),
+		"PackageStats":    reflect.ValueOf((*PackageStats)(nil)),
+		"Profile":         reflect.ValueOf((*Profile)(nil)),
+		"Provenance":      reflect.ValueOf((*Provenance)(nil)),
+		"Option":          reflect.ValueOf((*Option)(nil)),
+		"OptionFunc":      reflect.ValueOf((*OptionFunc)(nil)),
+		"Panic":           reflect.ValueOf((*Panic)(nil)),
+		"Priority":        reflect.ValueOf((*Priority)(nil)),
+		"QuotaError":      reflect.ValueOf((*QuotaError)(nil)),
+		"QuotaManager":    reflect.ValueOf((*QuotaManager)(nil)),
+		"QuotaUsage":      reflect.ValueOf((*QuotaUsage)(nil)),
+		"Redaction":       reflect.ValueOf((*Redaction)(nil)),
+		"Registration":    reflect.ValueOf((*Registration)(nil)),
+		"Registry":        reflect.ValueOf((*Registry)(nil)),
+		"Restrictions":    reflect.ValueOf((*Restrictions)(nil)),
+		"RuntimeError":    reflect.ValueOf((*RuntimeError)(nil)),
+		"Scheduler":       reflect.ValueOf((*Scheduler)(nil)),
+		"Secrets":         reflect.ValueOf((*Secrets)(nil)),
+		"SecretsFunc":     reflect.ValueOf((*SecretsFunc)(nil)),
+		"SourceMap":       reflect.ValueOf((*SourceMap)(nil)),
+		"SourceMapping":   reflect.ValueOf((*SourceMapping)(nil)),
+		"Store":           reflect.ValueOf((*Store)(nil)),
+		"Stream":          reflect.ValueOf((*Stream)(nil)),
+		"SymbolInfo":      reflect.ValueOf((*SymbolInfo)(nil)),
+		"SymbolMatch":     reflect.ValueOf((*SymbolMatch)(nil)),
+		"TaskGroup":       reflect.ValueOf((*TaskGroup)(nil)),
+		"Timeouts":        reflect.ValueOf((*Timeouts)(nil)),
+		"TraceCall":       reflect.ValueOf((*TraceCall)(nil)),
+		"Tracer":          reflect.ValueOf((*Tracer)(nil)),
+		"TypeCycleError":  reflect.ValueOf((*TypeCycleError)(nil)),
+		"UnresolvedError": reflect.ValueOf((*UnresolvedError)(nil)),
+		"UnresolvedRef":   reflect.ValueOf((*UnresolvedRef)(nil)),
 	},
 }
 
@@ -189,9 +485,10 @@ type _error struct {
 
 func (w _error) Error() string { return w.WError() }
 
-// Panic is an error recovered from a panic call in interpreted code.
+// Panic is an error recovered from a panic call in interpreted code, or from
+// a run-time error.
 type Panic struct {
-	// Value is the recovered value of a call to panic.
+	// Value is the recovered value of a call to panic, or a *RuntimeError.
 	Value interface{}
 
 	// Callers is the call stack obtained from the recover call.
@@ -200,13 +497,32 @@ type Panic struct {
 
 	// Stack is the call stack buffer for debug.
 	Stack []byte
-}
 
-// TODO: Capture interpreter stack frames also and remove
-// fmt.Println(n.cfgErrorf("panic")) in runCfg.
+	// Trace lists the interpreted functions traversed by the panic, innermost
+	// first, in the form "name file:line:col".
+	Trace []string
+}
 
 func (e Panic) Error() string { return fmt.Sprint(e.Value) }
 
+// Format implements fmt.Formatter: the %+v verb prints the error followed by
+// the interpreted functions traversed by the panic, innermost first, in the
+// format of the goroutine traces of Go, and the other verbs the error only.
+func (e Panic) Format(s fmt.State, verb rune) {
+	formatTrace(s, verb, e.Error(), e.Trace)
+}
+
+// Unwrap returns the recovered value if it is an error, or nil.
+func (e Panic) Unwrap() error {
+	err, _ := e.Value.(error)
+	return err
+}
+
+// ErrInterrupted is returned by interpreted functions called from binary code,
+// such as io.Reader implementations used by io.Copy, when the evaluation is
+// cancelled before they complete.
+var ErrInterrupted = errors.New("interpreted code interrupted")
+
 // Walk traverses AST n in depth first order, call cbin function
 // at node entry and cbout function at node exit.
 func (n *node) Walk(in func(n *node) bool, out func(n *node)) {
@@ -226,17 +542,390 @@ type Options struct {
 	// GoPath sets GOPATH for the interpreter.
 	GoPath string
 
-	// BuildTags sets build constraints for the interpreter.
+	// BuildTags sets build constraints for the interpreter. They select the
+	// source files by their //go:build or +build lines, as go build does.
 	BuildTags []string
 
+	// Workspace maps module paths to the directories of their sources, as
+	// the "use" directives of a go.work file (see ReadWorkspace). Packages of
+	// these modules are resolved from these directories in priority, before
+	// vendor and GOPATH directories, so a package shared by several modules,
+	// such as the API of a host and a plugin using it, has a single identity.
+	Workspace map[string]string
+
+	// SourceRoots are directories of package sources, laid out as GOPATH/src,
+	// searched in order after the workspace modules and before vendor and
+	// GOPATH directories, so a host can layer for example builtin scripts,
+	// user scripts and their dependencies. A package is imported from the
+	// first root containing it.
+	SourceRoots []SourceRoot
+
+	// Fetcher downloads the modules imported by Interpreter.ImportRemote.
+	// If nil, remote imports are disabled.
+	Fetcher *Fetcher
+
+	// PreferSource imports a package from its sources, if found, even when
+	// it is also available as binary symbols, as for the development of a
+	// package whose binary symbols are used by the host. The types declared
+	// by the sources which also exist as binary types are bridged to the
+	// binary ones, so values can be exchanged with binary code, and their
+	// methods are those of the binary types.
+	PreferSource bool
+
+	// GOOS and GOARCH select the target platform seen by interpreted code,
+	// if different from the host. They set the build constraints applied to
+	// source files, the values of runtime.GOOS, runtime.GOARCH,
+	// strconv.IntSize and math/bits.UintSize, the results of unsafe.Sizeof
+	// and unsafe.Alignof, and the range of int, uint and uintptr constants.
+	// An empty value stands for the host one. Computations are still
+	// performed with the host representation of values at run time. The
+	// evaluations of an interpreter with an unsupported GOARCH fail.
+	GOOS, GOARCH string
+
 	// Standard input, output and error streams.
 	// They default to os.Stding, os.Stdout and os.Stderr respectively.
 	Stdin          io.Reader
 	Stdout, Stderr io.Writer
+
+	// ASTDotWriter and CFGDotWriter, if not nil, receive the abstract syntax
+	// trees and the control flow graphs of the compiled sources, in graphviz
+	// dot format, instead of the dot command run if the YAEGI_AST_DOT and
+	// YAEGI_CFG_DOT environment variables are set. They can be replaced for
+	// an evaluation, see DotWriters.
+	ASTDotWriter, CFGDotWriter io.Writer
+
+	// Store is a key-value store provided to interpreted code, which accesses it
+	// by importing the "yaegi/kv" package. If nil, the package is not available.
+	Store Store
+
+	// Secrets gives interpreted code access to secrets through the
+	// "yaegi/secrets" package. If nil, the package is not available.
+	Secrets Secrets
+
+	// Files is a set of virtual files which interpreted code reads and writes
+	// through the "yaegi/files" package. If nil, the package is not available.
+	Files *Files
+
+	// ContractMode is the behavior of the failed contracts asserted by
+	// interpreted code with the "yaegi/contracts" package, see ContractsPath.
+	ContractMode ContractMode
+
+	// SharedRegistries makes interpreted code register database drivers with
+	// sql.Register and HTTP handlers with http.Handle and http.HandleFunc in
+	// the process-wide registries of the host. By default, they are registered
+	// in registries scoped to the interpreter, so scripts do not pollute each
+	// other nor the host, see Interpreter.ServeMux.
+	SharedRegistries bool
+
+	// OnRegister, if not nil, is called for each registration of interpreted
+	// code to a process-wide registry, such as by the init function of a
+	// package.
+	OnRegister func(Registration)
+
+	// SharedGlobals makes interpreted code use the default logger of the log
+	// package and the command line flags flag.CommandLine and flag.Usage of
+	// the host. By default, they are replaced by ones scoped to the
+	// interpreter, which write to Stderr, so scripts do not alter the behavior
+	// of the host. In both cases, log.Fatal panics instead of exiting.
+	// See SharedRegistries for http.DefaultServeMux.
+	SharedGlobals bool
+
+	// OnGoroutinePanic, if not nil, is called when a goroutine started by
+	// interpreted code panics, which then does not crash the program.
+	OnGoroutinePanic func(Panic)
+
+	// CollectProfile enables the collection of an execution profile, returned
+	// by Interpreter.Profile.
+	CollectProfile bool
+
+	// CollectCallStats enables the measure of the calls of interpreted code
+	// to the functions and methods of binary packages, returned by
+	// Interpreter.CallStats.
+	CollectCallStats bool
+
+	// CollectProvenance enables the collection of the module, version and
+	// license files of the source packages imported from directories,
+	// returned by Interpreter.Provenance.
+	CollectProvenance bool
+
+	// CollectCoverage enables the collection of the statement coverage of the
+	// source files, written by Interpreter.CoverageProfile.
+	CollectCoverage bool
+
+	// Profile, if not nil, is the execution profile of a previous run, used to
+	// specialize the call sites found hot, executed at least 1000 times, to a
+	// faster call of their statically known callee. The profile positions must
+	// match the current sources: profiles of modified sources are merely less
+	// effective.
+	Profile *Profile
+
+	// NoInline disables the inlining of small interpreted functions, getters
+	// and wrappers returning a parameter or one of its fields, which are
+	// then called as any function. Inlined calls do not appear in panic
+	// traces. Inlining is also disabled by Debugger, CallStack and Tracer.
+	NoInline bool
+
+	// PoolFrames enables the recycling of the frames of the calls of
+	// interpreted functions, which hold their local variables, to reduce the
+	// allocations of servers calling them at high rates. The frames which
+	// may be referred to once their call returned, such as by closures,
+	// goroutines or functions passed to binary code, are not recycled. It has
+	// no effect with Debugger or CallStack. See Interpreter.FrameStats.
+	PoolFrames bool
+
+	// Tracer, if not nil, is notified of the calls of interpreted functions,
+	// with their timings, and of the execution of each node if it is a
+	// NodeTracer. See CPUProfile.
+	Tracer Tracer
+
+	// Debugger, if not nil, controls the execution of interpreted code, with
+	// breakpoints and steps. It disables inlining.
+	Debugger *Debugger
+
+	// CallStack enables the recording of the calls of interpreted functions,
+	// returned by Interpreter.Stack, at the cost of slower calls. It disables
+	// inlining, so that panic traces include all the interpreted functions.
+	CallStack bool
+
+	// OnAmbiguousImport, if not nil, is called when an import path resolves
+	// to several packages, such as binary symbols and source directories, or
+	// a vendored copy and a GOPATH one, to report the one which is used.
+	OnAmbiguousImport func(AmbiguousImport)
+
+	// ImportHook, if not nil, is called synchronously as source packages are
+	// imported, from their resolution to the run of their init functions, to
+	// report progress or instrument slow imports. See ImportEvent.
+	ImportHook func(ImportEvent)
+
+	// Audit, if not nil, journals each evaluation and source import, with
+	// the hash of its source, the identity of its caller, its duration,
+	// outcome and resources used, to an append-only sink, in records chained
+	// by their hash. See AuditRecord.
+	Audit *Audit
+
+	// Env is the initial environment of interpreted code, in the form
+	// "key=value", isolated from the process environment. If Env is nil,
+	// it is a copy of the process environment at interpreter creation. Use
+	// an empty slice to start with an empty environment.
+	Env []string
+
+	// Args are the command line arguments of interpreted code, starting with
+	// the program name, as seen in os.Args and parsed by flag.Parse. If Args
+	// is nil, they are those of the process, and flag.Parse ignores the flags
+	// not defined by interpreted code, such as the ones of the host.
+	Args []string
+
+	// Timeouts limits the duration of blocking calls of the standard library
+	// made by interpreted code, which can not be interrupted otherwise.
+	Timeouts Timeouts
+
+	// Callbacks restricts the types of the interpreted functions bound by
+	// the host with Bind and EvalInto, and the duration of their calls.
+	Callbacks CallbackPolicy
+
+	// EagerCompile, if true, compiles all the functions of imported source
+	// packages at import, after their initialization. By default, only the
+	// signatures of these functions are processed at import, and the body of
+	// a function is compiled when first referenced by compiled code. This
+	// makes the import of large packages of which few functions are used much
+	// faster, but moves the compilation latency, and the report of errors, to
+	// the first use. Blank imports, such as import _ "pkg", only compile the
+	// code reachable from package variables and init functions, even in eager
+	// mode.
+	EagerCompile bool
+
+	// TypingDiagnostics enables the report by Interpreter.Diagnostics of the
+	// uses of the empty interface and of reflection in interpreted code,
+	// which block the static typing of values and are much slower to
+	// interpret than their typed alternatives.
+	TypingDiagnostics bool
+
+	// REPLHistory is the number of previous results bound to _1, _2, ... in
+	// REPL mode, _1 being the most recent. The last result is always bound
+	// to the blank identifier _. Note that user variables with the same names
+	// are shadowed.
+	REPLHistory int
+
+	// WrapStatements allows the sources without package clause, evaluated by
+	// Eval or by EvalPath, such as scripts, to mix global declarations with
+	// bare statements and expressions. The declarations are global, and the
+	// statements are evaluated in the global scope, as the inputs of Eval
+	// holding only statements. By default, such an input of Eval holds
+	// either declarations or statements, and EvalPath requires a package
+	// clause.
+	WrapStatements bool
+
+	// OperatorMethods allows the arithmetic operators +, -, *, / and the
+	// comparison operators on the values of a binary type which is not a
+	// boolean, numeric or string type, such as a decimal, money or vector
+	// type, if it has the methods of the operator: for a type T, the methods
+	// Add, Sub, Mul and Div of signature func(T) T, and Cmp of signature
+	// func(T) int, returning -1, 0 or +1, used for all the comparisons.
+	// Both operands must be of type T: constants must first be converted,
+	// for example by a constructor of the host package. The assignment
+	// operators +=, -=, *= and /= are allowed as well.
+	OperatorMethods bool
+
+	// PinnedFuncs lists the functions of binary packages, designated by their
+	// import path and name such as "example.com/gui.Draw", whose calls by
+	// interpreted code run on an OS thread locked by runtime.LockOSThread,
+	// the same for all of them, whatever the goroutine of the caller, for
+	// the host APIs requiring thread affinity, such as GUI toolkits or some
+	// C libraries. The callbacks of the pinned functions into interpreted
+	// code run on this thread as well. See also PinThread.
+	PinnedFuncs []string
+
+	// CopiedFuncs maps functions of binary packages, designated as for
+	// PinnedFuncs, to the values deep copied by their calls by interpreted
+	// code: the arguments, so that the host does not retain references to
+	// the memory of the script, or the results, so that the script does not
+	// retain references to the internal slices, maps and structures of the
+	// host after the call, or both. The pointers, slices, maps, arrays,
+	// structs and interfaces are copied, but not the functions, channels
+	// and unexported fields of structs.
+	CopiedFuncs map[string]CopyMode
+
+	// Dialect, if not nil, rewrites the sources before they are parsed, the
+	// ones evaluated as the ones of imported packages, for example to expand
+	// the syntax sugar of a DSL, such as a pipeline operator, into Go code.
+	// It returns the Go source and the map of its positions to the ones of
+	// the original text, so that the errors, traces and debugger locations
+	// refer to the original text. An error of Dialect is the error of the
+	// evaluation or import. It may be called concurrently for the files of a
+	// package.
+	Dialect func(src string) (string, SourceMap, error)
+
+	// BestEffort, if true, makes the import of a source package skip its files
+	// containing syntax errors instead of failing, so the rest of the package
+	// remains usable. The errors are then reported by ImportErrors.
+	BestEffort bool
+
+	// MaxErrors, if positive, makes the import of a source package which
+	// fails go on to report up to MaxErrors errors at once, in an
+	// *ImportError, instead of the first one only. The syntax errors of all
+	// the files are reported, or else the errors of the global declarations,
+	// or else the compilation errors of all the functions, which are then
+	// compiled at import.
+	MaxErrors int
+
+	// LazyImportCycles, if true, allows import cycles between source
+	// packages. A package imported again while being imported is bound to
+	// its partial import, and its symbols are resolved when used, so a cycle
+	// succeeds if the packages only refer to each other in the bodies of
+	// functions compiled after the cycle completes, such as mutually
+	// recursive functions, or methods using the types of the other package.
+	// References needed to declare or initialize the packages still fail.
+	// By default, import cycles are errors of code CodeImportCycle.
+	LazyImportCycles bool
+
+	// MaxTypeDepth is the maximum nesting of the type expressions resolved by
+	// the compiler, including the declarations not resolved yet of the types
+	// they refer to. Deeper types are compile errors rather than a stack
+	// overflow of the host. It defaults to 10000.
+	MaxTypeDepth int
+
+	// Restrictions, if not nil, limit the packages and symbols available to
+	// interpreted code, such as SafeRestrictions for untrusted code.
+	Restrictions *Restrictions
+
+	// HostAPIVersion is the version of the API of the host, such as "v1.4",
+	// and Capabilities the names of the capabilities of the host, given to
+	// interpreted code by the "yaegi/hostinfo" package, see HostInfoPath.
+	HostAPIVersion string
+	Capabilities   []string
+
+	// MaxSteps, if positive, is the maximum number of nodes executed by
+	// each evaluation, including the functions called by the host until the
+	// next evaluation. It bounds the CPU time of the evaluation.
+	MaxSteps int64
+
+	// MaxGoroutines, if positive, is the maximum number of goroutines of
+	// interpreted code running at the same time.
+	MaxGoroutines int
+
+	// MaxSpawnedGoroutines, if positive, is the maximum number of goroutines
+	// started by the go statements of each evaluation, including the
+	// functions called by the host until the next evaluation, whether they
+	// are still running or not. It bounds the goroutines spawned in loops.
+	MaxSpawnedGoroutines int64
+
+	// QueueGoroutines makes the go statements exceeding MaxGoroutines wait
+	// for the end of a running goroutine, as in a worker pool, instead of
+	// aborting the evaluation. The wait is interrupted by the cancellation of
+	// EvalWithContext.
+	QueueGoroutines bool
+
+	// MaxFrameMemory, if positive, is the maximum memory in bytes of the
+	// frames of the interpreted functions running at the same time, which
+	// bounds the depth of recursive calls. It is estimated from the sizes of
+	// the local variables, not including the memory they refer to.
+	MaxFrameMemory int64
+
+	// Quotas, if not nil, coordinates the quotas of the interpreters of
+	// Tenant with the other interpreters of the same tenant, see
+	// QuotaManager.
+	Quotas *QuotaManager
+	Tenant string
+
+	// Scheduler, if not nil, pauses the evaluations of the interpreter while
+	// evaluations of a higher priority run in the other interpreters of the
+	// scheduler, see Scheduler. Priority is the priority of the evaluations
+	// without one set by WithPriority.
+	Scheduler *Scheduler
+	Priority  Priority
+
+	// Interpreted code exceeding one of these quotas is aborted, and its
+	// evaluation returns a *QuotaError.
+
+	// EvalCacheSize, if positive, is the number of compiled expressions
+	// cached by Eval, EvalWithContext and EvalInto, keyed by the SHA-256
+	// digest of their source and their expected result type, so evaluating
+	// the same expression again, such as a feature flag, skips its parsing
+	// and compilation. The least recently used expressions are evicted first.
+	// The cache is flushed by the evaluation of anything but an expression,
+	// such as declarations, and by the changes of packages. See
+	// Interpreter.EvalCacheStats.
+	EvalCacheSize int
+
+	// OutputLimit, if not nil, limits the size of the output written to
+	// Stdout and Stderr, and of the results of evaluations.
+	OutputLimit *OutputLimit
+
+	// EvalTimers, if true, stops the timers and tickers created by
+	// interpreted code with the time package when the evaluation creating
+	// them completes or is cancelled, so scripts can not leak them in long
+	// running hosts. Timers and tickers used after the end of their
+	// evaluation, such as by goroutines, never fire.
+	EvalTimers bool
+
+	// VirtualOS, if true, virtualizes the process state accessed by
+	// interpreted code with the os package, in addition to the environment
+	// which is always per interpreter: os.Exit terminates the evaluation,
+	// which returns an *ExitError, instead of the process, and the working
+	// directory, initialized to WorkDir or else the process one, is per
+	// interpreter. It is changed by os.Chdir, returned by os.Getwd, and used
+	// to resolve the relative path names passed to the functions of the os,
+	// io/ioutil and path/filepath packages which open, create, inspect or
+	// modify files. The other functions, such as filepath.Walk or the ones of
+	// os/exec, use the process working directory. Note that a call to os.Exit
+	// runs the deferred functions, and can be recovered by interpreted code.
+	VirtualOS bool
+	WorkDir   string
+
+	// Redaction, if not nil, defines the runtime values hidden from the
+	// errors and the debugging information produced by the interpreter.
+	Redaction *Redaction
 }
 
-// New returns a new interpreter.
-func New(options Options) *Interpreter {
+// New returns a new interpreter, configured by opts, applied in order: an
+// Options value, then With functions, as in
+//
+//	interp.New(interp.Options{GoPath: gopath}, interp.WithStdout(w))
+func New(opts ...Option) *Interpreter {
+	var options Options
+	for _, o := range opts {
+		o.apply(&options)
+	}
 	i := Interpreter{
 		opt:      opt{context: build.Default},
 		frame:    &frame{data: []reflect.Value{}},
@@ -246,8 +935,9 @@ func New(options Options) *Interpreter {
 		binPkg:   Exports{"": map[string]reflect.Value{"_error": reflect.ValueOf((*_error)(nil))}},
 		srcPkg:   imports{},
 		pkgNames: map[string]string{},
-		rdir:     map[string]bool{},
+		sources:  map[string][]srcFile{},
 		hooks:    &hooks{},
+		thread:   &osThread{},
 	}
 
 	if i.opt.stdin = options.Stdin; i.opt.stdin == nil {
@@ -261,11 +951,110 @@ func New(options Options) *Interpreter {
 	if i.opt.stderr = options.Stderr; i.opt.stderr == nil {
 		i.opt.stderr = os.Stderr
 	}
+	if options.OutputLimit != nil {
+		i.limitOutput(options.OutputLimit)
+	}
+	if options.EvalCacheSize > 0 {
+		i.evalCache = newEvalCache(options.EvalCacheSize)
+	}
+	i.opt.sharedGlobals = options.SharedGlobals
+
+	i.opt.restrictions = options.Restrictions
+	i.quotas = newQuotas(options)
+	if options.EvalTimers {
+		i.timers = newTimers()
+	}
+
+	env := options.Env
+	if env == nil {
+		env = os.Environ()
+	}
+	i.env = newEnviron(env)
+	if options.Args != nil {
+		i.args = append([]string{}, options.Args...)
+	}
+	if options.VirtualOS {
+		i.vos = newVOS(options.WorkDir)
+	}
+	i.redaction = options.Redaction
+	i.registries = newRegistries(options.SharedRegistries, options.OnRegister)
+
+	if options.Store != nil {
+		i.Use(storeExports(options.Store))
+	}
+
+	if options.Secrets != nil {
+		i.Use(secretsExports(options.Secrets))
+	}
 
+	if options.Files != nil {
+		i.Use(filesExports(options.Files))
+	}
+
+	i.Use(Exports{StreamPath: {
+		"Yield":       reflect.ValueOf(i.yieldValue),
+		"ErrNoStream": reflect.ValueOf(ErrNoStream),
+	}})
+	i.opt.contractMode = options.ContractMode
+	i.Use(i.contractsExports())
+	i.Use(i.tasksExports())
+
+	i.opt.onGoroutinePanic = options.OnGoroutinePanic
+	i.opt.bestEffort = options.BestEffort
+	i.opt.maxErrors = options.MaxErrors
+	i.opt.lazyImportCycles = options.LazyImportCycles
+	if i.opt.maxTypeDepth = options.MaxTypeDepth; i.opt.maxTypeDepth <= 0 {
+		i.opt.maxTypeDepth = defaultMaxTypeDepth
+	}
+	i.opt.timeouts = options.Timeouts
+	i.opt.callbacks = options.Callbacks
+	i.opt.eagerCompile = options.EagerCompile
+	i.opt.typingDiagnostics = options.TypingDiagnostics
+	i.opt.replHistory = options.REPLHistory
+	i.opt.wrapStatements = options.WrapStatements
+	i.opt.operatorMethods = options.OperatorMethods
+	i.opt.pinnedFuncs = options.PinnedFuncs
+	i.opt.copiedFuncs = options.CopiedFuncs
+	i.opt.dialect = options.Dialect
 	i.opt.context.GOPATH = options.GoPath
+	i.opt.workspace = options.Workspace
+	i.opt.sourceRoots = options.SourceRoots
+	i.opt.fetcher = options.Fetcher
+	i.opt.preferSource = options.PreferSource
+	i.opt.onAmbiguousImport = options.OnAmbiguousImport
+	i.opt.importHook = options.ImportHook
+	if options.Audit != nil && options.Audit.Sink != nil {
+		i.opt.audit = newAuditLog(options.Audit)
+	}
+	i.opt.collectProfile = options.CollectProfile
+	i.opt.collectCallStats = options.CollectCallStats
+	i.opt.collectProvenance = options.CollectProvenance
+	i.opt.callStack = options.CallStack
+	if options.CollectCoverage {
+		i.opt.coverage = &coverage{files: map[string][]*coverBlock{}, triggers: map[coverPos]*coverBlock{}}
+	}
+	i.opt.profile = options.Profile
+	i.opt.noInline = options.NoInline || options.Debugger != nil || options.CallStack || options.Tracer != nil
+	i.opt.debugger = options.Debugger
+	i.opt.poolFrames = options.PoolFrames && options.Debugger == nil && !options.CallStack
+	if options.Tracer != nil {
+		i.opt.tracing = &tracing{tracer: options.Tracer}
+	}
+	i.opt.srcFS = i.mountRoots(osFS{})
 	if len(options.BuildTags) > 0 {
 		i.opt.context.BuildTags = options.BuildTags
 	}
+	if t, err := newTarget(options.GOOS, options.GOARCH, i.opt.context.GOOS, i.opt.context.GOARCH); err != nil {
+		i.opt.targetErr = err
+	} else if t != nil {
+		i.opt.target = t
+		i.opt.context.GOOS, i.opt.context.GOARCH = t.goos, t.goarch
+	}
+	i.opt.hostInfo = i.newHostInfo(options)
+	i.Use(i.hostInfoExports())
+
+	i.opt.astDotWriter = options.ASTDotWriter
+	i.opt.cfgDotWriter = options.CFGDotWriter
 
 	// astDot activates AST graph display for the interpreter
 	i.opt.astDot, _ = strconv.ParseBool(os.Getenv("YAEGI_AST_DOT"))
@@ -307,6 +1096,7 @@ const (
 func initUniverse() *scope {
 	sc := &scope{global: true, sym: map[string]*symbol{
 		// predefined Go types
+		"any":         {kind: typeSym, typ: &itype{cat: interfaceT}},
 		"bool":        {kind: typeSym, typ: &itype{cat: boolT, name: "bool"}},
 		"byte":        {kind: typeSym, typ: &itype{cat: uint8T, name: "uint8"}},
 		"complex64":   {kind: typeSym, typ: &itype{cat: complex64T, name: "complex64"}},
@@ -375,30 +1165,105 @@ func (interp *Interpreter) resizeFrame() {
 // Eval evaluates Go code represented as a string. Eval returns the last result
 // computed by the interpreter, and a non nil error in case of failure.
 func (interp *Interpreter) Eval(src string) (res reflect.Value, err error) {
-	return interp.eval(src, "", true)
+	interp.evalMutex.Lock()
+	defer interp.evalMutex.Unlock()
+	return interp.eval(src, "", true, nil)
+}
+
+// EvalInto evaluates Go code represented as a string, as Eval, and stores its
+// last result in the value pointed to by dst, which must be a non nil pointer.
+// The result type is checked against the type of *dst at compile time, so an
+// incompatible source is not executed. Untyped constants are converted to the
+// destination type. A function destination is subject to Options.Callbacks,
+// as for Bind.
+func (interp *Interpreter) EvalInto(src string, dst interface{}) error {
+	rv := reflect.ValueOf(dst)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() {
+		return fmt.Errorf("invalid destination %T: not a non nil pointer", dst)
+	}
+	v := rv.Elem()
+	t := v.Type()
+	if t.Kind() == reflect.Func {
+		if err := interp.allowCallback(t); err != nil {
+			return err
+		}
+	}
+
+	interp.evalMutex.Lock()
+	res, err := interp.eval(src, "", true, t)
+	interp.evalMutex.Unlock()
+	if err != nil {
+		return err
+	}
+	if res.IsValid() {
+		if vi, ok := res.Interface().(valueInterface); ok {
+			res = vi.value
+		}
+	}
+	switch {
+	case !res.IsValid():
+		v.Set(reflect.Zero(t))
+	case res.Type().AssignableTo(t):
+		v.Set(res)
+	case res.Type().ConvertibleTo(t):
+		v.Set(res.Convert(t))
+	default:
+		return fmt.Errorf("cannot assign value of type %v to %v", res.Type(), t)
+	}
+	if t.Kind() == reflect.Func && !v.IsNil() {
+		v.Set(interp.wrapCallback(reflect.ValueOf(v.Interface())))
+	}
+	return nil
 }
 
 // EvalPath evaluates Go code located at path and returns the last result computed
 // by the interpreter, and a non nil error in case of failure.
 // The main function of the main package is executed if present.
 func (interp *Interpreter) EvalPath(path string) (res reflect.Value, err error) {
+	interp.evalMutex.Lock()
+	defer interp.evalMutex.Unlock()
+	return interp.evalPath(path)
+}
+
+func (interp *Interpreter) evalPath(path string) (res reflect.Value, err error) {
 	if !isFile(path) {
-		_, err := interp.importSrc(mainID, path, NoTest)
-		return res, err
+		interp.resetQuotas()
+		defer interp.stopTimers()
+		defer interp.recoverPanic(&err)
+		if _, err := interp.importSrc(mainID, path, NoTest); err != nil || !interp.eagerCompile {
+			return res, err
+		}
+		return res, interp.compileImport(path)
 	}
 
 	b, err := ioutil.ReadFile(path)
 	if err != nil {
 		return res, err
 	}
-	return interp.eval(string(b), path, false)
+	if src := string(b); interp.wrapStatements && firstToken(interp.fset, src) != token.PACKAGE {
+		return interp.eval(src, path, true, nil)
+	}
+	return interp.eval(string(b), path, false, nil)
 }
 
 // EvalTgz evaluates an io.Reader as a tgz file and returns the last result computed
 // by the interpreter, and a non nil error in case of failure.
 // The main function of the main package is executed if present
 func (interp *Interpreter) EvalTgz(reader io.Reader) (res reflect.Value, err error) {
-	_, err = interp.importSrcArchive(reader, NoTest)
+	return interp.EvalArchive(reader, ArchiveTarGz)
+}
+
+// EvalArchive evaluates the Go source files of an archive read from reader, in
+// the zip, tar or gzip compressed tar format, detected from the content if
+// format is ArchiveDetect. It returns the last result computed by the
+// interpreter, and a non nil error in case of failure.
+// The main function of the main package is executed if present.
+func (interp *Interpreter) EvalArchive(reader io.Reader, format ArchiveFormat) (res reflect.Value, err error) {
+	interp.evalMutex.Lock()
+	defer interp.evalMutex.Unlock()
+	defer interp.stopTimers()
+	defer interp.recoverPanic(&err)
+	_, err = interp.importSrcArchive(archiveRoot, reader, ArchiveOptions{Format: format}, NoTest)
 	return res, err
 }
 
@@ -407,6 +1272,10 @@ func (interp *Interpreter) EvalTgz(reader io.Reader) (res reflect.Value, err err
 // The main function, test functions and benchmark functions are internally compiled but not
 // executed. Test functions can be retrieved using the Symbol() method.
 func (interp *Interpreter) EvalTest(path string) error {
+	interp.evalMutex.Lock()
+	defer interp.evalMutex.Unlock()
+	interp.resetQuotas()
+	defer interp.stopTimers()
 	_, err := interp.importSrc(mainID, path, Test)
 	return err
 }
@@ -416,6 +1285,7 @@ func (interp *Interpreter) EvalTest(path string) error {
 // returned.
 func (interp *Interpreter) Symbols(importPath string) Exports {
 	m := map[string]map[string]reflect.Value{}
+	_ = interp.compileDeferred(importPath, true)
 	interp.mutex.RLock()
 	defer interp.mutex.RUnlock()
 
@@ -425,14 +1295,17 @@ func (interp *Interpreter) Symbols(importPath string) Exports {
 		}
 		syms := map[string]reflect.Value{}
 		for n, s := range v {
-			if !canExport(n) {
-				// Skip private non-exported symbols.
+			if !canExport(n) || isInstanceName(n) {
+				// Skip private non-exported symbols, and instances of generics.
 				continue
 			}
 			switch s.kind {
 			case constSym:
 				syms[n] = s.rval
 			case funcSym:
+				if interp.compileFunc(s.node) != nil {
+					continue
+				}
 				syms[n] = genFunctionWrapper(s.node)(interp.frame)
 			case varSym:
 				syms[n] = interp.frame.data[s.index]
@@ -472,22 +1345,37 @@ func isFile(path string) bool {
 	return err == nil && fi.Mode().IsRegular()
 }
 
-func (interp *Interpreter) eval(src, name string, inc bool) (res reflect.Value, err error) {
+// eval evaluates src, named name. If want is not nil, the result of src is
+// checked at compile time to be assignable to a value of type want.
+func (interp *Interpreter) eval(src, name string, inc bool, want reflect.Type) (res reflect.Value, err error) {
 	if name != "" {
 		interp.name = name
 	}
 	if interp.name == "" {
 		interp.name = DefaultSourceName
 	}
-
-	defer func() {
-		r := recover()
-		if r != nil {
-			var pc [64]uintptr // 64 frames should be enough.
-			n := runtime.Callers(1, pc[:])
-			err = Panic{Value: r, Callers: pc[:n], Stack: debug.Stack()}
+	if interp.targetErr != nil {
+		return res, interp.targetErr
+	}
+	interp.resetQuotas()
+	defer interp.stopTimers()
+
+	defer interp.auditEval(src)(&err)
+	defer interp.recoverPanic(&err)
+
+	// Identical expressions are compiled once.
+	var cacheKey evalKey
+	cacheable := false
+	if interp.evalCache != nil {
+		if !inc {
+			interp.evalCache.flush()
+		} else {
+			var e *evalEntry
+			if cacheKey, e, cacheable = interp.cachedEval(src, want); e != nil {
+				return interp.runCached(e)
+			}
 		}
-	}()
+	}
 
 	// Parse source to AST.
 	pkgName, root, err := interp.ast(src, interp.name, inc)
@@ -495,15 +1383,8 @@ func (interp *Interpreter) eval(src, name string, inc bool) (res reflect.Value,
 		return res, err
 	}
 
-	if interp.astDot {
-		dotCmd := interp.dotCmd
-		if dotCmd == "" {
-			dotCmd = defaultDotCmd(interp.name, "yaegi-ast-")
-		}
-		root.astDot(dotWriter(dotCmd), interp.name)
-		if interp.noRun {
-			return res, err
-		}
+	if interp.writeASTDot(root, interp.name) && interp.noRun {
+		return res, err
 	}
 
 	// Perform global types analysis.
@@ -514,21 +1395,22 @@ func (interp *Interpreter) eval(src, name string, inc bool) (res reflect.Value,
 	// Annotate AST with CFG informations.
 	initNodes, err := interp.cfg(root, pkgName)
 	if err != nil {
-		if interp.cfgDot {
-			dotCmd := interp.dotCmd
-			if dotCmd == "" {
-				dotCmd = defaultDotCmd(interp.name, "yaegi-cfg-")
-			}
-			root.cfgDot(dotWriter(dotCmd))
-		}
+		interp.writeCFGDot(root, interp.name)
 		return res, err
 	}
 
+	if want != nil {
+		if err = (typecheck{}).result(root, want); err != nil {
+			return res, err
+		}
+	}
+
 	if root.kind != fileStmt {
 		// REPL may skip package statement.
 		setExec(root.start)
 	}
 	interp.mutex.Lock()
+	interp.addRoots(pkgName, root)
 	gs := interp.scopes[pkgName]
 	if interp.universe.sym[pkgName] == nil {
 		// Make the package visible under a path identical to its name.
@@ -539,17 +1421,11 @@ func (interp *Interpreter) eval(src, name string, inc bool) (res reflect.Value,
 	interp.mutex.Unlock()
 
 	// Add main to list of functions to run, after all inits.
-	if m := gs.sym[mainID]; pkgName == mainID && m != nil {
+	if m := gs.sym[mainID]; pkgName == mainID && m != nil && !interp.restoring {
 		initNodes = append(initNodes, m.node)
 	}
 
-	if interp.cfgDot {
-		dotCmd := interp.dotCmd
-		if dotCmd == "" {
-			dotCmd = defaultDotCmd(interp.name, "yaegi-cfg-")
-		}
-		root.cfgDot(dotWriter(dotCmd))
-	}
+	interp.writeCFGDot(root, interp.name)
 
 	if interp.noRun {
 		return res, err
@@ -561,7 +1437,9 @@ func (interp *Interpreter) eval(src, name string, inc bool) (res reflect.Value,
 	}
 
 	// Init interpreter execution memory frame.
-	interp.frame.setrunid(interp.runid())
+	if interp.startRun() != interp.runid() {
+		return res, interp.runErr()
+	}
 	interp.frame.mutex.Lock()
 	interp.resizeFrame()
 	interp.frame.mutex.Unlock()
@@ -569,6 +1447,14 @@ func (interp *Interpreter) eval(src, name string, inc bool) (res reflect.Value,
 	// Execute node closures.
 	interp.run(root, nil)
 
+	// A program file embeds the files of its directory.
+	if !inc {
+		sources := []srcFile{{name: interp.name, src: src}}
+		if err = interp.embedFiles(pkgName, sources, dirTree{osFS{}, filepath.Dir(interp.name)}); err != nil {
+			return res, err
+		}
+	}
+
 	// Wire and execute global vars.
 	n, err := genGlobalVars([]*node{root}, interp.scopes[pkgName])
 	if err != nil {
@@ -577,10 +1463,24 @@ func (interp *Interpreter) eval(src, name string, inc bool) (res reflect.Value,
 	interp.run(n, nil)
 
 	for _, n := range initNodes {
+		if interp.frame.runid() != interp.runid() {
+			return res, interp.runErr()
+		}
 		interp.run(n, interp.frame)
 	}
-	v := genValue(root)
+	interp.recordSource(src, inc)
+	if root.kind == fileStmt {
+		// Declarations have no result.
+		return res, interp.abortErr()
+	}
+	v := genInterfaceWrapper(root, want)
 	res = v(interp.frame)
+	if err = interp.abortErr(); err != nil {
+		return reflect.Value{}, err
+	}
+	if cacheable {
+		interp.evalCache.put(&evalEntry{key: cacheKey, root: root, result: v})
+	}
 
 	// If result is an interpreter node, wrap it in a runtime callable function.
 	if res.IsValid() {
@@ -589,29 +1489,89 @@ func (interp *Interpreter) eval(src, name string, inc bool) (res reflect.Value,
 		}
 	}
 
-	return res, err
+	return interp.truncateResult(res), err
 }
 
 // EvalWithContext evaluates Go code represented as a string. It returns
 // a map on current interpreted package exported symbols.
 func (interp *Interpreter) EvalWithContext(ctx context.Context, src string) (reflect.Value, error) {
+	return interp.withContext(ctx, func() (reflect.Value, error) { return interp.eval(src, "", true, nil) })
+}
+
+// EvalPathWithContext evaluates Go code located at path, as EvalPath, and
+// stops the execution of the init functions and main function of the
+// evaluated and imported packages if ctx is done first.
+func (interp *Interpreter) EvalPathWithContext(ctx context.Context, path string) (reflect.Value, error) {
+	return interp.withContext(ctx, func() (reflect.Value, error) { return interp.evalPath(path) })
+}
+
+// withContext runs eval, and stops the interpreted code it executes when ctx
+// is done, returning the context error. The evaluation lock is held until
+// eval returns, or is stopped, so that the interpreter remains usable if the
+// stopped code does not terminate, such as when blocked in a binary call.
+func (interp *Interpreter) withContext(ctx context.Context, eval func() (reflect.Value, error)) (reflect.Value, error) {
 	var v reflect.Value
 	var err error
 
+	interp.evalMutex.Lock()
 	interp.mutex.Lock()
 	interp.done = make(chan struct{})
 	interp.cancelChan = !interp.opt.fastChan
 	interp.mutex.Unlock()
 
+	// The run id is set before eval starts, so that it is stopped even if ctx
+	// is done before the execution, see startRun.
+	e := &ctxEval{id: interp.runid()}
+	e.dot, _ = ctx.Value(dotWritersKey{}).(dotWriters)
+	interp.frame.setrunid(e.id)
+
+	var restoreAudit func()
+	if a := interp.audit; a != nil {
+		restoreAudit = a.setContext(ctx)
+	}
+	leave := interp.schedule(ctx)
+	var once sync.Once
+	release := func() {
+		once.Do(func() {
+			leave()
+			if restoreAudit != nil {
+				restoreAudit()
+			}
+			interp.evalMutex.Unlock()
+		})
+	}
+
+	run := func() {
+		g := goroutineID()
+		interp.mutex.Lock()
+		if interp.ctxEvals == nil {
+			interp.ctxEvals = map[uint64]*ctxEval{}
+		}
+		interp.ctxEvals[g] = e
+		interp.mutex.Unlock()
+		defer func() {
+			interp.mutex.Lock()
+			delete(interp.ctxEvals, g)
+			interp.mutex.Unlock()
+		}()
+		v, err = eval()
+	}
 	done := make(chan struct{})
 	go func() {
+		defer release()
 		defer close(done)
-		v, err = interp.Eval(src)
+		if pin, _ := ctx.Value(pinThreadKey{}).(bool); pin {
+			interp.thread.run(run)
+			return
+		}
+		run()
 	}()
 
 	select {
 	case <-ctx.Done():
 		interp.stop()
+		interp.stopTimers()
+		release()
 		return reflect.Value{}, ctx.Err()
 	case <-done:
 	}
@@ -628,12 +1588,32 @@ func (interp *Interpreter) stop() {
 
 func (interp *Interpreter) runid() uint64 { return atomic.LoadUint64(&interp.id) }
 
-// getWrapper returns the wrapper type of the corresponding interface, or nil if not found.
-func (interp *Interpreter) getWrapper(t reflect.Type) reflect.Type {
-	if p, ok := interp.binPkg[t.PkgPath()]; ok {
-		return p["_"+t.Name()].Type().Elem()
+// ctxEval is an evaluation in EvalWithContext.
+type ctxEval struct {
+	id  uint64     // run id of the evaluation, see startRun
+	dot dotWriters // dot writers of the evaluation, see DotWriters
+}
+
+// contextEval returns the evaluation in EvalWithContext run by the calling
+// goroutine, or nil.
+func (interp *Interpreter) contextEval() *ctxEval {
+	interp.mutex.RLock()
+	defer interp.mutex.RUnlock()
+	return interp.ctxEvals[goroutineID()]
+}
+
+// startRun enables the execution of the global frame after a previous
+// cancellation, and returns the id of the run. In EvalWithContext, the id set
+// before the evaluation is kept, so a cancellation during the compilation is
+// not missed, and the evaluation does not run if it differs from the current
+// one.
+func (interp *Interpreter) startRun() uint64 {
+	if e := interp.contextEval(); e != nil {
+		return e.id
 	}
-	return nil
+	id := interp.runid()
+	interp.frame.setrunid(id)
+	return id
 }
 
 // Use loads binary runtime symbols in the interpreter context so
@@ -648,17 +1628,42 @@ func (interp *Interpreter) Use(values Exports) {
 		if interp.binPkg[k] == nil {
 			interp.binPkg[k] = make(map[string]reflect.Value)
 		}
+		if interp.binLoaded == nil {
+			interp.binLoaded = map[string]time.Time{}
+		}
+		interp.binLoaded[k] = time.Now()
 
 		for s, sym := range v {
 			interp.binPkg[k][s] = sym
 		}
 	}
 
+	interp.flushEvalCache()
+	interp.useWrappers(values)
+	fixStdio(interp, values)
+	fixRegistries(interp, values)
+
 	// Checks if input values correspond to stdlib packages by looking for one
 	// well known stdlib package path.
-	if _, ok := values["fmt"]; ok {
-		fixStdio(interp)
+	if _, ok := values["os"]; ok {
+		fixEnv(interp)
+	}
+	if interp.vos != nil {
+		fixVOS(interp, values)
+	}
+	if values["net"] != nil || values["time"] != nil {
+		fixTimeouts(interp)
+	}
+	if values["time"] != nil {
+		fixTimers(interp)
 	}
+	if values["runtime"] != nil || values["strconv"] != nil || values["math/bits"] != nil || values["unsafe"] != nil {
+		fixTarget(interp)
+	}
+	interp.applyOverrides(values)
+	fixPinned(interp, values)
+	fixCopied(interp, values)
+	interp.restrict()
 }
 
 // fixStdio redefines interpreter stdlib symbols to use the standard input,
@@ -666,54 +1671,125 @@ func (interp *Interpreter) Use(values Exports) {
 // the interpreter only. Global values os.Stdin, os.Stdout and os.Stderr are
 // not changed. Note that it is possible to escape the virtualized stdio by
 // read/write directly to file descriptors 0, 1, 2.
-func fixStdio(interp *Interpreter) {
-	p := interp.binPkg["fmt"]
-	if p == nil {
-		return
+// The standard output is the one of the interpreter at the time of each write,
+// so it can be redirected, as by Examples.
+// Unless Options.SharedGlobals is set, the default logger and command line
+// flags are also replaced, see bindMethods.
+// Only the packages of the used values are redefined, so that the stdlib
+// packages exported separately are also redirected, and the state of the
+// others is preserved.
+func fixStdio(interp *Interpreter, values Exports) {
+	stdin, stderr := interp.stdin, interp.stderr
+
+	if p := interp.binPkg["fmt"]; p != nil && values["fmt"] != nil {
+		p["Print"] = reflect.ValueOf(func(a ...interface{}) (n int, err error) { return fmt.Fprint(interp.stdout, a...) })
+		p["Printf"] = reflect.ValueOf(func(f string, a ...interface{}) (n int, err error) { return fmt.Fprintf(interp.stdout, f, a...) })
+		p["Println"] = reflect.ValueOf(func(a ...interface{}) (n int, err error) { return fmt.Fprintln(interp.stdout, a...) })
+
+		p["Scan"] = reflect.ValueOf(func(a ...interface{}) (n int, err error) { return fmt.Fscan(stdin, a...) })
+		p["Scanf"] = reflect.ValueOf(func(f string, a ...interface{}) (n int, err error) { return fmt.Fscanf(stdin, f, a...) })
+		p["Scanln"] = reflect.ValueOf(func(a ...interface{}) (n int, err error) { return fmt.Fscanln(stdin, a...) })
 	}
 
-	stdin, stdout, stderr := interp.stdin, interp.stdout, interp.stderr
-
-	p["Print"] = reflect.ValueOf(func(a ...interface{}) (n int, err error) { return fmt.Fprint(stdout, a...) })
-	p["Printf"] = reflect.ValueOf(func(f string, a ...interface{}) (n int, err error) { return fmt.Fprintf(stdout, f, a...) })
-	p["Println"] = reflect.ValueOf(func(a ...interface{}) (n int, err error) { return fmt.Fprintln(stdout, a...) })
-
-	p["Scan"] = reflect.ValueOf(func(a ...interface{}) (n int, err error) { return fmt.Fscan(stdin, a...) })
-	p["Scanf"] = reflect.ValueOf(func(f string, a ...interface{}) (n int, err error) { return fmt.Fscanf(stdin, f, a...) })
-	p["Scanln"] = reflect.ValueOf(func(a ...interface{}) (n int, err error) { return fmt.Fscanln(stdin, a...) })
-
-	if p = interp.binPkg["flag"]; p != nil {
-		c := flag.NewFlagSet(os.Args[0], flag.PanicOnError)
+	if p := interp.binPkg["flag"]; p != nil && values["flag"] != nil && !interp.sharedGlobals {
+		name := os.Args[0]
+		if len(interp.args) > 0 {
+			name = interp.args[0]
+		}
+		c := flag.NewFlagSet(name, flag.PanicOnError)
 		c.SetOutput(stderr)
+		usage := func() {
+			fmt.Fprintf(c.Output(), "Usage of %s:\n", c.Name())
+			c.PrintDefaults()
+		}
+		c.Usage = func() { usage() }
 		p["CommandLine"] = reflect.ValueOf(&c).Elem()
+		p["Usage"] = reflect.ValueOf(&usage).Elem()
+		// The functions operating on flag.CommandLine operate on c instead.
+		bindMethods(p, values["flag"], reflect.ValueOf(c))
+		p["Parse"] = reflect.ValueOf(func() {
+			args := interp.args
+			switch {
+			case args == nil:
+				args = definedFlags(c, os.Args[1:])
+			case len(args) > 0:
+				args = args[1:]
+			}
+			_ = c.Parse(args)
+		})
 	}
 
-	if p = interp.binPkg["log"]; p != nil {
-		l := log.New(stderr, "", log.LstdFlags)
-		// Restrict Fatal symbols to panic instead of exit.
-		p["Fatal"] = reflect.ValueOf(l.Panic)
-		p["Fatalf"] = reflect.ValueOf(l.Panicf)
-		p["Fatalln"] = reflect.ValueOf(l.Panicln)
-
-		p["Flags"] = reflect.ValueOf(l.Flags)
-		p["Output"] = reflect.ValueOf(l.Output)
-		p["Panic"] = reflect.ValueOf(l.Panic)
-		p["Panicf"] = reflect.ValueOf(l.Panicf)
-		p["Panicln"] = reflect.ValueOf(l.Panicln)
-		p["Prefix"] = reflect.ValueOf(l.Prefix)
-		p["Print"] = reflect.ValueOf(l.Print)
-		p["Printf"] = reflect.ValueOf(l.Printf)
-		p["Println"] = reflect.ValueOf(l.Println)
-		p["SetFlags"] = reflect.ValueOf(l.SetFlags)
-		p["SetOutput"] = reflect.ValueOf(l.SetOutput)
-		p["SetPrefix"] = reflect.ValueOf(l.SetPrefix)
-		p["Writer"] = reflect.ValueOf(l.Writer)
-	}
-
-	if p = interp.binPkg["os"]; p != nil {
+	if p := interp.binPkg["log"]; p != nil && values["log"] != nil {
+		if interp.sharedGlobals {
+			// Restrict Fatal symbols to panic instead of exit.
+			p["Fatal"] = reflect.ValueOf(log.Panic)
+			p["Fatalf"] = reflect.ValueOf(log.Panicf)
+			p["Fatalln"] = reflect.ValueOf(log.Panicln)
+		} else {
+			l := log.New(stderr, "", log.LstdFlags)
+			// The functions operating on the default logger operate on l instead.
+			bindMethods(p, values["log"], reflect.ValueOf(l))
+			if _, ok := values["log"]["Default"]; ok {
+				p["Default"] = reflect.ValueOf(func() *log.Logger { return l })
+			}
+			// Restrict Fatal symbols to panic instead of exit.
+			p["Fatal"] = reflect.ValueOf(l.Panic)
+			p["Fatalf"] = reflect.ValueOf(l.Panicf)
+			p["Fatalln"] = reflect.ValueOf(l.Panicln)
+		}
+	}
+
+	if p := interp.binPkg["os"]; p != nil && values["os"] != nil {
 		p["Stdin"] = reflect.ValueOf(&stdin).Elem()
-		p["Stdout"] = reflect.ValueOf(&stdout).Elem()
+		p["Stdout"] = reflect.ValueOf(&interp.opt.stdout).Elem()
 		p["Stderr"] = reflect.ValueOf(&stderr).Elem()
+		if interp.args != nil {
+			p["Args"] = reflect.ValueOf(&interp.args).Elem()
+		}
+	}
+}
+
+// definedFlags returns the command line arguments args without the flags
+// which are not defined in c, with their values, up to the first non-flag
+// argument.
+func definedFlags(c *flag.FlagSet, args []string) []string {
+	var r []string
+	for i := 0; i < len(args); i++ {
+		a := args[i]
+		if len(a) < 2 || a[0] != '-' || a == "--" {
+			return append(r, args[i:]...)
+		}
+		name := strings.TrimLeft(a, "-")
+		hasValue := strings.Contains(name, "=")
+		if hasValue {
+			name = name[:strings.Index(name, "=")]
+		}
+		f := c.Lookup(name)
+		if f == nil {
+			continue
+		}
+		r = append(r, a)
+		if b, ok := f.Value.(interface{ IsBoolFlag() bool }); hasValue || ok && b.IsBoolFlag() || i+1 == len(args) {
+			continue
+		}
+		// The value of the flag is the next argument.
+		i++
+		r = append(r, args[i])
+	}
+	return r
+}
+
+// bindMethods redefines the functions of syms which have the same name and
+// type as a method of recv, such as the functions of a package operating on a
+// default instance, to call this method.
+func bindMethods(p map[string]reflect.Value, syms map[string]reflect.Value, recv reflect.Value) {
+	for name, v := range syms {
+		if v.Kind() != reflect.Func {
+			continue
+		}
+		if m := recv.MethodByName(name); m.IsValid() && m.Type() == v.Type() {
+			p[name] = m
+		}
 	}
 }
 
@@ -724,7 +1800,7 @@ func ignoreScannerError(e *scanner.Error, s string) bool {
 	if strings.HasSuffix(msg, "found 'EOF'") {
 		return true
 	}
-	if msg == "raw string literal not terminated" {
+	if msg == "raw string literal not terminated" || msg == "comment not terminated" {
 		return true
 	}
 	if strings.HasPrefix(msg, "expected operand, found '}'") && !strings.HasSuffix(s, "}") {
@@ -742,7 +1818,7 @@ func (interp *Interpreter) REPL() (reflect.Value, error) {
 	// in REPL mode. These packages are already loaded anyway.
 	sc := interp.universe
 	for k := range interp.binPkg {
-		name := identifier.FindString(k)
+		name := binPkgName(k)
 		if name == "" || name == "rand" || name == "scanner" || name == "template" || name == "pprof" {
 			// Skip any package with an ambiguous name (i.e crypto/rand vs math/rand).
 			// Those will have to be imported explicitly.
@@ -761,6 +1837,8 @@ func (interp *Interpreter) REPL() (reflect.Value, error) {
 	var v reflect.Value            // result value from eval
 	var err error                  // error from eval
 	src := ""                      // source string to evaluate
+	lineno := 0                    // number of input lines read so far
+	start := 1                     // input line number of the start of src
 
 	signal.Notify(sig, os.Interrupt)
 	defer signal.Stop(sig)
@@ -796,14 +1874,27 @@ func (interp *Interpreter) REPL() (reflect.Value, error) {
 			cancel()
 			return v, err
 		case line = <-lines:
+			lineno++
+			if src == "" {
+				start = lineno
+			}
 			src += line + "\n"
 		}
 
-		v, err = interp.EvalWithContext(ctx, src)
+		// Report positions relative to the whole input rather than to the
+		// current source chunk.
+		chunk := src
+		if start > 1 {
+			chunk = lineDirective(start) + src
+		}
+		v, err = interp.withContext(ctx, func() (reflect.Value, error) { return interp.evalAtomic(chunk) })
+		if err == nil && v.IsValid() {
+			interp.bindResult(v)
+		}
 		if err != nil {
 			switch e := err.(type) {
 			case scanner.ErrorList:
-				if len(e) > 0 && ignoreScannerError(e[0], line) {
+				if IsIncomplete(src) {
 					continue
 				}
 				fmt.Fprintln(errs, strings.TrimPrefix(e[0].Error(), DefaultSourceName+":"))
@@ -822,6 +1913,35 @@ func (interp *Interpreter) REPL() (reflect.Value, error) {
 	}
 }
 
+// bindResult binds the REPL result v to the blank identifier in the main
+// package scope, so it can be used as a value in the next inputs. The previous
+// results are also bound to _1, _2, ... up to the configured history size,
+// _1 being the most recent, identical to _. Results are held by their symbols,
+// as binary values, so the global frame is not modified.
+func (interp *Interpreter) bindResult(v reflect.Value) {
+	interp.mutex.Lock()
+	defer interp.mutex.Unlock()
+
+	sc := interp.scopes[mainID]
+	if sc == nil {
+		return
+	}
+	for i := interp.replHistory; i > 1; i-- {
+		if sym := sc.sym["_"+strconv.Itoa(i-1)]; sym != nil {
+			sc.sym["_"+strconv.Itoa(i)] = sym
+		}
+	}
+
+	// Copy the result, which may refer to a reusable frame location.
+	rval := reflect.New(v.Type()).Elem()
+	rval.Set(v)
+	sym := &symbol{kind: binSym, typ: &itype{cat: valueT, rtype: v.Type(), scope: sc}, rval: rval}
+	sc.sym["_"] = sym
+	if interp.replHistory > 0 {
+		sc.sym["_1"] = sym
+	}
+}
+
 func doPrompt(out io.Writer) func(v reflect.Value) {
 	return func(v reflect.Value) {
 		if v.IsValid() {
@@ -42,32 +42,32 @@ func TestEvalArithmetic(t *testing.T) {
 		{desc: "add_FI", src: "2.3 + 3", res: "5.3"},
 		{desc: "add_IF", src: "2 + 3.3", res: "5.3"},
 		{desc: "add_SS", src: `"foo" + "bar"`, res: "foobar"},
-		{desc: "add_SI", src: `"foo" + 1`, err: "1:28: invalid operation: mismatched types string and int"},
-		{desc: "sub_SS", src: `"foo" - "bar"`, err: "1:28: invalid operation: operator - not defined on string"},
+		{desc: "add_SI", src: `"foo" + 1`, err: "1:1: invalid operation: mismatched types string and int"},
+		{desc: "sub_SS", src: `"foo" - "bar"`, err: "1:1: invalid operation: operator - not defined on string"},
 		{desc: "sub_II", src: "7 - 3", res: "4"},
 		{desc: "sub_FI", src: "7.2 - 3", res: "4.2"},
 		{desc: "sub_IF", src: "7 - 3.2", res: "3.8"},
 		{desc: "mul_II", src: "2 * 3", res: "6"},
 		{desc: "mul_FI", src: "2.2 * 3", res: "6.6"},
 		{desc: "mul_IF", src: "3 * 2.2", res: "6.6"},
-		{desc: "quo_Z", src: "3 / 0", err: "1:28: invalid operation: division by zero"},
-		{desc: "rem_FI", src: "8.2 % 4", err: "1:28: invalid operation: operator % not defined on float64"},
-		{desc: "rem_Z", src: "8 % 0", err: "1:28: invalid operation: division by zero"},
+		{desc: "quo_Z", src: "3 / 0", err: "1:1: invalid operation: division by zero"},
+		{desc: "rem_FI", src: "8.2 % 4", err: "1:1: invalid operation: operator % not defined on float64"},
+		{desc: "rem_Z", src: "8 % 0", err: "1:1: invalid operation: division by zero"},
 		{desc: "shl_II", src: "1 << 8", res: "256"},
-		{desc: "shl_IN", src: "1 << -1", err: "1:28: invalid operation: shift count type int, must be integer"},
+		{desc: "shl_IN", src: "1 << -1", err: "1:1: invalid operation: shift count type int, must be integer"},
 		{desc: "shl_IF", src: "1 << 1.0", res: "2"},
-		{desc: "shl_IF1", src: "1 << 1.1", err: "1:28: invalid operation: shift count type float64, must be integer"},
+		{desc: "shl_IF1", src: "1 << 1.1", err: "1:1: invalid operation: shift count type float64, must be integer"},
 		{desc: "shl_IF2", src: "1.0 << 1", res: "2"},
 		{desc: "shr_II", src: "1 >> 8", res: "0"},
-		{desc: "shr_IN", src: "1 >> -1", err: "1:28: invalid operation: shift count type int, must be integer"},
+		{desc: "shr_IN", src: "1 >> -1", err: "1:1: invalid operation: shift count type int, must be integer"},
 		{desc: "shr_IF", src: "1 >> 1.0", res: "0"},
-		{desc: "shr_IF1", src: "1 >> 1.1", err: "1:28: invalid operation: shift count type float64, must be integer"},
+		{desc: "shr_IF1", src: "1 >> 1.1", err: "1:1: invalid operation: shift count type float64, must be integer"},
 		{desc: "neg_I", src: "-2", res: "-2"},
 		{desc: "pos_I", src: "+2", res: "2"},
 		{desc: "bitnot_I", src: "^2", res: "-3"},
-		{desc: "bitnot_F", src: "^0.2", err: "1:28: invalid operation: operator ^ not defined on float64"},
+		{desc: "bitnot_F", src: "^0.2", err: "1:1: invalid operation: operator ^ not defined on float64"},
 		{desc: "not_B", src: "!false", res: "true"},
-		{desc: "not_I", src: "!0", err: "1:28: invalid operation: operator ! not defined on int"},
+		{desc: "not_I", src: "!0", err: "1:1: invalid operation: operator ! not defined on int"},
 	})
 }
 
@@ -75,7 +75,7 @@ func TestEvalStar(t *testing.T) {
 	i := interp.New(interp.Options{})
 	runTests(t, i, []testCase{
 		{src: `a := &struct{A int}{1}; b := *a`, res: "{1}"},
-		{src: `a := struct{A int}{1}; b := *a`, err: "1:57: invalid operation: cannot indirect \"a\""},
+		{src: `a := struct{A int}{1}; b := *a`, err: "1:30: invalid operation: cannot indirect \"a\""},
 	})
 }
 
@@ -83,10 +83,10 @@ func TestEvalAssign(t *testing.T) {
 	i := interp.New(interp.Options{})
 	runTests(t, i, []testCase{
 		{src: `a := "Hello"; a += " world"`, res: "Hello world"},
-		{src: `b := "Hello"; b += 1`, err: "1:42: invalid operation: mismatched types string and int"},
-		{src: `c := "Hello"; c -= " world"`, err: "1:42: invalid operation: operator -= not defined on string"},
-		{src: "e := 64.4; e %= 64", err: "1:39: invalid operation: operator %= not defined on float64"},
-		{src: "f := int64(3.2)", err: "1:39: cannot convert expression of type float64 to type int64"},
+		{src: `b := "Hello"; b += 1`, err: "1:15: invalid operation: mismatched types string and int"},
+		{src: `c := "Hello"; c -= " world"`, err: "1:15: invalid operation: operator -= not defined on string"},
+		{src: "e := 64.4; e %= 64", err: "1:12: invalid operation: operator %= not defined on float64"},
+		{src: "f := int64(3.2)", err: "1:12: cannot convert expression of type float64 to type int64"},
 		{src: "g := 1; g <<= 8", res: "256"},
 		{src: "h := 1; h >>= 8", res: "0"},
 	})
@@ -100,39 +100,39 @@ func TestEvalBuiltin(t *testing.T) {
 		{src: `c := []int{1}; d := []int{2, 3}; c = append(c, d...); c`, res: "[1 2 3]"},
 		{src: `string(append([]byte("hello "), "world"...))`, res: "hello world"},
 		{src: `e := "world"; string(append([]byte("hello "), e...))`, res: "hello world"},
-		{src: `b := []int{1}; b = append(1, 2, 3); b`, err: "1:54: first argument to append must be slice; have int"},
+		{src: `b := []int{1}; b = append(1, 2, 3); b`, err: "1:27: first argument to append must be slice; have int"},
 		{src: `g := len(a)`, res: "1"},
 		{src: `g := cap(a)`, res: "1"},
 		{src: `g := len("test")`, res: "4"},
 		{src: `g := len(map[string]string{"a": "b"})`, res: "1"},
 		{src: `a := len()`, err: "not enough arguments in call to len"},
 		{src: `a := len([]int, 0)`, err: "too many arguments for len"},
-		{src: `g := cap("test")`, err: "1:37: invalid argument for cap"},
-		{src: `g := cap(map[string]string{"a": "b"})`, err: "1:37: invalid argument for cap"},
+		{src: `g := cap("test")`, err: "1:10: invalid argument for cap"},
+		{src: `g := cap(map[string]string{"a": "b"})`, err: "1:10: invalid argument for cap"},
 		{src: `h := make(chan int, 1); close(h); len(h)`, res: "0"},
-		{src: `close(a)`, err: "1:34: invalid operation: non-chan type []int"},
-		{src: `h := make(chan int, 1); var i <-chan int = h; close(i)`, err: "1:80: invalid operation: cannot close receive-only channel"},
+		{src: `close(a)`, err: "1:7: invalid operation: non-chan type []int"},
+		{src: `h := make(chan int, 1); var i <-chan int = h; close(i)`, err: "1:53: invalid operation: cannot close receive-only channel"},
 		{src: `j := make([]int, 2)`, res: "[0 0]"},
 		{src: `j := make([]int, 2, 3)`, res: "[0 0]"},
-		{src: `j := make(int)`, err: "1:38: cannot make int; type must be slice, map, or channel"},
-		{src: `j := make([]int)`, err: "1:33: not enough arguments in call to make"},
-		{src: `j := make([]int, 0, 1, 2)`, err: "1:33: too many arguments for make"},
-		{src: `j := make([]int, 2, 1)`, err: "1:33: len larger than cap in make"},
-		{src: `j := make([]int, "test")`, err: "1:45: cannot convert \"test\" to int"},
+		{src: `j := make(int)`, err: "1:11: cannot make int; type must be slice, map, or channel"},
+		{src: `j := make([]int)`, err: "1:6: not enough arguments in call to make"},
+		{src: `j := make([]int, 0, 1, 2)`, err: "1:6: too many arguments for make"},
+		{src: `j := make([]int, 2, 1)`, err: "1:6: len larger than cap in make"},
+		{src: `j := make([]int, "test")`, err: "1:18: cannot convert \"test\" to int"},
 		{src: `k := []int{3, 4}; copy(k, []int{1,2}); k`, res: "[1 2]"},
 		{src: `f := []byte("Hello"); copy(f, "world"); string(f)`, res: "world"},
-		{src: `copy(g, g)`, err: "1:28: copy expects slice arguments"},
-		{src: `copy(a, "world")`, err: "1:28: arguments to copy have different element types []int and string"},
+		{src: `copy(g, g)`, err: "1:1: copy expects slice arguments"},
+		{src: `copy(a, "world")`, err: "1:1: arguments to copy have different element types []int and string"},
 		{src: `l := map[string]int{"a": 1, "b": 2}; delete(l, "a"); l`, res: "map[b:2]"},
-		{src: `delete(a, 1)`, err: "1:35: first argument to delete must be map; have []int"},
-		{src: `l := map[string]int{"a": 1, "b": 2}; delete(l, 1)`, err: "1:75: cannot use int as type string in delete"},
+		{src: `delete(a, 1)`, err: "1:8: first argument to delete must be map; have []int"},
+		{src: `l := map[string]int{"a": 1, "b": 2}; delete(l, 1)`, err: "1:48: cannot use int as type string in delete"},
 		{src: `a := []int{1,2}; println(a...)`, err: "invalid use of ... with builtin println"},
 		{src: `m := complex(3, 2); real(m)`, res: "3"},
 		{src: `m := complex(3, 2); imag(m)`, res: "2"},
-		{src: `m := complex("test", 2)`, err: "1:33: invalid types string and int"},
-		{src: `imag("test")`, err: "1:33: cannot convert \"test\" to complex128"},
-		{src: `imag(a)`, err: "1:33: invalid argument type []int for imag"},
-		{src: `real(a)`, err: "1:33: invalid argument type []int for real"},
+		{src: `m := complex("test", 2)`, err: "1:6: invalid types string and int"},
+		{src: `imag("test")`, err: "1:6: cannot convert \"test\" to complex128"},
+		{src: `imag(a)`, err: "1:6: invalid argument type []int for imag"},
+		{src: `real(a)`, err: "1:6: invalid argument type []int for real"},
 	})
 }
 
@@ -185,7 +185,7 @@ func TestEvalNil(t *testing.T) {
 	i := interp.New(interp.Options{})
 	i.Use(stdlib.Symbols)
 	runTests(t, i, []testCase{
-		{desc: "assign nil", src: "a := nil", err: "1:33: use of untyped nil"},
+		{desc: "assign nil", src: "a := nil", err: "1:6: use of untyped nil"},
 		{desc: "return nil", pre: func() { eval(t, i, "func getNil() error {return nil}") }, src: "getNil()", res: "<nil>"},
 		{
 			desc: "return func which return error",
@@ -376,13 +376,13 @@ func TestEvalCompositeArray(t *testing.T) {
 	eval(t, i, `const l = 10`)
 	runTests(t, i, []testCase{
 		{src: "a := []int{1, 2, 7: 20, 30}", res: "[1 2 0 0 0 0 0 20 30]"},
-		{src: `a := []int{1, 1.2}`, err: "1:42: 6/5 truncated to int"},
-		{src: `a := []int{0:1, 0:1}`, err: "1:46: duplicate index 0 in array or slice literal"},
-		{src: `a := []int{1.1:1, 1.2:"test"}`, err: "1:39: index float64 must be integer constant"},
-		{src: `a := [2]int{1, 1.2}`, err: "1:43: 6/5 truncated to int"},
-		{src: `a := [1]int{1, 2}`, err: "1:43: index 1 is out of bounds (>= 1)"},
+		{src: `a := []int{1, 1.2}`, err: "1:15: 6/5 truncated to int"},
+		{src: `a := []int{0:1, 0:1}`, err: "1:19: duplicate index 0 in array or slice literal"},
+		{src: `a := []int{1.1:1, 1.2:"test"}`, err: "1:12: index float64 must be integer constant"},
+		{src: `a := [2]int{1, 1.2}`, err: "1:16: 6/5 truncated to int"},
+		{src: `a := [1]int{1, 2}`, err: "1:16: index 1 is out of bounds (>= 1)"},
 		{src: `b := [l]int{1, 2}`, res: "[1 2 0 0 0 0 0 0 0 0]"},
-		{src: `i := 10; a := [i]int{1, 2}`, err: "1:43: non-constant array bound \"i\""},
+		{src: `i := 10; a := [i]int{1, 2}`, err: "1:16: non-constant array bound \"i\""},
 	})
 }
 
@@ -390,10 +390,10 @@ func TestEvalCompositeMap(t *testing.T) {
 	i := interp.New(interp.Options{})
 	runTests(t, i, []testCase{
 		{src: `a := map[string]int{"one":1, "two":2}`, res: "map[one:1 two:2]"},
-		{src: `a := map[string]int{1:1, 2:2}`, err: "1:48: cannot convert 1 to string"},
-		{src: `a := map[string]int{"one":1, "two":2.2}`, err: "1:63: 11/5 truncated to int"},
-		{src: `a := map[string]int{1, "two":2}`, err: "1:48: missing key in map literal"},
-		{src: `a := map[string]int{"one":1, "one":2}`, err: "1:57: duplicate key one in map literal"},
+		{src: `a := map[string]int{1:1, 2:2}`, err: "1:21: cannot convert 1 to string"},
+		{src: `a := map[string]int{"one":1, "two":2.2}`, err: "1:36: 11/5 truncated to int"},
+		{src: `a := map[string]int{1, "two":2}`, err: "1:21: missing key in map literal"},
+		{src: `a := map[string]int{"one":1, "one":2}`, err: "1:30: duplicate key one in map literal"},
 	})
 }
 
@@ -402,16 +402,16 @@ func TestEvalCompositeStruct(t *testing.T) {
 	runTests(t, i, []testCase{
 		{src: `a := struct{A,B,C int}{}`, res: "{0 0 0}"},
 		{src: `a := struct{A,B,C int}{1,2,3}`, res: "{1 2 3}"},
-		{src: `a := struct{A,B,C int}{1,2.2,3}`, err: "1:53: 11/5 truncated to int"},
-		{src: `a := struct{A,B,C int}{1,2}`, err: "1:53: too few values in struct literal"},
-		{src: `a := struct{A,B,C int}{1,2,3,4}`, err: "1:57: too many values in struct literal"},
-		{src: `a := struct{A,B,C int}{1,B:2,3}`, err: "1:53: mixture of field:value and value elements in struct literal"},
+		{src: `a := struct{A,B,C int}{1,2.2,3}`, err: "1:26: 11/5 truncated to int"},
+		{src: `a := struct{A,B,C int}{1,2}`, err: "1:26: too few values in struct literal"},
+		{src: `a := struct{A,B,C int}{1,2,3,4}`, err: "1:30: too many values in struct literal"},
+		{src: `a := struct{A,B,C int}{1,B:2,3}`, err: "1:26: mixture of field:value and value elements in struct literal"},
 		{src: `a := struct{A,B,C int}{A:1,B:2,C:3}`, res: "{1 2 3}"},
 		{src: `a := struct{A,B,C int}{B:2}`, res: "{0 2 0}"},
-		{src: `a := struct{A,B,C int}{A:1,D:2,C:3}`, err: "1:55: unknown field D in struct literal"},
-		{src: `a := struct{A,B,C int}{A:1,A:2,C:3}`, err: "1:55: duplicate field name A in struct literal"},
-		{src: `a := struct{A,B,C int}{A:1,B:2.2,C:3}`, err: "1:57: 11/5 truncated to int"},
-		{src: `a := struct{A,B,C int}{A:1,2,C:3}`, err: "1:55: mixture of field:value and value elements in struct literal"},
+		{src: `a := struct{A,B,C int}{A:1,D:2,C:3}`, err: "1:28: unknown field D in struct literal"},
+		{src: `a := struct{A,B,C int}{A:1,A:2,C:3}`, err: "1:28: duplicate field name A in struct literal"},
+		{src: `a := struct{A,B,C int}{A:1,B:2.2,C:3}`, err: "1:30: 11/5 truncated to int"},
+		{src: `a := struct{A,B,C int}{A:1,2,C:3}`, err: "1:28: mixture of field:value and value elements in struct literal"},
 	})
 }
 
@@ -430,14 +430,14 @@ func TestEvalSliceExpression(t *testing.T) {
 		{src: `s := "hello"[1:3]`, res: "el"},
 		{src: `str := "hello"
 			   s := str[1:3]`, res: "el"},
-		{src: `a := int(1)[0:1]`, err: "1:33: cannot slice type int"},
-		{src: `a := ([3]int{0,1,2})[1:3]`, err: "1:33: cannot slice type [3]int"},
-		{src: `a := (&[]int{0,1,2,3})[1:3]`, err: "1:33: cannot slice type *[]int"},
-		{src: `a := "hello"[1:3:4]`, err: "1:45: invalid operation: 3-index slice of string"},
+		{src: `a := int(1)[0:1]`, err: "1:6: cannot slice type int"},
+		{src: `a := ([3]int{0,1,2})[1:3]`, err: "1:6: cannot slice type [3]int"},
+		{src: `a := (&[]int{0,1,2,3})[1:3]`, err: "1:6: cannot slice type *[]int"},
+		{src: `a := "hello"[1:3:4]`, err: "1:18: invalid operation: 3-index slice of string"},
 		{src: `ar := [3]int{0,1,2}
 			   a := ar[:4]`, err: "2:16: index int is out of bounds"},
-		{src: `a := []int{0,1,2,3}[1::4]`, err: "1:49: 2nd index required in 3-index slice"},
-		{src: `a := []int{0,1,2,3}[1:3:]`, err: "1:51: 3rd index required in 3-index slice"},
+		{src: `a := []int{0,1,2,3}[1::4]`, err: "1:22: 2nd index required in 3-index slice"},
+		{src: `a := []int{0,1,2,3}[1:3:]`, err: "1:24: 3rd index required in 3-index slice"},
 		{src: `a := []int{0,1,2}[3:1]`, err: "invalid index values, must be low <= high <= max"},
 	})
 }
@@ -448,7 +448,7 @@ func TestEvalConversion(t *testing.T) {
 		{src: `a := uint64(1)`, res: "1"},
 		{src: `i := 1.1; a := uint64(i)`, res: "1"},
 		{src: `b := string(49)`, res: "1"},
-		{src: `c := uint64(1.1)`, err: "1:40: cannot convert expression of type float64 to type uint64"},
+		{src: `c := uint64(1.1)`, err: "1:13: cannot convert expression of type float64 to type uint64"},
 	})
 }
 
@@ -594,7 +594,7 @@ func TestEvalBinCall(t *testing.T) {
 	}
 	runTests(t, i, []testCase{
 		{src: `a := fmt.Sprint(1, 2.3)`, res: "1 2.3"},
-		{src: `a := fmt.Sprintf()`, err: "1:33: not enough arguments in call to fmt.Sprintf"},
+		{src: `a := fmt.Sprintf()`, err: "1:6: not enough arguments in call to fmt.Sprintf"},
 		{src: `i := 1
 			   a := fmt.Sprintf(i)`, err: "2:24: cannot use type int as type string"},
 		{src: `a := fmt.Sprint()`, res: ""},
@@ -906,7 +906,7 @@ func TestImportPathIsKey(t *testing.T) {
 		}
 	}
 
-	packages := i.Packages()
+	packages := i.PackageNames()
 	if len(packages) != len(wantPackages) {
 		t.Fatalf("want %d, got %d", len(wantPackages), len(packages))
 	}
@@ -1159,6 +1159,63 @@ func testConcurrentComposite(t *testing.T, filePath string) {
 	}
 }
 
+func TestEvalInto(t *testing.T) {
+	i := interp.New(interp.Options{})
+	i.Use(stdlib.Symbols)
+	eval(t, i, `
+import "io"
+
+var count int
+
+type T struct{}
+
+func (T) Read(b []byte) (int, error) { return 0, io.EOF }
+
+func double(a int) int { return 2 * a }
+`)
+
+	var f float64
+	if err := i.EvalInto("1 + 2", &f); err != nil || f != 3 {
+		t.Errorf("got %v, %v, want 3", f, err)
+	}
+
+	var fn func(int) int
+	if err := i.EvalInto("double", &fn); err != nil || fn(3) != 6 {
+		t.Errorf("got %v, want a function doubling its input", err)
+	}
+
+	var r io.Reader
+	if err := i.EvalInto("T{}", &r); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := r.Read(nil); err != io.EOF {
+		t.Errorf("got %v, want EOF", err)
+	}
+
+	// Incompatible sources are rejected before being executed.
+	var n int
+	var i32 int32
+	var w io.Writer
+	for _, test := range []struct {
+		src string
+		dst interface{}
+		err string
+	}{
+		{src: "count = 1\n\"x\"", dst: &n, err: "2:1: cannot convert \"x\" to int"},
+		{src: "count = 1\n1 << 40", dst: &i32, err: "2:1: 1099511627776 overflows int32"},
+		{src: "count = 1\nT{}", dst: &w, err: "2:1: cannot use type main.T as type io.Writer in assignment"},
+		{src: "count = 1", dst: n, err: "invalid destination int: not a non nil pointer"},
+	} {
+		err := i.EvalInto(test.src, test.dst)
+		if err == nil || err.Error() != test.err {
+			t.Errorf("%q: got error %v, want %s", test.src, err, test.err)
+		}
+	}
+	if v := eval(t, i, "count"); v.Interface() != 0 {
+		t.Errorf("got count %v, want 0", v)
+	}
+}
+
 func TestEvalScanner(t *testing.T) {
 	type testCase struct {
 		desc      string
@@ -1288,6 +1345,60 @@ func TestEvalScanner(t *testing.T) {
 	}
 }
 
+func TestEvalPositions(t *testing.T) {
+	i := interp.New(interp.Options{})
+
+	// Positions in wrapped statements and declarations refer to the user input.
+	for src, want := range map[string]string{
+		`a := 1 + "x"`:             "1:6: invalid operation: mismatched types int and string",
+		"a := 1\nb := 2 + \"x\"":   "2:6: invalid operation: mismatched types int and string",
+		`var a int = "x"`:          "1:13: cannot convert \"x\" to int",
+		`func g() { _ = 1 + "x" }`: "1:16: invalid operation: mismatched types int and string",
+		`println(/b)`:              "_.go:1:9: expected operand, found '/'",
+	} {
+		_, err := i.Eval(src)
+		if err == nil || err.Error() != want {
+			t.Errorf("%q: got error %v, want %s", src, err, want)
+		}
+	}
+
+	_, err := i.Eval("a := 1\n_ = a\nfunc() { panic(a) }()")
+	p, ok := err.(interp.Panic)
+	if !ok {
+		t.Fatalf("got %v, want panic", err)
+	}
+	if want := "func literal _.go:3:10"; len(p.Trace) == 0 || p.Trace[0] != want {
+		t.Errorf("got trace %q, want %s as first entry", p.Trace, want)
+	}
+
+	// The REPL reports positions relative to the whole input.
+	var stderr bytes.Buffer
+	i = interp.New(interp.Options{Stdin: strings.NewReader("a := 1\nfunc g() {\n_ = 1 + \"x\"\n}\n"), Stderr: &stderr})
+	_, _ = i.REPL()
+	if got, want := stderr.String(), "3:5: invalid operation: mismatched types int and string\n"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func TestREPLResult(t *testing.T) {
+	_ = os.Setenv("YAEGI_PROMPT", "1")
+	defer func() {
+		_ = os.Setenv("YAEGI_PROMPT", "0")
+	}()
+
+	src := "1 + 2\n_ * 10\n\"s\"\n_2 + _3\nx, _ := _, 0\nfor _, c := range \"a\" { x += int(c) }\nx\n"
+	var stdout, stderr bytes.Buffer
+	i := interp.New(interp.Options{Stdin: strings.NewReader(src), Stdout: &stdout, Stderr: &stderr, REPLHistory: 3})
+	_, _ = i.REPL()
+	if stderr.Len() > 0 {
+		t.Fatal(stderr.String())
+	}
+	want := "> : 3\n> : 30\n> : s\n> : 33\n"
+	if got := stdout.String(); !strings.HasPrefix(got, want) || !strings.HasSuffix(got, "> : 130\n> ") {
+		t.Errorf("got %q, want %q ... %q", got, want, "> : 130\n> ")
+	}
+}
+
 type safeBuffer struct {
 	mu  sync.RWMutex
 	buf *bytes.Buffer
@@ -188,3 +188,22 @@ func TestIsNatural(t *testing.T) {
 		}
 	}
 }
+
+func TestEvalRoots(t *testing.T) {
+	i := New(Options{})
+	if _, err := i.Eval(`func f() int { return 1 }`); err != nil {
+		t.Fatal(err)
+	}
+	for k := 0; k < 10; k++ {
+		if _, err := i.Eval(`f()`); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if n := len(i.roots[mainID]); n != 2 {
+		t.Fatalf("got %d roots, want 2", n)
+	}
+	edges := i.CallGraph()
+	if len(edges) != 1 || edges[0].Caller != "main.init" || edges[0].Callee != "main.f" {
+		t.Fatalf("unexpected call graph %v", edges)
+	}
+}
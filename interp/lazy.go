@@ -0,0 +1,135 @@
+package interp
+
+import "sort"
+
+// States of functions declarations of imported packages, whose compilation is
+// delayed until they are referenced.
+const (
+	funcLazy      = iota // not compiled
+	funcCompiling        // being compiled
+	funcCompiled         // compiled on demand
+	funcSkipped          // never compiled, see skipFunc
+)
+
+// deferFuncs delays the compilation of the functions declared in root, which
+// belongs to the imported package importPath, until they are referenced.
+// Their signatures are already known from the global types analysis.
+// Methods, which may be called through interfaces, init and main functions
+// are always compiled. As function declarations have no side effect, the
+// bodies of the functions not used by the program are never compiled.
+func (interp *Interpreter) deferFuncs(root *node, importPath string) {
+	interp.lazyMutex.Lock()
+	defer interp.lazyMutex.Unlock()
+
+	if interp.lazy == nil {
+		interp.lazy = map[*node]*lazyFunc{}
+	}
+	for _, n := range root.child {
+		if n.kind != funcDecl || isMethod(n) || len(n.child) < 4 {
+			continue
+		}
+		if name := n.child[1].ident; name == "init" || name == mainID {
+			continue
+		}
+		interp.lazy[n] = &lazyFunc{path: importPath}
+	}
+}
+
+// skipFunc excludes the function declaration n of the package importPath from
+// compilation, as for methods of source types bridged to binary ones, which
+// are provided by the binary types.
+func (interp *Interpreter) skipFunc(n *node, importPath string) {
+	interp.lazyMutex.Lock()
+	defer interp.lazyMutex.Unlock()
+
+	if interp.lazy == nil {
+		interp.lazy = map[*node]*lazyFunc{}
+	}
+	interp.lazy[n] = &lazyFunc{path: importPath, state: funcSkipped}
+}
+
+// lazyFunc is a function declaration whose compilation is delayed.
+type lazyFunc struct {
+	path  string // import path of the package
+	state int
+	err   error // compilation error
+}
+
+// isDeferred returns true if the compilation of the function declaration n is
+// handled by compileFunc, and must be skipped when compiling its package.
+func (interp *Interpreter) isDeferred(n *node) bool {
+	interp.lazyMutex.Lock()
+	defer interp.lazyMutex.Unlock()
+
+	f, ok := interp.lazy[n]
+	return ok && f.state != funcCompiling
+}
+
+// compileFunc compiles the function declaration n if its compilation was
+// delayed, and returns the compilation error if any.
+func (interp *Interpreter) compileFunc(n *node) error {
+	interp.lazyMutex.Lock()
+	f, ok := interp.lazy[n]
+	if !ok || f.state != funcLazy {
+		var err error
+		if ok {
+			err = f.err
+		}
+		interp.lazyMutex.Unlock()
+		return err
+	}
+	f.state = funcCompiling
+	interp.lazyMutex.Unlock()
+
+	_, err := interp.cfg(n, f.path)
+	if err == nil {
+		err = genRun(n)
+	}
+
+	interp.lazyMutex.Lock()
+	f.state, f.err = funcCompiled, err
+	interp.lazyMutex.Unlock()
+	return err
+}
+
+// compileDeferred compiles the delayed functions of the package importPath,
+// or of all packages if importPath is empty, in source order. If exported is
+// true, only the exported functions are compiled. The first error, if any, is
+// returned.
+func (interp *Interpreter) compileDeferred(importPath string, exported bool) (err error) {
+	for _, n := range interp.deferredFuncs(importPath, exported) {
+		if e := interp.compileFunc(n); err == nil {
+			err = e
+		}
+	}
+	return err
+}
+
+// compileDeferredErrs compiles the delayed functions of the package
+// importPath, in source order, and accumulates their errors in errs until
+// its maximum is reached.
+func (interp *Interpreter) compileDeferredErrs(importPath string, errs *errorList) {
+	for _, n := range interp.deferredFuncs(importPath, false) {
+		if err := interp.compileFunc(n); err != nil && !errs.add(err) {
+			return
+		}
+	}
+}
+
+// deferredFuncs returns the delayed functions of the package importPath, or
+// of all packages if importPath is empty, in source order. If exported is
+// true, only the exported functions are returned.
+func (interp *Interpreter) deferredFuncs(importPath string, exported bool) []*node {
+	var funcs []*node
+	interp.lazyMutex.Lock()
+	for n, f := range interp.lazy {
+		if f.state != funcLazy || importPath != "" && f.path != importPath || exported && !canExport(n.child[1].ident) {
+			continue
+		}
+		funcs = append(funcs, n)
+	}
+	interp.lazyMutex.Unlock()
+
+	sort.Slice(funcs, func(i, j int) bool { return funcs[i].pos < funcs[j].pos })
+	return funcs
+}
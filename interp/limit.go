@@ -0,0 +1,140 @@
+package interp
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+	"time"
+)
+
+// Limits defines the limits applied to calls of host functions by Limit.
+// A zero field means no limit.
+type Limits struct {
+	// Rate is the maximum sustained number of calls per second.
+	Rate float64
+
+	// Burst is the maximum number of calls which can be made at once above
+	// Rate. It defaults to 1 if Rate is set.
+	Burst int
+
+	// MaxConcurrent is the maximum number of calls running at the same time.
+	MaxConcurrent int
+}
+
+// LimitError is the error returned to interpreted code when a call to a
+// limited host function exceeds its limits.
+type LimitError struct {
+	Func   string // qualified name of the called function
+	Reason string // "rate" or "concurrency"
+}
+
+func (e *LimitError) Error() string {
+	return fmt.Sprintf("%s: %s limit exceeded", e.Func, e.Reason)
+}
+
+// Limit returns a copy of values in which each function is wrapped to enforce
+// the limits l, shared by all the wrapped functions. The result is meant to be
+// passed to Interpreter.Use. Using a distinct Limit result per interpreter
+// gives per interpreter limits.
+//
+// When a limit is exceeded, the function is not called. If its last result is
+// an error, it returns a *LimitError with zero values for the other results,
+// otherwise it panics with a *LimitError, which can be recovered by the script.
+//
+// Only package level functions are wrapped: methods, and variables of function
+// type are left unchanged.
+func Limit(values Exports, l Limits) Exports {
+	lim := newLimiter(l)
+	res := make(Exports, len(values))
+	for p, syms := range values {
+		m := make(map[string]reflect.Value, len(syms))
+		for name, v := range syms {
+			if p != selfPrefix && v.Kind() == reflect.Func && !v.CanAddr() {
+				v = lim.wrap(p+"."+name, v)
+			}
+			m[name] = v
+		}
+		res[p] = m
+	}
+	return res
+}
+
+// limiter implements a token bucket rate limiter and a concurrency cap.
+type limiter struct {
+	Limits
+	sem chan struct{}
+
+	mu     sync.Mutex
+	tokens float64
+	last   time.Time
+}
+
+func newLimiter(l Limits) *limiter {
+	if l.Rate > 0 && l.Burst < 1 {
+		l.Burst = 1
+	}
+	lim := &limiter{Limits: l, tokens: float64(l.Burst)}
+	if l.MaxConcurrent > 0 {
+		lim.sem = make(chan struct{}, l.MaxConcurrent)
+	}
+	return lim
+}
+
+// allow reports whether a call is allowed by the rate limit.
+func (lim *limiter) allow() bool {
+	if lim.Rate <= 0 {
+		return true
+	}
+	lim.mu.Lock()
+	defer lim.mu.Unlock()
+
+	now := time.Now()
+	if !lim.last.IsZero() {
+		lim.tokens += now.Sub(lim.last).Seconds() * lim.Rate
+		if max := float64(lim.Burst); lim.tokens > max {
+			lim.tokens = max
+		}
+	}
+	lim.last = now
+	if lim.tokens < 1 {
+		return false
+	}
+	lim.tokens--
+	return true
+}
+
+// wrap returns a function of the same type as fn which enforces the limits.
+func (lim *limiter) wrap(name string, fn reflect.Value) reflect.Value {
+	t := fn.Type()
+	fail := func(reason string) []reflect.Value {
+		var err error = &LimitError{Func: name, Reason: reason}
+		n := t.NumOut()
+		if n == 0 || t.Out(n-1) != errorType {
+			panic(err)
+		}
+		out := make([]reflect.Value, n)
+		for i := range out[:n-1] {
+			out[i] = reflect.Zero(t.Out(i))
+		}
+		out[n-1] = reflect.ValueOf(&err).Elem()
+		return out
+	}
+
+	return reflect.MakeFunc(t, func(in []reflect.Value) []reflect.Value {
+		if lim.sem != nil {
+			select {
+			case lim.sem <- struct{}{}:
+				defer func() { <-lim.sem }()
+			default:
+				return fail("concurrency")
+			}
+		}
+		if !lim.allow() {
+			return fail("rate")
+		}
+		if t.IsVariadic() {
+			return fn.CallSlice(in)
+		}
+		return fn.Call(in)
+	})
+}
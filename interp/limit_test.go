@@ -0,0 +1,66 @@
+package interp_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/traefik/yaegi/interp"
+)
+
+func TestLimit(t *testing.T) {
+	calls := 0
+	host := interp.Exports{
+		"host/db": {
+			"Query": reflect.ValueOf(func(q string) (string, error) { calls++; return "ok: " + q, nil }),
+			"Count": reflect.ValueOf(func() int { calls++; return calls }),
+		},
+	}
+
+	i := interp.New(interp.Options{})
+	i.Use(interp.Symbols)
+	i.Use(interp.Limit(host, interp.Limits{Rate: 0.001, Burst: 2}))
+
+	eval(t, i, `import "host/db"`)
+	assertEval(t, i, `r, _ := db.Query("a"); r`, "", "ok: a")
+	assertEval(t, i, `db.Count()`, "", "2")
+	assertEval(t, i, `_, err := db.Query("b"); err.Error()`, "", "host/db.Query: rate limit exceeded")
+
+	eval(t, i, `import "github.com/traefik/yaegi/interp"`)
+	assertEval(t, i, `_, err := db.Query("c"); err.(*interp.LimitError).Reason`, "", "rate")
+	eval(t, i, `
+func count() (n int, err interface{}) {
+	defer func() { err = recover() }()
+	return db.Count(), nil
+}`)
+	assertEval(t, i, `_, err := count(); err != nil`, "", "true")
+
+	if calls != 2 {
+		t.Errorf("got %d calls, want 2", calls)
+	}
+}
+
+func TestLimitConcurrency(t *testing.T) {
+	started, block := make(chan bool), make(chan bool)
+	host := interp.Exports{
+		"host/slow": {
+			"Wait": reflect.ValueOf(func() error { started <- true; <-block; return nil }),
+		},
+	}
+	limited := interp.Limit(host, interp.Limits{MaxConcurrent: 1})
+	i := interp.New(interp.Options{})
+	i.Use(limited)
+
+	res := make(chan []reflect.Value)
+	go func() { res <- limited["host/slow"]["Wait"].Call(nil) }()
+	<-started
+
+	eval(t, i, `import "host/slow"`)
+	v := eval(t, i, `slow.Wait()`)
+	if err, ok := v.Interface().(error); !ok || err.Error() != "host/slow.Wait: concurrency limit exceeded" {
+		t.Errorf("got %v, want concurrency error", v)
+	}
+	block <- true
+	if v := <-res; !v[0].IsNil() {
+		t.Errorf("got %v, want nil", v[0])
+	}
+}
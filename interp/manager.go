@@ -0,0 +1,559 @@
+package interp
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// ErrManagerClosed is returned by the methods of a closed Manager.
+var ErrManagerClosed = errors.New("interpreter manager closed")
+
+// ManagerOptions are the options of a Manager.
+type ManagerOptions struct {
+	// New returns a new interpreter of the given name, such as a tenant or a
+	// plugin, configured by the host with its options, symbols and sources.
+	New func(name string) (*Interpreter, error)
+
+	// IdleTimeout, if greater than zero, is the duration after which an
+	// interpreter which has not been used is closed.
+	IdleTimeout time.Duration
+
+	// MaxLifetime, if greater than zero, is the duration after which an
+	// interpreter is recycled: it is closed once no longer in use, and
+	// replaced by a new one at its next use.
+	MaxLifetime time.Duration
+
+	// OnClose, if not nil, is called when an interpreter is closed, with the
+	// reason, for example to release the resources the host attached to it.
+	OnClose func(name string, i *Interpreter, reason CloseReason)
+
+	// Restart, if not nil, closes the interpreters whose calls of Do fail,
+	// to restart them at their next use, as configured by the policy.
+	Restart *RestartPolicy
+}
+
+// RestartPolicy is the policy of a Manager for the interpreters failing, such
+// as plugins whose evaluations repeatedly panic or exceed their quotas. A
+// failed interpreter is closed, and replaced by a new one at its next use,
+// after a backoff delay. An interpreter failing too often is disabled by a
+// circuit breaker. Meanwhile, Get and Do return a *RestartError. The state
+// of the interpreters is reported by Manager.Report.
+type RestartPolicy struct {
+	// Failure returns true if the error returned by the function passed to
+	// Do is a failure of the interpreter. If nil, the failures are the
+	// panics of interpreted code, as a Panic, and the exceeded quotas, as a
+	// *QuotaError.
+	Failure func(err error) bool
+
+	// Backoff is the delay before the restart of a failed interpreter,
+	// doubled for each consecutive failure, up to MaxBackoff if greater than
+	// zero. The consecutive failures are reset by a successful call of Do.
+	Backoff    time.Duration
+	MaxBackoff time.Duration
+
+	// MaxRestarts, if greater than zero, is the maximum number of restarts
+	// of an interpreter within Window, or since its first failure if Window
+	// is zero. The next failure opens the circuit, disabling the
+	// interpreter for OpenTimeout, or until Manager.ResetRestarts if zero.
+	// Once the timeout expires, the interpreter is restarted for a trial:
+	// the circuit is closed by a successful call of Do, and opened again by
+	// a failure.
+	MaxRestarts int
+	Window      time.Duration
+	OpenTimeout time.Duration
+}
+
+// failure returns true if err is a failure of an interpreter.
+func (p *RestartPolicy) failure(err error) bool {
+	if err == nil {
+		return false
+	}
+	if p.Failure != nil {
+		return p.Failure(err)
+	}
+	var pe Panic
+	return errors.As(err, &pe) || errors.Is(err, ErrLimitExceeded)
+}
+
+// backoff returns the delay before the restart after the given number of
+// consecutive failures.
+func (p *RestartPolicy) backoff(failures int) time.Duration {
+	d := p.Backoff
+	for k := 1; k < failures && k < 32 && (p.MaxBackoff <= 0 || d < p.MaxBackoff); k++ {
+		d *= 2
+	}
+	if p.MaxBackoff > 0 && d > p.MaxBackoff {
+		d = p.MaxBackoff
+	}
+	return d
+}
+
+// CircuitState is the state of the circuit breaker of an interpreter, see
+// RestartPolicy.
+type CircuitState int
+
+// States of circuit breakers.
+const (
+	CircuitClosed   CircuitState = iota // the interpreter is available
+	CircuitOpen                         // the interpreter is disabled after too many restarts
+	CircuitHalfOpen                     // the interpreter is restarted for a trial
+)
+
+func (c CircuitState) String() string {
+	switch c {
+	case CircuitClosed:
+		return "closed"
+	case CircuitOpen:
+		return "open"
+	case CircuitHalfOpen:
+		return "half-open"
+	}
+	return "unknown"
+}
+
+// ErrUnavailable is the error wrapped by the RestartError returned for an
+// interpreter waiting for its restart, or disabled.
+var ErrUnavailable = errors.New("interpreter unavailable")
+
+// RestartError is the error returned by Manager.Get and Manager.Do for an
+// interpreter whose restart is delayed by its backoff, or disabled by its
+// open circuit, see RestartPolicy.
+type RestartError struct {
+	Name    string
+	Circuit CircuitState // CircuitOpen if disabled
+	RetryAt time.Time    // end of the backoff or of the open circuit, zero if open until reset
+	Err     error        // last failure
+}
+
+func (e *RestartError) Error() string {
+	if e.Circuit == CircuitOpen {
+		return fmt.Sprintf("%s: %s disabled after repeated failures: %v", ErrUnavailable, e.Name, e.Err)
+	}
+	return fmt.Sprintf("%s: %s restarting after failure: %v", ErrUnavailable, e.Name, e.Err)
+}
+
+// Unwrap returns ErrUnavailable.
+func (e *RestartError) Unwrap() error { return ErrUnavailable }
+
+// CloseReason is the reason of the closing of an interpreter by a Manager.
+type CloseReason int
+
+// Reasons of closing.
+const (
+	CloseRemoved CloseReason = iota // closed by Manager.Remove or Manager.Close
+	CloseIdle                       // not used for ManagerOptions.IdleTimeout
+	CloseExpired                    // older than ManagerOptions.MaxLifetime
+	CloseFailed                     // failed, see ManagerOptions.Restart
+)
+
+func (r CloseReason) String() string {
+	switch r {
+	case CloseRemoved:
+		return "removed"
+	case CloseIdle:
+		return "idle"
+	case CloseExpired:
+		return "expired"
+	case CloseFailed:
+		return "failed"
+	}
+	return "unknown"
+}
+
+// ManagedInterpreter reports on an interpreter of a Manager.
+type ManagedInterpreter struct {
+	Name     string
+	Created  time.Time  // creation of the interpreter
+	LastUsed time.Time  // last call of Get or Do, or end of Do
+	Uses     int64      // number of calls of Get and Do
+	Busy     int        // number of calls of Do in progress
+	Frames   FrameStats // memory of the interpreter
+
+	// Restart state, see ManagerOptions.Restart. An interpreter waiting for
+	// its restart, or disabled, has a zero Created time.
+	Restarts    int          // restarts after failures, since the first one or ResetRestarts
+	Failures    int          // consecutive failures
+	LastFailure error        // last failure, or nil
+	Circuit     CircuitState // state of the circuit breaker
+	RetryAt     time.Time    // end of the backoff or of the open circuit, if any
+}
+
+// Manager creates and tracks named interpreters, such as one per tenant or
+// per plugin of a host, and closes them once idle or too old, as configured
+// by ManagerOptions. It is safe for concurrent use.
+//
+// Closing an interpreter stops its timers, cancels its subscriptions to the
+// buses of the host, see UseTopics, and stops the execution of its code
+// still running, such as goroutines, at their next statement. Interpreters
+// being used by Do are not closed for idleness or age, the other ones must
+// no longer be used once closed.
+type Manager struct {
+	opts    ManagerOptions
+	mu      sync.Mutex
+	interps map[string]*managed
+	failed  map[string]*restartState // restart state of the failed interpreters, indexed by name
+	closed  bool
+	done    chan struct{}    // closed by Close, to stop the sweeping
+	now     func() time.Time // for testing, defaults to time.Now
+}
+
+// managed is an interpreter of a Manager.
+type managed struct {
+	ready    chan struct{} // closed once interp is created, or failed with err
+	interp   *Interpreter
+	err      error
+	created  time.Time
+	lastUsed time.Time
+	uses     int64
+	busy     int
+}
+
+// restartState is the restart state of a failed interpreter of a Manager.
+type restartState struct {
+	restarts []time.Time // restarts in the window of the policy
+	total    int         // restarts since the first failure or reset
+	failures int         // consecutive failures
+	last     error       // last failure
+	circuit  CircuitState
+	retryAt  time.Time // end of the backoff or of the open circuit
+}
+
+// NewManager returns a Manager of the interpreters created by opts.New. If
+// an idle timeout or a maximum lifetime is set, the interpreters are checked
+// periodically, at half the smallest of them, until the manager is closed.
+func NewManager(opts ManagerOptions) *Manager {
+	m := &Manager{opts: opts, interps: map[string]*managed{}, failed: map[string]*restartState{}, done: make(chan struct{})}
+	interval := opts.IdleTimeout
+	if d := opts.MaxLifetime; d > 0 && (interval <= 0 || d < interval) {
+		interval = d
+	}
+	if interval > 0 {
+		go m.sweepEvery(interval / 2)
+	}
+	return m
+}
+
+func (m *Manager) time() time.Time {
+	if m.now != nil {
+		return m.now()
+	}
+	return time.Now()
+}
+
+func (m *Manager) sweepEvery(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-m.done:
+			return
+		case <-ticker.C:
+			m.Sweep()
+		}
+	}
+}
+
+// Get returns the interpreter name, created by ManagerOptions.New if it does
+// not exist, or if it has expired and is not in use.
+func (m *Manager) Get(name string) (*Interpreter, error) {
+	e, err := m.acquire(name, false)
+	if err != nil {
+		return nil, err
+	}
+	return e.interp, nil
+}
+
+// Do calls fn with the interpreter name, as returned by Get, and returns its
+// error. The interpreter is not closed for idleness or age during fn. If the
+// error is a failure of the interpreter for ManagerOptions.Restart, the
+// interpreter is closed, to be restarted.
+func (m *Manager) Do(name string, fn func(i *Interpreter) error) (err error) {
+	e, err := m.acquire(name, true)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		m.mu.Lock()
+		e.busy--
+		e.lastUsed = m.time()
+		failed := m.record(name, e, err)
+		m.mu.Unlock()
+		if failed {
+			m.close(name, e, CloseFailed)
+		}
+	}()
+	return fn(e.interp)
+}
+
+// record records the result err of a call of Do with the interpreter e, and
+// returns true if it failed and must be closed. It is called with m.mu held.
+func (m *Manager) record(name string, e *managed, err error) bool {
+	p := m.opts.Restart
+	if p == nil {
+		return false
+	}
+	st := m.failed[name]
+	if !p.failure(err) {
+		if st != nil {
+			st.failures = 0
+			if st.circuit == CircuitHalfOpen {
+				st.circuit, st.restarts = CircuitClosed, nil
+			}
+		}
+		return false
+	}
+	if m.interps[name] != e {
+		// Already failed, or removed.
+		return false
+	}
+	delete(m.interps, name)
+	if st == nil {
+		st = &restartState{}
+		m.failed[name] = st
+	}
+	now := m.time()
+	st.failures++
+	st.last = err
+
+	if p.Window > 0 {
+		k := 0
+		for k < len(st.restarts) && now.Sub(st.restarts[k]) >= p.Window {
+			k++
+		}
+		st.restarts = st.restarts[k:]
+	}
+	if st.circuit == CircuitHalfOpen || p.MaxRestarts > 0 && len(st.restarts) >= p.MaxRestarts {
+		st.circuit, st.retryAt = CircuitOpen, time.Time{}
+		if p.OpenTimeout > 0 {
+			st.retryAt = now.Add(p.OpenTimeout)
+		}
+		return true
+	}
+	st.restarts = append(st.restarts, now)
+	st.total++
+	st.retryAt = now.Add(p.backoff(st.failures))
+	return true
+}
+
+// ResetRestarts clears the restart state of the interpreter name, closing
+// its circuit, so that it is available again at its next use.
+func (m *Manager) ResetRestarts(name string) {
+	m.mu.Lock()
+	delete(m.failed, name)
+	m.mu.Unlock()
+}
+
+// available returns an error if the interpreter name, failed, is waiting for
+// its restart or disabled at time now. It is called with m.mu held.
+func (m *Manager) available(name string, now time.Time) error {
+	st := m.failed[name]
+	if st == nil {
+		return nil
+	}
+	if st.circuit == CircuitOpen && !st.retryAt.IsZero() && !now.Before(st.retryAt) {
+		st.circuit, st.restarts = CircuitHalfOpen, nil
+	}
+	if st.circuit == CircuitOpen || now.Before(st.retryAt) {
+		return &RestartError{Name: name, Circuit: st.circuit, RetryAt: st.retryAt, Err: st.last}
+	}
+	return nil
+}
+
+// acquire returns the interpreter name, created if needed, and marks it as
+// busy if set.
+func (m *Manager) acquire(name string, busy bool) (*managed, error) {
+	m.mu.Lock()
+	if m.closed {
+		m.mu.Unlock()
+		return nil, ErrManagerClosed
+	}
+	now := m.time()
+	var expired *managed
+	e := m.interps[name]
+	if e != nil && e.interp != nil && e.busy == 0 && m.expired(e, now) {
+		delete(m.interps, name)
+		expired, e = e, nil
+	}
+	if e == nil {
+		if err := m.available(name, now); err != nil {
+			m.mu.Unlock()
+			if expired != nil {
+				m.close(name, expired, CloseExpired)
+			}
+			return nil, err
+		}
+		e = &managed{ready: make(chan struct{}), created: now, lastUsed: now}
+		m.interps[name] = e
+		m.mu.Unlock()
+		if expired != nil {
+			m.close(name, expired, CloseExpired)
+		}
+		i, err := m.opts.New(name)
+		m.mu.Lock()
+		e.interp, e.err = i, err
+		if err != nil && m.interps[name] == e {
+			delete(m.interps, name)
+		}
+		close(e.ready)
+	}
+	m.mu.Unlock()
+
+	<-e.ready
+	if e.err != nil {
+		return nil, e.err
+	}
+	m.mu.Lock()
+	if m.interps[name] != e {
+		// Removed meanwhile, by Remove or Close.
+		m.mu.Unlock()
+		return m.acquire(name, busy)
+	}
+	defer m.mu.Unlock()
+	e.lastUsed = m.time()
+	e.uses++
+	if busy {
+		e.busy++
+	}
+	return e, nil
+}
+
+// expired returns true if the interpreter e is older than the maximum
+// lifetime at time now.
+func (m *Manager) expired(e *managed, now time.Time) bool {
+	return m.opts.MaxLifetime > 0 && now.Sub(e.created) >= m.opts.MaxLifetime
+}
+
+// Sweep closes the interpreters which are idle or have expired, and are not
+// in use. It is called periodically if a timeout is set in ManagerOptions.
+func (m *Manager) Sweep() {
+	type closing struct {
+		name   string
+		e      *managed
+		reason CloseReason
+	}
+	var list []closing
+	m.mu.Lock()
+	now := m.time()
+	for name, e := range m.interps {
+		if e.interp == nil || e.busy > 0 {
+			continue
+		}
+		switch {
+		case m.expired(e, now):
+			list = append(list, closing{name, e, CloseExpired})
+		case m.opts.IdleTimeout > 0 && now.Sub(e.lastUsed) >= m.opts.IdleTimeout:
+			list = append(list, closing{name, e, CloseIdle})
+		default:
+			continue
+		}
+		delete(m.interps, name)
+	}
+	m.mu.Unlock()
+
+	for _, c := range list {
+		m.close(c.name, c.e, c.reason)
+	}
+}
+
+// Remove closes the interpreter name, even if in use, and returns false if
+// it does not exist.
+func (m *Manager) Remove(name string) bool {
+	m.mu.Lock()
+	e := m.interps[name]
+	if e == nil || e.interp == nil {
+		m.mu.Unlock()
+		return false
+	}
+	delete(m.interps, name)
+	m.mu.Unlock()
+
+	m.close(name, e, CloseRemoved)
+	return true
+}
+
+// Close closes all the interpreters, and the manager, whose methods then
+// return ErrManagerClosed.
+func (m *Manager) Close() {
+	m.mu.Lock()
+	if m.closed {
+		m.mu.Unlock()
+		return
+	}
+	m.closed = true
+	close(m.done)
+	interps := m.interps
+	m.interps = map[string]*managed{}
+	m.mu.Unlock()
+
+	for name, e := range interps {
+		<-e.ready
+		if e.err == nil {
+			m.close(name, e, CloseRemoved)
+		}
+	}
+}
+
+// close closes the interpreter e, removed from the manager.
+func (m *Manager) close(name string, e *managed, reason CloseReason) {
+	e.interp.stopTimers()
+	e.interp.CloseSubscriptions()
+	atomic.AddUint64(&e.interp.id, 1)
+	if m.opts.OnClose != nil {
+		m.opts.OnClose(name, e.interp, reason)
+	}
+}
+
+// Report returns the interpreters of the manager, and the failed ones
+// waiting for their restart or disabled, sorted by name.
+func (m *Manager) Report() []ManagedInterpreter {
+	m.mu.Lock()
+	var list []ManagedInterpreter
+	var interps []*Interpreter
+	for name, e := range m.interps {
+		if e.interp == nil {
+			continue
+		}
+		list = append(list, ManagedInterpreter{
+			Name:     name,
+			Created:  e.created,
+			LastUsed: e.lastUsed,
+			Uses:     e.uses,
+			Busy:     e.busy,
+		})
+		interps = append(interps, e.interp)
+	}
+	for k := range list {
+		if st := m.failed[list[k].Name]; st != nil {
+			st.report(&list[k])
+		}
+	}
+	for name, st := range m.failed {
+		if e := m.interps[name]; e == nil || e.interp == nil {
+			r := ManagedInterpreter{Name: name}
+			st.report(&r)
+			list = append(list, r)
+		}
+	}
+	m.mu.Unlock()
+
+	for k, i := range interps {
+		list[k].Frames = i.FrameStats()
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].Name < list[j].Name })
+	return list
+}
+
+// report sets the restart state of r.
+func (st *restartState) report(r *ManagedInterpreter) {
+	r.Restarts = st.total
+	r.Failures = st.failures
+	r.LastFailure = st.last
+	r.Circuit = st.circuit
+	if st.circuit == CircuitOpen || st.failures > 0 {
+		r.RetryAt = st.retryAt
+	}
+}
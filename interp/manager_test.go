@@ -0,0 +1,226 @@
+package interp
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestManager(t *testing.T) {
+	var mu sync.Mutex
+	now := time.Unix(0, 0)
+	var created, closed []string
+	m := NewManager(ManagerOptions{
+		New: func(name string) (*Interpreter, error) {
+			if name == "bad" {
+				return nil, errors.New("bad tenant")
+			}
+			mu.Lock()
+			created = append(created, name)
+			mu.Unlock()
+			i := New(Options{})
+			if _, err := i.Eval(fmt.Sprintf("var name = %q", name)); err != nil {
+				return nil, err
+			}
+			return i, nil
+		},
+		IdleTimeout: time.Minute,
+		MaxLifetime: time.Hour,
+		OnClose: func(name string, i *Interpreter, reason CloseReason) {
+			mu.Lock()
+			closed = append(closed, name+" "+reason.String())
+			mu.Unlock()
+		},
+	})
+	defer m.Close()
+	m.now = func() time.Time { return now }
+
+	a, err := m.Get("a")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v, err := a.Eval("name"); err != nil || v.String() != "a" {
+		t.Fatalf("got %v, %v", v, err)
+	}
+	if i, _ := m.Get("a"); i != a {
+		t.Error("interpreter a not reused")
+	}
+	if _, err := m.Get("bad"); err == nil || err.Error() != "bad tenant" {
+		t.Errorf("got error %v, want bad tenant", err)
+	}
+	if _, err := m.Get("b"); err != nil {
+		t.Fatal(err)
+	}
+
+	// a is idle, b is in use.
+	now = now.Add(2 * time.Minute)
+	if err := m.Do("b", func(i *Interpreter) error {
+		m.Sweep()
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+	r := m.Report()
+	if len(r) != 1 || r[0].Name != "b" || r[0].Uses != 2 || r[0].Busy != 0 || r[0].Frames.Global == 0 {
+		t.Errorf("unexpected report %+v", r)
+	}
+
+	// b is recycled at its next use, once expired.
+	now = now.Add(time.Hour)
+	b, err := m.Get("b")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r := m.Report(); len(r) != 1 || r[0].Uses != 1 || !r[0].Created.Equal(now) {
+		t.Errorf("unexpected report %+v", r)
+	}
+
+	if !m.Remove("b") || m.Remove("b") {
+		t.Error("unexpected Remove result")
+	}
+	if i, _ := m.Get("b"); i == b {
+		t.Error("removed interpreter b reused")
+	}
+
+	m.Close()
+	if _, err := m.Get("a"); err != ErrManagerClosed {
+		t.Errorf("got error %v, want %v", err, ErrManagerClosed)
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	if got, want := fmt.Sprint(created), "[a b b b]"; got != want {
+		t.Errorf("got created %s, want %s", got, want)
+	}
+	if got, want := fmt.Sprint(closed), "[a idle b expired b removed b removed]"; got != want {
+		t.Errorf("got closed %s, want %s", got, want)
+	}
+}
+
+func TestManagerStopsCode(t *testing.T) {
+	m := NewManager(ManagerOptions{New: func(string) (*Interpreter, error) { return New(Options{}), nil }})
+	defer m.Close()
+	i, err := m.Get("loop")
+	if err != nil {
+		t.Fatal(err)
+	}
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		_, _ = i.Eval("for {}")
+	}()
+	time.Sleep(10 * time.Millisecond)
+	m.Remove("loop")
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("code of removed interpreter still running")
+	}
+}
+
+func TestManagerRestart(t *testing.T) {
+	now := time.Unix(0, 0)
+	var closed []string
+	m := NewManager(ManagerOptions{
+		New: func(string) (*Interpreter, error) { return New(Options{MaxSteps: 1000}), nil },
+		OnClose: func(name string, i *Interpreter, reason CloseReason) {
+			closed = append(closed, name+" "+reason.String())
+		},
+		Restart: &RestartPolicy{
+			Backoff:     time.Second,
+			MaxBackoff:  3 * time.Second,
+			MaxRestarts: 3,
+			Window:      time.Minute,
+			OpenTimeout: time.Hour,
+		},
+	})
+	defer m.Close()
+	m.now = func() time.Time { return now }
+
+	eval := func(src string) error {
+		return m.Do("p", func(i *Interpreter) error {
+			_, err := i.Eval(src)
+			return err
+		})
+	}
+	state := func() string {
+		r := m.Report()
+		if len(r) != 1 {
+			t.Fatalf("unexpected report %+v", r)
+		}
+		var retry time.Duration
+		if !r[0].RetryAt.IsZero() {
+			retry = r[0].RetryAt.Sub(now)
+		}
+		return fmt.Sprintf("%d %d %s %v", r[0].Restarts, r[0].Failures, r[0].Circuit, retry)
+	}
+	unavailable := func(circuit CircuitState) {
+		t.Helper()
+		var re *RestartError
+		if err := eval("1"); !errors.As(err, &re) || !errors.Is(err, ErrUnavailable) || re.Circuit != circuit {
+			t.Fatalf("got error %v, want restart error with %s circuit", err, circuit)
+		}
+	}
+
+	// Compilation errors are not failures, panics are.
+	if err := eval("undefined"); err == nil {
+		t.Fatal("missing error")
+	}
+	if err := eval(`panic("boom")`); err == nil {
+		t.Fatal("missing error")
+	}
+	if got, want := state(), "1 1 closed 1s"; got != want {
+		t.Fatalf("got state %s, want %s", got, want)
+	}
+	unavailable(CircuitClosed)
+
+	// The backoff doubles with the consecutive failures.
+	now = now.Add(time.Second)
+	if err := eval("for {}"); !errors.Is(err, ErrLimitExceeded) {
+		t.Fatalf("got error %v, want %v", err, ErrLimitExceeded)
+	}
+	if got, want := state(), "2 2 closed 2s"; got != want {
+		t.Fatalf("got state %s, want %s", got, want)
+	}
+
+	// A success resets the consecutive failures.
+	now = now.Add(2 * time.Second)
+	if err := eval("1"); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := state(), "2 0 closed 0s"; got != want {
+		t.Fatalf("got state %s, want %s", got, want)
+	}
+
+	// Too many restarts open the circuit, until a trial after its timeout.
+	_ = eval(`panic("boom")`)
+	now = now.Add(3 * time.Second)
+	_ = eval(`panic("boom")`)
+	if got, want := state(), "3 2 open 1h0m0s"; got != want {
+		t.Fatalf("got state %s, want %s", got, want)
+	}
+	unavailable(CircuitOpen)
+
+	// A failed trial opens the circuit again, a successful one closes it.
+	now = now.Add(time.Hour)
+	_ = eval(`panic("boom")`)
+	if got, want := state(), "3 3 open 1h0m0s"; got != want {
+		t.Fatalf("got state %s, want %s", got, want)
+	}
+	now = now.Add(time.Hour)
+	if err := eval("1"); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := state(), "3 0 closed 0s"; got != want {
+		t.Fatalf("got state %s, want %s", got, want)
+	}
+
+	m.ResetRestarts("p")
+	if got, want := state(), "0 0 closed 0s"; got != want {
+		t.Fatalf("got state %s, want %s", got, want)
+	}
+	if got, want := fmt.Sprint(closed), "[p failed p failed p failed p failed p failed]"; got != want {
+		t.Errorf("got closed %s, want %s", got, want)
+	}
+}
@@ -0,0 +1,124 @@
+package interp
+
+import (
+	"container/list"
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+)
+
+// Memoize declares the interpreted function name as pure, i.e. its results
+// depend only on its arguments, and makes the interpreter cache them for
+// subsequent calls with the same arguments. The name can be qualified by the
+// import path of a source package, as in "foo/bar.Baz".
+//
+// At most size results are kept, the least recently used being evicted first.
+// If size is not positive, the cache is not bounded.
+//
+// Arguments must be of comparable types, and results must not be of interface
+// or function types. Variadic functions and methods are not supported.
+// A call which panics is not cached. The slices returned are copies of the
+// cached ones, but the values referred to by results of other reference types,
+// such as maps or pointers, are shared by the calls with the same arguments,
+// and must not be modified.
+func (interp *Interpreter) Memoize(name string, size int) error {
+	def, err := interp.lookupFunc(name)
+	if err != nil {
+		return err
+	}
+	t := def.typ
+	if len(t.ret) == 0 {
+		return errors.New("memoize: function has no result: " + name)
+	}
+	for _, a := range t.arg {
+		if a.cat == interfaceT || a.cat == variadicT || !a.TypeOf().Comparable() {
+			return fmt.Errorf("memoize: %s: argument of type %v can not be cached", name, a.TypeOf())
+		}
+	}
+	for _, r := range t.ret {
+		if r.cat == interfaceT || r.cat == funcT {
+			return fmt.Errorf("memoize: %s: result of type %v can not be cached", name, r.TypeOf())
+		}
+	}
+
+	fn := genFunctionWrapper(def)(interp.frame)
+	c := &memoCache{size: size, entries: map[interface{}]*list.Element{}, lru: list.New()}
+	keyType := reflect.ArrayOf(len(t.arg), interf)
+
+	memo := reflect.MakeFunc(fn.Type(), func(in []reflect.Value) []reflect.Value {
+		k := reflect.New(keyType).Elem()
+		for i, v := range in {
+			k.Index(i).Set(v)
+		}
+		key := k.Interface()
+		if out, ok := c.get(key); ok {
+			return copyResults(out)
+		}
+		out := fn.Call(in)
+		c.put(key, out)
+		return copyResults(out)
+	})
+
+	interp.mutex.Lock()
+	// A valid rval in a function definition supersedes its interpreted body at call.
+	def.rval = memo
+	interp.mutex.Unlock()
+	return nil
+}
+
+// copyResults returns a copy of the cached results out, where the slices are
+// also copied, so the caller can modify them.
+func copyResults(out []reflect.Value) []reflect.Value {
+	r := make([]reflect.Value, len(out))
+	for i, v := range out {
+		if v.Kind() == reflect.Slice && !v.IsNil() {
+			c := reflect.MakeSlice(v.Type(), v.Len(), v.Len())
+			reflect.Copy(c, v)
+			v = c
+		}
+		r[i] = v
+	}
+	return r
+}
+
+// memoCache is a LRU cache of function results, indexed by arguments.
+type memoCache struct {
+	mu      sync.Mutex
+	size    int // maximum number of entries, unbounded if not positive
+	entries map[interface{}]*list.Element
+	lru     *list.List // of *memoEntry, most recently used first
+}
+
+type memoEntry struct {
+	key interface{}
+	out []reflect.Value
+}
+
+func (c *memoCache) get(key interface{}) ([]reflect.Value, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	c.lru.MoveToFront(e)
+	return e.Value.(*memoEntry).out, true
+}
+
+func (c *memoCache) put(key interface{}, out []reflect.Value) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if e, ok := c.entries[key]; ok {
+		c.lru.MoveToFront(e)
+		return
+	}
+	c.entries[key] = c.lru.PushFront(&memoEntry{key: key, out: out})
+	if c.size > 0 && c.lru.Len() > c.size {
+		e := c.lru.Back()
+		c.lru.Remove(e)
+		delete(c.entries, e.Value.(*memoEntry).key)
+	}
+}
@@ -0,0 +1,77 @@
+package interp_test
+
+import (
+	"testing"
+
+	"github.com/traefik/yaegi/interp"
+)
+
+func TestMemoize(t *testing.T) {
+	i := interp.New(interp.Options{})
+
+	eval(t, i, `
+var calls int
+
+type point struct{ x, y int }
+
+func fib(n int) int {
+	calls++
+	if n < 2 {
+		return n
+	}
+	return fib(n-1) + fib(n-2)
+}
+
+func dist(p point, s string) (int, string) {
+	calls++
+	return p.x*p.x + p.y*p.y, s
+}
+
+func any(v interface{}) int { return 0 }
+
+func none(n int) {}
+
+func digits(n int) []int {
+	calls++
+	var d []int
+	for ; n > 0; n /= 10 {
+		d = append(d, n%10)
+	}
+	return d
+}
+`)
+
+	if err := i.Memoize("fib", 0); err != nil {
+		t.Fatal(err)
+	}
+	assertEval(t, i, `fib(50)`, "", "12586269025")
+	assertEval(t, i, `calls`, "", "51")
+	assertEval(t, i, `fib(50)`, "", "12586269025")
+	assertEval(t, i, `calls`, "", "51")
+
+	if err := i.Memoize("main.dist", 1); err != nil {
+		t.Fatal(err)
+	}
+	eval(t, i, `calls = 0`)
+	assertEval(t, i, `_, s := dist(point{1, 2}, "a"); s`, "", "a")
+	assertEval(t, i, `d, _ := dist(point{1, 2}, "a"); d`, "", "5")
+	assertEval(t, i, `calls`, "", "1")
+	assertEval(t, i, `d, _ := dist(point{3, 4}, "a"); d`, "", "25")
+	assertEval(t, i, `d, _ := dist(point{1, 2}, "a"); d`, "", "5")
+	assertEval(t, i, `calls`, "", "3")
+
+	// The slices returned can be modified without altering the cache.
+	if err := i.Memoize("digits", 0); err != nil {
+		t.Fatal(err)
+	}
+	eval(t, i, `calls = 0`)
+	assertEval(t, i, `ds := digits(123); ds[0] = 9; ds`, "", "[9 2 1]")
+	assertEval(t, i, `digits(123)`, "", "[3 2 1]")
+	assertEval(t, i, `calls`, "", "1")
+
+	for _, name := range []string{"any", "none", "nothere"} {
+		if err := i.Memoize(name, 0); err == nil {
+			t.Errorf("%s: expected error", name)
+		}
+	}
+}
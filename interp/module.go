@@ -0,0 +1,270 @@
+package interp
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"go/build"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// ReadModule reads the go.mod file at path, and returns the module paths of
+// the main module and of its requirements, mapped to their directories,
+// suitable for Options.Workspace. Required modules are located in the module
+// cache ($GOMODCACHE, or the pkg/mod directory of the first GOPATH entry),
+// as downloaded by "go mod download", or in the directories of replace
+// directives. Modules missing from the module cache are omitted. The others
+// are verified against the go.sum file next to go.mod, by the hashes recorded
+// in the module cache by the go command, as it does, or else by hashing their
+// content.
+func ReadModule(path string) (map[string]string, error) {
+	return readModule(path, nil)
+}
+
+// readModule reads the go.mod file at path, as ReadModule. The modules
+// missing from the module cache are obtained with fetch, if not nil, given
+// their go.sum hash.
+func readModule(path string, fetch func(mod module, sum string) (string, error)) (map[string]string, error) {
+	buf, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	dir := filepath.Dir(path)
+
+	main := modulePath(buf)
+	if main == "" {
+		return nil, fmt.Errorf("%s: no module directive", path)
+	}
+	require, err := parseDirectives(buf, "require")
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", path, err)
+	}
+	replace, err := parseReplace(buf)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", path, err)
+	}
+	sums, err := readSums(filepath.Join(dir, "go.sum"))
+	if err != nil {
+		return nil, err
+	}
+	cache := modCache()
+
+	modules := map[string]string{main: dir}
+	for _, r := range require {
+		if len(r.args) != 2 {
+			return nil, fmt.Errorf("%s:%d: invalid require directive", path, r.line)
+		}
+		mod := module{r.args[0], r.args[1]}
+		if to, ok := replace[mod]; ok {
+			mod = to
+		} else if to, ok := replace[module{path: mod.path}]; ok {
+			mod = to
+		}
+		if mod.version == "" {
+			// Replacement by a local directory.
+			if !filepath.IsAbs(mod.path) {
+				mod.path = filepath.Join(dir, mod.path)
+			}
+			modules[r.args[0]] = mod.path
+			continue
+		}
+
+		mdir, err := mod.dir(cache)
+		if err != nil {
+			return nil, fmt.Errorf("%s:%d: %v", path, r.line, err)
+		}
+		_, err = os.Stat(mdir)
+		if err != nil && fetch == nil {
+			continue
+		}
+		sum, ok := sums[mod]
+		if !ok {
+			return nil, fmt.Errorf("%s: missing go.sum entry for %s %s", path, mod.path, mod.version)
+		}
+		if err != nil {
+			if modules[r.args[0]], err = fetch(mod, sum); err != nil {
+				return nil, fmt.Errorf("%s:%d: %v", path, r.line, err)
+			}
+			continue
+		}
+		// As the go command, trust the hash recorded when the module was
+		// extracted, and hash its content only if there is none.
+		h := cachedSum(cache, mod)
+		if h == "" {
+			if h, err = hashDir(mdir, mod.path+"@"+mod.version); err != nil {
+				return nil, err
+			}
+		}
+		if h != sum {
+			return nil, fmt.Errorf("%s: checksum mismatch for %s %s: got %s, go.sum has %s", path, mod.path, mod.version, h, sum)
+		}
+		modules[r.args[0]] = mdir
+	}
+	return modules, nil
+}
+
+// module is a module path and version. The path of a module replaced by a
+// local directory is the directory, with an empty version.
+type module struct {
+	path, version string
+}
+
+// dir returns the directory of the module in the module cache.
+func (m module) dir(cache string) (string, error) {
+	p, err := escapePath(m.path)
+	if err != nil {
+		return "", err
+	}
+	v, err := escapePath(m.version)
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(cache, filepath.FromSlash(p+"@"+v)), nil
+}
+
+// cachedSum returns the hash of the module mod recorded by the go command in
+// the download directory of the module cache, when it extracted the module,
+// or "" if there is none.
+func cachedSum(cache string, mod module) string {
+	p, err := escapePath(mod.path)
+	if err != nil {
+		return ""
+	}
+	v, err := escapePath(mod.version)
+	if err != nil {
+		return ""
+	}
+	b, err := ioutil.ReadFile(filepath.Join(cache, "cache", "download", filepath.FromSlash(p), "@v", v+".ziphash"))
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(b))
+}
+
+// parseReplace returns the replacements of the replace directives of a go.mod
+// file, indexed by the replaced module. The version of the replaced module is
+// empty if all its versions are replaced.
+func parseReplace(buf []byte) (map[module]module, error) {
+	entries, err := parseDirectives(buf, "replace")
+	if err != nil {
+		return nil, err
+	}
+	replace := map[module]module{}
+	for _, e := range entries {
+		var from, to module
+		switch a := e.args; {
+		case len(a) == 3 && a[1] == "=>":
+			from, to = module{path: a[0]}, module{path: a[2]}
+		case len(a) == 4 && a[1] == "=>":
+			from, to = module{path: a[0]}, module{a[2], a[3]}
+		case len(a) == 4 && a[2] == "=>":
+			from, to = module{a[0], a[1]}, module{path: a[3]}
+		case len(a) == 5 && a[2] == "=>":
+			from, to = module{a[0], a[1]}, module{a[3], a[4]}
+		default:
+			return nil, fmt.Errorf("%d: invalid replace directive", e.line)
+		}
+		replace[from] = to
+	}
+	return replace, nil
+}
+
+// readSums returns the hashes of the module contents listed in the go.sum
+// file at path. A missing file has no entries.
+func readSums(path string) (map[module]string, error) {
+	sums := map[module]string{}
+	buf, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return sums, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	s := bufio.NewScanner(bytes.NewReader(buf))
+	for line := 1; s.Scan(); line++ {
+		f := strings.Fields(s.Text())
+		if len(f) == 0 {
+			continue
+		}
+		if len(f) != 3 {
+			return nil, fmt.Errorf("%s:%d: malformed line", path, line)
+		}
+		if strings.HasSuffix(f[1], "/go.mod") {
+			continue
+		}
+		sums[module{f[0], f[1]}] = f[2]
+	}
+	return sums, s.Err()
+}
+
+// modCache returns the directory of the module cache.
+func modCache() string {
+	if dir := os.Getenv("GOMODCACHE"); dir != "" {
+		return dir
+	}
+	gopath := filepath.SplitList(build.Default.GOPATH)
+	if len(gopath) == 0 {
+		return ""
+	}
+	return filepath.Join(gopath[0], "pkg", "mod")
+}
+
+// escapePath returns the module path or version as stored in the module
+// cache, where upper case letters are replaced by an exclamation mark
+// followed by the lower case letter, for case insensitive file systems.
+func escapePath(s string) (string, error) {
+	var b strings.Builder
+	for _, r := range s {
+		switch {
+		case r == '!' || r >= 0x80:
+			return "", fmt.Errorf("invalid module path or version %q", s)
+		case 'A' <= r && r <= 'Z':
+			b.WriteByte('!')
+			b.WriteRune(r + 'a' - 'A')
+		default:
+			b.WriteRune(r)
+		}
+	}
+	return b.String(), nil
+}
+
+// hashDir returns the hash of the content of the module directory dir, in the
+// "h1:" form of go.sum files: the base64 encoded SHA-256 of a summary listing
+// the SHA-256 of each file, named prefix/relative path, in sorted order.
+func hashDir(dir, prefix string) (string, error) {
+	var files []string
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			return nil
+		}
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		files = append(files, filepath.ToSlash(rel))
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+	sort.Strings(files)
+
+	h := sha256.New()
+	for _, file := range files {
+		buf, err := ioutil.ReadFile(filepath.Join(dir, filepath.FromSlash(file)))
+		if err != nil {
+			return "", err
+		}
+		fmt.Fprintf(h, "%x  %s\n", sha256.Sum256(buf), prefix+"/"+file)
+	}
+	return "h1:" + base64.StdEncoding.EncodeToString(h.Sum(nil)), nil
+}
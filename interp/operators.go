@@ -0,0 +1,122 @@
+package interp
+
+import (
+	"reflect"
+)
+
+// operatorNames are the names of the methods implementing the operators on
+// binary types, see Options.OperatorMethods.
+var operatorNames = map[action]string{
+	aAdd:          "Add",
+	aSub:          "Sub",
+	aMul:          "Mul",
+	aQuo:          "Div",
+	aEqual:        "Cmp",
+	aNotEqual:     "Cmp",
+	aGreater:      "Cmp",
+	aGreaterEqual: "Cmp",
+	aLower:        "Cmp",
+	aLowerEqual:   "Cmp",
+}
+
+// operatorMethod returns the method implementing the operator a, possibly an
+// assignment operator, on the operands c0 and c1, if they are of the same
+// binary type, which is not a boolean, numeric or string type, and if
+// operator methods are enabled.
+func (interp *Interpreter) operatorMethod(a action, c0, c1 *node) (reflect.Method, bool) {
+	if !interp.operatorMethods || c0.typ == nil || c1.typ == nil || c0.typ.cat != valueT || c1.typ.cat != valueT {
+		return reflect.Method{}, false
+	}
+	if isAssignAction(a) {
+		a--
+	}
+	t := c0.typ.rtype
+	name, ok := operatorNames[a]
+	if !ok || t != c1.typ.rtype || isConstKind(t.Kind()) {
+		return reflect.Method{}, false
+	}
+	m, ok := t.MethodByName(name)
+	if !ok {
+		return reflect.Method{}, false
+	}
+	out := t
+	if name == "Cmp" {
+		out = reflect.TypeOf(0)
+	}
+	mt := m.Type
+	if mt.NumIn() != 2 || mt.In(1) != t || mt.NumOut() != 1 || mt.Out(0) != out {
+		return reflect.Method{}, false
+	}
+	return m, true
+}
+
+// binaryMethod generates the binary expression n, computed by calling the
+// operator method m on its operands.
+func binaryMethod(m reflect.Method) bltnGenerator {
+	return func(n *node) {
+		tnext := getExec(n.tnext)
+		v0, v1 := genValue(n.child[0]), genValue(n.child[1])
+		fn := m.Func
+
+		if !isComparisonAction(n.action) {
+			dest := genValueOutput(n, m.Type.Out(0))
+			n.exec = func(f *frame) bltn {
+				dest(f).Set(fn.Call([]reflect.Value{v0(f), v1(f)})[0])
+				return tnext
+			}
+			return
+		}
+
+		cmp := compareResult(n.action)
+		dest := genValueOutput(n, reflect.TypeOf(true))
+		if n.fnext == nil {
+			n.exec = func(f *frame) bltn {
+				dest(f).SetBool(cmp(int(fn.Call([]reflect.Value{v0(f), v1(f)})[0].Int())))
+				return tnext
+			}
+			return
+		}
+		fnext := getExec(n.fnext)
+		n.exec = func(f *frame) bltn {
+			if cmp(int(fn.Call([]reflect.Value{v0(f), v1(f)})[0].Int())) {
+				dest(f).SetBool(true)
+				return tnext
+			}
+			dest(f).SetBool(false)
+			return fnext
+		}
+	}
+}
+
+// assignMethod generates the assignment operation n, computed by calling the
+// operator method m on its operands.
+func assignMethod(m reflect.Method) bltnGenerator {
+	return func(n *node) {
+		next := getExec(n.tnext)
+		v0, v1 := genValue(n.child[0]), genValue(n.child[1])
+		fn := m.Func
+		n.exec = func(f *frame) bltn {
+			v := v0(f)
+			v.Set(fn.Call([]reflect.Value{v, v1(f)})[0])
+			return next
+		}
+	}
+}
+
+// compareResult returns the function computing the result of the comparison
+// a from the result of a Cmp method.
+func compareResult(a action) func(int) bool {
+	switch a {
+	case aEqual:
+		return func(c int) bool { return c == 0 }
+	case aNotEqual:
+		return func(c int) bool { return c != 0 }
+	case aGreater:
+		return func(c int) bool { return c > 0 }
+	case aGreaterEqual:
+		return func(c int) bool { return c >= 0 }
+	case aLower:
+		return func(c int) bool { return c < 0 }
+	}
+	return func(c int) bool { return c <= 0 }
+}
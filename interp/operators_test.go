@@ -0,0 +1,93 @@
+package interp
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// cents is a fixed point decimal with 2 digits, exposing operator methods.
+type cents struct{ v int64 }
+
+func (c cents) Add(d cents) cents { return cents{c.v + d.v} }
+func (c cents) Sub(d cents) cents { return cents{c.v - d.v} }
+func (c cents) Mul(d cents) cents { return cents{c.v * d.v / 100} }
+func (c cents) Div(d cents) cents { return cents{c.v * 100 / d.v} }
+
+func (c cents) Cmp(d cents) int {
+	switch {
+	case c.v < d.v:
+		return -1
+	case c.v > d.v:
+		return 1
+	}
+	return 0
+}
+
+var centsExports = Exports{"money": {
+	"Cents": reflect.ValueOf((*cents)(nil)),
+	"New":   reflect.ValueOf(func(v int64) cents { return cents{v} }),
+}}
+
+func TestOperatorMethods(t *testing.T) {
+	i := New(Options{OperatorMethods: true})
+	i.Use(centsExports)
+	if _, err := i.Eval(`
+import "money"
+
+func total(prices []money.Cents, rate money.Cents) (t money.Cents) {
+	for _, p := range prices {
+		t += p
+	}
+	return t * rate
+}
+
+func discount(p money.Cents) money.Cents {
+	if p >= money.New(10000) {
+		return p - p/money.New(1000)
+	}
+	return p
+}
+
+func boxed(a, b money.Cents) interface{} { return a * b }
+
+var m = map[string]money.Cents{"a": money.New(100)}
+`); err != nil {
+		t.Fatal(err)
+	}
+
+	for src, want := range map[string]interface{}{
+		`total([]money.Cents{money.New(250), money.New(750)}, money.New(150))`: cents{1500},
+		`discount(money.New(20000))`:                                         cents{18000},
+		`discount(money.New(5000))`:                                          cents{5000},
+		`money.New(100) == money.New(100)`:                                   true,
+		`money.New(100) != money.New(100)`:                                   false,
+		`money.New(100) < money.New(200) && money.New(300) > money.New(200)`: true,
+		`money.New(100) <= money.New(99)`:                                    false,
+		`m["a"] += money.New(50); m["a"]`:                                    cents{150},
+		`x := money.New(1); x = x + money.New(2); x`:                         cents{3},
+		`boxed(money.New(100), money.New(200))`:                              cents{200},
+	} {
+		v, err := i.Eval(src)
+		if err != nil {
+			t.Errorf("%s: %v", src, err)
+			continue
+		}
+		got := v.Interface()
+		if vi, ok := got.(valueInterface); ok {
+			got = vi.value.Interface()
+		}
+		if got != want {
+			t.Errorf("%s: got %v, want %v", src, got, want)
+		}
+	}
+
+	i = New(Options{})
+	i.Use(centsExports)
+	if _, err := i.Eval(`import "money"`); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := i.Eval(`money.New(1) + money.New(2)`); err == nil || !strings.Contains(err.Error(), "operator + not defined") {
+		t.Errorf("got error %v, want operator not defined", err)
+	}
+}
@@ -0,0 +1,81 @@
+package interp
+
+import "io"
+
+// An Option configures an interpreter created by New. Options is itself an
+// Option, which sets all the options at once, so New(Options{...}) keeps
+// working, and the With functions set one option each. New capabilities are
+// added as fields of Options, settable with OptionFunc, without changing the
+// signature of New.
+type Option interface {
+	apply(*Options)
+}
+
+// apply replaces all the options by o.
+func (o Options) apply(dst *Options) { *dst = o }
+
+// OptionFunc is an Option setting any field of Options, as in:
+//
+//	interp.New(interp.WithStdout(w), interp.OptionFunc(func(o *interp.Options) {
+//		o.MaxSteps = 1e6
+//	}))
+type OptionFunc func(*Options)
+
+func (f OptionFunc) apply(o *Options) { f(o) }
+
+// WithGoPath sets Options.GoPath.
+func WithGoPath(gopath string) Option {
+	return OptionFunc(func(o *Options) { o.GoPath = gopath })
+}
+
+// WithBuildTags adds tags to Options.BuildTags.
+func WithBuildTags(tags ...string) Option {
+	return OptionFunc(func(o *Options) { o.BuildTags = append(o.BuildTags, tags...) })
+}
+
+// WithWorkspace sets the directory of the module path in Options.Workspace.
+func WithWorkspace(path, dir string) Option {
+	return OptionFunc(func(o *Options) {
+		ws := make(map[string]string, len(o.Workspace)+1)
+		for k, v := range o.Workspace {
+			ws[k] = v
+		}
+		ws[path] = dir
+		o.Workspace = ws
+	})
+}
+
+// WithTarget sets Options.GOOS and Options.GOARCH.
+func WithTarget(goos, goarch string) Option {
+	return OptionFunc(func(o *Options) { o.GOOS, o.GOARCH = goos, goarch })
+}
+
+// WithStdin sets Options.Stdin.
+func WithStdin(r io.Reader) Option {
+	return OptionFunc(func(o *Options) { o.Stdin = r })
+}
+
+// WithStdout sets Options.Stdout.
+func WithStdout(w io.Writer) Option {
+	return OptionFunc(func(o *Options) { o.Stdout = w })
+}
+
+// WithStderr sets Options.Stderr.
+func WithStderr(w io.Writer) Option {
+	return OptionFunc(func(o *Options) { o.Stderr = w })
+}
+
+// WithEnv sets Options.Env.
+func WithEnv(env ...string) Option {
+	return OptionFunc(func(o *Options) { o.Env = append([]string{}, env...) })
+}
+
+// WithTimeouts sets Options.Timeouts.
+func WithTimeouts(t Timeouts) Option {
+	return OptionFunc(func(o *Options) { o.Timeouts = t })
+}
+
+// WithRestrictions sets Options.Restrictions.
+func WithRestrictions(r *Restrictions) Option {
+	return OptionFunc(func(o *Options) { o.Restrictions = r })
+}
@@ -0,0 +1,46 @@
+package interp
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+)
+
+func TestOptions(t *testing.T) {
+	var stdout bytes.Buffer
+	i := New(
+		Options{GoPath: "/go", BuildTags: []string{"a"}},
+		WithBuildTags("b", "c"),
+		WithStdout(&stdout),
+		WithEnv("FOO=bar"),
+		OptionFunc(func(o *Options) { o.REPLHistory = 3 }),
+	)
+	if i.context.GOPATH != "/go" || !reflect.DeepEqual(i.context.BuildTags, []string{"a", "b", "c"}) || i.replHistory != 3 {
+		t.Fatalf("unexpected options %q %q %d", i.context.GOPATH, i.context.BuildTags, i.replHistory)
+	}
+	if i.stdout != &stdout {
+		t.Fatal("stdout not set")
+	}
+	if v, ok := i.LookupEnv("FOO"); !ok || v != "bar" {
+		t.Fatalf("got FOO=%q, want bar", v)
+	}
+
+	// An Options value replaces all the options set before.
+	i = New(WithGoPath("/tmp"), Options{})
+	if i.context.GOPATH != "" {
+		t.Fatalf("got GOPATH %q, want none", i.context.GOPATH)
+	}
+	if i = New(); i.context.GOPATH != "" {
+		t.Fatalf("got GOPATH %q, want none", i.context.GOPATH)
+	}
+}
+
+func TestUnsupportedTarget(t *testing.T) {
+	i := New(WithTarget("linux", "z80"))
+	if _, err := i.Eval(`1`); err == nil || err.Error() != `unsupported GOARCH "z80"` {
+		t.Fatalf("got error %v, want unsupported GOARCH", err)
+	}
+	if _, err := i.CompilePackage("p", map[string]string{"p.go": "package p\n"}); err == nil {
+		t.Fatal("missing error for an unsupported GOARCH")
+	}
+}
@@ -0,0 +1,135 @@
+package interp
+
+import (
+	"io"
+	"reflect"
+	"sync"
+	"unicode/utf8"
+)
+
+// TruncationMarker is the default marker of truncated outputs and results,
+// see OutputLimit.
+const TruncationMarker = "[truncated]"
+
+// OutputLimit limits the size of the output and results of interpreted code,
+// so an evaluation service is protected from scripts producing huge outputs.
+// A zero field means no limit.
+type OutputLimit struct {
+	// MaxBytes is the maximum number of bytes written to each of Stdout and
+	// Stderr by an evaluation, including the functions called by the host
+	// until the next evaluation. Beyond, Marker is written once, and the
+	// rest of the output is discarded.
+	MaxBytes int64
+
+	// MaxLen is the maximum length of the strings and slices returned as
+	// result by the Eval methods. Longer strings are truncated and suffixed
+	// by Marker, longer slices are truncated.
+	MaxLen int
+
+	// Marker is written as is in place of the truncated output, and suffixes
+	// the truncated strings. It defaults to TruncationMarker.
+	Marker string
+
+	// Abort makes the evaluations exceeding MaxBytes fail with a QuotaError
+	// named "output", instead of going on with their output discarded.
+	Abort bool
+}
+
+func (l *OutputLimit) marker() string {
+	if l.Marker == "" {
+		return TruncationMarker
+	}
+	return l.Marker
+}
+
+// limitWriter writes up to the limit of bytes of the current evaluation.
+type limitWriter struct {
+	interp *Interpreter
+	limit  *OutputLimit
+	w      io.Writer
+
+	mu        sync.Mutex
+	n         int64 // bytes written by the current evaluation
+	truncated bool
+}
+
+func (l *limitWriter) Write(p []byte) (int, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.truncated {
+		return len(p), nil
+	}
+	max := l.limit.MaxBytes
+	if rest := max - l.n; int64(len(p)) > rest {
+		l.truncated = true
+		if _, err := l.w.Write(p[:rest]); err != nil {
+			return 0, err
+		}
+		l.n = max
+		if _, err := io.WriteString(l.w, l.limit.marker()); err != nil {
+			return 0, err
+		}
+		if l.limit.Abort {
+			l.interp.exceed("output", max)
+		}
+		return len(p), nil
+	}
+	n, err := l.w.Write(p)
+	l.n += int64(n)
+	return n, err
+}
+
+func (l *limitWriter) reset() {
+	l.mu.Lock()
+	l.n = 0
+	l.truncated = false
+	l.mu.Unlock()
+}
+
+// limitOutput wraps the standard output and error of the interpreter to
+// enforce the output limit.
+func (interp *Interpreter) limitOutput(limit *OutputLimit) {
+	interp.outputLimit = limit
+	if limit.MaxBytes <= 0 {
+		return
+	}
+	interp.opt.stdout = &limitWriter{interp: interp, limit: limit, w: interp.opt.stdout}
+	interp.opt.stderr = &limitWriter{interp: interp, limit: limit, w: interp.opt.stderr}
+}
+
+// resetOutput starts the count of output bytes of a new evaluation.
+func (interp *Interpreter) resetOutput() {
+	for _, w := range []io.Writer{interp.stdout, interp.stderr} {
+		if l, ok := w.(*limitWriter); ok {
+			l.reset()
+		}
+	}
+}
+
+// truncateResult returns the result v of an evaluation truncated to the
+// maximum length of results.
+func (interp *Interpreter) truncateResult(v reflect.Value) reflect.Value {
+	limit := interp.outputLimit
+	if limit == nil || limit.MaxLen <= 0 || !v.IsValid() {
+		return v
+	}
+	max := limit.MaxLen
+	switch v.Kind() {
+	case reflect.String:
+		s := v.String()
+		if len(s) <= max {
+			return v
+		}
+		// Do not split a multibyte character.
+		for max > 0 && !utf8.RuneStart(s[max]) {
+			max--
+		}
+		return reflect.ValueOf(s[:max] + limit.marker()).Convert(v.Type())
+	case reflect.Slice:
+		if v.Len() > max {
+			return v.Slice(0, max)
+		}
+	}
+	return v
+}
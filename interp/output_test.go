@@ -0,0 +1,76 @@
+package interp
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestOutputLimit(t *testing.T) {
+	var stdout bytes.Buffer
+	i := New(Options{Stdout: &stdout, OutputLimit: &OutputLimit{MaxBytes: 10}})
+	i.Use(Exports{"fmt": {"Sprint": reflect.ValueOf(fmt.Sprint)}})
+	if _, err := i.Eval(`import "fmt"`); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := i.Eval(`func hello() { for i := 0; i < 100; i++ { fmt.Println("hello") } }`); err != nil {
+		t.Fatal(err)
+	}
+
+	// The count of bytes is reset at each evaluation.
+	for n := 0; n < 2; n++ {
+		if _, err := i.Eval(`hello()`); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if got, want := stdout.String(), strings.Repeat("hello\nhell"+TruncationMarker, 2); got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func TestOutputLimitAbort(t *testing.T) {
+	var stdout bytes.Buffer
+	i := New(Options{Stdout: &stdout, OutputLimit: &OutputLimit{MaxBytes: 4, Marker: "...", Abort: true}})
+	i.Use(Exports{"fmt": {"Sprint": reflect.ValueOf(fmt.Sprint)}})
+	if _, err := i.Eval(`import "fmt"`); err != nil {
+		t.Fatal(err)
+	}
+	_, err := i.Eval(`for { fmt.Print("x") }`)
+	var qe *QuotaError
+	if !errors.As(err, &qe) || qe.Quota != "output" || qe.Max != 4 {
+		t.Fatalf("got error %v, want output quota error", err)
+	}
+	if got, want := stdout.String(), "xxxx..."; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func TestOutputLimitResult(t *testing.T) {
+	i := New(Options{OutputLimit: &OutputLimit{MaxLen: 2, Marker: "~"}})
+	for _, test := range []struct {
+		src  string
+		want interface{}
+	}{
+		{src: `"hi"`, want: "hi"},
+		{src: `"hello"`, want: "he~"},
+		{src: `"héllo"`, want: "h~"},
+		{src: `[]int{1, 2, 3}`, want: []int{1, 2}},
+		{src: `1234`, want: 1234},
+	} {
+		res, err := i.Eval(test.src)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := res.Interface(); !reflect.DeepEqual(got, test.want) {
+			t.Errorf("%s: got %v, want %v", test.src, got, test.want)
+		}
+	}
+
+	var s string
+	if err := i.EvalInto(`"hello"`, &s); err != nil || s != "he~" {
+		t.Errorf("got %q %v, want %q", s, err, "he~")
+	}
+}
@@ -0,0 +1,65 @@
+package interp
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// Override replaces the symbol name of the binary package importPath, loaded
+// by Use, by value for the code of the interpreter only, such as time.Now or
+// rand.Int by deterministic versions for reproducible runs. The value must be
+// assignable to the type of the original symbol: a function of the same
+// signature, or for a variable, a value of its type, copied in a variable of
+// the interpreter. Types and constants can not be overridden. The override
+// persists when the package is provided again by Use. The code compiled
+// before keeps using the original symbol.
+func (interp *Interpreter) Override(importPath, name string, value reflect.Value) error {
+	interp.evalMutex.Lock()
+	defer interp.evalMutex.Unlock()
+
+	pkg := interp.binPkg[importPath]
+	if pkg == nil {
+		return fmt.Errorf("override %s.%s: package not loaded", importPath, name)
+	}
+	orig, ok := pkg[name]
+	switch {
+	case !ok:
+		return fmt.Errorf("override %s.%s: symbol not found", importPath, name)
+	case isBinType(orig):
+		return fmt.Errorf("override %s.%s: cannot override a type", importPath, name)
+	case !value.IsValid():
+		return fmt.Errorf("override %s.%s: invalid value", importPath, name)
+	case !value.Type().AssignableTo(orig.Type()):
+		return fmt.Errorf("override %s.%s: cannot use %s as %s", importPath, name, value.Type(), orig.Type())
+	}
+
+	v := value.Convert(orig.Type())
+	if orig.CanAddr() {
+		// A variable: the interpreted code may assign it.
+		v = reflect.New(orig.Type()).Elem()
+		v.Set(value)
+	}
+	if interp.overrides == nil {
+		interp.overrides = map[string]map[string]reflect.Value{}
+	}
+	if interp.overrides[importPath] == nil {
+		interp.overrides[importPath] = map[string]reflect.Value{}
+	}
+	interp.overrides[importPath][name] = v
+	pkg[name] = interp.copied(importPath, name, interp.pinned(importPath, name, v))
+	interp.flushEvalCache()
+	return nil
+}
+
+// applyOverrides restores the overridden symbols of the binary packages of
+// values, just provided by Use.
+func (interp *Interpreter) applyOverrides(values Exports) {
+	for path, syms := range interp.overrides {
+		if values[path] == nil {
+			continue
+		}
+		for name, v := range syms {
+			interp.binPkg[path][name] = v
+		}
+	}
+}
@@ -0,0 +1,77 @@
+package interp
+
+import (
+	"math/rand"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+type fakeNow func() time.Time
+
+var fakeLimit = 3
+
+func TestOverride(t *testing.T) {
+	i := New(Options{})
+	symbols := Exports{
+		"time": {
+			"Now":  reflect.ValueOf(time.Now),
+			"Time": reflect.ValueOf((*time.Time)(nil)),
+		},
+		"math/rand": {
+			"Int": reflect.ValueOf(rand.Int),
+		},
+		"limits": {
+			"Max": reflect.ValueOf(&fakeLimit).Elem(),
+		},
+	}
+	i.Use(symbols)
+
+	epoch := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	if err := i.Override("time", "Now", reflect.ValueOf(fakeNow(func() time.Time { return epoch }))); err != nil {
+		t.Fatal(err)
+	}
+	if err := i.Override("math/rand", "Int", reflect.ValueOf(func() int { return 4 })); err != nil {
+		t.Fatal(err)
+	}
+	if err := i.Override("limits", "Max", reflect.ValueOf(10)); err != nil {
+		t.Fatal(err)
+	}
+	// The package provided again keeps its overrides.
+	i.Use(symbols)
+
+	if _, err := i.Eval(`import ("limits"; "math/rand"; "time")`); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := i.Eval(`limits.Max++`); err != nil {
+		t.Fatal(err)
+	}
+	v, err := i.Eval(`time.Now().Year() + rand.Int() + limits.Max`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v.Int() != 2035 {
+		t.Errorf("got %d, want 2035", v.Int())
+	}
+	if fakeLimit != 3 {
+		t.Errorf("host variable modified: %d", fakeLimit)
+	}
+
+	for _, test := range []struct {
+		path, name string
+		value      reflect.Value
+		err        string
+	}{
+		{path: "os", name: "Exit", value: reflect.ValueOf(func(int) {}), err: "override os.Exit: package not loaded"},
+		{path: "time", name: "Since", value: reflect.ValueOf(time.Since), err: "override time.Since: symbol not found"},
+		{path: "time", name: "Time", value: reflect.ValueOf((*time.Time)(nil)), err: "override time.Time: cannot override a type"},
+		{path: "time", name: "Now", value: reflect.Value{}, err: "override time.Now: invalid value"},
+		{path: "time", name: "Now", value: reflect.ValueOf(func() int64 { return 0 }), err: "override time.Now: cannot use func() int64 as func() time.Time"},
+		{path: "limits", name: "Max", value: reflect.ValueOf("a"), err: "override limits.Max: cannot use string as int"},
+	} {
+		if err := i.Override(test.path, test.name, test.value); err == nil || !strings.Contains(err.Error(), test.err) {
+			t.Errorf("%s.%s: got error %v, want %q", test.path, test.name, err, test.err)
+		}
+	}
+}
@@ -0,0 +1,141 @@
+package interp
+
+import (
+	"fmt"
+	"reflect"
+	"runtime"
+	"runtime/debug"
+	"strings"
+)
+
+// RuntimeError is a run-time error of interpreted code, such as an out of
+// range index or a nil pointer dereference, reported as the Value of a Panic
+// error. It implements runtime.Error, as the run-time errors of compiled
+// code, so that a host can tell the crashes of interpreted code from its
+// explicit panics.
+type RuntimeError struct {
+	Msg   string      // message, as the one of the Go runtime
+	Value interface{} // value panicked by the interpreter implementation
+	Trace []string    // interpreted functions traversed by the panic, as Panic.Trace
+}
+
+func (e *RuntimeError) Error() string { return e.Msg }
+
+// RuntimeError marks e as a run-time error.
+func (e *RuntimeError) RuntimeError() {}
+
+// Unwrap returns the value panicked by the interpreter implementation if it is
+// an error, or nil.
+func (e *RuntimeError) Unwrap() error {
+	err, _ := e.Value.(error)
+	return err
+}
+
+// Stack returns the interpreted stack trace of e, in the format of the
+// goroutine traces of Go: the message, then for each function traversed by
+// the panic, innermost first, its name and its position. Top level code has no
+// name.
+func (e *RuntimeError) Stack() string {
+	var sb strings.Builder
+	sb.WriteString(e.Msg + "\n")
+	writeTrace(&sb, e.Trace)
+	return sb.String()
+}
+
+// Format implements fmt.Formatter: the %+v verb prints the interpreted stack
+// trace of e, as Stack, and the other verbs its message.
+func (e *RuntimeError) Format(s fmt.State, verb rune) {
+	formatTrace(s, verb, e.Msg, e.Trace)
+}
+
+// formatTrace formats the message msg of an error, followed by its trace of
+// interpreted functions for the %+v verb.
+func formatTrace(s fmt.State, verb rune, msg string, trace []string) {
+	switch {
+	case verb == 'v' && s.Flag('+'):
+		var sb strings.Builder
+		sb.WriteString(msg + "\n")
+		writeTrace(&sb, trace)
+		fmt.Fprint(s, sb.String())
+	case verb == 'q':
+		fmt.Fprintf(s, "%q", msg)
+	default:
+		fmt.Fprint(s, msg)
+	}
+}
+
+// writeTrace writes the trace of interpreted functions, in the format of the
+// goroutine traces of Go.
+func writeTrace(sb *strings.Builder, trace []string) {
+	for _, t := range trace {
+		name, pos := "", t
+		if i := strings.LastIndex(t, " "); i >= 0 {
+			name, pos = t[:i], t[i+1:]
+		}
+		if name != "" {
+			sb.WriteString(name + "\n")
+		}
+		sb.WriteString("\t" + pos + "\n")
+	}
+}
+
+// runtimeError returns the run-time error of the value v panicked by the
+// interpreter implementation, with the trace of interpreted functions, or nil
+// if v is an explicit panic of interpreted code. The panics of the reflect
+// package are translated to the messages of the Go runtime.
+func runtimeError(v interface{}, trace []string) *RuntimeError {
+	var msg string
+	switch e := v.(type) {
+	case runtime.Error:
+		msg = e.Error()
+	case *reflect.ValueError:
+		// An operation on the zero Value, such as the element of a nil pointer.
+		msg = "runtime error: invalid memory address or nil pointer dereference"
+	case string:
+		switch {
+		case strings.HasSuffix(e, "index out of range"):
+			msg = "runtime error: index out of range"
+		case strings.HasSuffix(e, "slice index out of bounds"):
+			msg = "runtime error: slice bounds out of range"
+		case strings.HasPrefix(e, "interface conversion: "):
+			msg = e
+		default:
+			return nil
+		}
+	default:
+		return nil
+	}
+	return &RuntimeError{Msg: msg, Value: v, Trace: trace}
+}
+
+// newPanic returns the Panic error of the value r recovered from interpreted
+// code, whose run-time errors are translated to *RuntimeError.
+func (interp *Interpreter) newPanic(r interface{}) Panic {
+	var pc [64]uintptr // 64 frames should be enough.
+	n := runtime.Callers(2, pc[:])
+	v, trace := untrace(r)
+	if e := runtimeError(v, trace); e != nil {
+		v = e
+	} else {
+		v = interp.redactValue(v)
+	}
+	return Panic{Value: v, Trace: trace, Callers: pc[:n], Stack: debug.Stack()}
+}
+
+// recoverPanic sets *err to the error of a panic of interpreted code, the
+// *ExitError of a call to os.Exit or a Panic. It is deferred by the methods
+// running interpreted code on behalf of the host, so that a crash of
+// interpreted code does not crash the host.
+func (interp *Interpreter) recoverPanic(err *error) {
+	r := recover()
+	if r == nil {
+		return
+	}
+	if v, _ := untrace(r); v != nil {
+		if e, ok := v.(*ExitError); ok {
+			*err = e
+			return
+		}
+	}
+	*err = interp.newPanic(r)
+}
@@ -0,0 +1,80 @@
+package interp
+
+import (
+	"bytes"
+	"errors"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+func TestRuntimeError(t *testing.T) {
+	i := New(Options{})
+	if _, err := i.Eval(`
+type T struct{ x int }
+
+func index(s []int, i int) int { return s[i] }
+
+func deref(p *T) int {
+	if p == nil {
+		return p.x
+	}
+	return 0
+}
+
+func store(m map[int]int) { m[1] = 1 }
+
+func div(a, b int) int { return a / b }
+
+func assert(x interface{}) string { return x.(string) }
+
+func explicit() { panic("boom") }`); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, test := range []struct {
+		src, msg, trace string
+	}{
+		{src: "index([]int{1}, 2)", msg: "runtime error: index out of range", trace: "index _.go:4:41"},
+		{src: "deref(nil)", msg: "runtime error: invalid memory address or nil pointer dereference", trace: "deref _.go:7:"},
+		{src: "store(nil)", msg: "assignment to entry in nil map", trace: "store _.go:13:29"},
+		{src: "div(1, 0)", msg: "runtime error: integer divide by zero", trace: "div _.go:15:"},
+		{src: "assert(1)", msg: "interface conversion: interface {} is int, not string", trace: "assert _.go:17:"},
+	} {
+		_, err := i.Eval(test.src)
+		var re runtime.Error
+		if !errors.As(err, &re) {
+			t.Fatalf("%s: got %v, want a runtime.Error", test.src, err)
+		}
+		if err.Error() != test.msg {
+			t.Errorf("%s: got %q, want %q", test.src, err, test.msg)
+		}
+		e := err.(Panic).Value.(*RuntimeError)
+		if len(e.Trace) == 0 || !strings.HasPrefix(e.Trace[0], test.trace) {
+			t.Errorf("%s: got trace %q, want first %q", test.src, e.Trace, test.trace)
+		}
+		if s := e.Stack(); !strings.HasPrefix(s, test.msg+"\n"+strings.Fields(test.trace)[0]+"\n\t_.go:") {
+			t.Errorf("%s: unexpected stack %q", test.src, s)
+		}
+	}
+
+	_, err := i.Eval("explicit()")
+	var re runtime.Error
+	if err == nil || errors.As(err, &re) {
+		t.Fatalf("got %v, want an explicit panic", err)
+	}
+}
+
+func TestImportArchivePanic(t *testing.T) {
+	_, zipData, _ := makeArchives(t, map[string]string{
+		"plugin.go": "package plugin\n\nvar s []int\n\nfunc init() { s[1] = 1 }\n",
+	})
+	_, err := New(Options{}).ImportArchive("example.com/plugin", bytes.NewReader(zipData), ArchiveOptions{})
+	var re *RuntimeError
+	if !errors.As(err, &re) {
+		t.Fatalf("got %v, want a *RuntimeError", err)
+	}
+	if len(re.Trace) == 0 || !strings.HasPrefix(re.Trace[0], "init example.com/plugin/plugin.go:5:") {
+		t.Fatalf("unexpected trace %q", re.Trace)
+	}
+}
@@ -0,0 +1,68 @@
+package interp
+
+import (
+	"fmt"
+	"go/ast"
+	"go/doc"
+	"go/parser"
+	"go/token"
+	"path/filepath"
+	"strings"
+)
+
+// srcFile stores the name and the content of a package source file.
+type srcFile struct {
+	name string // file name, as registered in the interpreter FileSet
+	src  string // file content
+}
+
+// Doc returns the documentation of the source package identified by importPath,
+// which must have been previously imported in the interpreter.
+// The documentation is computed by go/doc from the package source files. If
+// test files are present along the package sources, their Example functions
+// are associated to the documented symbols.
+func (interp *Interpreter) Doc(importPath string) (*doc.Package, error) {
+	interp.mutex.RLock()
+	sources, ok := interp.sources[importPath]
+	interp.mutex.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("package not found: %s", importPath)
+	}
+
+	// A dedicated FileSet is used, to not pollute the interpreter one
+	// with files which are not evaluated.
+	fset := token.NewFileSet()
+	var files []*ast.File
+	seen := map[string]bool{}
+	for _, s := range sources {
+		f, err := parser.ParseFile(fset, s.name, s.src, parser.ParseComments)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, f)
+		seen[s.name] = true
+	}
+
+	// Look for examples in test files located in the package directory, if any.
+	if len(sources) > 0 {
+		dir := filepath.Dir(sources[0].name)
+		infos, _ := interp.srcFS.ReadDir(dir)
+		for _, fi := range infos {
+			name := filepath.Join(dir, fi.Name())
+			if !strings.HasSuffix(name, "_test.go") || seen[name] || skipFile(&interp.context, name, false) {
+				continue
+			}
+			b, err := interp.srcFS.ReadFile(name)
+			if err != nil {
+				continue
+			}
+			f, err := parser.ParseFile(fset, name, b, parser.ParseComments)
+			if err != nil {
+				return nil, err
+			}
+			files = append(files, f)
+		}
+	}
+
+	return doc.NewFromFiles(fset, files, importPath)
+}
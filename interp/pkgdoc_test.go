@@ -0,0 +1,47 @@
+package interp_test
+
+import (
+	"testing"
+
+	"github.com/traefik/yaegi/interp"
+	"github.com/traefik/yaegi/stdlib"
+)
+
+func TestDoc(t *testing.T) {
+	i := interp.New(interp.Options{GoPath: "./testdata"})
+	i.Use(stdlib.Symbols)
+
+	if _, err := i.Doc("guthib.com/doc"); err == nil {
+		t.Fatal("expected error for a package not yet imported")
+	}
+
+	if _, err := i.Eval(`import "guthib.com/doc"`); err != nil {
+		t.Fatal(err)
+	}
+
+	d, err := i.Doc("guthib.com/doc")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d.Name != "doc" {
+		t.Errorf("got package name %q, want %q", d.Name, "doc")
+	}
+	if want := "Package doc is a documented package.\n"; d.Doc != want {
+		t.Errorf("got package doc %q, want %q", d.Doc, want)
+	}
+	if len(d.Funcs) != 1 || d.Funcs[0].Name != "Hello" {
+		t.Fatalf("unexpected funcs: %v", d.Funcs)
+	}
+	if want := "Hello returns a greeting for name.\n"; d.Funcs[0].Doc != want {
+		t.Errorf("got func doc %q, want %q", d.Funcs[0].Doc, want)
+	}
+	if len(d.Funcs[0].Examples) != 1 || d.Funcs[0].Examples[0].Output != "Hello world\n" {
+		t.Errorf("unexpected examples: %v", d.Funcs[0].Examples)
+	}
+	if len(d.Types) != 1 || d.Types[0].Name != "Greeter" {
+		t.Errorf("unexpected types: %v", d.Types)
+	}
+	if len(d.Consts) != 1 || d.Consts[0].Names[0] != "Answer" {
+		t.Errorf("unexpected consts: %v", d.Consts)
+	}
+}
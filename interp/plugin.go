@@ -0,0 +1,45 @@
+// +build linux,cgo darwin,cgo freebsd,cgo
+
+package interp
+
+import (
+	"fmt"
+	"plugin"
+	"reflect"
+)
+
+// PluginSymbols is the name of the variable holding the symbols of a compiled
+// plugin loaded by UsePlugin.
+const PluginSymbols = "Symbols"
+
+// UsePlugin opens the Go plugin at path, compiled with -buildmode=plugin, and
+// uses the symbols of its PluginSymbols variable, of type Exports or
+// map[string]map[string]reflect.Value as generated by yaegi extract, as Use
+// does. It allows to provide heavy dependencies, such as database drivers, as
+// compiled code, and to script the glue code. The plugin must be built with
+// the same version of the interpreter and of the packages shared with the
+// host. Plugins are only supported on Linux, macOS and FreeBSD, with cgo.
+func (interp *Interpreter) UsePlugin(path string) error {
+	p, err := plugin.Open(path)
+	if err != nil {
+		return err
+	}
+	sym, err := p.Lookup(PluginSymbols)
+	if err != nil {
+		return fmt.Errorf("plugin %s: %w", path, err)
+	}
+	return interp.usePluginSymbols(path, sym)
+}
+
+// usePluginSymbols uses the symbols sym of the plugin at path.
+func (interp *Interpreter) usePluginSymbols(path string, sym plugin.Symbol) error {
+	switch s := sym.(type) {
+	case *Exports:
+		interp.Use(*s)
+	case *map[string]map[string]reflect.Value:
+		interp.Use(*s)
+	default:
+		return fmt.Errorf("plugin %s: %s is a %T, not a variable of type interp.Exports", path, PluginSymbols, sym)
+	}
+	return nil
+}
@@ -0,0 +1,15 @@
+// +build !cgo !linux,!darwin,!freebsd
+
+package interp
+
+import "errors"
+
+// PluginSymbols is the name of the variable holding the symbols of a compiled
+// plugin loaded by UsePlugin.
+const PluginSymbols = "Symbols"
+
+// UsePlugin loads the Go plugin at path. Plugins are only supported on Linux,
+// macOS and FreeBSD, with cgo: it always returns an error on this platform.
+func (interp *Interpreter) UsePlugin(path string) error {
+	return errors.New("plugin " + path + ": plugins are only supported on linux, darwin and freebsd, with cgo")
+}
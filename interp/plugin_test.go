@@ -0,0 +1,40 @@
+// +build linux,cgo darwin,cgo freebsd,cgo
+
+package interp
+
+import (
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestUsePlugin(t *testing.T) {
+	if err := New(Options{}).UsePlugin(filepath.Join("testdata", "missing.so")); err == nil {
+		t.Error("got no error, want an error for a missing plugin")
+	}
+
+	exports := Exports{"a": {"Answer": reflect.ValueOf(func() int { return 42 })}}
+	symbols := map[string]map[string]reflect.Value{"b": {"Name": reflect.ValueOf(func() string { return "b" })}}
+	i := New(Options{})
+	for _, sym := range []interface{}{&exports, &symbols} {
+		if err := i.usePluginSymbols("p.so", sym); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := i.Eval(`import ("a"; "b")`); err != nil {
+		t.Fatal(err)
+	}
+	res, err := i.Eval(`b.Name() + string(rune('0' + a.Answer() % 10))`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := res.String(); got != "b2" {
+		t.Errorf("got %q, want %q", got, "b2")
+	}
+
+	var n int
+	if err := i.usePluginSymbols("p.so", &n); err == nil || !strings.Contains(err.Error(), "*int") {
+		t.Errorf("got %v, want a type error", err)
+	}
+}
@@ -0,0 +1,197 @@
+// Package plugintest provides golden tests of interpreted plugins.
+//
+// A plugin is a source package loaded by the interpreter, with an entry
+// function called on each JSON fixture of a directory. The results of the
+// calls, encoded in JSON, are compared to golden files, written next to the
+// fixtures with the ".golden" extension instead of ".json":
+//
+//	func TestPlugin(t *testing.T) {
+//		plugintest.Run(t, plugintest.Config{
+//			Options: interp.Options{GoPath: "./_gopath"},
+//			Use:     []interp.Exports{stdlib.Symbols},
+//			Import:  "example.com/plugin",
+//			Entry:   "Handle",
+//		})
+//	}
+//
+// The golden files are created or updated by running the tests with the
+// -update flag.
+package plugintest
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/traefik/yaegi/interp"
+)
+
+var update = flag.Bool("update", false, "update the golden files of plugin tests")
+
+// Config describes a plugin and its golden tests.
+type Config struct {
+	Options interp.Options   // options of the interpreter loading the plugin
+	Use     []interp.Exports // binary symbols available to the plugin, such as stdlib.Symbols
+	Import  string           // import path of the plugin package
+	Entry   string           // name of the entry function of the plugin package
+	Dir     string           // directory of the fixtures and golden files, "testdata" if empty
+	Update  bool             // write the golden files instead of comparing them, as the -update flag
+}
+
+// Plugin is a plugin loaded by an interpreter.
+type Plugin struct {
+	Interp *interp.Interpreter
+	entry  reflect.Value
+}
+
+// Load returns the plugin described by c, with a new interpreter.
+func Load(c Config) (*Plugin, error) {
+	i := interp.New(c.Options)
+	for _, values := range c.Use {
+		i.Use(values)
+	}
+	if _, err := i.Eval(fmt.Sprintf("import _plugin %q", c.Import)); err != nil {
+		return nil, err
+	}
+	entry, err := i.Eval("_plugin." + c.Entry)
+	if err != nil {
+		return nil, err
+	}
+	if entry.Kind() != reflect.Func {
+		return nil, fmt.Errorf("%s.%s is not a function", c.Import, c.Entry)
+	}
+	return &Plugin{Interp: i, entry: entry}, nil
+}
+
+// Call calls the entry function of the plugin with the arguments decoded from
+// the JSON input, and returns its results encoded in JSON. An entry function
+// with a single parameter takes the whole input, otherwise the input must be
+// an array of the arguments. Several results are encoded as an array. If the
+// last result is an error, only this error is encoded if not nil, as an
+// object with the "error" key.
+func (p *Plugin) Call(input []byte) ([]byte, error) {
+	t := p.entry.Type()
+	in := make([]reflect.Value, t.NumIn())
+	switch len(in) {
+	case 0:
+	case 1:
+		v := reflect.New(t.In(0))
+		if err := json.Unmarshal(input, v.Interface()); err != nil {
+			return nil, err
+		}
+		in[0] = v.Elem()
+	default:
+		var args []json.RawMessage
+		if err := json.Unmarshal(input, &args); err != nil {
+			return nil, err
+		}
+		if len(args) != len(in) {
+			return nil, fmt.Errorf("got %d arguments, want %d", len(args), len(in))
+		}
+		for i, a := range args {
+			v := reflect.New(t.In(i))
+			if err := json.Unmarshal(a, v.Interface()); err != nil {
+				return nil, fmt.Errorf("argument %d: %w", i, err)
+			}
+			in[i] = v.Elem()
+		}
+	}
+
+	var out []reflect.Value
+	if t.IsVariadic() {
+		out = p.entry.CallSlice(in)
+	} else {
+		out = p.entry.Call(in)
+	}
+
+	var res interface{}
+	if n := len(out); n > 0 && t.Out(n-1) == reflect.TypeOf((*error)(nil)).Elem() {
+		if err, _ := out[n-1].Interface().(error); err != nil {
+			res = map[string]string{"error": err.Error()}
+		}
+		out = out[:n-1]
+	}
+	if res == nil {
+		switch len(out) {
+		case 0:
+		case 1:
+			res = out[0].Interface()
+		default:
+			a := make([]interface{}, len(out))
+			for i, v := range out {
+				a[i] = v.Interface()
+			}
+			res = a
+		}
+	}
+	b, err := json.MarshalIndent(res, "", "\t")
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '\n'), nil
+}
+
+// Run loads the plugin described by c, and runs a subtest for each JSON
+// fixture of the test directory, comparing the result of the entry function
+// to the golden file of the fixture.
+func Run(t *testing.T, c Config) {
+	t.Helper()
+	p, err := Load(c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dir := c.Dir
+	if dir == "" {
+		dir = "testdata"
+	}
+	fixtures, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(fixtures) == 0 {
+		t.Fatalf("no fixtures in %s", dir)
+	}
+	sort.Strings(fixtures)
+
+	for _, fixture := range fixtures {
+		fixture := fixture
+		name := strings.TrimSuffix(filepath.Base(fixture), ".json")
+		t.Run(name, func(t *testing.T) {
+			if err := p.check(fixture, c.Update || *update); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+// check calls the plugin with the input of the fixture file, and compares
+// the result to its golden file, or writes it if update is true.
+func (p *Plugin) check(fixture string, update bool) error {
+	input, err := ioutil.ReadFile(fixture)
+	if err != nil {
+		return err
+	}
+	got, err := p.Call(input)
+	if err != nil {
+		return err
+	}
+	golden := strings.TrimSuffix(fixture, ".json") + ".golden"
+	if update {
+		return ioutil.WriteFile(golden, got, 0644)
+	}
+	want, err := ioutil.ReadFile(golden)
+	if err != nil {
+		return fmt.Errorf("%v (run with -update to create it)", err)
+	}
+	if !bytes.Equal(got, want) {
+		return fmt.Errorf("%s: got\n%s\nwant\n%s", golden, got, want)
+	}
+	return nil
+}
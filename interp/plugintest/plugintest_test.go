@@ -0,0 +1,126 @@
+package plugintest
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/traefik/yaegi/interp"
+)
+
+const pluginSrc = `package plugin
+
+import "errors"
+
+type Request struct {
+	Name  string
+	Count int
+}
+
+type Response struct {
+	Greeting string ` + "`json:\"greeting\"`" + `
+}
+
+func Handle(r Request) (Response, error) {
+	if r.Count < 0 {
+		return Response{}, errors.New("negative count")
+	}
+	s := ""
+	for i := 0; i < r.Count; i++ {
+		s += "hello "
+	}
+	return Response{Greeting: s + r.Name}, nil
+}
+
+func Add(a, b int) int { return a + b }
+`
+
+func TestRun(t *testing.T) {
+	tmp, err := ioutil.TempDir("", "plugintest")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		_ = os.RemoveAll(tmp)
+	}()
+
+	files := map[string]string{
+		"src/plugin/plugin.go":    pluginSrc,
+		"testdata/ok.json":        `{"Name": "bob", "Count": 2}`,
+		"testdata/negative.json":  `{"Name": "bob", "Count": -1}`,
+		"testdata/add/sum.json":   `[1, 2]`,
+		"testdata/add/wrong.json": `[1]`,
+	}
+	for name, src := range files {
+		name = filepath.Join(tmp, name)
+		if err := os.MkdirAll(filepath.Dir(name), 0700); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(name, []byte(src), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	c := Config{
+		Options: interp.Options{GoPath: tmp},
+		Use:     []interp.Exports{{"errors": {"New": reflect.ValueOf(errors.New)}}},
+		Import:  "plugin",
+		Entry:   "Handle",
+		Dir:     filepath.Join(tmp, "testdata"),
+		Update:  true,
+	}
+	Run(t, c)
+
+	golden := map[string]string{
+		"ok.golden":       "{\n\t\"greeting\": \"hello hello bob\"\n}\n",
+		"negative.golden": "{\n\t\"error\": \"negative count\"\n}\n",
+	}
+	for name, want := range golden {
+		b, err := ioutil.ReadFile(filepath.Join(c.Dir, name))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(b) != want {
+			t.Errorf("%s: got %q, want %q", name, b, want)
+		}
+	}
+
+	// The results are now compared to the golden files.
+	c.Update = false
+	Run(t, c)
+
+	p, err := Load(c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	name := filepath.Join(c.Dir, "ok.golden")
+	if err := ioutil.WriteFile(name, []byte("{}\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := p.check(filepath.Join(c.Dir, "ok.json"), false); err == nil {
+		t.Error("want error on golden file mismatch")
+	}
+
+	// Entry functions with several parameters take an array of arguments.
+	c.Entry = "Add"
+	if p, err = Load(c); err != nil {
+		t.Fatal(err)
+	}
+	if err := p.check(filepath.Join(c.Dir, "add", "sum.json"), true); err != nil {
+		t.Fatal(err)
+	}
+	if b, _ := ioutil.ReadFile(filepath.Join(c.Dir, "add", "sum.golden")); string(b) != "3\n" {
+		t.Errorf("got %q, want %q", b, "3\n")
+	}
+	if err := p.check(filepath.Join(c.Dir, "add", "wrong.json"), true); err == nil {
+		t.Error("want error on wrong number of arguments")
+	}
+
+	c.Entry = "Request"
+	if _, err := Load(c); err == nil {
+		t.Error("want error on entry not a function")
+	}
+}
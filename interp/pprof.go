@@ -0,0 +1,218 @@
+package interp
+
+import (
+	"compress/gzip"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// CPUProfile is a Tracer aggregating the time spent in interpreted functions,
+// excluding their interpreted callees, by call stack. It is written in the
+// pprof format, to be analysed with "go tool pprof", where host profiles only
+// show the frames of the interpreter.
+//
+// The time of a function is the wall-clock time of its execution, including
+// the time blocked or spent in binary functions.
+type CPUProfile struct {
+	mu      sync.Mutex
+	start   time.Time
+	samples map[string]*cpuSample // indexed by stack key
+}
+
+// cpuSample is the time spent in a call stack.
+type cpuSample struct {
+	stack []traceFunc // innermost first
+	count int64
+	time  time.Duration
+}
+
+// NewCPUProfile returns an empty profile, started now.
+func NewCPUProfile() *CPUProfile {
+	return &CPUProfile{start: time.Now(), samples: map[string]*cpuSample{}}
+}
+
+// Enter implements Tracer.
+func (p *CPUProfile) Enter(c *TraceCall) {}
+
+// Exit implements Tracer.
+func (p *CPUProfile) Exit(c *TraceCall, total, self time.Duration) {
+	var stack []traceFunc
+	var key strings.Builder
+	for ; c != nil; c = c.Caller {
+		stack = append(stack, traceFunc{name: c.Func, file: c.File, line: c.Line})
+		key.WriteString(c.Func)
+		key.WriteByte('\n')
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	s := p.samples[key.String()]
+	if s == nil {
+		s = &cpuSample{stack: stack}
+		p.samples[key.String()] = s
+	}
+	s.count++
+	s.time += self
+}
+
+// WriteTo writes the profile to w, in the gzip compressed protocol buffer
+// format of pprof, with the sample types "samples/count", the number of
+// calls, and "cpu/nanoseconds".
+func (p *CPUProfile) WriteTo(w io.Writer) (int64, error) {
+	p.mu.Lock()
+	keys := make([]string, 0, len(p.samples))
+	for k := range p.samples {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var b protoBuffer
+	strs := map[string]int64{"": 0}
+	strTable := []string{""}
+	str := func(s string) int64 {
+		i, ok := strs[s]
+		if !ok {
+			i = int64(len(strTable))
+			strs[s] = i
+			strTable = append(strTable, s)
+		}
+		return i
+	}
+	valueType := func(tag int, typ, unit string) {
+		b.message(tag, func(b *protoBuffer) {
+			b.intField(1, str(typ))
+			b.intField(2, str(unit))
+		})
+	}
+
+	// Profile fields, see https://github.com/google/pprof/blob/main/proto/profile.proto.
+	valueType(1, "samples", "count")
+	valueType(1, "cpu", "nanoseconds")
+	funcs := map[traceFunc]uint64{}
+	var funcList []traceFunc
+	for _, k := range keys {
+		s := p.samples[k]
+		ids := make([]uint64, len(s.stack))
+		for i, f := range s.stack {
+			id, ok := funcs[f]
+			if !ok {
+				id = uint64(len(funcList) + 1)
+				funcs[f] = id
+				funcList = append(funcList, f)
+			}
+			ids[i] = id
+		}
+		b.message(2, func(b *protoBuffer) {
+			b.packed(1, ids)
+			b.packed(2, []uint64{uint64(s.count), uint64(s.time)})
+		})
+	}
+	// Each function has a single location, with the same id.
+	for i, f := range funcList {
+		id := uint64(i + 1)
+		line := int64(f.line)
+		b.message(4, func(b *protoBuffer) {
+			b.uintField(1, id)
+			b.message(4, func(b *protoBuffer) {
+				b.uintField(1, id)
+				b.intField(2, line)
+			})
+		})
+	}
+	for i, f := range funcList {
+		id, name, file, line := uint64(i+1), str(f.name), str(f.file), int64(f.line)
+		b.message(5, func(b *protoBuffer) {
+			b.uintField(1, id)
+			b.intField(2, name)
+			b.intField(3, name)
+			b.intField(4, file)
+			b.intField(5, line)
+		})
+	}
+	b.intField(9, p.start.UnixNano())
+	b.intField(10, int64(time.Since(p.start)))
+	valueType(11, "cpu", "nanoseconds")
+	b.intField(12, 1)
+	p.mu.Unlock()
+
+	// The string table is encoded last, once complete.
+	for _, s := range strTable {
+		b.stringField(6, s)
+	}
+
+	cw := &countWriter{w: w}
+	zw := gzip.NewWriter(cw)
+	if _, err := zw.Write(b.buf); err != nil {
+		return cw.n, err
+	}
+	err := zw.Close()
+	return cw.n, err
+}
+
+// protoBuffer encodes protocol buffer messages.
+type protoBuffer struct {
+	buf []byte
+}
+
+func (b *protoBuffer) varint(x uint64) {
+	for x >= 0x80 {
+		b.buf = append(b.buf, byte(x)|0x80)
+		x >>= 7
+	}
+	b.buf = append(b.buf, byte(x))
+}
+
+// key encodes the key of field tag, with wire type typ.
+func (b *protoBuffer) key(tag, typ int) { b.varint(uint64(tag)<<3 | uint64(typ)) }
+
+func (b *protoBuffer) uintField(tag int, x uint64) {
+	if x == 0 {
+		return
+	}
+	b.key(tag, 0)
+	b.varint(x)
+}
+
+func (b *protoBuffer) intField(tag int, x int64) { b.uintField(tag, uint64(x)) }
+
+// stringField encodes s, even if empty, as in repeated fields.
+func (b *protoBuffer) stringField(tag int, s string) {
+	b.key(tag, 2)
+	b.varint(uint64(len(s)))
+	b.buf = append(b.buf, s...)
+}
+
+// packed encodes the repeated field of varints xs.
+func (b *protoBuffer) packed(tag int, xs []uint64) {
+	var p protoBuffer
+	for _, x := range xs {
+		p.varint(x)
+	}
+	b.key(tag, 2)
+	b.varint(uint64(len(p.buf)))
+	b.buf = append(b.buf, p.buf...)
+}
+
+// message encodes the embedded message written by f.
+func (b *protoBuffer) message(tag int, f func(*protoBuffer)) {
+	var m protoBuffer
+	f(&m)
+	b.key(tag, 2)
+	b.varint(uint64(len(m.buf)))
+	b.buf = append(b.buf, m.buf...)
+}
+
+// countWriter counts the bytes written to w.
+type countWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (c *countWriter) Write(p []byte) (int, error) {
+	n, err := c.w.Write(p)
+	c.n += int64(n)
+	return n, err
+}
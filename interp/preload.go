@@ -0,0 +1,37 @@
+package interp
+
+// PreloadImports imports the packages of paths, as the import declarations of
+// interpreted code would, but without declaring them in any scope, so that
+// hosts can pay the cost of imports ahead of time, such as during the warm-up
+// of a server, before its health check succeeds, instead of on the first
+// request importing them. The function bodies of the source packages and of
+// their dependencies, otherwise compiled on first use, are compiled too. The
+// binary packages, already loaded by Use, are only checked against
+// Options.Restrictions. The paths are typically the import list of a plugin
+// manifest. It returns the error of the first package failing to import.
+func (interp *Interpreter) PreloadImports(paths ...string) (err error) {
+	interp.evalMutex.Lock()
+	defer interp.evalMutex.Unlock()
+	interp.resetQuotas()
+	defer interp.stopTimers()
+	defer interp.recoverPanic(&err)
+
+	for _, path := range paths {
+		if err := interp.restrictions.allowImport(path); err != nil {
+			return err
+		}
+		interp.mutex.RLock()
+		bin := interp.binPkg[path] != nil
+		interp.mutex.RUnlock()
+		if bin && !interp.importsSource(mainID, path) {
+			continue
+		}
+		if _, err := interp.importSrc(mainID, path, NoTest); err != nil {
+			return err
+		}
+		if err := interp.compileImport(path); err != nil {
+			return err
+		}
+	}
+	return interp.compileDeferred("", false)
+}
@@ -0,0 +1,67 @@
+package interp
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestPreloadImports(t *testing.T) {
+	goPath, err := ioutil.TempDir("", "preload")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		_ = os.RemoveAll(goPath)
+	}()
+
+	files := map[string]string{
+		"dep/dep.go":   "package dep\n\nfunc Twice(x int) int { return 2 * x }\n",
+		"app/app.go":   "package app\n\nimport \"dep\"\n\nfunc Run(x int) int { return dep.Twice(x) + 1 }\n",
+		"bad/bad.go":   "package bad\n\nfunc F() int { return undefined }\n",
+		"other/oth.go": "package other\n",
+	}
+	for name, src := range files {
+		name = filepath.Join(goPath, "src", name)
+		if err := os.MkdirAll(filepath.Dir(name), 0700); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(name, []byte(src), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	i := New(Options{GoPath: goPath, Restrictions: &Restrictions{Deny: []string{"other"}}})
+	if err := i.PreloadImports("app"); err != nil {
+		t.Fatal(err)
+	}
+	for _, path := range []string{"app", "dep"} {
+		if i.srcPkg[path] == nil {
+			t.Errorf("package %s not imported", path)
+		}
+	}
+	if funcs := i.deferredFuncs("", false); len(funcs) > 0 {
+		t.Errorf("got %d functions not compiled", len(funcs))
+	}
+	if _, err := i.Eval(`app.Run(1)`); err == nil {
+		t.Error("preloaded package declared in the main scope")
+	}
+	if _, err := i.Eval(`import "app"`); err != nil {
+		t.Fatal(err)
+	}
+	v, err := i.Eval(`app.Run(1)`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v.Int() != 3 {
+		t.Errorf("got %d, want 3", v.Int())
+	}
+
+	if err := i.PreloadImports("bad"); err == nil {
+		t.Error("got no error for a package failing to compile")
+	}
+	if err := i.PreloadImports("other"); err == nil || err.Error() != `import "other" not allowed` {
+		t.Errorf("got error %v for a denied package", err)
+	}
+}
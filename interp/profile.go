@@ -0,0 +1,280 @@
+package interp
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+// hotCalls is the number of executions from which a call site of a profile is
+// considered hot, see Options.Profile.
+const hotCalls = 1000
+
+// Profile is an execution profile of interpreted code: the number of
+// executions of the call sites of interpreted functions, indexed by source
+// position in the form "file:line:column". A profile collected during a run,
+// see Options.CollectProfile, can guide the compilation of the next runs of
+// the same code, see Options.Profile.
+type Profile struct {
+	Calls map[string]int64
+}
+
+// ReadProfile reads a profile in the format written by Profile.WriteTo.
+func ReadProfile(r io.Reader) (*Profile, error) {
+	p := &Profile{Calls: map[string]int64{}}
+	s := bufio.NewScanner(r)
+	for line := 1; s.Scan(); line++ {
+		l := strings.TrimSpace(s.Text())
+		if l == "" {
+			continue
+		}
+		i := strings.LastIndex(l, " ")
+		if i < 0 {
+			return nil, fmt.Errorf("profile:%d: missing count", line)
+		}
+		count, err := strconv.ParseInt(l[i+1:], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("profile:%d: %v", line, err)
+		}
+		p.Calls[strings.TrimSpace(l[:i])] += count
+	}
+	return p, s.Err()
+}
+
+// WriteTo writes the profile to w, one call site per line, as its position
+// followed by its count, sorted by position.
+func (p *Profile) WriteTo(w io.Writer) (int64, error) {
+	pos := make([]string, 0, len(p.Calls))
+	for k := range p.Calls {
+		pos = append(pos, k)
+	}
+	sort.Strings(pos)
+
+	var n int64
+	for _, k := range pos {
+		c, err := fmt.Fprintf(w, "%s %d\n", k, p.Calls[k])
+		n += int64(c)
+		if err != nil {
+			return n, err
+		}
+	}
+	return n, nil
+}
+
+// Profile returns the execution profile collected so far, if
+// Options.CollectProfile is set, or nil otherwise.
+func (interp *Interpreter) Profile() *Profile {
+	if !interp.collectProfile {
+		return nil
+	}
+	interp.profileMutex.Lock()
+	defer interp.profileMutex.Unlock()
+
+	p := &Profile{Calls: make(map[string]int64, len(interp.counters))}
+	for pos, c := range interp.counters {
+		if v := atomic.LoadInt64(c); v > 0 {
+			p.Calls[pos] = v
+		}
+	}
+	return p
+}
+
+// countCalls makes the call node n count its executions in the collected
+// profile.
+func (interp *Interpreter) countCalls(n *node) {
+	pos := interp.fset.Position(n.pos).String()
+	interp.profileMutex.Lock()
+	if interp.counters == nil {
+		interp.counters = map[string]*int64{}
+	}
+	c, ok := interp.counters[pos]
+	if !ok {
+		c = new(int64)
+		interp.counters[pos] = c
+	}
+	interp.profileMutex.Unlock()
+
+	exec := n.exec
+	n.exec = func(f *frame) bltn {
+		atomic.AddInt64(c, 1)
+		return exec(f)
+	}
+}
+
+// hotCallee returns the function declaration statically called by the call
+// node n, if the call site is hot in the profile guiding the compilation, or
+// nil otherwise. Only calls of plain functions, not methods or variadic
+// functions, are considered. Calls are not specialized if they are traced or
+// tracked, see Options.Tracer, Debugger and CallStack.
+func (interp *Interpreter) hotCallee(n *node) *node {
+	if interp.profile == nil || interp.quotas != nil || interp.tracing != nil || interp.trackFrames() {
+		return nil
+	}
+	if interp.profile.Calls[interp.fset.Position(n.pos).String()] < hotCalls {
+		return nil
+	}
+	c := n.child[0]
+	if c.kind != identExpr || c.findex >= 0 || c.recv != nil || n.anc.kind == goStmt || variadicPos(n) >= 0 {
+		return nil
+	}
+	def, ok := c.val.(*node)
+	if !ok || def.kind != funcDecl || isMethod(def) {
+		return nil
+	}
+	return def
+}
+
+// callHot returns the execution function of a hot call of the function
+// declaration def, specialized from the generic one of call: the callee is
+// known, and its frame type is computed once. If the callee is a leaf
+// function, see isLeaf, its frames are recycled, and its body is run without
+// the panic handling of runCfg, as it has no deferred calls: a panic is
+// reported at the call site. A memoized callee, see Memoize, is called
+// through its cache.
+func callHot(n, def *node, values, rvalues []func(*frame) reflect.Value, tnext, fnext bltn) bltn {
+	numRet := len(def.typ.ret)
+	leaf := isLeaf(def)
+	var once sync.Once
+	var st reflect.Type
+	var pool sync.Pool
+
+	return func(f *frame) bltn {
+		if memo := def.rval; memo.IsValid() {
+			return callMemo(f, memo, values, rvalues, tnext, fnext)
+		}
+		anc := f
+		if def.frame != nil {
+			anc = def.frame
+		}
+		once.Do(func() { st = n.interp.frameType(def) })
+
+		var nf *frame
+		var hf *hotFrame
+		if leaf && st != nil {
+			if hf, _ = pool.Get().(*hotFrame); hf == nil {
+				hf = newHotFrame(st, len(def.types))
+			} else {
+				hf.reset()
+			}
+			nf = hf.frame
+			nf.anc, nf.id, nf.done = anc, anc.runid(), anc.done
+		} else {
+			nf = newFrame(anc, len(def.types), anc.runid())
+			if st != nil {
+				initFrameType(nf, st, len(def.types))
+			}
+		}
+		for i, v := range rvalues {
+			if v != nil {
+				nf.data[i] = v(f)
+			}
+		}
+		if dest := nf.data[numRet:]; len(dest) > 0 {
+			for i, v := range values {
+				if val := v(f); !val.IsZero() {
+					dest[i].Set(val)
+				}
+			}
+		}
+
+		if leaf {
+			runLeaf(def, nf)
+		} else {
+			runFunc(def, nf, f)
+		}
+		res := fnext == nil || nf.data[0].Bool()
+		if hf != nil {
+			pool.Put(hf)
+		}
+
+		if !res {
+			return fnext
+		}
+		return tnext
+	}
+}
+
+// runLeaf runs the body of the leaf function def in frame f, as runFunc but
+// without the panic handling of runCfg.
+func runLeaf(def *node, f *frame) {
+	fc := &def.interp.frames
+	defer fc.leave(fc.enter(f))
+	for exec := def.child[3].start.exec; exec != nil && f.runid() == def.interp.runid(); {
+		exec = exec(f)
+	}
+}
+
+// callMemo calls the memoized function memo, with the argument values
+// computed in frame f, and stores its results with rvalues.
+func callMemo(f *frame, memo reflect.Value, values, rvalues []func(*frame) reflect.Value, tnext, fnext bltn) bltn {
+	in := make([]reflect.Value, len(values))
+	for i, v := range values {
+		in[i] = v(f)
+	}
+	out := memo.Call(in)
+	for i, v := range rvalues {
+		if v != nil {
+			v(f).Set(out[i])
+		}
+	}
+	if fnext != nil && !out[0].Bool() {
+		return fnext
+	}
+	return tnext
+}
+
+// hotFrame is a recycled frame of a leaf function.
+type hotFrame struct {
+	frame  *frame
+	val    reflect.Value   // frame values, of the frame struct type
+	zero   reflect.Value   // zero value of the frame struct type
+	fields []reflect.Value // fields of val
+}
+
+func newHotFrame(st reflect.Type, n int) *hotFrame {
+	hf := &hotFrame{
+		frame:  &frame{data: make([]reflect.Value, n)},
+		val:    reflect.New(st).Elem(),
+		zero:   reflect.Zero(st),
+		fields: make([]reflect.Value, n),
+	}
+	hf.frame.size = int64(st.Size())
+	for i := range hf.fields {
+		hf.fields[i] = hf.val.Field(i)
+	}
+	copy(hf.frame.data, hf.fields)
+	return hf
+}
+
+// reset restores the zero frame values, and the frame slots which held the
+// result locations of the caller.
+func (hf *hotFrame) reset() {
+	hf.val.Set(hf.zero)
+	copy(hf.frame.data, hf.fields)
+}
+
+// isLeaf returns true if the body of the function declaration def can not
+// retain references to its frame once returned: it calls no function, does
+// not create closures, takes no address or slice of its values, and does not
+// handle interface values, which may refer to them. It can not defer calls
+// either.
+func isLeaf(def *node) bool {
+	leaf := true
+	def.child[3].Walk(func(n *node) bool {
+		switch {
+		case !leaf:
+		case n.kind == callExpr, n.kind == funcLit, n.kind == goStmt, n.kind == deferStmt, n.kind == sliceExpr:
+			leaf = false
+		case n.kind == unaryExpr && n.action == aAddr, n.typ != nil && n.typ.cat == interfaceT:
+			leaf = false
+		}
+		return leaf
+	}, nil)
+	return leaf
+}
@@ -0,0 +1,148 @@
+package interp_test
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/traefik/yaegi/interp"
+)
+
+const profileSrc = `package main
+
+func add(a, b int) int { return a + b }
+
+func divmod(a, b int) (int, int) { return a / b, a % b }
+
+func even(n int) bool { return n%2 == 0 }
+
+func sum(n int) (s int) {
+	for i := 0; i < n; i++ {
+		if even(i) {
+			s = add(s, i)
+		}
+		q, r := divmod(i, 7)
+		s += q - r
+	}
+	return s
+}
+`
+
+func TestProfile(t *testing.T) {
+	i := interp.New(interp.Options{CollectProfile: true})
+	eval(t, i, profileSrc)
+	want := eval(t, i, "sum(3000)").Int()
+
+	var buf bytes.Buffer
+	if _, err := i.Profile().WriteTo(&buf); err != nil {
+		t.Fatal(err)
+	}
+	p, err := interp.ReadProfile(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c := p.Calls["_.go:11:6"]; c != 3000 {
+		t.Errorf("got %d calls of even, want 3000", c)
+	}
+	if c := p.Calls["_.go:12:8"]; c != 1500 {
+		t.Errorf("got %d calls of add, want 1500", c)
+	}
+
+	// The hot call sites are specialized, with the same results.
+	i = interp.New(interp.Options{Profile: p})
+	eval(t, i, profileSrc)
+	if got := eval(t, i, "sum(3000)").Int(); got != want {
+		t.Errorf("got %d, want %d", got, want)
+	}
+	if got := eval(t, i, "sum(10)").Int(); got != -1 {
+		t.Errorf("got %d, want -1", got)
+	}
+
+	// A memoized callee of a hot call site is called through its cache.
+	i = interp.New(interp.Options{Profile: p})
+	eval(t, i, strings.Replace(profileSrc, "{ return a / b", "{ calls++; return a / b", 1)+"\nvar calls int\n")
+	if err := i.Memoize("divmod", 0); err != nil {
+		t.Fatal(err)
+	}
+	for k := 0; k < 2; k++ {
+		if got := eval(t, i, "sum(3000)").Int(); got != want {
+			t.Errorf("got %d, want %d", got, want)
+		}
+	}
+	if got := eval(t, i, "calls").Int(); got != 3000 {
+		t.Errorf("got %d calls of divmod, want 3000", got)
+	}
+}
+
+func TestCallStats(t *testing.T) {
+	const delay = 10 * time.Millisecond
+	i := interp.New(interp.Options{CollectCallStats: true})
+	i.Use(interp.Exports{
+		"host": {
+			"Wait":            reflect.ValueOf(func() { time.Sleep(delay) }),
+			"Add":             reflect.ValueOf(func(a, b int) int { return a + b }),
+			"NewBufferString": reflect.ValueOf(bytes.NewBufferString),
+		},
+	})
+	eval(t, i, `import "host"`)
+	eval(t, i, `func run() int {
+	host.Wait()
+	s := 0
+	for i := 0; i < 5; i++ {
+		s = host.Add(s, i)
+	}
+	return s + host.NewBufferString("abc").Len()
+}`)
+	if got := eval(t, i, "run()").Int(); got != 13 {
+		t.Fatalf("got %d, want 13", got)
+	}
+
+	stats := i.CallStats()
+	if len(stats) != 4 {
+		t.Fatalf("unexpected stats %v", stats)
+	}
+	if s := stats[0]; s.Name != "host.Wait" || s.Calls != 1 || s.Time < delay {
+		t.Errorf("got %+v, want 1 call of host.Wait of at least %v", s, delay)
+	}
+	calls := map[string]int64{}
+	for _, s := range stats {
+		calls[s.Name] = s.Calls
+	}
+	if calls["host.Add"] != 5 || calls["host.NewBufferString"] != 1 || calls["(*bytes.Buffer).Len"] != 1 {
+		t.Errorf("unexpected calls %v", calls)
+	}
+
+	if stats := interp.New(interp.Options{}).CallStats(); len(stats) != 0 {
+		t.Errorf("unexpected stats %v", stats)
+	}
+}
+
+func TestProfileInterface(t *testing.T) {
+	src := `package main
+
+type T struct{ a, b int }
+
+func box(a int) interface{} { t := T{a, a}; var i interface{} = t; return i }
+
+func boxes(n int) []interface{} {
+	var r []interface{}
+	for i := 0; i < n; i++ {
+		v := box(i)
+		r = append(r, v)
+	}
+	return r
+}
+`
+	i := interp.New(interp.Options{CollectProfile: true})
+	eval(t, i, src)
+	eval(t, i, "boxes(1000)")
+
+	i = interp.New(interp.Options{Profile: i.Profile()})
+	eval(t, i, src)
+	eval(t, i, "r := boxes(1000)")
+	if v := eval(t, i, "r[10].(T).b").Int(); v != 10 {
+		t.Errorf("got %d, want 10", v)
+	}
+}
@@ -0,0 +1,254 @@
+package interp
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// A Program is an interpreted function, compiled once and ready to be run
+// many times against different inputs.
+type Program struct {
+	// Parallelism is the maximum number of concurrent runs performed by
+	// RunBatch. A value less than 2 runs the batch sequentially.
+	Parallelism int
+
+	interp *Interpreter
+	path   string         // import path of the function package
+	name   string         // name of the function, as given to Program
+	def    *node          // function definition node
+	params []string       // names of input parameters
+	ptypes []reflect.Type // types of input parameters
+	reuse  bool           // true if a frame can be reused between runs
+}
+
+// Result is the result of a Program run.
+type Result struct {
+	Values []interface{} // returned values
+	Err    error         // non nil if the run panicked
+}
+
+// Program returns the interpreted function named name as a Program. The name
+// can be qualified by the import path of a source package, as in "foo/bar.Baz",
+// otherwise the function is searched in the main package.
+func (interp *Interpreter) Program(name string) (*Program, error) {
+	def, err := interp.lookupFunc(name)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &Program{interp: interp, path: mainID, name: name, def: def, reuse: true}
+	if i := strings.LastIndex(name, "."); i >= 0 {
+		p.path = name[:i]
+	}
+	for _, field := range def.child[2].child[0].child {
+		cl := len(field.child) - 1
+		if cl == 0 {
+			p.params = append(p.params, "")
+		}
+		for _, c := range field.child[:cl] {
+			p.params = append(p.params, c.ident)
+		}
+	}
+	for _, t := range def.typ.arg {
+		p.ptypes = append(p.ptypes, t.TypeOf())
+	}
+
+	// A frame can not be reused if its content may be captured and retained
+	// by closures or goroutines after the function returns.
+	def.child[3].Walk(func(n *node) bool {
+		switch {
+		case n.kind == funcLit, n.kind == goStmt, n.action == aGetFunc, n.action == aMethod:
+			p.reuse = false
+		}
+		return p.reuse
+	}, nil)
+	return p, nil
+}
+
+// lookupFunc returns the definition node of an interpreted function.
+func (interp *Interpreter) lookupFunc(name string) (*node, error) {
+	pkg, fname := mainID, name
+	if i := strings.LastIndex(name, "."); i >= 0 {
+		pkg, fname = name[:i], name[i+1:]
+	}
+
+	interp.mutex.RLock()
+	sc, ok := interp.scopes[pkg]
+	var sym *symbol
+	if ok {
+		sym = sc.sym[fname]
+	}
+	interp.mutex.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("package not found: %s", pkg)
+	}
+	if sym == nil || sym.kind != funcSym || sym.node == nil {
+		return nil, fmt.Errorf("function not found: %s", name)
+	}
+	def := sym.node
+	if d, ok := def.val.(*node); ok {
+		def = d
+	}
+	if err := interp.compileFunc(def); err != nil {
+		return nil, err
+	}
+	if def.kind != funcDecl || def.child[3].start == nil {
+		return nil, fmt.Errorf("function not compiled: %s", name)
+	}
+	return def, nil
+}
+
+// Params returns the names of the program input parameters.
+func (p *Program) Params() []string { return p.params }
+
+// Run runs the program once, with input parameters set from env, indexed
+// by parameter name. Missing parameters are set to their zero value.
+func (p *Program) Run(env map[string]interface{}) (Result, error) {
+	in, err := p.args(env)
+	if err != nil {
+		return Result{}, err
+	}
+	return p.call(nil, in), nil
+}
+
+// RunBatch runs the program for each set of input parameters in envs, and
+// returns the results in the same order. If Parallelism is set, runs are
+// dispatched on concurrent workers. Execution frames are reused between runs
+// when it is safe to do so.
+// An error is returned if one of the inputs is invalid, in which case
+// nothing is run. Errors occurring at run time are reported in each Result.
+func (p *Program) RunBatch(envs []map[string]interface{}) ([]Result, error) {
+	ins := make([][]reflect.Value, len(envs))
+	for i, env := range envs {
+		in, err := p.args(env)
+		if err != nil {
+			return nil, fmt.Errorf("input %d: %w", i, err)
+		}
+		ins[i] = in
+	}
+
+	res := make([]Result, len(envs))
+	workers := p.Parallelism
+	if workers < 2 {
+		f := p.newFrame()
+		for i, in := range ins {
+			res[i] = p.call(f, in)
+		}
+		return res, nil
+	}
+
+	var wg sync.WaitGroup
+	jobs := make(chan int)
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			f := p.newFrame()
+			for i := range jobs {
+				res[i] = p.call(f, ins[i])
+			}
+		}()
+	}
+	for i := range ins {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+	return res, nil
+}
+
+// args converts input parameters indexed by name into positional arguments.
+func (p *Program) args(env map[string]interface{}) ([]reflect.Value, error) {
+	in := make([]reflect.Value, len(p.params))
+	for k := range env {
+		found := false
+		for _, name := range p.params {
+			if name == k {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("unknown parameter: %s", k)
+		}
+	}
+	for i, name := range p.params {
+		t := p.ptypes[i]
+		v, ok := env[name]
+		if !ok || v == nil {
+			in[i] = reflect.Zero(t)
+			continue
+		}
+		rv := reflect.ValueOf(v)
+		switch {
+		case rv.Type().AssignableTo(t):
+		case rv.Type().ConvertibleTo(t):
+			rv = rv.Convert(t)
+		default:
+			return nil, fmt.Errorf("invalid type %v for parameter %s, want %v", rv.Type(), name, t)
+		}
+		in[i] = rv
+	}
+	return in, nil
+}
+
+// newFrame returns a frame to be reused between runs, or nil if not possible.
+func (p *Program) newFrame() *frame {
+	if !p.reuse {
+		return nil
+	}
+	return newFrame(p.interp.frame, len(p.def.types), p.interp.runid())
+}
+
+// call runs the program function in frame f, or in a new frame if f is nil.
+func (p *Program) call(f *frame, in []reflect.Value) (res Result) {
+	def := p.def
+	if f == nil {
+		f = newFrame(p.interp.frame, len(def.types), p.interp.runid())
+	} else {
+		f.deferred = nil
+		f.recovered = nil
+		f.setrunid(p.interp.runid())
+	}
+	p.interp.initFrame(f, def)
+
+	numRet := len(def.typ.ret)
+	d := f.data[numRet:]
+	for i, arg := range in {
+		typ := def.typ.arg[i]
+		switch {
+		case typ.cat == interfaceT:
+			if arg.Kind() == reflect.Interface {
+				arg = arg.Elem()
+			}
+			d[i].Set(reflect.ValueOf(valueInterface{value: arg}))
+		case typ.cat == funcT && arg.Kind() == reflect.Func:
+			d[i].Set(reflect.ValueOf(genFunctionNode(arg)))
+		default:
+			d[i].Set(arg)
+		}
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			res.Err = p.interp.newPanic(r)
+		}
+	}()
+	runFunc(def, f, nil)
+
+	res.Values = make([]interface{}, numRet)
+	for i, r := range f.data[:numRet] {
+		if n, ok := r.Interface().(*node); ok {
+			r = genFunctionWrapper(n)(p.interp.frame)
+		}
+		if def.typ.ret[i].cat == interfaceT {
+			r = r.Interface().(valueInterface).value
+		}
+		if r.IsValid() {
+			res.Values[i] = r.Interface()
+		}
+	}
+	return res
+}
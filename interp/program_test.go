@@ -0,0 +1,129 @@
+package interp_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/traefik/yaegi/interp"
+	"github.com/traefik/yaegi/stdlib"
+)
+
+func TestProgramRunBatch(t *testing.T) {
+	i := interp.New(interp.Options{})
+	i.Use(stdlib.Symbols)
+
+	eval(t, i, `
+import "strings"
+
+func score(name string, n int, tags []string) (string, int) {
+	s := n * 2
+	p := &s
+	*p++
+	return strings.ToUpper(name), *p + len(tags)
+}
+
+func fail(n int) int {
+	if n < 0 {
+		panic("negative")
+	}
+	return n
+}
+
+func adder(n int) func(int) int {
+	return func(x int) int { return x + n }
+}
+`)
+
+	p, err := i.Program("score")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := p.Params(), []string{"name", "n", "tags"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got params %v, want %v", got, want)
+	}
+
+	envs := make([]map[string]interface{}, 100)
+	for k := range envs {
+		envs[k] = map[string]interface{}{"name": "a", "n": k, "tags": []string{"x"}}
+	}
+	envs[1] = map[string]interface{}{}
+
+	for _, par := range []int{0, 4} {
+		p.Parallelism = par
+		res, err := p.RunBatch(envs)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for k, r := range res {
+			want := []interface{}{"A", 2*k + 2}
+			if k == 1 {
+				want = []interface{}{"", 1}
+			}
+			if r.Err != nil || !reflect.DeepEqual(r.Values, want) {
+				t.Errorf("parallelism %d, input %d: got %v %v, want %v", par, k, r.Values, r.Err, want)
+			}
+		}
+	}
+
+	if _, err := p.RunBatch([]map[string]interface{}{{"foo": 1}}); err == nil {
+		t.Error("expected error on unknown parameter")
+	}
+	if _, err := p.RunBatch([]map[string]interface{}{{"n": "x"}}); err == nil {
+		t.Error("expected error on invalid parameter type")
+	}
+
+	p, err = i.Program("fail")
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := p.RunBatch([]map[string]interface{}{{"n": 1}, {"n": -1}, {"n": int8(3)}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res[0].Err != nil || res[0].Values[0] != 1 || res[2].Values[0] != 3 {
+		t.Errorf("unexpected results: %v", res)
+	}
+	if _, ok := res[1].Err.(interp.Panic); !ok {
+		t.Errorf("got error %v, want panic", res[1].Err)
+	}
+
+	// Returned closures must remain valid after subsequent runs.
+	p, err = i.Program("adder")
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err = p.RunBatch([]map[string]interface{}{{"n": 1}, {"n": 10}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for k, want := range []int{2, 11} {
+		if got := res[k].Values[0].(func(int) int)(1); got != want {
+			t.Errorf("closure %d: got %d, want %d", k, got, want)
+		}
+	}
+
+	if _, err := i.Program("nothere"); err == nil {
+		t.Error("expected error on undefined function")
+	}
+}
+
+func BenchmarkProgramRunBatch(b *testing.B) {
+	i := interp.New(interp.Options{})
+	if _, err := i.Eval(`func f(a, b int) int { return a*b + 1 }`); err != nil {
+		b.Fatal(err)
+	}
+	p, err := i.Program("f")
+	if err != nil {
+		b.Fatal(err)
+	}
+	envs := make([]map[string]interface{}, 1000)
+	for k := range envs {
+		envs[k] = map[string]interface{}{"a": k, "b": 3}
+	}
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		if _, err := p.RunBatch(envs); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,192 @@
+package interp
+
+import (
+	"bufio"
+	"bytes"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// Provenance describes the origin of a source package imported from a
+// directory, collected if Options.CollectProvenance is set, so that hosts can
+// enforce license policies on the packages used by interpreted code.
+type Provenance struct {
+	Path     string    // import path of the package
+	Dir      string    // directory of the source files
+	Module   string    // path of the module containing the package, empty if no go.mod is found
+	Version  string    // module version, if resolvable from the module cache or vendor/modules.txt
+	Licenses []License // license files found in the package directory and its parents, up to the module root
+}
+
+// License is a license file of a package.
+type License struct {
+	File string // path of the file
+	ID   string // SPDX identifier of the license, if recognized
+	Text string // content of the file
+}
+
+// Provenance returns the provenance of the source packages imported from
+// directories so far, sorted by import path, if Options.CollectProvenance is
+// set, or nil otherwise.
+func (interp *Interpreter) Provenance() []Provenance {
+	interp.mutex.RLock()
+	defer interp.mutex.RUnlock()
+
+	if !interp.collectProvenance {
+		return nil
+	}
+	ps := make([]Provenance, 0, len(interp.provenance))
+	for _, p := range interp.provenance {
+		ps = append(ps, *p)
+	}
+	sort.Slice(ps, func(i, j int) bool { return ps[i].Path < ps[j].Path })
+	return ps
+}
+
+// addProvenance records the provenance of the package importPath, imported
+// from dir.
+func (interp *Interpreter) addProvenance(importPath, dir string) {
+	p := &Provenance{Path: importPath, Dir: dir}
+
+	// Collect the license files from dir up to the module root, or to the
+	// root of the GOPATH entry or vendor directory containing dir.
+	for d := dir; ; d = filepath.Dir(d) {
+		p.Licenses = append(p.Licenses, interp.licenses(d)...)
+		if buf, err := interp.srcFS.ReadFile(filepath.Join(d, "go.mod")); err == nil {
+			p.Module = modulePath(buf)
+		}
+		if i := strings.LastIndex(filepath.Base(d), "@"); i >= 0 {
+			// Root of a module in the module cache.
+			p.Version = unescapePath(filepath.Base(d)[i+1:])
+		}
+		if p.Module != "" || p.Version != "" {
+			break
+		}
+		if b := filepath.Base(filepath.Dir(d)); b == "src" || b == "vendor" || filepath.Dir(d) == d {
+			break
+		}
+	}
+
+	if p.Version == "" {
+		if i := strings.LastIndex(dir, string(filepath.Separator)+"vendor"+string(filepath.Separator)); i >= 0 {
+			if m, v := interp.vendoredModule(filepath.Join(dir[:i], "vendor", "modules.txt"), importPath); m != "" {
+				p.Module, p.Version = m, v
+			}
+		}
+	}
+
+	interp.mutex.Lock()
+	if interp.provenance == nil {
+		interp.provenance = map[string]*Provenance{}
+	}
+	interp.provenance[importPath] = p
+	interp.mutex.Unlock()
+}
+
+// licenses returns the license files of the directory dir.
+func (interp *Interpreter) licenses(dir string) []License {
+	files, err := interp.srcFS.ReadDir(dir)
+	if err != nil {
+		return nil
+	}
+	var ls []License
+	for _, f := range files {
+		if f.IsDir() || !isLicenseFile(f.Name()) {
+			continue
+		}
+		name := filepath.Join(dir, f.Name())
+		buf, err := interp.srcFS.ReadFile(name)
+		if err != nil {
+			continue
+		}
+		ls = append(ls, License{File: name, ID: licenseID(string(buf)), Text: string(buf)})
+	}
+	return ls
+}
+
+// isLicenseFile returns true if name is the name of a license file, such as
+// LICENSE, LICENSE.md, COPYING or NOTICE.
+func isLicenseFile(name string) bool {
+	n := strings.ToUpper(strings.TrimSuffix(name, filepath.Ext(name)))
+	for _, prefix := range []string{"LICENSE", "LICENCE", "COPYING", "NOTICE", "UNLICENSE"} {
+		if strings.HasPrefix(n, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// licensePatterns are the phrases identifying common licenses, in order of
+// precedence, all the phrases of a pattern being required.
+var licensePatterns = []struct {
+	id      string
+	phrases []string
+}{
+	{"AGPL-3.0", []string{"GNU AFFERO GENERAL PUBLIC LICENSE", "Version 3"}},
+	{"LGPL-3.0", []string{"GNU LESSER GENERAL PUBLIC LICENSE", "Version 3"}},
+	{"LGPL-2.1", []string{"GNU LESSER GENERAL PUBLIC LICENSE", "Version 2.1"}},
+	{"GPL-3.0", []string{"GNU GENERAL PUBLIC LICENSE", "Version 3"}},
+	{"GPL-2.0", []string{"GNU GENERAL PUBLIC LICENSE", "Version 2"}},
+	{"MPL-2.0", []string{"Mozilla Public License", "2.0"}},
+	{"Apache-2.0", []string{"Apache License", "Version 2.0"}},
+	{"BSD-3-Clause", []string{"Redistribution and use in source and binary forms", "Neither the name"}},
+	{"BSD-2-Clause", []string{"Redistribution and use in source and binary forms"}},
+	{"MIT", []string{"Permission is hereby granted, free of charge"}},
+	{"ISC", []string{"Permission to use, copy, modify, and/or distribute this software for any purpose"}},
+	{"Unlicense", []string{"This is free and unencumbered software released into the public domain"}},
+}
+
+// licenseID returns the SPDX identifier of the license text, or an empty
+// string if not recognized.
+func licenseID(text string) string {
+	text = strings.Join(strings.Fields(text), " ")
+	for _, p := range licensePatterns {
+		ok := true
+		for _, phrase := range p.phrases {
+			if !strings.Contains(text, phrase) {
+				ok = false
+				break
+			}
+		}
+		if ok {
+			return p.id
+		}
+	}
+	return ""
+}
+
+// vendoredModule returns the path and version of the module providing the
+// package importPath, according to the vendor/modules.txt file at path.
+func (interp *Interpreter) vendoredModule(path, importPath string) (module, version string) {
+	buf, err := interp.srcFS.ReadFile(path)
+	if err != nil {
+		return "", ""
+	}
+	s := bufio.NewScanner(bytes.NewReader(buf))
+	for s.Scan() {
+		f := strings.Fields(s.Text())
+		if len(f) < 3 || f[0] != "#" {
+			continue
+		}
+		// The longest module path prefix of the import path wins.
+		if (importPath == f[1] || strings.HasPrefix(importPath, f[1]+"/")) && len(f[1]) > len(module) {
+			module, version = f[1], f[2]
+		}
+	}
+	return module, version
+}
+
+// unescapePath reverses escapePath, replacing "!x" by "X".
+func unescapePath(s string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] == '!' && i+1 < len(s) {
+			i++
+			b.WriteString(strings.ToUpper(s[i : i+1]))
+			continue
+		}
+		b.WriteByte(s[i])
+	}
+	return b.String()
+}
@@ -0,0 +1,78 @@
+package interp
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestProvenance(t *testing.T) {
+	tmp, err := ioutil.TempDir("", "provenance")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		_ = os.RemoveAll(tmp)
+	}()
+
+	const (
+		mit    = "MIT License\n\nPermission is hereby granted, free of charge, to any person\nobtaining a copy of this software.\n"
+		apache = "Apache License\nVersion 2.0, January 2004\n"
+		bsd    = "Redistribution and use in source and binary forms, with or without\nmodification, are permitted. Neither the name of the copyright holder...\n"
+	)
+	modDir := filepath.Join(tmp, "pkg", "mod", "example.com", "!mod@v1.0.0")
+	files := map[string]string{
+		"src/app/app.go":                          "package app\n\nimport (\n\t\"example.com/Mod\"\n\t\"github.com/vend/v\"\n\t\"lib\"\n)\n\nvar N = lib.N + v.N + mod.N\n",
+		"src/app/LICENSE":                         mit,
+		"src/app/vendor/modules.txt":              "# github.com/vend/v v1.2.0\n## explicit\ngithub.com/vend/v\n",
+		"src/app/vendor/github.com/vend/v/v.go":   "package v\n\nconst N = 1\n",
+		"src/lib/lib.go":                          "package lib\n\nconst N = 1\n",
+		"src/lib/COPYING.txt":                     apache,
+		"pkg/mod/example.com/!mod@v1.0.0/go.mod":  "module example.com/Mod\n",
+		"pkg/mod/example.com/!mod@v1.0.0/m.go":    "package mod\n\nconst N = 1\n",
+		"pkg/mod/example.com/!mod@v1.0.0/LICENSE": bsd,
+	}
+	for name, src := range files {
+		name = filepath.Join(tmp, name)
+		if err := os.MkdirAll(filepath.Dir(name), 0700); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(name, []byte(src), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	i := New(Options{GoPath: tmp, Workspace: map[string]string{"example.com/Mod": modDir}, CollectProvenance: true})
+	if _, err := i.Eval(`import "app"`); err != nil {
+		t.Fatal(err)
+	}
+	ps := i.Provenance()
+	if len(ps) != 4 {
+		t.Fatalf("unexpected provenance %+v", ps)
+	}
+	want := []struct {
+		path, module, version, license string
+	}{
+		{"app", "", "", "MIT"},
+		{"example.com/Mod", "example.com/Mod", "v1.0.0", "BSD-3-Clause"},
+		{"github.com/vend/v", "github.com/vend/v", "v1.2.0", ""},
+		{"lib", "", "", "Apache-2.0"},
+	}
+	for k, w := range want {
+		p := ps[k]
+		if p.Path != w.path || p.Module != w.module || p.Version != w.version {
+			t.Errorf("got %s %q %q, want %s %q %q", p.Path, p.Module, p.Version, w.path, w.module, w.version)
+		}
+		switch {
+		case w.license == "" && len(p.Licenses) != 0:
+			t.Errorf("%s: unexpected licenses %+v", p.Path, p.Licenses)
+		case w.license != "" && (len(p.Licenses) != 1 || p.Licenses[0].ID != w.license):
+			t.Errorf("%s: got licenses %+v, want %s", p.Path, p.Licenses, w.license)
+		}
+	}
+
+	if ps := New(Options{}).Provenance(); ps != nil {
+		t.Errorf("unexpected provenance %+v", ps)
+	}
+}
@@ -0,0 +1,372 @@
+package interp
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+	"sync/atomic"
+	"unsafe"
+)
+
+// ErrLimitExceeded is the error wrapped by the QuotaError returned by the
+// evaluation of interpreted code which exceeds one of its quotas.
+var ErrLimitExceeded = errors.New("limit exceeded")
+
+// QuotaError is the error returned by the evaluation of interpreted code
+// which exceeds one of the quotas set by Options.MaxSteps,
+// Options.MaxGoroutines, Options.MaxSpawnedGoroutines, Options.MaxFrameMemory
+// or Options.OutputLimit with Abort, or one of the budget of its tenant in
+// Options.Quotas. The evaluation is then aborted, including the goroutines it
+// started.
+type QuotaError struct {
+	Quota string // "steps", "goroutines", "spawned goroutines", "frame memory" or "output", prefixed by "tenant " for a tenant budget
+	Max   int64  // value of the exceeded quota
+}
+
+func (e *QuotaError) Error() string {
+	return fmt.Sprintf("%s: %s quota of %d", ErrLimitExceeded, e.Quota, e.Max)
+}
+
+// Unwrap returns ErrLimitExceeded.
+func (e *QuotaError) Unwrap() error { return ErrLimitExceeded }
+
+// quotas are the resource quotas of interpreted code. The steps and spawned
+// goroutines are counted from the start of each evaluation, the goroutines and
+// frame memory are those currently in use.
+type quotas struct {
+	maxSteps       int64
+	maxGoroutines  int64
+	maxSpawned     int64
+	maxFrameMemory int64
+
+	steps       int64         // executed nodes, updated atomically
+	goroutines  int64         // running goroutines, updated atomically
+	spawned     int64         // started goroutines, updated atomically
+	slots       chan struct{} // running goroutines, if go statements wait for a slot
+	frameMemory int64         // bytes of active frames, updated atomically
+
+	tenant *tenant // budget shared with the other interpreters of the tenant, or nil
+
+	sched    *Scheduler // scheduler of the evaluations, or nil
+	priority Priority   // default priority of the evaluations
+	current  int64      // priority of the current evaluation, accessed atomically
+
+	mu  sync.Mutex
+	err error // quota error of the current evaluation
+}
+
+// newQuotas returns the quotas set in options, or nil if none is set.
+func newQuotas(options Options) *quotas {
+	abortOutput := options.OutputLimit != nil && options.OutputLimit.MaxBytes > 0 && options.OutputLimit.Abort
+	if options.MaxSteps <= 0 && options.MaxGoroutines <= 0 && options.MaxSpawnedGoroutines <= 0 && options.MaxFrameMemory <= 0 && options.Quotas == nil && options.Scheduler == nil && !abortOutput {
+		return nil
+	}
+	q := &quotas{
+		maxSteps:       options.MaxSteps,
+		maxGoroutines:  int64(options.MaxGoroutines),
+		maxSpawned:     options.MaxSpawnedGoroutines,
+		maxFrameMemory: options.MaxFrameMemory,
+	}
+	if options.QueueGoroutines && q.maxGoroutines > 0 {
+		q.slots = make(chan struct{}, q.maxGoroutines)
+	}
+	if options.Quotas != nil {
+		q.tenant = options.Quotas.tenant(options.Tenant)
+	}
+	if q.sched = options.Scheduler; q.sched != nil {
+		q.sched.init()
+		q.priority, q.current = options.Priority, int64(options.Priority)
+	}
+	return q
+}
+
+// countSteps returns true if the executed nodes must be counted.
+func (q *quotas) countSteps() bool { return q.maxSteps > 0 || q.tenant != nil || q.sched != nil }
+
+// resetQuotas starts the count of steps, spawned goroutines and output bytes
+// of a new evaluation, and clears the termination of the previous one by
+// os.Exit.
+func (interp *Interpreter) resetQuotas() {
+	interp.resetExit()
+	interp.resetOutput()
+	q := interp.quotas
+	if q == nil {
+		return
+	}
+	atomic.StoreInt64(&q.steps, 0)
+	atomic.StoreInt64(&q.spawned, 0)
+	q.mu.Lock()
+	q.err = nil
+	q.mu.Unlock()
+}
+
+// quotaErr returns the error of the current evaluation if it exceeded a
+// quota, or nil.
+func (interp *Interpreter) quotaErr() error {
+	q := interp.quotas
+	if q == nil {
+		return nil
+	}
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.err
+}
+
+// abortErr returns the error of the current evaluation if it was aborted by
+// an exceeded quota or by os.Exit in a goroutine, or nil.
+func (interp *Interpreter) abortErr() error {
+	if err := interp.exitErr(); err != nil {
+		return err
+	}
+	return interp.quotaErr()
+}
+
+// runErr returns the error of an execution interrupted by a cancellation, an
+// exceeded quota or os.Exit.
+func (interp *Interpreter) runErr() error {
+	if err := interp.abortErr(); err != nil {
+		return err
+	}
+	return errCancelled
+}
+
+// exceed aborts the execution of interpreted code, which exceeded the quota
+// named name of value max, as a cancellation does.
+func (interp *Interpreter) exceed(name string, max int64) {
+	q := interp.quotas
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.err == nil {
+		q.err = &QuotaError{Quota: name, Max: max}
+		atomic.AddUint64(&interp.id, 1)
+	}
+}
+
+// step counts an executed node, and pauses the execution while evaluations
+// of a higher priority are active in the scheduler.
+func (q *quotas) step(interp *Interpreter) {
+	if s := q.sched; s != nil {
+		if p := atomic.LoadInt64(&q.current); s.preempted(p) {
+			s.wait(interp, p)
+		}
+	}
+	if q.maxSteps > 0 && atomic.AddInt64(&q.steps, 1) > q.maxSteps {
+		interp.exceed("steps", q.maxSteps)
+	}
+	if t := q.tenant; t != nil {
+		if max := atomic.LoadInt64(&t.maxSteps); atomic.AddInt64(&t.steps, 1) > max && max > 0 {
+			interp.exceed("tenant steps", max)
+		}
+	}
+}
+
+// startGoroutine counts a started goroutine, and returns false if it exceeds
+// the quota. If Options.QueueGoroutines is set, it waits for the end of a
+// running goroutine instead, and returns false if the execution is stopped
+// meanwhile.
+func (q *quotas) startGoroutine(interp *Interpreter) bool {
+	if q.maxSpawned > 0 && atomic.AddInt64(&q.spawned, 1) > q.maxSpawned {
+		interp.exceed("spawned goroutines", q.maxSpawned)
+		return false
+	}
+	if q.slots != nil {
+		if !q.waitSlot(interp) {
+			return false
+		}
+	} else if q.maxGoroutines > 0 && atomic.AddInt64(&q.goroutines, 1) > q.maxGoroutines {
+		atomic.AddInt64(&q.goroutines, -1)
+		interp.exceed("goroutines", q.maxGoroutines)
+		return false
+	}
+	if t := q.tenant; t != nil {
+		if max := atomic.LoadInt64(&t.maxGoroutines); atomic.AddInt64(&t.goroutines, 1) > max && max > 0 {
+			atomic.AddInt64(&t.goroutines, -1)
+			q.releaseGoroutine()
+			interp.exceed("tenant goroutines", max)
+			return false
+		}
+	}
+	return true
+}
+
+// waitSlot waits for a slot of running goroutine, and returns false if the
+// execution is stopped first, by the cancellation of EvalWithContext.
+func (q *quotas) waitSlot(interp *Interpreter) bool {
+	select {
+	case q.slots <- struct{}{}:
+		return true
+	default:
+	}
+	id := interp.runid()
+	interp.mutex.RLock()
+	done := interp.done
+	interp.mutex.RUnlock()
+	select {
+	case q.slots <- struct{}{}:
+		return true
+	case <-done:
+		if interp.runid() != id {
+			return false
+		}
+	}
+	// The channel is the one of a previous evaluation.
+	q.slots <- struct{}{}
+	return true
+}
+
+// releaseGoroutine releases the count of a running goroutine by the
+// interpreter quotas.
+func (q *quotas) releaseGoroutine() {
+	if q.slots != nil {
+		<-q.slots
+	} else if q.maxGoroutines > 0 {
+		atomic.AddInt64(&q.goroutines, -1)
+	}
+}
+
+// endGoroutine counts a terminated goroutine.
+func (q *quotas) endGoroutine() {
+	q.releaseGoroutine()
+	if q.tenant != nil {
+		atomic.AddInt64(&q.tenant.goroutines, -1)
+	}
+}
+
+// enterFrame counts the memory of the frame f of a starting execution, and
+// returns its size, to be released by leaveFrame.
+func (q *quotas) enterFrame(interp *Interpreter, f *frame) int64 {
+	if q.maxFrameMemory <= 0 && q.tenant == nil {
+		return 0
+	}
+	size := frameSize(f)
+	if q.maxFrameMemory > 0 && atomic.AddInt64(&q.frameMemory, size) > q.maxFrameMemory {
+		interp.exceed("frame memory", q.maxFrameMemory)
+	}
+	if t := q.tenant; t != nil {
+		if max := atomic.LoadInt64(&t.maxFrameMemory); atomic.AddInt64(&t.frameMemory, size) > max && max > 0 {
+			interp.exceed("tenant frame memory", max)
+		}
+	}
+	return size
+}
+
+// leaveFrame releases the memory of a frame counted by enterFrame.
+func (q *quotas) leaveFrame(size int64) {
+	if size <= 0 {
+		return
+	}
+	if q.maxFrameMemory > 0 {
+		atomic.AddInt64(&q.frameMemory, -size)
+	}
+	if q.tenant != nil {
+		atomic.AddInt64(&q.tenant.frameMemory, -size)
+	}
+}
+
+// frameSize returns an estimation of the memory used by the frame f: the
+// frame itself, its value slots and the values they hold, not including the
+// memory referred to by these values.
+func frameSize(f *frame) int64 {
+	f.mutex.RLock()
+	defer f.mutex.RUnlock()
+	size := int64(unsafe.Sizeof(*f)) + int64(len(f.data))*int64(unsafe.Sizeof(reflect.Value{}))
+	for _, v := range f.data {
+		if v.IsValid() {
+			size += int64(v.Type().Size())
+		}
+	}
+	return size
+}
+
+// Budget is the set of quotas shared by the interpreters of a tenant, see
+// QuotaManager. A zero field means no limit.
+type Budget struct {
+	// MaxSteps is the maximum number of nodes executed by the interpreters of
+	// the tenant, since the budget was set or the last call to ResetSteps.
+	MaxSteps int64
+
+	// MaxGoroutines is the maximum number of goroutines of the interpreters
+	// of the tenant running at the same time.
+	MaxGoroutines int
+
+	// MaxFrameMemory is the maximum memory in bytes of the frames of the
+	// interpreted functions of the tenant running at the same time, as for
+	// Options.MaxFrameMemory.
+	MaxFrameMemory int64
+}
+
+// QuotaUsage is the resource usage of the interpreters of a tenant.
+type QuotaUsage struct {
+	Steps       int64 // nodes executed since the budget was set or reset
+	Goroutines  int   // running goroutines
+	FrameMemory int64 // bytes of active frames
+}
+
+// QuotaManager coordinates the quotas of all the interpreters of the tenants
+// of a process, in addition to the per interpreter quotas of Options, so
+// that a tenant running many scripts can not monopolize the host. The
+// interpreters of a tenant are created with Options.Quotas set to the
+// manager and Options.Tenant set to the tenant name, and share the tenant
+// budget. An interpreter exceeding it is aborted with a *QuotaError.
+//
+// The budgets can be changed at any time, taking effect at the next executed
+// node, goroutine or call. The zero value is ready to use, with no budget.
+type QuotaManager struct {
+	mu      sync.Mutex
+	tenants map[string]*tenant
+}
+
+// tenant is the budget and the usage of the interpreters of a tenant, all
+// accessed atomically. The usage is counted even without limit, to be
+// reported by QuotaManager.Usage.
+type tenant struct {
+	maxSteps       int64
+	maxGoroutines  int64
+	maxFrameMemory int64
+
+	steps       int64
+	goroutines  int64
+	frameMemory int64
+}
+
+// tenant returns the tenant of name, created without limit if needed.
+func (m *QuotaManager) tenant(name string) *tenant {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	t := m.tenants[name]
+	if t == nil {
+		if m.tenants == nil {
+			m.tenants = map[string]*tenant{}
+		}
+		t = &tenant{}
+		m.tenants[name] = t
+	}
+	return t
+}
+
+// SetBudget sets the budget of the interpreters of tenant name, and resets
+// its count of steps.
+func (m *QuotaManager) SetBudget(name string, b Budget) {
+	t := m.tenant(name)
+	atomic.StoreInt64(&t.maxSteps, b.MaxSteps)
+	atomic.StoreInt64(&t.maxGoroutines, int64(b.MaxGoroutines))
+	atomic.StoreInt64(&t.maxFrameMemory, b.MaxFrameMemory)
+	atomic.StoreInt64(&t.steps, 0)
+}
+
+// ResetSteps resets the count of steps of tenant name, for example
+// periodically, to give it a budget of steps per period.
+func (m *QuotaManager) ResetSteps(name string) {
+	atomic.StoreInt64(&m.tenant(name).steps, 0)
+}
+
+// Usage returns the current resource usage of tenant name.
+func (m *QuotaManager) Usage(name string) QuotaUsage {
+	t := m.tenant(name)
+	return QuotaUsage{
+		Steps:       atomic.LoadInt64(&t.steps),
+		Goroutines:  int(atomic.LoadInt64(&t.goroutines)),
+		FrameMemory: atomic.LoadInt64(&t.frameMemory),
+	}
+}
@@ -0,0 +1,156 @@
+package interp_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/traefik/yaegi/interp"
+)
+
+func TestQuotas(t *testing.T) {
+	for _, test := range []struct {
+		desc  string
+		opts  interp.Options
+		src   string
+		quota string
+	}{
+		{
+			desc:  "steps",
+			opts:  interp.Options{MaxSteps: 10000},
+			src:   `func run() { for { } }`,
+			quota: "steps",
+		},
+		{
+			desc:  "goroutines",
+			opts:  interp.Options{MaxGoroutines: 10},
+			src:   `func run() { c := make(chan int); for { go func() { <-c }() } }`,
+			quota: "goroutines",
+		},
+		{
+			desc:  "spawned goroutines",
+			opts:  interp.Options{MaxSpawnedGoroutines: 10},
+			src:   `func run() { for { go func() {}() } }`,
+			quota: "spawned goroutines",
+		},
+		{
+			desc:  "frame memory",
+			opts:  interp.Options{MaxFrameMemory: 1 << 20},
+			src:   `func f(i int) int { return f(i+1) }; func run() { f(0) }`,
+			quota: "frame memory",
+		},
+	} {
+		t.Run(test.desc, func(t *testing.T) {
+			i := interp.New(test.opts)
+			eval(t, i, test.src)
+			_, err := i.Eval(`run()`)
+			qe, ok := err.(*interp.QuotaError)
+			if !ok {
+				t.Fatalf("got %v, want a quota error", err)
+			}
+			if qe.Quota != test.quota {
+				t.Errorf("got quota %q, want %q", qe.Quota, test.quota)
+			}
+			if qe.Unwrap() != interp.ErrLimitExceeded {
+				t.Errorf("got %v, want ErrLimitExceeded", qe.Unwrap())
+			}
+
+			// The interpreter remains usable, with a new budget.
+			if _, err := i.Eval(`x := 1`); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
+
+func TestQueueGoroutines(t *testing.T) {
+	i := interp.New(interp.Options{MaxGoroutines: 2, QueueGoroutines: true})
+	eval(t, i, `
+func sum(n int) (s int) {
+	c := make(chan int, n)
+	for k := 0; k < n; k++ {
+		go func(k int) { c <- k }(k)
+	}
+	for k := 0; k < n; k++ {
+		s += <-c
+	}
+	return s
+}
+
+func block() {
+	c := make(chan int)
+	go func() { <-c }()
+	go func() { <-c }()
+	go func() {}()
+}`)
+	if v, err := i.Eval(`sum(20)`); err != nil || v.Interface() != 190 {
+		t.Fatalf("got %v, %v, want 190", v, err)
+	}
+
+	// A go statement waiting for a slot is stopped by the cancellation.
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	if _, err := i.EvalWithContext(ctx, `block()`); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("got %v, want context.DeadlineExceeded", err)
+	}
+}
+
+func TestQuotaManager(t *testing.T) {
+	m := &interp.QuotaManager{}
+	m.SetBudget("a", interp.Budget{MaxSteps: 10000, MaxGoroutines: 5})
+
+	newInterp := func(tenant string) *interp.Interpreter {
+		i := interp.New(interp.Options{Quotas: m, Tenant: tenant})
+		eval(t, i, `
+var c = make(chan int)
+
+func start(n int) {
+	for k := 0; k < n; k++ {
+		go func() { <-c }()
+	}
+}
+
+func loop() { for {} }`)
+		return i
+	}
+	quota := func(err error) string {
+		if qe, ok := err.(*interp.QuotaError); ok {
+			return qe.Quota
+		}
+		return ""
+	}
+	i1, i2, other := newInterp("a"), newInterp("a"), newInterp("b")
+
+	// The goroutines of the interpreters of a tenant share its budget.
+	if _, err := i1.Eval(`start(3)`); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := i2.Eval(`start(3)`); quota(err) != "tenant goroutines" {
+		t.Fatalf("got %v, want a tenant goroutines quota error", err)
+	}
+	if _, err := other.Eval(`start(3)`); err != nil {
+		t.Fatal(err)
+	}
+	if got := m.Usage("b").Goroutines; got != 3 {
+		t.Errorf("got %d goroutines, want 3", got)
+	}
+
+	// The steps of a tenant are counted until reset.
+	if _, err := i1.Eval(`loop()`); quota(err) != "tenant steps" {
+		t.Fatalf("got %v, want a tenant steps quota error", err)
+	}
+	if _, err := i2.Eval(`x := 1`); quota(err) != "tenant steps" {
+		t.Fatalf("got %v, want a tenant steps quota error", err)
+	}
+	if _, err := other.Eval(`x := 1`); err != nil {
+		t.Fatal(err)
+	}
+	m.ResetSteps("a")
+	if _, err := i2.Eval(`x := 1`); err != nil {
+		t.Fatal(err)
+	}
+	if got := m.Usage("a").Steps; got <= 0 || got > 10000 {
+		t.Errorf("got %d steps, want a count since the reset", got)
+	}
+}
@@ -0,0 +1,212 @@
+package interp
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+)
+
+// Redacted replaces the redacted values in the messages of the interpreter.
+const Redacted = "[REDACTED]"
+
+// Redaction defines the runtime values hidden from the messages produced by
+// the interpreter, so secrets do not leak into logs: the values of panics
+// returned as Panic errors or passed to Options.OnGoroutinePanic, the values
+// of the variables of a stopped Debugger, and the summaries of EvalDiff.
+//
+// A value is redacted if it matches one of the rules. A value containing
+// redacted parts, such as a struct with a redacted field, is replaced by its
+// formatted value, as by fmt.Sprint, with these parts replaced by Redacted.
+// Note that the Error and String methods of values are used as is.
+type Redaction struct {
+	// Types are the types of redacted values, such as the type of the
+	// credentials of a host.
+	Types []reflect.Type
+
+	// Tag is the key of the struct tags marking redacted fields, such as
+	// "redact" for fields tagged `redact:"true"`. A field is redacted if its
+	// tag has the key with a value other than "false".
+	Tag string
+
+	// Func, if not nil, reports whether the value v is redacted, in addition
+	// to the other rules.
+	Func func(v reflect.Value) bool
+}
+
+// match returns true if the value v is redacted.
+func (r *Redaction) match(v reflect.Value) bool {
+	for _, t := range r.Types {
+		if v.Type() == t {
+			return true
+		}
+	}
+	return r.Func != nil && r.Func(v)
+}
+
+// matchField returns true if the field f is redacted.
+func (r *Redaction) matchField(f reflect.StructField) bool {
+	if r.Tag == "" {
+		return false
+	}
+	val, ok := f.Tag.Lookup(r.Tag)
+	return ok && val != "false"
+}
+
+// format returns the formatted value v, and true if it has redacted parts.
+func (r *Redaction) format(v reflect.Value) (string, bool) {
+	p := &redactPrinter{r: r}
+	p.print(v, 0)
+	return p.buf.String(), p.redacted
+}
+
+// redactValue returns v, or its redacted formatted value if it has redacted
+// parts.
+func (interp *Interpreter) redactValue(v interface{}) interface{} {
+	r := interp.redaction
+	if r == nil {
+		return v
+	}
+	if s, ok := r.format(reflect.ValueOf(v)); ok {
+		return s
+	}
+	return v
+}
+
+// redactPrinter formats values as the %v verb of fmt, except for the redacted
+// parts.
+type redactPrinter struct {
+	r        *Redaction
+	buf      bytes.Buffer
+	redacted bool
+}
+
+func (p *redactPrinter) print(v reflect.Value, depth int) {
+	if !v.IsValid() {
+		p.buf.WriteString("<nil>")
+		return
+	}
+	if p.r.match(v) {
+		p.redacted = true
+		p.buf.WriteString(Redacted)
+		return
+	}
+	if v.CanInterface() {
+		// Interpreted values may be wrapped, such as the values of panics.
+		switch x := v.Interface().(type) {
+		case reflect.Value:
+			p.print(x, depth)
+			return
+		case valueInterface:
+			p.print(x.value, depth)
+			return
+		}
+	}
+	if v.CanInterface() && !(v.Kind() == reflect.Ptr && v.IsNil()) {
+		switch x := v.Interface().(type) {
+		case error:
+			p.buf.WriteString(x.Error())
+			return
+		case fmt.Stringer:
+			p.buf.WriteString(x.String())
+			return
+		}
+	}
+
+	switch v.Kind() {
+	case reflect.Interface:
+		p.print(v.Elem(), depth)
+	case reflect.Ptr:
+		if depth == 0 && !v.IsNil() {
+			switch v.Elem().Kind() {
+			case reflect.Array, reflect.Map, reflect.Slice, reflect.Struct:
+				// As fmt, only follow the pointers at top level.
+				p.buf.WriteByte('&')
+				p.print(v.Elem(), depth+1)
+				return
+			}
+		}
+		p.pointer(v)
+	case reflect.Struct:
+		p.buf.WriteByte('{')
+		for i := 0; i < v.NumField(); i++ {
+			if i > 0 {
+				p.buf.WriteByte(' ')
+			}
+			if p.r.matchField(v.Type().Field(i)) {
+				p.redacted = true
+				p.buf.WriteString(Redacted)
+				continue
+			}
+			p.print(v.Field(i), depth+1)
+		}
+		p.buf.WriteByte('}')
+	case reflect.Array, reflect.Slice:
+		p.buf.WriteByte('[')
+		for i := 0; i < v.Len(); i++ {
+			if i > 0 {
+				p.buf.WriteByte(' ')
+			}
+			p.print(v.Index(i), depth+1)
+		}
+		p.buf.WriteByte(']')
+	case reflect.Map:
+		type entry struct{ key, val string }
+		entries := make([]entry, 0, v.Len())
+		for _, k := range v.MapKeys() {
+			kp := &redactPrinter{r: p.r}
+			kp.print(k, depth+1)
+			vp := &redactPrinter{r: p.r}
+			vp.print(v.MapIndex(k), depth+1)
+			p.redacted = p.redacted || kp.redacted || vp.redacted
+			entries = append(entries, entry{kp.buf.String(), vp.buf.String()})
+		}
+		sort.Slice(entries, func(i, j int) bool { return entries[i].key < entries[j].key })
+		p.buf.WriteString("map[")
+		for i, e := range entries {
+			if i > 0 {
+				p.buf.WriteByte(' ')
+			}
+			p.buf.WriteString(e.key + ":" + e.val)
+		}
+		p.buf.WriteByte(']')
+	case reflect.Chan, reflect.Func, reflect.UnsafePointer:
+		p.pointer(v)
+	default:
+		p.basic(v)
+	}
+}
+
+// basic formats the value v of a basic type, which may not be interfaced if
+// obtained from an unexported field.
+func (p *redactPrinter) basic(v reflect.Value) {
+	if v.CanInterface() {
+		fmt.Fprint(&p.buf, v.Interface())
+		return
+	}
+	switch v.Kind() {
+	case reflect.Bool:
+		p.buf.WriteString(strconv.FormatBool(v.Bool()))
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		p.buf.WriteString(strconv.FormatInt(v.Int(), 10))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		p.buf.WriteString(strconv.FormatUint(v.Uint(), 10))
+	case reflect.Float32:
+		p.buf.WriteString(strconv.FormatFloat(v.Float(), 'g', -1, 32))
+	case reflect.Float64:
+		p.buf.WriteString(strconv.FormatFloat(v.Float(), 'g', -1, 64))
+	case reflect.Complex64, reflect.Complex128:
+		fmt.Fprint(&p.buf, v.Complex())
+	case reflect.String:
+		p.buf.WriteString(v.String())
+	}
+}
+
+func (p *redactPrinter) pointer(v reflect.Value) {
+	if v.IsNil() {
+		p.buf.WriteString("<nil>")
+		return
+	}
+	fmt.Fprintf(&p.buf, "0x%x", v.Pointer())
+}
@@ -0,0 +1,76 @@
+package interp
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+type secretToken string
+
+func TestRedaction(t *testing.T) {
+	i := New(Options{Redaction: &Redaction{
+		Types: []reflect.Type{reflect.TypeOf(secretToken(""))},
+		Tag:   "redact",
+		Func: func(v reflect.Value) bool {
+			return v.Kind() == reflect.String && strings.HasPrefix(v.String(), "sk-")
+		},
+	}})
+	i.Use(Exports{"host": {"Token": reflect.ValueOf((*secretToken)(nil))}})
+	if _, err := i.Eval(`
+import "host"
+
+type Creds struct {
+	User     string
+	Password string ` + "`redact:\"true\"`" + `
+	Comment  string ` + "`redact:\"false\"`" + `
+}
+
+func fail(v interface{}) { panic(v) }`); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		src  string
+		want interface{} // nil if the value is unchanged
+	}{
+		{src: `fail(Creds{"bob", "hunter2", "ok"})`, want: "{bob [REDACTED] ok}"},
+		{src: `fail(&Creds{User: "bob"})`, want: "&{bob [REDACTED] }"},
+		{src: `fail(map[string]host.Token{"b": "x", "a": "y"})`, want: "map[a:[REDACTED] b:[REDACTED]]"},
+		{src: `fail([]string{"key", "sk-123"})`, want: "[key [REDACTED]]"},
+		{src: `fail(3)`}, // not redacted, unchanged
+	}
+	for _, test := range tests {
+		_, err := i.Eval(test.src)
+		p, ok := err.(Panic)
+		if !ok {
+			t.Errorf("%s: got %v, want a panic", test.src, err)
+			continue
+		}
+		if _, redacted := p.Value.(string); test.want == nil && redacted || test.want != nil && p.Value != test.want {
+			t.Errorf("%s: got %#v, want %#v", test.src, p.Value, test.want)
+		}
+	}
+
+	_, changes, err := i.EvalDiff(`token := host.Token("abc"); c := Creds{User: "bob", Password: "x"}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []GlobalChange{
+		{Name: "c", Type: "main.Creds", New: "{bob [REDACTED] }", Created: true},
+		{Name: "token", Type: "github.com/traefik/yaegi/interp.secretToken", New: Redacted, Created: true},
+	}
+	if !reflect.DeepEqual(changes, want) {
+		t.Errorf("got %+v, want %+v", changes, want)
+	}
+
+	// Changes of redacted values are reported, without their values.
+	_, changes, err = i.EvalDiff(`c.Password = "y"`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want = []GlobalChange{{Name: "c", Type: "main.Creds", Old: "{bob [REDACTED] }", New: "{bob [REDACTED] }"}}
+	if !reflect.DeepEqual(changes, want) {
+		t.Errorf("got %+v, want %+v", changes, want)
+	}
+}
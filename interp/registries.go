@@ -0,0 +1,268 @@
+package interp
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/gob"
+	"net"
+	"net/http"
+	"reflect"
+	"sort"
+	"sync"
+)
+
+// Registration describes a registration of interpreted code to a process-wide
+// registry of the host, such as a database driver registered by the init
+// function of an interpreted package. See Options.OnRegister.
+type Registration struct {
+	Func   string      // registering function, such as "database/sql.Register" or "net/http.Handle"
+	Name   string      // registered name, such as a driver name or a handler pattern
+	Value  interface{} // registered value, such as a driver or a handler
+	Shared bool        // true if registered in the registry of the host, see Options.SharedRegistries
+}
+
+// registries holds the process-wide registries of the host as seen by
+// interpreted code, scoped to the interpreter unless shared.
+type registries struct {
+	shared     bool
+	onRegister func(Registration)
+
+	mu      sync.RWMutex
+	drivers map[string]driver.Driver
+	mux     *http.ServeMux
+}
+
+func newRegistries(shared bool, onRegister func(Registration)) *registries {
+	return &registries{shared: shared, onRegister: onRegister, drivers: map[string]driver.Driver{}, mux: http.NewServeMux()}
+}
+
+func (r *registries) notify(fn, name string, value interface{}) {
+	if r.onRegister != nil {
+		r.onRegister(Registration{Func: fn, Name: name, Value: value, Shared: r.shared})
+	}
+}
+
+// ServeMux returns the HTTP request multiplexer where interpreted code
+// registers handlers with http.Handle and http.HandleFunc, also used as
+// http.DefaultServeMux, and as the handler of the servers started by
+// interpreted code with a nil handler, by http.Serve, http.ListenAndServe or
+// the methods of http.Server. It is http.DefaultServeMux if
+// Options.SharedRegistries is set.
+func (interp *Interpreter) ServeMux() *http.ServeMux {
+	if interp.registries.shared {
+		return http.DefaultServeMux
+	}
+	return interp.registries.mux
+}
+
+// fixRegistries redefines the stdlib symbols of the used values which
+// register to process-wide registries, so the registrations are notified and
+// scoped to the interpreter unless shared.
+func fixRegistries(interp *Interpreter, values Exports) {
+	r := interp.registries
+
+	// The gob registry is always the one of the host, as it is used by the
+	// encoders and decoders of the host.
+	if p := interp.binPkg["encoding/gob"]; p != nil && values["encoding/gob"] != nil {
+		p["Register"] = reflect.ValueOf(interp.gobRegister)
+	}
+
+	if r.shared && r.onRegister == nil {
+		return
+	}
+
+	if p := interp.binPkg["database/sql"]; p != nil && values["database/sql"] != nil {
+		p["Register"] = reflect.ValueOf(r.registerDriver)
+		if !r.shared {
+			p["Drivers"] = reflect.ValueOf(r.sqlDrivers)
+			p["Open"] = reflect.ValueOf(r.sqlOpen)
+		}
+	}
+
+	if p := interp.binPkg["net/http"]; p != nil && values["net/http"] != nil {
+		p["Handle"] = reflect.ValueOf(func(pattern string, handler http.Handler) {
+			interp.ServeMux().Handle(pattern, handler)
+			r.notify("net/http.Handle", pattern, handler)
+		})
+		p["HandleFunc"] = reflect.ValueOf(func(pattern string, handler func(http.ResponseWriter, *http.Request)) {
+			interp.ServeMux().HandleFunc(pattern, handler)
+			r.notify("net/http.HandleFunc", pattern, handler)
+		})
+		if r.shared {
+			return
+		}
+		p["DefaultServeMux"] = reflect.ValueOf(&r.mux).Elem()
+		// A nil handler stands for the default multiplexer.
+		handler := func(h http.Handler) http.Handler {
+			if h == nil {
+				return interp.ServeMux()
+			}
+			return h
+		}
+		p["ListenAndServe"] = reflect.ValueOf(func(addr string, h http.Handler) error {
+			return http.ListenAndServe(addr, handler(h))
+		})
+		p["ListenAndServeTLS"] = reflect.ValueOf(func(addr, certFile, keyFile string, h http.Handler) error {
+			return http.ListenAndServeTLS(addr, certFile, keyFile, handler(h))
+		})
+		p["Serve"] = reflect.ValueOf(func(l net.Listener, h http.Handler) error {
+			return http.Serve(l, handler(h))
+		})
+		p["ServeTLS"] = reflect.ValueOf(func(l net.Listener, h http.Handler, certFile, keyFile string) error {
+			return http.ServeTLS(l, handler(h), certFile, keyFile)
+		})
+	}
+}
+
+// recvHook returns a function preparing the receiver of the binary method
+// name of type t, called when the method value is obtained, or nil if there
+// is none. Unless the registries are shared, the methods of http.Server
+// serving requests with a nil Handler, standing for http.DefaultServeMux, set
+// it to the multiplexer of the interpreter.
+func (interp *Interpreter) recvHook(t reflect.Type, name string) func(reflect.Value) {
+	if interp.registries.shared || t != reflect.TypeOf((*http.Server)(nil)) {
+		return nil
+	}
+	switch name {
+	case "Serve", "ServeTLS", "ListenAndServe", "ListenAndServeTLS":
+	default:
+		return nil
+	}
+	return func(v reflect.Value) {
+		if s, _ := v.Interface().(*http.Server); s != nil && s.Handler == nil {
+			s.Handler = interp.ServeMux()
+		}
+	}
+}
+
+// gobRegister replaces gob.Register, registering the values of interpreted
+// types under their name, see TypeName, rather than the description of their
+// structure, as for the named types of the host.
+func (interp *Interpreter) gobRegister(value interface{}) {
+	name := interp.TypeName(reflect.TypeOf(value))
+	if name == "" {
+		gob.Register(value)
+		return
+	}
+	gob.RegisterName(name, value)
+	interp.registries.notify("encoding/gob.Register", name, value)
+}
+
+// TypeName returns the name of the interpreted named type of reflect type t,
+// qualified by its import path as "main.Point", or "*main.Point" for a
+// pointer to it, or an empty string if t is not the type of an interpreted
+// named type. Interpreted types are represented by unnamed reflect types, so
+// TypeName provides a name stable across runs and processes to register them
+// in the host registries indexed by type names. It is used by gob.Register
+// in interpreted code, so the values of interpreted types can be encoded and
+// decoded by the host.
+//
+// Only the struct, array, slice and map types are named. Interpreted types of
+// identical structure share their reflect type, and so their name, which is
+// the first one in the order of import paths and type names.
+func (interp *Interpreter) TypeName(t reflect.Type) string {
+	if t == nil {
+		return ""
+	}
+	star := ""
+	if t.Kind() == reflect.Ptr {
+		star = "*"
+		t = t.Elem()
+	}
+	if t.Name() != "" {
+		return ""
+	}
+	switch t.Kind() {
+	case reflect.Struct, reflect.Array, reflect.Slice, reflect.Map:
+	default:
+		return ""
+	}
+
+	interp.mutex.RLock()
+	defer interp.mutex.RUnlock()
+	var names []string
+	for path, sc := range interp.scopes {
+		for name, sym := range sc.sym {
+			if sym.kind != typeSym || sym.typ == nil || sym.typ.incomplete || sym.typ.path != path || sym.typ.name != name {
+				continue
+			}
+			switch sym.typ.cat {
+			case structT, arrayT, mapT:
+			default:
+				continue
+			}
+			if sym.typ.TypeOf() == t {
+				names = append(names, path+"."+name)
+			}
+		}
+	}
+	if len(names) == 0 {
+		return ""
+	}
+	sort.Strings(names)
+	return star + names[0]
+}
+
+// registerDriver replaces sql.Register, with the same panics.
+func (r *registries) registerDriver(name string, d driver.Driver) {
+	if d == nil {
+		panic("sql: Register driver is nil")
+	}
+	if r.shared {
+		sql.Register(name, d)
+		r.notify("database/sql.Register", name, d)
+		return
+	}
+	r.mu.Lock()
+	if _, dup := r.drivers[name]; dup {
+		r.mu.Unlock()
+		panic("sql: Register called twice for driver " + name)
+	}
+	r.drivers[name] = d
+	r.mu.Unlock()
+	r.notify("database/sql.Register", name, d)
+}
+
+// sqlDrivers replaces sql.Drivers, listing both the drivers of the host and
+// the ones registered by interpreted code.
+func (r *registries) sqlDrivers() []string {
+	names := sql.Drivers()
+	r.mu.RLock()
+	for name := range r.drivers {
+		names = append(names, name)
+	}
+	r.mu.RUnlock()
+	sort.Strings(names)
+	return names
+}
+
+// sqlOpen replaces sql.Open, looking up the drivers registered by interpreted
+// code before the ones of the host.
+func (r *registries) sqlOpen(name, dsn string) (*sql.DB, error) {
+	r.mu.RLock()
+	d, ok := r.drivers[name]
+	r.mu.RUnlock()
+	if !ok {
+		return sql.Open(name, dsn)
+	}
+	if dc, ok := d.(driver.DriverContext); ok {
+		c, err := dc.OpenConnector(dsn)
+		if err != nil {
+			return nil, err
+		}
+		return sql.OpenDB(c), nil
+	}
+	return sql.OpenDB(dsnConnector{dsn: dsn, driver: d}), nil
+}
+
+// dsnConnector is the connector of a driver not implementing
+// driver.DriverContext, as in sql.Open.
+type dsnConnector struct {
+	dsn    string
+	driver driver.Driver
+}
+
+func (c dsnConnector) Connect(context.Context) (driver.Conn, error) { return c.driver.Open(c.dsn) }
+
+func (c dsnConnector) Driver() driver.Driver { return c.driver }
@@ -0,0 +1,275 @@
+package interp
+
+import (
+	"bytes"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/gob"
+	"errors"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+var errFakeConn = errors.New("fake connection")
+
+// fakeDriver records the names of the opened data sources.
+type fakeDriver struct{ opened *[]string }
+
+func (d fakeDriver) Open(name string) (driver.Conn, error) {
+	*d.opened = append(*d.opened, name)
+	return nil, errFakeConn
+}
+
+// httpHandler is the interface wrapper of http.Handler.
+type httpHandler struct {
+	WServeHTTP func(http.ResponseWriter, *http.Request)
+}
+
+func (W httpHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) { W.WServeHTTP(w, r) }
+
+func registriesExports(d driver.Driver) Exports {
+	return Exports{
+		"database/sql": {
+			"Drivers":  reflect.ValueOf(sql.Drivers),
+			"Open":     reflect.ValueOf(sql.Open),
+			"Register": reflect.ValueOf(sql.Register),
+		},
+		"net": {
+			"Listener": reflect.ValueOf((*net.Listener)(nil)),
+		},
+		"net/http": {
+			"DefaultServeMux": reflect.ValueOf(&http.DefaultServeMux).Elem(),
+			"HandleFunc":      reflect.ValueOf(http.HandleFunc),
+			"Handler":         reflect.ValueOf((*http.Handler)(nil)),
+			"Request":         reflect.ValueOf((*http.Request)(nil)),
+			"ResponseWriter":  reflect.ValueOf((*http.ResponseWriter)(nil)),
+			"Serve":           reflect.ValueOf(http.Serve),
+			"ServeMux":        reflect.ValueOf((*http.ServeMux)(nil)),
+			"Server":          reflect.ValueOf((*http.Server)(nil)),
+			"_Handler":        reflect.ValueOf((*httpHandler)(nil)),
+		},
+		"host": {
+			"Driver": reflect.ValueOf(&d).Elem(),
+		},
+	}
+}
+
+const registriesSrc = `
+import (
+	"database/sql"
+	"host"
+	"net"
+	"net/http"
+)
+
+func init() {
+	sql.Register("yaegi-fake", host.Driver)
+	http.HandleFunc("/yaegi-hello", func(w http.ResponseWriter, r *http.Request) { w.Write([]byte("hello")) })
+}
+
+func ping() error {
+	db, err := sql.Open("yaegi-fake", "dsn")
+	if err != nil {
+		return err
+	}
+	return db.Ping()
+}
+
+func mux() *http.ServeMux { return http.DefaultServeMux }
+
+func serve(l net.Listener) error { return http.Serve(l, nil) }
+
+func serveServer(l net.Listener) error { return (&http.Server{}).Serve(l) }
+
+func serveServerValue(l net.Listener) error {
+	var s http.Server
+	return s.Serve(l)
+}
+`
+
+func TestRegistries(t *testing.T) {
+	var opened []string
+	var regs []Registration
+	i := New(Options{OnRegister: func(r Registration) { regs = append(regs, r) }})
+	i.Use(registriesExports(fakeDriver{&opened}))
+	if _, err := i.Eval(registriesSrc); err != nil {
+		t.Fatal(err)
+	}
+
+	// Registrations are notified, and scoped to the interpreter.
+	if len(regs) != 2 || regs[0].Func != "database/sql.Register" || regs[0].Name != "yaegi-fake" ||
+		regs[1].Func != "net/http.HandleFunc" || regs[1].Name != "/yaegi-hello" || regs[1].Shared {
+		t.Fatalf("unexpected registrations %+v", regs)
+	}
+	for _, name := range sql.Drivers() {
+		if name == "yaegi-fake" {
+			t.Fatal("driver registered in the host")
+		}
+	}
+	if _, pattern := http.DefaultServeMux.Handler(httptest.NewRequest("GET", "/yaegi-hello", nil)); pattern != "" {
+		t.Fatal("handler registered in the host")
+	}
+
+	res, err := i.Eval(`ping()`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err, _ := res.Interface().(error); err != errFakeConn || len(opened) != 1 || opened[0] != "dsn" {
+		t.Fatalf("got %v %v, want %v [dsn]", err, opened, errFakeConn)
+	}
+
+	rec := httptest.NewRecorder()
+	i.ServeMux().ServeHTTP(rec, httptest.NewRequest("GET", "/yaegi-hello", nil))
+	if rec.Body.String() != "hello" {
+		t.Fatalf("got %q, want %q", rec.Body.String(), "hello")
+	}
+	if res, err := i.Eval(`mux()`); err != nil || res.Interface() != i.ServeMux() {
+		t.Fatalf("got %v %v, want the interpreter multiplexer", res, err)
+	}
+
+	// Servers with a nil handler use the interpreter multiplexer.
+	for _, name := range []string{"serve", "serveServer", "serveServerValue"} {
+		res, err := i.Eval(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		srv := httptest.NewUnstartedServer(nil)
+		go func() { _ = res.Interface().(func(net.Listener) error)(srv.Listener) }()
+		resp, err := http.Get("http://" + srv.Listener.Addr().String() + "/yaegi-hello")
+		if err != nil {
+			t.Fatal(err)
+		}
+		body, err := ioutil.ReadAll(resp.Body)
+		_ = resp.Body.Close()
+		_ = srv.Listener.Close()
+		if err != nil || string(body) != "hello" {
+			t.Fatalf("%s: got %q %v, want %q", name, body, err, "hello")
+		}
+	}
+
+	// Another interpreter registers the same names without conflict.
+	j := New(Options{})
+	j.Use(registriesExports(fakeDriver{&opened}))
+	if _, err := j.Eval(registriesSrc); err != nil {
+		t.Fatal(err)
+	}
+	if j.ServeMux() == i.ServeMux() {
+		t.Fatal("multiplexer shared between interpreters")
+	}
+
+	// Registering twice panics as sql.Register.
+	if _, err := j.Eval(`sql.Register("yaegi-fake", host.Driver)`); err == nil {
+		t.Fatal("expected a panic")
+	}
+}
+
+func TestSharedRegistries(t *testing.T) {
+	var regs []Registration
+	i := New(Options{SharedRegistries: true, OnRegister: func(r Registration) { regs = append(regs, r) }})
+	i.Use(Exports{"net/http": {
+		"HandleFunc":     reflect.ValueOf(http.HandleFunc),
+		"Request":        reflect.ValueOf((*http.Request)(nil)),
+		"ResponseWriter": reflect.ValueOf((*http.ResponseWriter)(nil)),
+	}})
+	if _, err := i.Eval(`
+import "net/http"
+
+func init() {
+	http.HandleFunc("/yaegi-shared", func(w http.ResponseWriter, r *http.Request) {})
+}`); err != nil {
+		t.Fatal(err)
+	}
+	if len(regs) != 1 || !regs[0].Shared {
+		t.Fatalf("unexpected registrations %+v", regs)
+	}
+	if i.ServeMux() != http.DefaultServeMux {
+		t.Fatal("got a scoped multiplexer, want http.DefaultServeMux")
+	}
+	if _, pattern := http.DefaultServeMux.Handler(httptest.NewRequest("GET", "/yaegi-shared", nil)); pattern != "/yaegi-shared" {
+		t.Fatal("handler not registered in the host")
+	}
+}
+
+func TestGobRegister(t *testing.T) {
+	var buf bytes.Buffer
+	var regs []Registration
+	i := New(Options{OnRegister: func(r Registration) { regs = append(regs, r) }})
+	i.Use(Exports{
+		"encoding/gob": {
+			"Register": reflect.ValueOf(gob.Register),
+		},
+		"host": {
+			"Save": reflect.ValueOf(func(v interface{}) error {
+				return gob.NewEncoder(&buf).Encode(&v)
+			}),
+			"Load": reflect.ValueOf(func() (v interface{}, err error) {
+				err = gob.NewDecoder(&buf).Decode(&v)
+				return v, err
+			}),
+		},
+	})
+	if _, err := i.Eval(`
+import (
+	"encoding/gob"
+	"host"
+)
+
+type Point struct{ X, Y int }
+
+type Path []Point
+
+func init() {
+	gob.Register(Point{})
+	gob.Register(&Path{})
+}
+`); err != nil {
+		t.Fatal(err)
+	}
+	if len(regs) != 2 || regs[0].Name != "main.Point" || regs[1].Name != "*main.Path" || regs[0].Func != "encoding/gob.Register" {
+		t.Fatalf("unexpected registrations %+v", regs)
+	}
+
+	if _, err := i.Eval(`
+func roundTrip() int {
+	if err := host.Save(&Path{{1, 2}, {3, 4}}); err != nil {
+		panic(err)
+	}
+	v, err := host.Load()
+	if err != nil {
+		panic(err)
+	}
+	p := v.(*Path)
+	return (*p)[1].X + (*p)[1].Y
+}`); err != nil {
+		t.Fatal(err)
+	}
+	v, err := i.Eval(`roundTrip()`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v.Interface() != 7 {
+		t.Errorf("got %v, want 7", v)
+	}
+
+	// The host decodes the values of the interpreted type.
+	if _, err := i.Eval(`host.Save(Point{5, 6})`); err != nil {
+		t.Fatal(err)
+	}
+	var dec interface{}
+	if err := gob.NewDecoder(&buf).Decode(&dec); err != nil {
+		t.Fatal(err)
+	}
+	if got := reflect.ValueOf(dec).Field(1).Interface(); got != 6 {
+		t.Errorf("got %v, want 6", got)
+	}
+	if name := i.TypeName(reflect.TypeOf(dec)); name != "main.Point" {
+		t.Errorf("got type name %q, want main.Point", name)
+	}
+	if name := i.TypeName(reflect.TypeOf(0)); name != "" {
+		t.Errorf("got type name %q for int", name)
+	}
+}
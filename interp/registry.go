@@ -0,0 +1,217 @@
+package interp
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Media types of the OCI image manifests accepted by Registry.
+const (
+	ociManifestType    = "application/vnd.oci.image.manifest.v1+json"
+	dockerManifestType = "application/vnd.docker.distribution.manifest.v2+json"
+)
+
+// Registry is a client of a content-addressed store of plugin archives, an
+// OCI registry or a plain HTTP server, fetching the archives by digest, such
+// as "sha256:<hex>". The archives are verified against their digest, and
+// cached in a local directory, so that a plugin deployed by digest is
+// downloaded once. See Interpreter.ImportDigest.
+type Registry struct {
+	// URL is the base URL of the store. From a plain HTTP store, the archive
+	// of digest "sha256:<hex>" is fetched at URL/sha256/<hex>.
+	URL string
+
+	// Repository, if not empty, is the name of the OCI repository holding
+	// the archives, at the registry URL such as "https://registry.example.com".
+	// The digest is the one of an image manifest, whose first layer is the
+	// archive, or the one of the archive blob itself.
+	Repository string
+
+	// Header holds the headers added to requests, such as Authorization.
+	Header http.Header
+
+	// Client is the HTTP client, http.DefaultClient if nil.
+	Client *http.Client
+
+	// CacheDir, if not empty, is the directory caching the fetched archives
+	// and manifests, by digest.
+	CacheDir string
+
+	// MaxSize, if positive, is the maximum size of an archive in bytes.
+	MaxSize int64
+}
+
+// Fetch returns the content of the archive of digest, from the cache if
+// present, or from the store.
+func (r *Registry) Fetch(ctx context.Context, digest string) ([]byte, error) {
+	if r.Repository == "" {
+		return r.blob(ctx, strings.TrimSuffix(r.URL, "/")+"/"+strings.Replace(digest, ":", "/", 1), digest, "")
+	}
+
+	base := strings.TrimSuffix(r.URL, "/") + "/v2/" + r.Repository
+	b, err := r.blob(ctx, base+"/manifests/"+digest, digest, ociManifestType+", "+dockerManifestType)
+	var se *statusError
+	if errors.As(err, &se) && se.code == http.StatusNotFound {
+		// Not a manifest, the digest is the one of the archive blob.
+		return r.blob(ctx, base+"/blobs/"+digest, digest, "")
+	}
+	if err != nil {
+		return nil, err
+	}
+	var m struct {
+		Layers []struct {
+			Digest string `json:"digest"`
+		} `json:"layers"`
+	}
+	if err := json.Unmarshal(b, &m); err != nil {
+		return nil, fmt.Errorf("manifest %s: %w", digest, err)
+	}
+	if len(m.Layers) == 0 {
+		return nil, fmt.Errorf("manifest %s: no layers", digest)
+	}
+	return r.blob(ctx, base+"/blobs/"+m.Layers[0].Digest, m.Layers[0].Digest, "")
+}
+
+// blob returns the content of digest, from the cache or fetched at url, with
+// the Accept header set to accept if not empty.
+func (r *Registry) blob(ctx context.Context, url, digest, accept string) ([]byte, error) {
+	h, sum, err := parseDigest(digest)
+	if err != nil {
+		return nil, err
+	}
+	var cache string
+	if r.CacheDir != "" {
+		cache = filepath.Join(r.CacheDir, digest[:strings.Index(digest, ":")], sum)
+		if b, err := ioutil.ReadFile(cache); err == nil {
+			_, _ = h.Write(b)
+			if hex.EncodeToString(h.Sum(nil)) == sum {
+				return b, nil
+			}
+			// The cached file is corrupted: fetch it again.
+			h.Reset()
+		}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range r.Header {
+		req.Header[k] = v
+	}
+	if accept != "" {
+		req.Header.Set("Accept", accept)
+	}
+	client := r.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode != http.StatusOK {
+		return nil, &statusError{url: url, code: resp.StatusCode}
+	}
+
+	body := io.Reader(resp.Body)
+	if r.MaxSize > 0 {
+		body = io.LimitReader(body, r.MaxSize+1)
+	}
+	var buf bytes.Buffer
+	if _, err := io.Copy(io.MultiWriter(&buf, h), body); err != nil {
+		return nil, err
+	}
+	if r.MaxSize > 0 && int64(buf.Len()) > r.MaxSize {
+		return nil, fmt.Errorf("%s: size exceeds %d bytes", digest, r.MaxSize)
+	}
+	if got := hex.EncodeToString(h.Sum(nil)); got != sum {
+		return nil, fmt.Errorf("%s: digest mismatch, got %s", digest, got)
+	}
+
+	if cache != "" {
+		if err := writeFileAtomic(cache, buf.Bytes()); err != nil {
+			return nil, err
+		}
+	}
+	return buf.Bytes(), nil
+}
+
+// ImportDigest fetches the archive of digest from the registry reg, and
+// imports it as ImportArchive.
+func (interp *Interpreter) ImportDigest(ctx context.Context, importPath string, reg *Registry, digest string, opts ArchiveOptions) (string, error) {
+	b, err := reg.Fetch(ctx, digest)
+	if err != nil {
+		return "", err
+	}
+	return interp.ImportArchive(importPath, bytes.NewReader(b), opts)
+}
+
+// statusError is the error of an HTTP request with an unexpected status.
+type statusError struct {
+	url  string
+	code int
+}
+
+func (e *statusError) Error() string {
+	return fmt.Sprintf("%s: %s", e.url, http.StatusText(e.code))
+}
+
+// parseDigest returns the hash function and the hex encoded sum of digest, of
+// the form "algorithm:sum", sha256 or sha512.
+func parseDigest(digest string) (hash.Hash, string, error) {
+	i := strings.Index(digest, ":")
+	if i < 0 {
+		return nil, "", fmt.Errorf("invalid digest %q", digest)
+	}
+	var h hash.Hash
+	switch digest[:i] {
+	case "sha256":
+		h = sha256.New()
+	case "sha512":
+		h = sha512.New()
+	default:
+		return nil, "", fmt.Errorf("unsupported digest algorithm %q", digest[:i])
+	}
+	sum := digest[i+1:]
+	if b, err := hex.DecodeString(sum); err != nil || len(b) != h.Size() || strings.ToLower(sum) != sum {
+		return nil, "", fmt.Errorf("invalid digest %q", digest)
+	}
+	return h, sum, nil
+}
+
+// writeFileAtomic writes data to the file name, creating its directory, so
+// that readers never see a partial file.
+func writeFileAtomic(name string, data []byte) error {
+	if err := os.MkdirAll(filepath.Dir(name), 0700); err != nil {
+		return err
+	}
+	f, err := ioutil.TempFile(filepath.Dir(name), filepath.Base(name)+".*")
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(data); err != nil {
+		_ = f.Close()
+		_ = os.Remove(f.Name())
+		return err
+	}
+	if err := f.Close(); err != nil {
+		_ = os.Remove(f.Name())
+		return err
+	}
+	return os.Rename(f.Name(), name)
+}
@@ -0,0 +1,123 @@
+package interp
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"sync/atomic"
+	"testing"
+)
+
+func TestRegistry(t *testing.T) {
+	cacheDir, err := ioutil.TempDir("", "registry")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		_ = os.RemoveAll(cacheDir)
+	}()
+
+	_, archive, _ := makeArchives(t, map[string]string{
+		"plugin.go": "package plugin\n\nfunc Hello() string { return \"hello\" }\n",
+	})
+	digest := func(b []byte) string {
+		sum := sha256.Sum256(b)
+		return "sha256:" + hex.EncodeToString(sum[:])
+	}
+	archiveDigest := digest(archive)
+	manifest := []byte(`{"schemaVersion": 2, "layers": [{"digest": "` + archiveDigest + `"}]}`)
+	manifestDigest := digest(manifest)
+
+	// The server stores the archive as a plain HTTP store, and as the layer of
+	// an OCI image in the repository "plugins/hello".
+	var requests int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		switch r.URL.Path {
+		case "/" + strings.Replace(archiveDigest, ":", "/", 1), "/v2/plugins/hello/blobs/" + archiveDigest:
+			_, _ = w.Write(archive)
+		case "/v2/plugins/hello/manifests/" + manifestDigest:
+			_, _ = w.Write(manifest)
+		case "/corrupted/" + strings.Replace(archiveDigest, ":", "/", 1):
+			_, _ = w.Write(append(archive, 0))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	ctx := context.Background()
+	for _, test := range []struct {
+		desc   string
+		reg    *Registry
+		digest string
+	}{
+		{desc: "http", reg: &Registry{URL: srv.URL}, digest: archiveDigest},
+		{desc: "oci manifest", reg: &Registry{URL: srv.URL, Repository: "plugins/hello"}, digest: manifestDigest},
+		{desc: "oci blob", reg: &Registry{URL: srv.URL, Repository: "plugins/hello"}, digest: archiveDigest},
+	} {
+		i := New(Options{})
+		if _, err := i.ImportDigest(ctx, "example.com/hello", test.reg, test.digest, ArchiveOptions{}); err != nil {
+			t.Fatalf("%s: %v", test.desc, err)
+		}
+		if _, err := i.Eval(`import "example.com/hello"`); err != nil {
+			t.Fatalf("%s: %v", test.desc, err)
+		}
+		v, err := i.Eval(`plugin.Hello()`)
+		if err != nil {
+			t.Fatalf("%s: %v", test.desc, err)
+		}
+		if v.String() != "hello" {
+			t.Errorf("%s: got %v, want hello", test.desc, v)
+		}
+	}
+
+	// Fetched archives are cached.
+	reg := &Registry{URL: srv.URL, Repository: "plugins/hello", CacheDir: cacheDir}
+	for k := 0; k < 2; k++ {
+		atomic.StoreInt32(&requests, 0)
+		b, err := reg.Fetch(ctx, manifestDigest)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(b, archive) {
+			t.Fatal("unexpected archive")
+		}
+		if n, want := atomic.LoadInt32(&requests), []int32{2, 0}[k]; n != want {
+			t.Errorf("fetch %d: got %d requests, want %d", k, n, want)
+		}
+	}
+
+	// A corrupted cache entry is fetched again.
+	sum := strings.TrimPrefix(archiveDigest, "sha256:")
+	if err := ioutil.WriteFile(filepath.Join(cacheDir, "sha256", sum), []byte("corrupted"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	atomic.StoreInt32(&requests, 0)
+	if _, err := reg.Fetch(ctx, manifestDigest); err != nil || atomic.LoadInt32(&requests) != 1 {
+		t.Errorf("got %d requests, error %v", atomic.LoadInt32(&requests), err)
+	}
+
+	for _, test := range []struct {
+		desc   string
+		reg    *Registry
+		digest string
+	}{
+		{desc: "digest mismatch", reg: &Registry{URL: srv.URL + "/corrupted"}, digest: archiveDigest},
+		{desc: "not found", reg: &Registry{URL: srv.URL}, digest: manifestDigest},
+		{desc: "too large", reg: &Registry{URL: srv.URL, MaxSize: int64(len(archive) - 1)}, digest: archiveDigest},
+		{desc: "invalid digest", reg: &Registry{URL: srv.URL}, digest: "sha256:1234"},
+		{desc: "unsupported algorithm", reg: &Registry{URL: srv.URL}, digest: "md5:" + sum[:32]},
+	} {
+		if _, err := test.reg.Fetch(ctx, test.digest); err == nil {
+			t.Errorf("%s: want error", test.desc)
+		}
+	}
+}
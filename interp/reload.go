@@ -0,0 +1,322 @@
+package interp
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+)
+
+// ReloadPackage reads again the sources of the package importPath, imported
+// from a directory, compiles them entirely, and runs again its initialization.
+// On success, the functions and methods of the previous package, already
+// referenced by compiled code, are rebound to their new version if their
+// signature is unchanged, so existing callers run the new code. On failure,
+// the previous package remains in use.
+//
+// Package variables and types referenced by compiled code keep referring to
+// those of the previous package, and so do calls inlined or specialized with
+// a profile. Interpreted code must not run while the package is reloaded.
+func (interp *Interpreter) ReloadPackage(importPath string) error {
+	interp.evalMutex.Lock()
+	defer interp.evalMutex.Unlock()
+	interp.flushEvalCache()
+	interp.mutex.RLock()
+	info := interp.pkgInfo[importPath]
+	interp.mutex.RUnlock()
+	if info == nil || info.Origin != OriginDir {
+		return fmt.Errorf("package %s not imported from a directory", importPath)
+	}
+	if interp.readOnlyDir(info.Dir) {
+		return fmt.Errorf("package %s is in a read-only source root", importPath)
+	}
+
+	// The functions of the previous package not compiled yet must be, as
+	// they are not rebound if missing from the new package.
+	_ = interp.compileDeferred(importPath, false)
+
+	prev := interp.savePackage(importPath)
+	interp.Invalidate(importPath)
+	interp.syncTypes()
+	_, err := interp.importSrcDir(info.Dir, info.root, importPath, NoTest)
+	interp.syncTypes()
+	if err == nil {
+		err = interp.compileImport(importPath)
+	}
+	if err != nil {
+		interp.Invalidate(importPath)
+		interp.restorePackage(prev)
+		return err
+	}
+
+	interp.mutex.RLock()
+	syms := interp.srcPkg[importPath]
+	interp.mutex.RUnlock()
+	interp.rebind(prev.syms, syms)
+	return nil
+}
+
+// UnloadPackage removes the source package importPath from the interpreter,
+// and releases the global frame slots of its variables, so the memory of
+// transient packages is reclaimed. The package is then read again by the next
+// import. It returns an error if the package is not loaded, or if it is
+// imported by another source package still loaded. The import of the package
+// by the main package is removed: code compiled against the package must not
+// run after it is unloaded.
+func (interp *Interpreter) UnloadPackage(importPath string) error {
+	interp.evalMutex.Lock()
+	defer interp.evalMutex.Unlock()
+	interp.flushEvalCache()
+	interp.mutex.RLock()
+	info := interp.pkgInfo[importPath]
+	var importers []string
+	for path, sc := range interp.scopes {
+		if path != importPath && path != mainID && importsPkg(sc, importPath) {
+			importers = append(importers, path)
+		}
+	}
+	roots := interp.roots[importPath]
+	interp.mutex.RUnlock()
+	if info == nil {
+		return fmt.Errorf("package %s not loaded from sources", importPath)
+	}
+	if len(importers) > 0 {
+		sort.Strings(importers)
+		return fmt.Errorf("package %s is imported by %s", importPath, strings.Join(importers, ", "))
+	}
+
+	interp.Invalidate(importPath)
+	for _, root := range roots {
+		root.Walk(func(n *node) bool {
+			interp.frameTypes.Delete(n)
+			return true
+		}, nil)
+	}
+
+	interp.mutex.Lock()
+	defer interp.mutex.Unlock()
+	if sc := interp.scopes[mainID]; sc != nil {
+		for name, s := range sc.sym {
+			if s.kind == pkgSym && s.typ != nil && s.typ.path == importPath {
+				delete(sc.sym, name)
+			}
+		}
+	}
+	interp.freeSlots(info.slots)
+	return nil
+}
+
+// importsPkg returns true if the scope sc imports the package importPath.
+func importsPkg(sc *scope, importPath string) bool {
+	for _, s := range sc.sym {
+		if s.kind == pkgSym && s.typ != nil && s.typ.path == importPath {
+			return true
+		}
+	}
+	return false
+}
+
+// freeSlots releases the global frame slots of the range allocated by the
+// import of a package, except those of the dependencies still loaded, then
+// shrinks the global frame of its free slots at end. It must be called with
+// the interpreter mutex held.
+func (interp *Interpreter) freeSlots(slots [2]int) {
+	if interp.free == nil {
+		interp.free = map[int]bool{}
+	}
+	f := interp.frame
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	types := interp.universe.types
+	for _, sc := range interp.scopes {
+		if len(sc.types) > len(types) {
+			types = sc.types
+		}
+	}
+	for i := slots[0]; i < slots[1] && i < len(f.data); i++ {
+		if interp.slotOwned(i, slots) {
+			continue
+		}
+		f.data[i] = reflect.New(types[i]).Elem()
+		interp.free[i] = true
+	}
+
+	l := len(types)
+	for l > 0 && interp.free[l-1] {
+		delete(interp.free, l-1)
+		l--
+	}
+	types = types[:l:l]
+	interp.universe.types = types
+	for _, sc := range interp.scopes {
+		sc.types = types
+	}
+	if len(f.data) > l {
+		// Copy the remaining slots, so the storage of the released ones is
+		// reclaimed, as resizeFrame only grows the frame.
+		data := make([]reflect.Value, l)
+		copy(data, f.data)
+		f.data = data
+	}
+}
+
+// slotOwned returns true if the global frame slot i, of the range slots,
+// belongs to another loaded package whose import is nested in this range.
+func (interp *Interpreter) slotOwned(i int, slots [2]int) bool {
+	for _, info := range interp.pkgInfo {
+		s := info.slots
+		if s != slots && s[0] >= slots[0] && s[1] <= slots[1] && i >= s[0] && i < s[1] {
+			return true
+		}
+	}
+	return false
+}
+
+// syncTypes makes the universe and the package scopes share the longest list
+// of global frame types, so the globals of the reloaded package and of the
+// code compiled later are allocated distinct frame slots.
+func (interp *Interpreter) syncTypes() {
+	interp.mutex.Lock()
+	defer interp.mutex.Unlock()
+	types := interp.universe.types
+	for _, sc := range interp.scopes {
+		if len(sc.types) > len(types) {
+			types = sc.types
+		}
+	}
+	interp.universe.types = types
+	for _, sc := range interp.scopes {
+		sc.types = types
+	}
+}
+
+// savedPackage is the state of a source package, restored if its reload
+// fails.
+type savedPackage struct {
+	path    string
+	syms    map[string]*symbol
+	name    string
+	sources []srcFile
+	scope   *scope
+	roots   []*node
+	info    *PackageInfo
+}
+
+func (interp *Interpreter) savePackage(importPath string) *savedPackage {
+	interp.mutex.RLock()
+	defer interp.mutex.RUnlock()
+	return &savedPackage{
+		path:    importPath,
+		syms:    interp.srcPkg[importPath],
+		name:    interp.pkgNames[importPath],
+		sources: interp.sources[importPath],
+		scope:   interp.scopes[importPath],
+		roots:   interp.roots[importPath],
+		info:    interp.pkgInfo[importPath],
+	}
+}
+
+func (interp *Interpreter) restorePackage(p *savedPackage) {
+	interp.mutex.Lock()
+	defer interp.mutex.Unlock()
+	interp.srcPkg[p.path] = p.syms
+	interp.pkgNames[p.path] = p.name
+	interp.sources[p.path] = p.sources
+	interp.scopes[p.path] = p.scope
+	interp.roots[p.path] = p.roots
+	interp.pkgInfo[p.path] = p.info
+}
+
+// rebind replaces the declarations of the functions and methods of the
+// previous package symbols by the new ones of same name and signature, so
+// compiled code referring to the previous declaration nodes, or to those
+// already rebound to them, runs the new ones.
+func (interp *Interpreter) rebind(prev, syms map[string]*symbol) {
+	interp.mutex.Lock()
+	defer interp.mutex.Unlock()
+	if interp.rebound == nil {
+		interp.rebound = map[*node][]*node{}
+	}
+	bind := func(old, n *node) {
+		nodes := append(interp.rebound[old], old)
+		delete(interp.rebound, old)
+		for _, o := range nodes {
+			*o = *n
+		}
+		interp.rebound[n] = nodes
+	}
+	for name, ps := range prev {
+		s := syms[name]
+		if s == nil || s.kind != ps.kind {
+			continue
+		}
+		switch ps.kind {
+		case funcSym:
+			if ps.node != nil && s.node != nil && ps.node != s.node && ps.typ.id() == s.typ.id() {
+				bind(ps.node, s.node)
+			}
+		case typeSym:
+			if ps.typ == nil || s.typ == nil {
+				continue
+			}
+			for _, pm := range ps.typ.method {
+				if m, _ := s.typ.lookupMethod(pm.child[1].ident); m != nil && m != pm && m.typ.id() == pm.typ.id() {
+					bind(pm, m)
+				}
+			}
+		}
+	}
+}
+
+// WatchPackage polls the source directory of the package importPath every
+// interval, and reloads the package with ReloadPackage when its files change,
+// until ctx is done. If not nil, onReload is called after each reload with its
+// error. WatchPackage blocks and returns the error of ctx.
+func (interp *Interpreter) WatchPackage(ctx context.Context, importPath string, interval time.Duration, onReload func(error)) error {
+	interp.mutex.RLock()
+	info := interp.pkgInfo[importPath]
+	interp.mutex.RUnlock()
+	if info == nil || info.Origin != OriginDir {
+		return fmt.Errorf("package %s not imported from a directory", importPath)
+	}
+
+	last := interp.dirState(info.Dir)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+		state := interp.dirState(info.Dir)
+		if state == last {
+			continue
+		}
+		last = state
+		err := interp.ReloadPackage(importPath)
+		if onReload != nil {
+			onReload(err)
+		}
+	}
+}
+
+// dirState returns a summary of the names, sizes and modification times of
+// the Go files of dir.
+func (interp *Interpreter) dirState(dir string) string {
+	files, err := interp.srcFS.ReadDir(dir)
+	if err != nil {
+		return err.Error()
+	}
+	var state string
+	for _, fi := range files {
+		if filepath.Ext(fi.Name()) == ".go" {
+			state += fmt.Sprintf("%s %d %d\n", fi.Name(), fi.Size(), fi.ModTime().UnixNano())
+		}
+	}
+	return state
+}
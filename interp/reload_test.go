@@ -0,0 +1,154 @@
+package interp
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestReloadPackage(t *testing.T) {
+	goPath, err := ioutil.TempDir("", "reload")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		_ = os.RemoveAll(goPath)
+	}()
+
+	name := filepath.Join(goPath, "src", "calc", "calc.go")
+	if err := os.MkdirAll(filepath.Dir(name), 0700); err != nil {
+		t.Fatal(err)
+	}
+	// Files are replaced at once, so the watcher never reads partial ones.
+	write := func(src string) {
+		t.Helper()
+		if err := ioutil.WriteFile(name+".tmp", []byte(src), 0600); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.Rename(name+".tmp", name); err != nil {
+			t.Fatal(err)
+		}
+	}
+	check := func(i *Interpreter, src string, want int64) {
+		t.Helper()
+		v, err := i.Eval(src)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if v.Int() != want {
+			t.Errorf("%s: got %d, want %d", src, v.Int(), want)
+		}
+	}
+
+	write("package calc\n\nvar Inits int\n\nfunc init() { Inits++ }\n\ntype T struct{ N int }\n\nfunc (t T) Get() int { n := t.N; return n }\n\nfunc Value() int { return 1 }\n")
+	i := New(Options{GoPath: goPath})
+	if _, err := i.Eval(`import "calc"`); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := i.Eval(`func get() int { return calc.Value() + calc.T{N: 10}.Get() }`); err != nil {
+		t.Fatal(err)
+	}
+	check(i, `get()`, 11)
+
+	write("package calc\n\nvar Inits int\n\nfunc init() { Inits += 10 }\n\ntype T struct{ N int }\n\nfunc (t T) Get() int { n := t.N; return 2 * n }\n\nfunc Value() int { return 2 }\n")
+	if err := i.ReloadPackage("calc"); err != nil {
+		t.Fatal(err)
+	}
+	check(i, `get()`, 22)
+	check(i, `calc.Inits`, 10)
+
+	// A failed reload keeps the previous package.
+	write("package calc\n\nfunc Value() int { return undefined }\n")
+	if err := i.ReloadPackage("calc"); err == nil {
+		t.Error("want reload error")
+	}
+	check(i, `get()`, 22)
+	check(i, `calc.Value()`, 2)
+
+	// The package is reloaded by a watcher when its files change.
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	reloaded := make(chan error, 1)
+	go func() {
+		_ = i.WatchPackage(ctx, "calc", 10*time.Millisecond, func(err error) { reloaded <- err })
+	}()
+	time.Sleep(20 * time.Millisecond)
+	write("package calc\n\ntype T struct{ N int }\n\nfunc (t T) Get() int { n := t.N; return 3 * n }\n\nfunc Value() int { return 3 }\n")
+	select {
+	case err := <-reloaded:
+		if err != nil {
+			t.Fatal(err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("package not reloaded")
+	}
+	check(i, `get()`, 33)
+
+	if err := i.ReloadPackage("fmt"); err == nil {
+		t.Error("want error on package not imported")
+	}
+}
+
+func TestUnloadPackage(t *testing.T) {
+	goPath, err := ioutil.TempDir("", "unload")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		_ = os.RemoveAll(goPath)
+	}()
+
+	files := map[string]string{
+		"dep/dep.go": "package dep\n\nvar Data = make([]byte, 1<<20)\n",
+		"app/app.go": "package app\n\nimport \"dep\"\n\nvar n = len(dep.Data)\n\nfunc Len() int { return n }\n",
+	}
+	for name, src := range files {
+		name = filepath.Join(goPath, "src", name)
+		if err := os.MkdirAll(filepath.Dir(name), 0700); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(name, []byte(src), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	i := New(Options{GoPath: goPath})
+	size := len(i.frame.data)
+	if _, err := i.Eval(`import "app"`); err != nil {
+		t.Fatal(err)
+	}
+	if len(i.frame.data) <= size {
+		t.Fatalf("got frame size %d, want more than %d", len(i.frame.data), size)
+	}
+
+	if err := i.UnloadPackage("dep"); err == nil || err.Error() != "package dep is imported by app" {
+		t.Errorf("got error %v", err)
+	}
+	if err := i.UnloadPackage("app"); err != nil {
+		t.Fatal(err)
+	}
+	if err := i.UnloadPackage("dep"); err != nil {
+		t.Fatal(err)
+	}
+	if len(i.frame.data) != size || cap(i.frame.data) != size {
+		t.Errorf("got frame size %d, capacity %d, want %d", len(i.frame.data), cap(i.frame.data), size)
+	}
+	if err := i.UnloadPackage("dep"); err == nil {
+		t.Error("want error on package not loaded")
+	}
+
+	// The unloaded packages can be imported again.
+	if _, err := i.Eval(`import "app"`); err != nil {
+		t.Fatal(err)
+	}
+	v, err := i.Eval(`app.Len()`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v.Int() != 1<<20 {
+		t.Errorf("got %d, want %d", v.Int(), 1<<20)
+	}
+}
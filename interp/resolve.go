@@ -0,0 +1,98 @@
+package interp
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+)
+
+// BinaryLocation is the location of a package provided as binary symbols,
+// see Interpreter.Use.
+const BinaryLocation = "<binary>"
+
+// AmbiguousImport reports an import path which resolves to several packages,
+// among binary symbols, workspace modules, source roots, vendor directories
+// and GOPATH.
+type AmbiguousImport struct {
+	Path       string   // import path
+	Importer   string   // path, relative to GOPATH/src, of the importing package root
+	Chosen     string   // location of the imported package
+	Candidates []string // locations of all the candidates, in priority order
+}
+
+func (a AmbiguousImport) String() string {
+	return fmt.Sprintf("import %q is ambiguous, using %s among: %s", a.Path, a.Chosen, strings.Join(a.Candidates, ", "))
+}
+
+// checkAmbiguity calls the OnAmbiguousImport hook if the package importPath,
+// imported from rPath, resolves to several candidates. Each ambiguity is
+// reported once.
+func (interp *Interpreter) checkAmbiguity(rPath, importPath string) {
+	if interp.onAmbiguousImport == nil || isPathRelative(importPath) {
+		return
+	}
+
+	var candidates []string
+	seen := map[string]bool{}
+	add := func(loc string) {
+		if !seen[loc] {
+			seen[loc] = true
+			candidates = append(candidates, loc)
+		}
+	}
+	addDir := func(dir string) {
+		if fi, err := interp.srcFS.Stat(dir); err == nil && fi.IsDir() {
+			add(dir)
+		}
+	}
+
+	if interp.binPkg[importPath] != nil && !interp.preferSource {
+		add(BinaryLocation)
+	}
+	if dir, ok := interp.workspaceDir(importPath); ok {
+		addDir(dir)
+	}
+	for _, r := range interp.sourceRoots {
+		addDir(filepath.Join(r.Dir, filepath.FromSlash(importPath)))
+	}
+	goPath := interp.context.GOPATH
+	if rPath != mainID {
+		// Nested vendor directories, from the closest to the importer.
+		for root := rPath; ; root = filepath.Dir(root) {
+			if root == "." {
+				root = ""
+			}
+			addDir(filepath.Join(goPath, "src", root, vendor, importPath))
+			if root == "" || root == string(filepath.Separator) {
+				break
+			}
+		}
+	}
+	addDir(filepath.Join(goPath, "src", importPath))
+	if interp.binPkg[importPath] != nil && interp.preferSource {
+		add(BinaryLocation)
+	}
+	if len(candidates) < 2 {
+		return
+	}
+
+	chosen := BinaryLocation
+	if interp.binPkg[importPath] == nil || interp.importsSource(rPath, importPath) {
+		dir, _, err := interp.srcDir(rPath, importPath)
+		if err != nil {
+			return
+		}
+		chosen = dir
+		add(dir)
+	}
+
+	key := importPath + "\x00" + chosen
+	if interp.ambiguous[key] {
+		return
+	}
+	if interp.ambiguous == nil {
+		interp.ambiguous = map[string]bool{}
+	}
+	interp.ambiguous[key] = true
+	interp.onAmbiguousImport(AmbiguousImport{Path: importPath, Importer: rPath, Chosen: chosen, Candidates: candidates})
+}
@@ -0,0 +1,147 @@
+package interp
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// Restrictions limit the packages and symbols which interpreted code can use,
+// to run untrusted code. They are enforced when binary symbols are loaded by
+// Interpreter.Use, where restricted symbols are dropped, and at compile time,
+// where imports of restricted packages and uses of restricted symbols are
+// reported as errors.
+//
+// Packages are designated by their import path, or by a pattern ending with
+// "/..." which also matches the packages below it, such as "net/...".
+// Symbols are designated by their package import path and name, such as
+// "os.RemoveAll".
+//
+// Note that restrictions only apply to package level symbols: methods of the
+// values returned by allowed functions remain available.
+type Restrictions struct {
+	// Allow, if not nil, lists the only packages which can be imported,
+	// whether source or binary.
+	Allow []string
+
+	// Deny lists the packages which can not be imported, even if allowed.
+	Deny []string
+
+	// DenySymbols lists the symbols of binary packages which can not be used.
+	DenySymbols []string
+}
+
+// SafeRestrictions returns restrictions suitable for untrusted code, which
+// has no access to the file system, network, processes, unsafe memory, nor to
+// the interpreter itself. Text output of the fmt and log packages is allowed,
+// as it goes to the streams of the interpreter.
+func SafeRestrictions() *Restrictions {
+	return &Restrictions{
+		Deny: []string{
+			"github.com/traefik/yaegi/...",
+			"io/ioutil",
+			"net/...",
+			"os/exec",
+			"os/signal",
+			"os/user",
+			"plugin",
+			"runtime/debug",
+			"syscall",
+			"unsafe",
+		},
+		DenySymbols: []string{
+			"os.Chdir", "os.Chmod", "os.Chown", "os.Chtimes", "os.Create",
+			"os.CreateTemp", "os.DirFS", "os.Executable", "os.Exit",
+			"os.FindProcess", "os.Getwd", "os.Lchown", "os.Link", "os.Lstat",
+			"os.Mkdir", "os.MkdirAll", "os.MkdirTemp", "os.NewFile", "os.Open",
+			"os.OpenFile", "os.Pipe", "os.ReadDir", "os.ReadFile", "os.Readlink",
+			"os.Remove", "os.RemoveAll", "os.Rename", "os.StartProcess", "os.Stat",
+			"os.Stderr", "os.Stdin", "os.Stdout", "os.Symlink", "os.TempDir",
+			"os.Truncate", "os.WriteFile",
+			"path/filepath.Abs", "path/filepath.EvalSymlinks", "path/filepath.Glob",
+			"path/filepath.Walk", "path/filepath.WalkDir",
+		},
+	}
+}
+
+// matchPath returns true if the import path matches one of the patterns.
+func matchPath(patterns []string, importPath string) bool {
+	for _, p := range patterns {
+		if p == importPath {
+			return true
+		}
+		if prefix := strings.TrimSuffix(p, "/..."); prefix != p && (importPath == prefix || strings.HasPrefix(importPath, prefix+"/")) {
+			return true
+		}
+	}
+	return false
+}
+
+// allowImport returns an error if the package importPath can not be imported.
+func (r *Restrictions) allowImport(importPath string) error {
+	if r == nil {
+		return nil
+	}
+	if r.Allow != nil && !matchPath(r.Allow, importPath) || matchPath(r.Deny, importPath) {
+		return fmt.Errorf("import %q not allowed", importPath)
+	}
+	return nil
+}
+
+// allowSymbol returns an error if the symbol name of the binary package
+// importPath can not be used.
+func (r *Restrictions) allowSymbol(importPath, name string) error {
+	if r == nil {
+		return nil
+	}
+	for _, s := range r.DenySymbols {
+		if s == importPath+"."+name {
+			return fmt.Errorf("use of %s.%s not allowed", importPath, name)
+		}
+	}
+	return nil
+}
+
+// restrict removes the restricted packages and symbols from the binary
+// packages loaded in the interpreter.
+func (interp *Interpreter) restrict() {
+	r := interp.restrictions
+	if r == nil {
+		return
+	}
+	for path, syms := range interp.binPkg {
+		if path == "" {
+			continue
+		}
+		if r.allowImport(path) != nil {
+			delete(interp.binPkg, path)
+			continue
+		}
+		for name := range syms {
+			if r.allowSymbol(path, name) != nil {
+				delete(syms, name)
+			}
+		}
+	}
+}
+
+// Restrict returns a copy of values without the packages and symbols denied
+// by r, such as a safe subset of the standard library symbols:
+//
+//	i.Use(interp.Restrict(stdlib.Symbols, interp.SafeRestrictions()))
+func Restrict(values Exports, r *Restrictions) Exports {
+	res := make(Exports, len(values))
+	for path, syms := range values {
+		if path != selfPrefix && r.allowImport(path) != nil {
+			continue
+		}
+		m := make(map[string]reflect.Value, len(syms))
+		for name, v := range syms {
+			if path == selfPrefix || r.allowSymbol(path, name) == nil {
+				m[name] = v
+			}
+		}
+		res[path] = m
+	}
+	return res
+}
@@ -0,0 +1,59 @@
+package interp_test
+
+import (
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/traefik/yaegi/interp"
+)
+
+func TestRestrictions(t *testing.T) {
+	host := interp.Exports{
+		"os": {
+			"Getpid":    reflect.ValueOf(os.Getpid),
+			"RemoveAll": reflect.ValueOf(os.RemoveAll),
+		},
+		"os/exec": {
+			"LookPath": reflect.ValueOf(func(string) (string, error) { return "", nil }),
+		},
+		"strings": {
+			"ToUpper": reflect.ValueOf(strings.ToUpper),
+		},
+	}
+
+	i := interp.New(interp.Options{Restrictions: interp.SafeRestrictions()})
+	i.Use(host)
+	i.Use(interp.Symbols)
+
+	eval(t, i, `import (
+	"os"
+	"strings"
+)`)
+	assertEval(t, i, `strings.ToUpper("a")`, "", "A")
+	assertEval(t, i, `os.Getpid() > 0`, "", "true")
+	assertEval(t, i, `os.RemoveAll("/tmp/x")`, "use of os.RemoveAll not allowed", "")
+	assertEval(t, i, `import "os/exec"`, `import "os/exec" not allowed`, "")
+	assertEval(t, i, `import "github.com/traefik/yaegi/interp"`, `import "github.com/traefik/yaegi/interp" not allowed`, "")
+	if _, ok := i.Symbols("os/exec")["os/exec"]; ok {
+		t.Error("os/exec symbols loaded")
+	}
+
+	i = interp.New(interp.Options{Restrictions: &interp.Restrictions{Allow: []string{"strings"}}})
+	i.Use(host)
+	eval(t, i, `import "strings"`)
+	assertEval(t, i, `strings.ToUpper("b")`, "", "B")
+	assertEval(t, i, `import "os"`, `import "os" not allowed`, "")
+
+	safe := interp.Restrict(host, interp.SafeRestrictions())
+	if _, ok := safe["os/exec"]; ok {
+		t.Error("os/exec not removed")
+	}
+	if _, ok := safe["os"]["RemoveAll"]; ok {
+		t.Error("os.RemoveAll not removed")
+	}
+	if _, ok := safe["os"]["Getpid"]; !ok {
+		t.Error("os.Getpid removed")
+	}
+}
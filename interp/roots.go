@@ -0,0 +1,116 @@
+package interp
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// SourceRoot is a directory of package sources, laid out as GOPATH/src,
+// where the package of import path "a/b" is in the directory Dir/a/b.
+// See Options.SourceRoots.
+type SourceRoot struct {
+	// Dir is the directory of the root. If the root is tied to a file
+	// system (see SourceRootFS), Dir is where it is mounted among the
+	// directories of the interpreter source files.
+	Dir string
+
+	// ReadOnly prevents the packages of the root from being reloaded by
+	// ReloadPackage, as for a set of builtin scripts.
+	ReadOnly bool
+
+	fsys filesystem // file system of the root, or nil for the interpreter one
+}
+
+// WithSourceRoot adds root to Options.SourceRoots, after the existing ones.
+func WithSourceRoot(root SourceRoot) Option {
+	return OptionFunc(func(o *Options) {
+		o.SourceRoots = append(o.SourceRoots[:len(o.SourceRoots):len(o.SourceRoots)], root)
+	})
+}
+
+// rootDir returns the directory of the package importPath in the first
+// source root containing it.
+func (interp *Interpreter) rootDir(importPath string) (string, bool) {
+	for _, r := range interp.sourceRoots {
+		dir := filepath.Join(r.Dir, filepath.FromSlash(importPath))
+		if fi, err := interp.srcFS.Stat(dir); err == nil && fi.IsDir() {
+			return dir, true
+		}
+	}
+	return "", false
+}
+
+// readOnlyDir returns true if dir is in a read-only source root.
+func (interp *Interpreter) readOnlyDir(dir string) bool {
+	for _, r := range interp.sourceRoots {
+		if r.ReadOnly && inDir(filepath.Clean(r.Dir), filepath.Clean(dir)) {
+			return true
+		}
+	}
+	return false
+}
+
+// inDir returns true if name is the directory dir or is in it.
+func inDir(dir, name string) bool {
+	return name == dir || strings.HasPrefix(name, strings.TrimSuffix(dir, string(filepath.Separator))+string(filepath.Separator))
+}
+
+// mountRoots returns the file system fsys, where the source roots tied to a
+// file system are mounted at their directory.
+func (interp *Interpreter) mountRoots(fsys filesystem) filesystem {
+	m := mountFS{base: fsys}
+	for _, r := range interp.sourceRoots {
+		if r.fsys != nil {
+			m.mounts = append(m.mounts, mount{dir: filepath.Clean(r.Dir), fsys: r.fsys})
+		}
+	}
+	if len(m.mounts) == 0 {
+		return fsys
+	}
+	return m
+}
+
+// mount is a file system mounted at a directory.
+type mount struct {
+	dir  string
+	fsys filesystem
+}
+
+// mountFS is a file system made of base, and of the file systems mounted on
+// it, which take precedence in their directories.
+type mountFS struct {
+	base   filesystem
+	mounts []mount
+}
+
+// resolve returns the file system of name, and the name in it.
+func (m mountFS) resolve(name string) (filesystem, string) {
+	name = filepath.Clean(name)
+	for _, mt := range m.mounts {
+		if inDir(mt.dir, name) {
+			return mt.fsys, filepath.Join(string(filepath.Separator), strings.TrimPrefix(name, mt.dir))
+		}
+	}
+	return m.base, name
+}
+
+func (m mountFS) ReadDir(dir string) ([]os.FileInfo, error) {
+	fsys, name := m.resolve(dir)
+	return fsys.ReadDir(name)
+}
+
+func (m mountFS) ReadFile(name string) ([]byte, error) {
+	fsys, name := m.resolve(name)
+	return fsys.ReadFile(name)
+}
+
+func (m mountFS) Stat(name string) (os.FileInfo, error) {
+	fsys, name := m.resolve(name)
+	return fsys.Stat(name)
+}
+
+func (m mountFS) Lstat(name string) (os.FileInfo, error) {
+	fsys, name := m.resolve(name)
+	return fsys.Lstat(name)
+}
@@ -0,0 +1,142 @@
+package interp
+
+import (
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"reflect"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// A Rule is a boolean expression compiled once against an environment type,
+// and which can be evaluated many times against values of this type.
+type Rule struct {
+	expr string
+	typ  reflect.Type
+	fn   func(interface{}) bool
+}
+
+// ruleEnvPath is the import path prefix of environment types exposed to rules.
+const ruleEnvPath = "yaegi/ruleenv"
+
+// CompileRule compiles the boolean Go expression expr, evaluated in the context
+// of env, which must be a struct or a pointer to struct. The exported fields of
+// env are accessible by name in expr, as local variables.
+// The rule can then be evaluated against any value of the same type as env.
+//
+// For example:
+//
+//	r, err := i.CompileRule(`Amount > 100 && Country == "FR"`, Order{})
+//	ok, err := r.Eval(Order{Amount: 120, Country: "FR"})
+//
+func (interp *Interpreter) CompileRule(expr string, env interface{}) (*Rule, error) {
+	typ := reflect.TypeOf(env)
+	st := typ
+	if st != nil && st.Kind() == reflect.Ptr {
+		st = st.Elem()
+	}
+	if st == nil || st.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("invalid rule environment type %v: not a struct", typ)
+	}
+
+	x, err := parser.ParseExpr(expr)
+	if err != nil {
+		return nil, err
+	}
+
+	// Only the fields referred to in the expression are bound to local variables.
+	used := map[string]bool{}
+	ast.Inspect(x, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.SelectorExpr:
+			ast.Inspect(n.X, func(n ast.Node) bool {
+				if id, ok := n.(*ast.Ident); ok {
+					used[id.Name] = true
+				}
+				return true
+			})
+			return false
+		case *ast.Ident:
+			used[n.Name] = true
+		}
+		return true
+	})
+
+	pkg, err := interp.importRuleEnv(st)
+	if err != nil {
+		return nil, err
+	}
+	envType := pkg + "." + st.Name()
+	if typ.Kind() == reflect.Ptr {
+		envType = "*" + envType
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "_rule := func(env interface{}) bool {\n\te := env.(%s)\n", envType)
+	for k := 0; k < st.NumField(); k++ {
+		f := st.Field(k)
+		if f.PkgPath != "" || !used[f.Name] {
+			continue
+		}
+		fmt.Fprintf(&b, "\t%s := e.%s\n\t_ = %s\n", f.Name, f.Name, f.Name)
+	}
+	// The if statement enforces the expression to be boolean at compile time.
+	fmt.Fprintf(&b, "\tif %s {\n\t\treturn true\n\t}\n\treturn false\n}\n_rule", expr)
+
+	v, err := interp.Eval(b.String())
+	if err != nil {
+		return nil, err
+	}
+	fn, ok := v.Interface().(func(interface{}) bool)
+	if !ok {
+		return nil, errors.New("rule expression is not boolean: " + expr)
+	}
+	return &Rule{expr: expr, typ: typ, fn: fn}, nil
+}
+
+// importRuleEnv exposes the struct type t to the interpreter in a binary
+// package, imported once in the main scope. The package name is returned.
+func (interp *Interpreter) importRuleEnv(t reflect.Type) (string, error) {
+	if t.Name() == "" {
+		return "", errors.New("invalid rule environment: anonymous struct")
+	}
+	name := "_ruleenv_" + strings.Map(func(r rune) rune {
+		if r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return r
+		}
+		return '_'
+	}, t.PkgPath())
+	p := ruleEnvPath + "/" + name
+
+	if _, ok := interp.binPkg[p][t.Name()]; ok {
+		return name, nil
+	}
+
+	interp.Use(Exports{p: {t.Name(): reflect.Zero(reflect.PtrTo(t))}})
+	if len(interp.binPkg[p]) > 1 {
+		// Package already imported for another type.
+		return name, nil
+	}
+	_, err := interp.Eval("import " + name + " " + strconv.Quote(p))
+	return name, err
+}
+
+// String returns the rule expression.
+func (r *Rule) String() string { return r.expr }
+
+// Eval evaluates the rule against env, which must be of the same type as the
+// environment given at compile time.
+func (r *Rule) Eval(env interface{}) (res bool, err error) {
+	if t := reflect.TypeOf(env); t != r.typ {
+		return false, fmt.Errorf("invalid rule environment type %v, want %v", t, r.typ)
+	}
+	defer func() {
+		if e := recover(); e != nil {
+			err = fmt.Errorf("rule %q: %v", r.expr, e)
+		}
+	}()
+	return r.fn(env), nil
+}
@@ -0,0 +1,112 @@
+package interp_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/traefik/yaegi/interp"
+)
+
+type Order struct {
+	Amount  float64
+	Country string
+	Items   []string
+}
+
+type Customer struct {
+	Name string
+	VIP  bool
+}
+
+func TestRule(t *testing.T) {
+	i := interp.New(interp.Options{})
+
+	r, err := i.CompileRule(`Amount > 100 && Country == "FR" || len(Items) > 2`, Order{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, test := range []struct {
+		env  Order
+		want bool
+	}{
+		{Order{Amount: 120, Country: "FR"}, true},
+		{Order{Amount: 120, Country: "US"}, false},
+		{Order{Amount: 10, Items: []string{"a", "b", "c"}}, true},
+	} {
+		got, err := r.Eval(test.env)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != test.want {
+			t.Errorf("%s on %+v: got %v, want %v", r, test.env, got, test.want)
+		}
+	}
+
+	// Rules on a pointer to another struct type, in the same interpreter.
+	r2, err := i.CompileRule(`VIP && Name != ""`, &Customer{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, err := r2.Eval(&Customer{Name: "bob", VIP: true}); err != nil || !got {
+		t.Errorf("got %v, %v, want true", got, err)
+	}
+	if _, err := r2.Eval(Customer{}); err == nil {
+		t.Error("expected error for invalid environment type")
+	}
+
+	// A second rule on the same type reuses the environment package.
+	if _, err := i.CompileRule(`Amount < 0`, Order{}); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := i.CompileRule(`Amount + 1`, Order{}); err == nil {
+		t.Error("expected error for non boolean expression")
+	}
+	if _, err := i.CompileRule(`true`, 3); err == nil {
+		t.Error("expected error for non struct environment")
+	}
+}
+
+func BenchmarkRule(b *testing.B) {
+	i := interp.New(interp.Options{})
+	r, err := i.CompileRule(`Amount > 100 && Country == "FR"`, Order{})
+	if err != nil {
+		b.Fatal(err)
+	}
+	env := Order{Amount: 120, Country: "FR"}
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		if ok, _ := r.Eval(env); !ok {
+			b.Fatal("unexpected result")
+		}
+	}
+}
+
+// BenchmarkRuleEval is the naive equivalent of BenchmarkRule, where the
+// expression is evaluated from source at each iteration.
+func BenchmarkRuleEval(b *testing.B) {
+	i := interp.New(interp.Options{})
+	i.Use(interp.Exports{"rules": {"Order": reflect.ValueOf((*Order)(nil))}})
+	if _, err := i.Eval(`import "rules"`); err != nil {
+		b.Fatal(err)
+	}
+	if _, err := i.Eval(`var env rules.Order`); err != nil {
+		b.Fatal(err)
+	}
+	if _, err := i.Eval(`func setEnv(o rules.Order) { env = o }`); err != nil {
+		b.Fatal(err)
+	}
+	set, err := i.Eval(`setEnv`)
+	if err != nil {
+		b.Fatal(err)
+	}
+	setEnv := set.Interface().(func(Order))
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		setEnv(Order{Amount: 120, Country: "FR"})
+		v, err := i.Eval(`env.Amount > 100 && env.Country == "FR"`)
+		if err != nil || !v.Bool() {
+			b.Fatal("unexpected result", err)
+		}
+	}
+}
@@ -105,6 +105,13 @@ func (interp *Interpreter) run(n *node, cf *frame) {
 	for i, t := range n.types {
 		f.data[i] = reflect.New(t).Elem()
 	}
+	if n.kind == funcDecl {
+		if interp.trackFrames() {
+			f.debug = &frameDebug{def: n}
+		}
+		runFunc(n, f, nil)
+		return
+	}
 	runCfg(n.start, f)
 }
 
@@ -114,18 +121,29 @@ func (interp *Interpreter) run(n *node, cf *frame) {
 func runCfg(n *node, f *frame) {
 	defer func() {
 		f.mutex.Lock()
-		f.recovered = recover()
+		var trace []string
+		f.recovered, trace = untrace(recover())
 		for _, val := range f.deferred {
 			val[0].Call(val[1:])
 		}
 		if f.recovered != nil {
-			fmt.Println(n.cfgErrorf("panic"))
 			f.mutex.Unlock()
-			panic(f.recovered)
+			panic(&tracedPanic{value: f.recovered, trace: append(trace, n.traceString())})
 		}
 		f.mutex.Unlock()
 	}()
 
+	if q := n.interp.quotas; q != nil {
+		defer q.leaveFrame(q.enterFrame(n.interp, f))
+		if q.countSteps() {
+			for exec := n.exec; exec != nil && f.runid() == n.interp.runid(); {
+				q.step(n.interp)
+				exec = exec(f)
+			}
+			return
+		}
+	}
+
 	for exec := n.exec; exec != nil && f.runid() == n.interp.runid(); {
 		exec = exec(f)
 	}
@@ -492,6 +510,68 @@ func assign(n *node) {
 	}
 }
 
+// storeMapEntry wraps the generator gen of an operation updating in place its
+// operand, a map entry, so that the result is stored back in the map.
+func storeMapEntry(gen bltnGenerator) bltnGenerator {
+	return func(n *node) {
+		gen(n)
+		exec := n.exec
+		c := n.child[0]
+		value0 := genValue(c.child[0]) // map
+		value1 := genValue(c.child[1]) // key
+		value := genValue(c)
+		n.exec = func(f *frame) bltn {
+			next := exec(f)
+			value0(f).SetMapIndex(value1(f), value(f))
+			return next
+		}
+	}
+}
+
+// freshVars wraps the generator gen of the first node of the definition of
+// the local variables vars, so that they are allocated again at each execution.
+// The function literals which captured the previous ones, in a previous
+// iteration of a loop, keep them.
+func freshVars(gen bltnGenerator, vars []*node) bltnGenerator {
+	return func(n *node) {
+		gen(n)
+		exec := n.exec
+		types := make([]reflect.Type, len(vars))
+		for i, v := range vars {
+			types[i] = v.typ.frameType()
+		}
+		n.exec = func(f *frame) bltn {
+			for i, v := range vars {
+				f.data[v.findex] = reflect.New(types[i]).Elem()
+			}
+			return exec(f)
+		}
+	}
+}
+
+// copyVars wraps the generator gen of the node starting an iteration of a
+// loop, so that the loop variables vars are allocated again before it, with
+// their current values. The function literals which captured the previous
+// ones, in a previous iteration, keep them.
+func copyVars(gen bltnGenerator, vars []*node) bltnGenerator {
+	return func(n *node) {
+		gen(n)
+		exec := n.exec
+		types := make([]reflect.Type, len(vars))
+		for i, v := range vars {
+			types[i] = v.typ.frameType()
+		}
+		n.exec = func(f *frame) bltn {
+			for i, v := range vars {
+				c := reflect.New(types[i]).Elem()
+				c.Set(f.data[v.findex])
+				f.data[v.findex] = c
+			}
+			return exec(f)
+		}
+	}
+}
+
 func not(n *node) {
 	dest := genValue(n)
 	value := genValue(n.child[0])
@@ -556,9 +636,10 @@ func _print(n *node) {
 	for i, c := range child {
 		values[i] = genValue(c)
 	}
-	out := n.interp.stdout
+	interp := n.interp
 
 	genBuiltinDeferWrapper(n, values, nil, func(args []reflect.Value) []reflect.Value {
+		out := interp.stdout
 		for i, value := range args {
 			if i > 0 {
 				fmt.Fprintf(out, " ")
@@ -575,9 +656,10 @@ func _println(n *node) {
 	for i, c := range child {
 		values[i] = genValue(c)
 	}
-	out := n.interp.stdout
+	interp := n.interp
 
 	genBuiltinDeferWrapper(n, values, nil, func(args []reflect.Value) []reflect.Value {
+		out := interp.stdout
 		for i, value := range args {
 			if i > 0 {
 				fmt.Fprintf(out, " ")
@@ -594,11 +676,16 @@ func _recover(n *node) {
 	dest := genValue(n)
 
 	n.exec = func(f *frame) bltn {
-		if f.anc.recovered == nil {
+		fr := f.anc
+		if fr.deferrer != nil {
+			// The panic is recovered in the frame running the deferred closure.
+			fr = fr.deferrer
+		}
+		if fr.recovered == nil {
 			dest(f).Set(reflect.ValueOf(valueInterface{}))
 		} else {
-			dest(f).Set(reflect.ValueOf(valueInterface{n, reflect.ValueOf(f.anc.recovered)}))
-			f.anc.recovered = nil
+			dest(f).Set(reflect.ValueOf(valueInterface{n, reflect.ValueOf(fr.recovered)}))
+			fr.recovered = nil
 		}
 		return tnext
 	}
@@ -661,7 +748,6 @@ func genFunctionWrapper(n *node) func(*frame) reflect.Value {
 	if def, ok = n.val.(*node); !ok {
 		return genValueAsFunctionWrapper(n)
 	}
-	start := def.child[3].start
 	numRet := len(def.typ.ret)
 	var rcvr func(*frame) reflect.Value
 
@@ -673,18 +759,31 @@ func genFunctionWrapper(n *node) func(*frame) reflect.Value {
 		}
 	}
 	funcType := n.typ.TypeOf()
+	var closure func(*frame) reflect.Value
+	if n.kind == funcLit {
+		closure = genValue(n)
+	}
 
 	return func(f *frame) reflect.Value {
 		if n.frame != nil { // Use closure context if defined
 			f = n.frame
+		} else if closure != nil {
+			// Use the closure context of the function literal evaluated in f.
+			if c, ok := closure(f).Interface().(*node); ok && c != nil && c.frame != nil {
+				f = c.frame
+			}
 		}
-		return reflect.MakeFunc(funcType, func(in []reflect.Value) []reflect.Value {
+		f.escape()
+
+		// enter returns the frame of a call, and its input arguments.
+		enter := func() (*frame, []reflect.Value) {
 			// Allocate and init local frame. All values to be settable and addressable.
-			fr := newFrame(f, len(def.types), f.runid())
-			d := fr.data
-			for i, t := range def.types {
-				d[i] = reflect.New(t).Elem()
+			fr := def.interp.allocFrame(f, len(def.types), f.runid())
+			if def.interp.trackFrames() {
+				fr.debug = &frameDebug{def: def}
 			}
+			def.interp.initFrame(fr, def)
+			d := fr.data
 
 			// Copy method receiver as first argument, if defined
 			if rcvr != nil {
@@ -694,10 +793,17 @@ func genFunctionWrapper(n *node) func(*frame) reflect.Value {
 				} else {
 					dest.Set(src)
 				}
-				d = d[numRet+1:]
-			} else {
-				d = d[numRet:]
+				return fr, d[numRet+1:]
 			}
+			return fr, d[numRet:]
+		}
+		if fn := fastCallback(funcType, def, enter); fn.IsValid() {
+			return fn
+		}
+
+		return reflect.MakeFunc(funcType, func(in []reflect.Value) []reflect.Value {
+			defer rethrow()
+			fr, d := enter()
 
 			// Copy function input arguments in local frame
 			for i, arg := range in {
@@ -713,9 +819,18 @@ func genFunctionWrapper(n *node) func(*frame) reflect.Value {
 			}
 
 			// Interpreter code execution
-			runCfg(start, fr)
+			runFunc(def, fr, nil)
 
 			result := fr.data[:numRet]
+			if fr.runid() != def.interp.runid() && numRet > 0 && funcType.Out(numRet-1) == errorType {
+				// The evaluation was cancelled: report it to the binary caller,
+				// which could otherwise loop forever, as in io.Copy.
+				for i := range result[:numRet-1] {
+					result[i] = reflect.Zero(funcType.Out(i))
+				}
+				result[numRet-1] = reflect.ValueOf(&ErrInterrupted).Elem()
+				return result
+			}
 			for i, r := range result {
 				if v, ok := r.Interface().(*node); ok {
 					result[i] = genFunctionWrapper(v)(f)
@@ -756,6 +871,10 @@ func genInterfaceWrapper(n *node, typ reflect.Type) func(*frame) reflect.Value {
 		}
 	}
 	wrap := n.interp.getWrapper(typ)
+	if wrap == nil {
+		panic(n.cfgErrorf("cannot use %s as %v: no wrapper of the interface, see Interpreter.UseWrapper", n.typ.id(), typ))
+	}
+	zero := reflect.Zero(typ)
 
 	return func(f *frame) reflect.Value {
 		v := value(f)
@@ -763,7 +882,7 @@ func genInterfaceWrapper(n *node, typ reflect.Type) func(*frame) reflect.Value {
 		switch v.Kind() {
 		case reflect.Chan, reflect.Func, reflect.Interface, reflect.Map, reflect.Ptr, reflect.Slice:
 			if v.IsNil() {
-				return reflect.New(typ).Elem()
+				return zero
 			}
 			if v.Kind() == reflect.Ptr {
 				vv = v.Elem()
@@ -793,6 +912,9 @@ func genInterfaceWrapper(n *node, typ reflect.Type) func(*frame) reflect.Value {
 }
 
 func call(n *node) {
+	if n.interp.collectProfile {
+		defer n.interp.countCalls(n)
+	}
 	goroutine := n.anc.kind == goStmt
 	var method bool
 	value := genValue(n.child[0])
@@ -916,6 +1038,16 @@ func call(n *node) {
 		return
 	}
 
+	if value := n.interp.inlineCall(n, values); value != nil {
+		n.exec = callInline(value, rvalues, tnext, fnext)
+		return
+	}
+
+	if def := n.interp.hotCallee(n); def != nil {
+		n.exec = callHot(n, def, values, rvalues, tnext, fnext)
+		return
+	}
+
 	n.exec = func(f *frame) bltn {
 		var def *node
 		var ok bool
@@ -931,7 +1063,7 @@ func call(n *node) {
 				in[i] = v(f)
 			}
 			if goroutine {
-				go bf.Call(in)
+				n.interp.goroutine(func() { bf.Call(in) })
 				return tnext
 			}
 			out := bf.Call(in)
@@ -951,23 +1083,23 @@ func call(n *node) {
 		if def.frame != nil {
 			anc = def.frame
 		}
-		nf := newFrame(anc, len(def.types), anc.runid())
+		nf := n.interp.allocFrame(anc, len(def.types), anc.runid())
+		if n.interp.trackFrames() {
+			nf.debug = &frameDebug{def: def}
+			if !goroutine {
+				nf.debug.caller = f
+			}
+		}
 		var vararg reflect.Value
 
-		// Init return values
+		// Init local frame values, then return values
+		def.interp.initFrame(nf, def)
 		for i, v := range rvalues {
 			if v != nil {
 				nf.data[i] = v(f)
-			} else {
-				nf.data[i] = reflect.New(def.types[i]).Elem()
 			}
 		}
 
-		// Init local frame values
-		for i, t := range def.types[numRet:] {
-			nf.data[numRet+i] = reflect.New(t).Elem()
-		}
-
 		// Init variadic argument vector
 		varIndex := variadic
 		if variadic >= 0 {
@@ -1026,13 +1158,16 @@ func call(n *node) {
 
 		// Execute function body
 		if goroutine {
-			go runCfg(def.child[3].start, nf)
+			nf.escape()
+			n.interp.goroutine(func() { runFunc(def, nf, nil) })
 			return tnext
 		}
-		runCfg(def.child[3].start, nf)
+		runFunc(def, nf, f)
 
 		// Handle branching according to boolean result
-		if fnext != nil && !nf.data[0].Bool() {
+		res := fnext == nil || nf.data[0].Bool()
+		n.interp.releaseFrame(nf)
+		if !res {
 			return fnext
 		}
 		return tnext
@@ -1079,6 +1214,12 @@ func callBin(n *node) {
 	if n.action == aCallSlice {
 		callFn = func(v reflect.Value, in []reflect.Value) []reflect.Value { return v.CallSlice(in) }
 	}
+	if fn := n.interp.contractCall(n); fn != nil {
+		callFn = fn
+	}
+	if n.interp.collectCallStats {
+		callFn = n.interp.timeCalls(n, callFn)
+	}
 
 	for i, c := range child {
 		var defType reflect.Type
@@ -1096,13 +1237,15 @@ func callBin(n *node) {
 				ind := c.findex + j
 				values = append(values, func(f *frame) reflect.Value { return f.data[ind] })
 			}
-		case isRegularCall(c):
+		case isRegularCall(c) && len(c.child[0].typ.ret) != 1:
 			// Handle nested function calls: pass returned values as arguments
 			for j := range c.child[0].typ.ret {
 				ind := c.findex + j
 				values = append(values, func(f *frame) reflect.Value { return f.data[ind] })
 			}
 		default:
+			// A single value returned by an interpreted call is converted as
+			// any other argument, to an interface wrapper or a function.
 			if c.kind == basicLit || c.rval.IsValid() {
 				// Convert literal value (untyped) to function argument type (if not an interface{})
 				var argType reflect.Type
@@ -1154,7 +1297,8 @@ func callBin(n *node) {
 			for i, v := range values {
 				in[i] = v(f)
 			}
-			go callFn(value(f), in)
+			fn := value(f)
+			n.interp.goroutine(func() { callFn(fn, in) })
 			return tnext
 		}
 	case fnext != nil:
@@ -1240,6 +1384,16 @@ func getIndexBinMethod(n *node) {
 	value := genValue(n.child[0])
 	next := getExec(n.tnext)
 
+	if hook := n.interp.recvHook(n.child[0].typ.TypeOf(), n.child[1].ident); hook != nil {
+		n.exec = func(f *frame) bltn {
+			v := value(f)
+			hook(v)
+			getFrame(f, l).data[i] = v.Method(m)
+			return next
+		}
+		return
+	}
+
 	n.exec = func(f *frame) bltn {
 		// Can not use .Set() because dest type contains the receiver and source not
 		// dest(f).Set(value(f).Method(m))
@@ -1255,6 +1409,16 @@ func getIndexBinPtrMethod(n *node) {
 	value := genValue(n.child[0])
 	next := getExec(n.tnext)
 
+	if hook := n.interp.recvHook(reflect.PtrTo(n.child[0].typ.TypeOf()), n.child[1].ident); hook != nil {
+		n.exec = func(f *frame) bltn {
+			v := value(f).Addr()
+			hook(v)
+			getFrame(f, l).data[i] = v.Method(m)
+			return next
+		}
+		return
+	}
+
 	n.exec = func(f *frame) bltn {
 		// Can not use .Set() because dest type contains the receiver and source not
 		getFrame(f, l).data[i] = value(f).Addr().Method(m)
@@ -1493,8 +1657,24 @@ func getFunc(n *node) {
 	dest := genValue(n)
 	next := getExec(n.tnext)
 
+	if !escapes(n) {
+		// The function runs in the context of the current frame, as a
+		// function without closure context, and requires no allocation.
+		fv := reflect.ValueOf(n)
+		n.exec = func(f *frame) bltn {
+			dest(f).Set(fv)
+			return next
+		}
+		return
+	}
+
+	c := n.anc
+	deferred := c != nil && c.kind == callExpr && c.child[0] == n && c.anc.kind == deferStmt
 	n.exec = func(f *frame) bltn {
 		fr := f.clone()
+		if deferred {
+			fr.deferrer = f
+		}
 		nod := *n
 		nod.val = &nod
 		nod.frame = fr
@@ -2482,10 +2662,7 @@ func _append(n *node) {
 			value0 = genValue(n.child[2])
 		}
 
-		n.exec = func(f *frame) bltn {
-			dest(f).Set(reflect.Append(value(f), value0(f)))
-			return next
-		}
+		n.exec = appendOne(n, dest, value, value0)
 	}
 }
 
@@ -2504,6 +2681,18 @@ func _copy(n *node) {
 	in := []func(*frame) reflect.Value{genValueArray(n.child[1]), genValue(n.child[2])}
 	out := []func(*frame) reflect.Value{genValueOutput(n, reflect.TypeOf(0))}
 
+	if n.anc.kind != deferStmt {
+		// Avoid the allocations of the generic builtin wrapper, as copy is
+		// heavily used in I/O loops.
+		dst, src, dest := in[0], in[1], out[0]
+		next := getExec(n.tnext)
+		n.exec = func(f *frame) bltn {
+			dest(f).SetInt(int64(reflect.Copy(dst(f), src(f))))
+			return next
+		}
+		return
+	}
+
 	genBuiltinDeferWrapper(n, in, out, func(args []reflect.Value) []reflect.Value {
 		cnt := reflect.Copy(args[0], args[1])
 		return []reflect.Value{reflect.ValueOf(cnt)}
@@ -2970,13 +3159,13 @@ func _select(n *node) {
 	chanValues := make([]func(*frame) reflect.Value, nbClause)
 	assignedValues := make([]func(*frame) reflect.Value, nbClause)
 	okValues := make([]func(*frame) reflect.Value, nbClause)
-	cases := make([]reflect.SelectCase, nbClause+1)
+	dirs := make([]reflect.SelectCase, nbClause+1)
 	next := getExec(n.tnext)
 
 	for i := 0; i < nbClause; i++ {
 		cl := n.child[i]
 		if cl.kind == commClauseDefault {
-			cases[i].Dir = reflect.SelectDefault
+			dirs[i].Dir = reflect.SelectDefault
 			if len(cl.child) == 0 {
 				clause[i] = func(*frame) bltn { return next }
 			} else {
@@ -2989,7 +3178,7 @@ func _select(n *node) {
 		case len(cl.child) > 1:
 			// The comm clause contains a channel operation and a clause body.
 			clause[i] = getExec(cl.child[1].start)
-			chans[i], assigned[i], ok[i], cases[i].Dir = clauseChanDir(c0)
+			chans[i], assigned[i], ok[i], dirs[i].Dir = clauseChanDir(c0)
 			chanValues[i] = genValue(chans[i])
 			if assigned[i] != nil {
 				assignedValues[i] = genValue(assigned[i])
@@ -3000,18 +3189,21 @@ func _select(n *node) {
 		case c0.kind == exprStmt && len(c0.child) == 1 && c0.child[0].action == aRecv:
 			// The comm clause has an empty body clause after channel receive.
 			chanValues[i] = genValue(c0.child[0].child[0])
-			cases[i].Dir = reflect.SelectRecv
+			dirs[i].Dir = reflect.SelectRecv
 			clause[i] = func(*frame) bltn { return next }
 		case c0.kind == sendStmt:
 			// The comm clause as an empty body clause after channel send.
 			chanValues[i] = genValue(c0.child[0])
-			cases[i].Dir = reflect.SelectSend
+			dirs[i].Dir = reflect.SelectSend
 			assignedValues[i] = genValue(c0.child[1])
 			clause[i] = func(*frame) bltn { return next }
 		}
 	}
 
 	n.exec = func(f *frame) bltn {
+		// The cases are set per execution, as the select statement may run
+		// concurrently in several goroutines.
+		cases := append([]reflect.SelectCase(nil), dirs...)
 		f.mutex.RLock()
 		cases[nbClause] = f.done
 		f.mutex.RUnlock()
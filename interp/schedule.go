@@ -0,0 +1,159 @@
+package interp
+
+import (
+	"context"
+	"math"
+	"sync"
+	"sync/atomic"
+)
+
+// Priority is the priority class of an evaluation, see Scheduler.
+type Priority int
+
+// Priority classes. Other values can be used, a greater value having a
+// higher priority.
+const (
+	PriorityLow    Priority = -1 // batch work, paused while higher priority evaluations run
+	PriorityNormal Priority = 0  // default priority
+	PriorityHigh   Priority = 1  // interactive work
+)
+
+type priorityKey struct{}
+
+// WithPriority returns a copy of ctx providing the priority class of the
+// evaluations with this context, such as by EvalWithContext, instead of
+// Options.Priority.
+func WithPriority(ctx context.Context, p Priority) context.Context {
+	return context.WithValue(ctx, priorityKey{}, p)
+}
+
+// Scheduler coordinates the execution of the interpreters of a process by
+// priority, so that interactive evaluations do not wait behind batch
+// scripts for the CPU. The interpreters are created with Options.Scheduler
+// set to the scheduler, and their evaluations with a context, such as by
+// EvalWithContext, are active with the priority set by WithPriority, or else
+// Options.Priority, until they return.
+//
+// Interpreted code, including the goroutines it starts and the functions
+// called by the host, is paused between two nodes while an evaluation of a
+// higher priority is active in any interpreter of the scheduler, and resumes
+// when none is left, or stops when its evaluation is cancelled. Calls to
+// binary functions are not interrupted. Note that a paused evaluation keeps
+// the resources it holds: a higher priority evaluation waiting for one of
+// them, such as a lock or a channel, waits until it is cancelled. Low priority
+// work may also starve while higher priority evaluations keep running.
+//
+// The zero value is ready to use.
+type Scheduler struct {
+	mu      sync.Mutex
+	active  map[Priority]int // number of active evaluations by priority
+	changed chan struct{}    // closed when the highest priority decreases
+
+	top int64 // highest priority of the active evaluations, accessed atomically
+}
+
+// Active returns the number of active evaluations of priority p.
+func (s *Scheduler) Active(p Priority) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.active[p]
+}
+
+// init initializes the scheduler on the creation of an interpreter using it,
+// with no active evaluation.
+func (s *Scheduler) init() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.active == nil {
+		s.active = map[Priority]int{}
+		atomic.StoreInt64(&s.top, math.MinInt64)
+	}
+}
+
+// enter registers an active evaluation of priority p, and returns a function
+// unregistering it.
+func (s *Scheduler) enter(p Priority) func() {
+	s.mu.Lock()
+	s.active[p]++
+	s.update()
+	s.mu.Unlock()
+	return func() {
+		s.mu.Lock()
+		if s.active[p]--; s.active[p] == 0 {
+			delete(s.active, p)
+		}
+		s.update()
+		s.mu.Unlock()
+	}
+}
+
+// update recomputes the highest active priority, and wakes up the paused
+// evaluations if it decreased. It must be called with s.mu held.
+func (s *Scheduler) update() {
+	top := int64(math.MinInt64)
+	for p := range s.active {
+		if int64(p) > top {
+			top = int64(p)
+		}
+	}
+	if prev := atomic.SwapInt64(&s.top, top); top < prev && s.changed != nil {
+		close(s.changed)
+		s.changed = nil
+	}
+}
+
+// preempted returns true if evaluations of priority p must be paused.
+func (s *Scheduler) preempted(p int64) bool {
+	return p < atomic.LoadInt64(&s.top)
+}
+
+// wait pauses the interpreted code of priority p while a higher priority
+// evaluation is active, or until the execution is stopped, by the
+// cancellation of EvalWithContext.
+func (s *Scheduler) wait(interp *Interpreter, p int64) {
+	id := interp.runid()
+	interp.mutex.RLock()
+	done := interp.done
+	interp.mutex.RUnlock()
+	for {
+		s.mu.Lock()
+		if !s.preempted(p) {
+			s.mu.Unlock()
+			return
+		}
+		if s.changed == nil {
+			s.changed = make(chan struct{})
+		}
+		changed := s.changed
+		s.mu.Unlock()
+
+		select {
+		case <-changed:
+		case <-done:
+			if interp.runid() != id {
+				return
+			}
+			// The channel is the one of a previous evaluation.
+			done = nil
+		}
+	}
+}
+
+// schedule registers the evaluation with context ctx in the scheduler of the
+// interpreter, if any, and returns a function unregistering it.
+func (interp *Interpreter) schedule(ctx context.Context) func() {
+	q := interp.quotas
+	if q == nil || q.sched == nil {
+		return func() {}
+	}
+	p, ok := ctx.Value(priorityKey{}).(Priority)
+	if !ok {
+		p = q.priority
+	}
+	atomic.StoreInt64(&q.current, int64(p))
+	leave := q.sched.enter(p)
+	return func() {
+		leave()
+		atomic.StoreInt64(&q.current, int64(q.priority))
+	}
+}
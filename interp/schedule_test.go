@@ -0,0 +1,91 @@
+package interp
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestScheduler(t *testing.T) {
+	var s Scheduler
+	var ticks int64
+	release := make(chan struct{})
+	exports := Exports{"host": {
+		"Tick":  reflect.ValueOf(func() { atomic.AddInt64(&ticks, 1) }),
+		"Block": reflect.ValueOf(func() { <-release }),
+	}}
+
+	low := New(Options{Scheduler: &s, Priority: PriorityLow})
+	high := New(Options{Scheduler: &s})
+	for _, i := range []*Interpreter{low, high} {
+		i.Use(exports)
+		if _, err := i.Eval(`import "host"`); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	waitFor := func(desc string, cond func() bool) {
+		t.Helper()
+		for start := time.Now(); !cond(); time.Sleep(time.Millisecond) {
+			if time.Since(start) > 5*time.Second {
+				t.Fatalf("timeout waiting for %s", desc)
+			}
+		}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	lowDone := make(chan error, 1)
+	go func() {
+		_, err := low.EvalWithContext(ctx, `for { host.Tick() }`)
+		lowDone <- err
+	}()
+	waitFor("low priority work", func() bool { return atomic.LoadInt64(&ticks) > 0 })
+	if n := s.Active(PriorityLow); n != 1 {
+		t.Fatalf("got %d active low priority evaluations, want 1", n)
+	}
+
+	// A normal priority evaluation pauses the low priority one.
+	highDone := make(chan error, 1)
+	go func() {
+		_, err := high.EvalWithContext(context.Background(), `host.Block()`)
+		highDone <- err
+	}()
+	waitFor("high priority evaluation", func() bool { return s.Active(PriorityNormal) == 1 })
+	time.Sleep(20 * time.Millisecond)
+	paused := atomic.LoadInt64(&ticks)
+	time.Sleep(50 * time.Millisecond)
+	if n := atomic.LoadInt64(&ticks); n != paused {
+		t.Fatalf("low priority work not paused: %d ticks, then %d", paused, n)
+	}
+
+	// It resumes when the higher priority evaluation returns.
+	close(release)
+	if err := <-highDone; err != nil {
+		t.Fatal(err)
+	}
+	waitFor("resumed work", func() bool { return atomic.LoadInt64(&ticks) > paused })
+
+	// A paused evaluation can be cancelled.
+	block := make(chan struct{})
+	release = block
+	go func() {
+		_, err := high.EvalWithContext(WithPriority(context.Background(), PriorityHigh), `host.Block()`)
+		highDone <- err
+	}()
+	waitFor("high priority evaluation", func() bool { return s.Active(PriorityHigh) == 1 })
+	cancel()
+	if err := <-lowDone; !errors.Is(err, context.Canceled) {
+		t.Fatalf("got error %v, want %v", err, context.Canceled)
+	}
+	close(block)
+	if err := <-highDone; err != nil {
+		t.Fatal(err)
+	}
+	// The cancelled evaluation is left when its interpreted code stops.
+	waitFor("end of evaluations", func() bool {
+		return s.Active(PriorityLow)+s.Active(PriorityNormal)+s.Active(PriorityHigh) == 0
+	})
+}
@@ -0,0 +1,154 @@
+package interp
+
+import (
+	"go/parser"
+	"go/scanner"
+	"go/token"
+	"strings"
+)
+
+// IsIncomplete returns true if src, an input of a REPL without package
+// clause, is missing its end, such as an unclosed block, parenthesis,
+// composite literal, raw string or comment, so that more lines must be read
+// before evaluating it. It returns false for complete inputs, and for the
+// ones with syntax errors which more lines can not fix.
+func IsIncomplete(src string) bool {
+	fset := token.NewFileSet()
+	tok := firstToken(fset, src)
+	wrapped := src
+	switch tok {
+	case token.PACKAGE:
+	case token.CONST, token.FUNC, token.IMPORT, token.TYPE, token.VAR:
+		wrapped = "package main;" + src
+	default:
+		wrapped = wrapInMain(src)
+	}
+	_, err := parser.ParseFile(fset, "", wrapped, parser.DeclarationErrors)
+	if err != nil && tok == token.FUNC && !incompleteError(err, src) {
+		// A function literal, called or assigned.
+		_, err = parser.ParseFile(fset, "", wrapInMain(src), parser.DeclarationErrors)
+	}
+	return incompleteError(err, src)
+}
+
+// incompleteError returns true if err is a syntax error of the input src
+// caused by its missing end.
+func incompleteError(err error, src string) bool {
+	se, ok := err.(scanner.ErrorList)
+	if !ok || len(se) == 0 {
+		return false
+	}
+	return ignoreScannerError(se[0], strings.TrimSpace(src))
+}
+
+// firstToken returns the first token of src, ignoring comments.
+func firstToken(fset *token.FileSet, src string) token.Token {
+	var s scanner.Scanner
+	file := fset.AddFile("", fset.Base(), len(src))
+	s.Init(file, []byte(src), nil, 0)
+
+	_, tok, _ := s.Scan()
+	return tok
+}
+
+// splitScript splits src, an input without package clause, into its global
+// declarations and its statements, for Options.WrapStatements. Each part is
+// returned with the other one blanked, except for line breaks, so that the
+// positions in both parts are the ones of src. The parts are empty if src is
+// not made of both declarations and statements, or can not be scanned.
+func splitScript(src string) (decls, stmts string) {
+	var s scanner.Scanner
+	var failed bool
+	fset := token.NewFileSet()
+	file := fset.AddFile("", fset.Base(), len(src))
+	s.Init(file, []byte(src), func(token.Position, string) { failed = true }, 0)
+
+	d, b := []byte(src), []byte(src)
+	var hasDecl, hasStmt bool
+	depth, start := 0, -1
+	var item []token.Token // leading tokens of the current item
+	for {
+		pos, tok, _ := s.Scan()
+		off := file.Offset(pos)
+		if tok == token.EOF || tok == token.SEMICOLON && depth == 0 {
+			if start >= 0 {
+				end := off
+				if tok == token.SEMICOLON && off < len(src) && src[off] == ';' {
+					end++
+				}
+				if isDeclItem(item) {
+					hasDecl = true
+					blank(b[start:end])
+				} else {
+					hasStmt = true
+					blank(d[start:end])
+				}
+			}
+			if tok == token.EOF {
+				break
+			}
+			start, item = -1, item[:0]
+			continue
+		}
+		if start < 0 {
+			start = off
+		}
+		if len(item) < 32 {
+			item = append(item, tok)
+		}
+		switch tok {
+		case token.LPAREN, token.LBRACE, token.LBRACK:
+			depth++
+		case token.RPAREN, token.RBRACE, token.RBRACK:
+			depth--
+		}
+	}
+	if failed || !hasDecl || !hasStmt {
+		return "", ""
+	}
+	return string(d), string(b)
+}
+
+// isDeclItem returns true if the leading tokens of an item of a script are
+// the ones of a global declaration: a declaration of constants, types,
+// variables or imports, or of a function or method, as opposed to a function
+// literal.
+func isDeclItem(item []token.Token) bool {
+	if len(item) == 0 {
+		return false
+	}
+	switch item[0] {
+	case token.CONST, token.IMPORT, token.TYPE, token.VAR:
+		return true
+	case token.FUNC:
+	default:
+		return false
+	}
+	if len(item) > 1 && item[1] == token.IDENT {
+		return true
+	}
+	// A method has a receiver, followed by its name and the opening of its
+	// parameters or type parameters.
+	depth := 0
+	for i, tok := range item[1:] {
+		switch tok {
+		case token.LPAREN:
+			depth++
+		case token.RPAREN:
+			if depth--; depth == 0 {
+				rest := item[i+2:]
+				return len(rest) > 1 && rest[0] == token.IDENT && (rest[1] == token.LPAREN || rest[1] == token.LBRACK)
+			}
+		}
+	}
+	return false
+}
+
+// blank replaces the bytes of b by spaces, except for line breaks.
+func blank(b []byte) {
+	for i, c := range b {
+		if c != '\n' {
+			b[i] = ' '
+		}
+	}
+}
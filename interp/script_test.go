@@ -0,0 +1,78 @@
+package interp
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestIsIncomplete(t *testing.T) {
+	for src, want := range map[string]bool{
+		"x := 1":                             false,
+		"x := }":                             false,
+		"func f() {":                         true,
+		"func (r T) M() {":                   true,
+		"func() {":                           true,
+		"if x {":                             true,
+		"x := []int{1,":                      true,
+		"x := map[string]int{\n\"a\": 1,\n}": false,
+		"f(1,":                               true,
+		"1 +":                                true,
+		"s := `abc":                          true,
+		"/* comment":                         true,
+		"import (":                           true,
+		"type T struct {":                    true,
+	} {
+		if got := IsIncomplete(src); got != want {
+			t.Errorf("%q: got %v, want %v", src, got, want)
+		}
+	}
+}
+
+func TestWrapStatements(t *testing.T) {
+	src := `
+type T struct{ n int }
+
+func (t *T) Inc() { t.n++ }
+
+x := &T{}
+x.Inc()
+
+func double(i int) int { return 2 * i }
+
+var y = func() int { return 3 }()
+double(x.n) + y`
+
+	if _, err := New(Options{}).Eval(src); err == nil {
+		t.Fatal("got no error without WrapStatements")
+	}
+	i := New(Options{WrapStatements: true})
+	v, err := i.Eval(src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v.Interface() != 5 {
+		t.Errorf("got %v, want 5", v)
+	}
+	if v, err = i.Eval("x.n"); err != nil || v.Interface() != 1 {
+		t.Errorf("got %v, %v, want 1", v, err)
+	}
+
+	dir, err := ioutil.TempDir("", "script")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+	name := filepath.Join(dir, "script.go")
+	if err := ioutil.WriteFile(name, []byte("func half(i int) int { return i / 2 }\n\nz := half(8)\nundefined()\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	i = New(Options{WrapStatements: true})
+	if _, err := i.EvalPath(name); err == nil || !strings.Contains(err.Error(), "script.go:4:1: undefined: undefined") {
+		t.Errorf("got error %v", err)
+	}
+}
@@ -0,0 +1,62 @@
+package interp
+
+import (
+	"errors"
+	"reflect"
+)
+
+// SecretsPath is the import path of the package giving interpreted code access
+// to the secrets set in Options.
+const SecretsPath = "yaegi/secrets"
+
+// ErrSecretDenied is returned when access to a secret is not allowed.
+var ErrSecretDenied = errors.New("access to secret denied")
+
+// Secrets provides secret values to interpreted code. It is implemented by
+// the host, which is responsible for enforcing access policies. Using a
+// distinct Secrets value per interpreter allows per plugin policies.
+//
+// From interpreted code, it is used as follows:
+//
+//	import "yaegi/secrets"
+//
+//	token, err := secrets.Get("api_token")
+type Secrets interface {
+	Get(name string) (string, error)
+}
+
+// SecretsFunc is an adapter to use an ordinary function as Secrets.
+type SecretsFunc func(name string) (string, error)
+
+// Get implements Secrets.
+func (f SecretsFunc) Get(name string) (string, error) { return f(name) }
+
+// RestrictSecrets returns a Secrets giving access only to the allowed names
+// in s. Other names return ErrSecretDenied. If audit is not nil, it is called
+// for each access, with granted reporting if the access was allowed.
+func RestrictSecrets(s Secrets, allowed []string, audit func(name string, granted bool)) Secrets {
+	names := make(map[string]bool, len(allowed))
+	for _, n := range allowed {
+		names[n] = true
+	}
+	return SecretsFunc(func(name string) (string, error) {
+		ok := names[name]
+		if audit != nil {
+			audit(name, ok)
+		}
+		if !ok {
+			return "", ErrSecretDenied
+		}
+		return s.Get(name)
+	})
+}
+
+// secretsExports returns the symbols of the secrets package bound to s.
+func secretsExports(s Secrets) Exports {
+	return Exports{
+		SecretsPath: {
+			"Get":       reflect.ValueOf(s.Get),
+			"ErrDenied": reflect.ValueOf(ErrSecretDenied),
+		},
+	}
+}
@@ -0,0 +1,37 @@
+package interp_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/traefik/yaegi/interp"
+)
+
+func TestSecrets(t *testing.T) {
+	vault := interp.SecretsFunc(func(name string) (string, error) {
+		switch name {
+		case "token", "password":
+			return name + "-value", nil
+		}
+		return "", errors.New("not found")
+	})
+
+	var audit []string
+	i := interp.New(interp.Options{
+		Secrets: interp.RestrictSecrets(vault, []string{"token"}, func(name string, granted bool) {
+			audit = append(audit, fmt.Sprint(name, granted))
+		}),
+	})
+
+	eval(t, i, `import "yaegi/secrets"`)
+	assertEval(t, i, `v, _ := secrets.Get("token"); v`, "", "token-value")
+	assertEval(t, i, `_, err := secrets.Get("password"); err == secrets.ErrDenied`, "", "true")
+	if _, err := i.Eval(`secrets.ErrDenied = nil`); err == nil || interp.ErrSecretDenied == nil {
+		t.Fatal("secrets.ErrDenied is settable by interpreted code")
+	}
+
+	if got, want := fmt.Sprint(audit), "[tokentrue passwordfalse]"; got != want {
+		t.Errorf("got audit %s, want %s", got, want)
+	}
+}
@@ -0,0 +1,611 @@
+package interp
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"go/scanner"
+	"go/token"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// snapshotVersion is the version of the format of snapshots.
+const snapshotVersion = 1
+
+// SnapshotError is returned by Snapshot, along with the snapshot, when the
+// values of some global variables can not be serialized, such as channels,
+// functions, or pointers to values of the host.
+type SnapshotError struct {
+	Skipped []SkippedGlobal
+}
+
+// SkippedGlobal is a global variable whose value is not in a snapshot.
+type SkippedGlobal struct {
+	Path   string // import path of the package
+	Name   string // variable name
+	Reason string
+}
+
+func (e *SnapshotError) Error() string {
+	var b strings.Builder
+	b.WriteString("snapshot: values not serializable:")
+	for _, s := range e.Skipped {
+		fmt.Fprintf(&b, " %s.%s (%s);", s.Path, s.Name, s.Reason)
+	}
+	return strings.TrimSuffix(b.String(), ";")
+}
+
+// snapshot is the serialized state of an interpreter.
+type snapshot struct {
+	Version int              `json:"version"`
+	Sources []snapshotSource `json:"sources"`
+	Globals []snapshotGlobal `json:"globals"`
+}
+
+// snapshotSource is the declaring part of an evaluated source.
+type snapshotSource struct {
+	Name string `json:"name"`
+	Src  string `json:"src"`
+	Inc  bool   `json:"inc,omitempty"` // evaluated as Eval, otherwise as EvalPath
+}
+
+// snapshotGlobal is the value of a global variable.
+type snapshotGlobal struct {
+	Path  string      `json:"path"`
+	Name  string      `json:"name"`
+	Type  string      `json:"type"`
+	Value interface{} `json:"value"`
+}
+
+// Snapshot returns the state of the interpreter, to be restored by
+// RestoreSnapshot in a new interpreter, for example to resume a long-lived
+// scripting session after a restart of the process.
+//
+// The snapshot holds the declarations of the evaluated sources, from which
+// the scopes and the symbols of the packages are rebuilt, and the values of
+// the global variables of the source packages. The statements of the
+// evaluations, other than short variable declarations, are not kept.
+//
+// Values are copied deeply: pointers sharing a value are restored as
+// distinct pointers. Channels, functions, unsafe pointers, values of the host
+// with unexported fields, and interfaces holding other values than the ones
+// of predeclared types can not be serialized: the variables holding them are
+// reported in a *SnapshotError, returned along with the snapshot, and are
+// initialized again by their declaration when restored.
+func (interp *Interpreter) Snapshot() ([]byte, error) {
+	interp.evalMutex.Lock()
+	defer interp.evalMutex.Unlock()
+
+	s := snapshot{Version: snapshotVersion, Sources: interp.snapshotSources}
+	var skipped []SkippedGlobal
+
+	interp.mutex.RLock()
+	paths := make([]string, 0, len(interp.scopes))
+	for path := range interp.scopes {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	f := interp.frame
+	f.mutex.RLock()
+	for _, path := range paths {
+		sc := interp.scopes[path]
+		names := make([]string, 0, len(sc.sym))
+		for name, sym := range sc.sym {
+			if sym.kind == varSym && sym.index >= 0 && sym.index < len(f.data) && name != "_" {
+				names = append(names, name)
+			}
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			sym := sc.sym[name]
+			v, err := interp.encodeSnapshot(f.data[sym.index], map[uintptr]bool{})
+			if err != nil {
+				skipped = append(skipped, SkippedGlobal{Path: path, Name: name, Reason: err.Error()})
+				continue
+			}
+			s.Globals = append(s.Globals, snapshotGlobal{Path: path, Name: name, Type: sym.typ.id(), Value: v})
+		}
+	}
+	f.mutex.RUnlock()
+	interp.mutex.RUnlock()
+
+	b, err := json.Marshal(s)
+	if err != nil {
+		return nil, err
+	}
+	if skipped != nil {
+		return b, &SnapshotError{Skipped: skipped}
+	}
+	return b, nil
+}
+
+// RestoreSnapshot restores in the interpreter the state saved by Snapshot.
+// It must be called on a new interpreter, created with the same options and
+// binary symbols as the one of the snapshot. Packages imported by other means
+// than evaluated sources, such as ImportArchive, must be imported first.
+//
+// The declarations of the snapshot are evaluated again, without running the
+// main function, then the global variables are set to their saved values.
+// The initializers of the variables and the init functions are executed,
+// with their side effects.
+func (interp *Interpreter) RestoreSnapshot(data []byte) error {
+	var s snapshot
+	d := json.NewDecoder(bytes.NewReader(data))
+	d.UseNumber()
+	if err := d.Decode(&s); err != nil {
+		return fmt.Errorf("invalid snapshot: %v", err)
+	}
+	if s.Version != snapshotVersion {
+		return fmt.Errorf("invalid snapshot: unsupported version %d", s.Version)
+	}
+
+	interp.evalMutex.Lock()
+	defer interp.evalMutex.Unlock()
+
+	interp.restoring = true
+	defer func() { interp.restoring = false }()
+	for _, src := range s.Sources {
+		if _, err := interp.eval(src.Src, src.Name, src.Inc, nil); err != nil {
+			return fmt.Errorf("snapshot: %v", err)
+		}
+	}
+
+	// Decode all the values before setting them.
+	type restored struct {
+		index int
+		value reflect.Value
+	}
+	var values []restored
+	interp.mutex.RLock()
+	f := interp.frame
+	f.mutex.RLock()
+	for _, g := range s.Globals {
+		sc := interp.scopes[g.Path]
+		if sc == nil {
+			f.mutex.RUnlock()
+			interp.mutex.RUnlock()
+			return fmt.Errorf("snapshot: package %s not imported", g.Path)
+		}
+		sym := sc.sym[g.Name]
+		if sym == nil || sym.kind != varSym || sym.index < 0 || sym.index >= len(f.data) {
+			f.mutex.RUnlock()
+			interp.mutex.RUnlock()
+			return fmt.Errorf("snapshot: %s.%s is not a variable", g.Path, g.Name)
+		}
+		if t := sym.typ.id(); t != g.Type {
+			f.mutex.RUnlock()
+			interp.mutex.RUnlock()
+			return fmt.Errorf("snapshot: %s.%s is of type %s, not %s", g.Path, g.Name, t, g.Type)
+		}
+		v, err := interp.decodeSnapshot(g.Value, f.data[sym.index].Type())
+		if err != nil {
+			f.mutex.RUnlock()
+			interp.mutex.RUnlock()
+			return fmt.Errorf("snapshot: %s.%s: %v", g.Path, g.Name, err)
+		}
+		values = append(values, restored{sym.index, v})
+	}
+	f.mutex.RUnlock()
+	interp.mutex.RUnlock()
+
+	f.mutex.Lock()
+	for _, r := range values {
+		f.data[r.index].Set(r.value)
+	}
+	f.mutex.Unlock()
+	return nil
+}
+
+// recordSource records the declaring part of an evaluated source, for Snapshot.
+func (interp *Interpreter) recordSource(src string, inc bool) {
+	if inc && firstToken(token.NewFileSet(), src) != token.PACKAGE {
+		if src = declarations(src); src == "" {
+			return
+		}
+	}
+	interp.snapshotSources = append(interp.snapshotSources, snapshotSource{Name: interp.name, Src: src, Inc: inc})
+}
+
+// declarations returns src, an input without package clause, with its
+// statements blanked except the short variable declarations, or an empty
+// string if it has no declaration.
+func declarations(src string) string {
+	var s scanner.Scanner
+	fset := token.NewFileSet()
+	file := fset.AddFile("", fset.Base(), len(src))
+	s.Init(file, []byte(src), nil, 0)
+
+	b := []byte(src)
+	kept := false
+	depth, start := 0, -1
+	var item []token.Token // leading tokens of the current item
+	for {
+		pos, tok, _ := s.Scan()
+		off := file.Offset(pos)
+		if tok == token.EOF || tok == token.SEMICOLON && depth == 0 {
+			if start >= 0 {
+				end := off
+				if tok == token.SEMICOLON && off < len(src) && src[off] == ';' {
+					end++
+				}
+				if isDeclItem(item) || isDefineItem(item) {
+					kept = true
+				} else {
+					blank(b[start:end])
+				}
+			}
+			if tok == token.EOF {
+				break
+			}
+			start, item = -1, item[:0]
+			continue
+		}
+		if start < 0 {
+			start = off
+		}
+		if len(item) < 32 {
+			item = append(item, tok)
+		}
+		switch tok {
+		case token.LPAREN, token.LBRACE, token.LBRACK:
+			depth++
+		case token.RPAREN, token.RBRACE, token.RBRACK:
+			depth--
+		}
+	}
+	if !kept {
+		return ""
+	}
+	return string(b)
+}
+
+// isDefineItem returns true if the leading tokens of an item are the ones of
+// a short variable declaration.
+func isDefineItem(item []token.Token) bool {
+	for i, tok := range item {
+		switch {
+		case tok == token.DEFINE:
+			return i > 0
+		case tok != token.IDENT && tok != token.COMMA:
+			return false
+		}
+	}
+	return false
+}
+
+var (
+	textMarshalerType   = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+	textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+)
+
+// errNotSerializable is returned for values which can not be in a snapshot.
+func errNotSerializable(t reflect.Type) error {
+	return fmt.Errorf("%v is not serializable", t)
+}
+
+// encodeSnapshot returns the JSON compatible representation of v. Pointers
+// in seen are the ones being encoded, to detect cycles.
+func (interp *Interpreter) encodeSnapshot(v reflect.Value, seen map[uintptr]bool) (interface{}, error) {
+	if !v.IsValid() {
+		return nil, nil
+	}
+	t := v.Type()
+	switch {
+	case t == valueInterfaceType:
+		vi := v.Interface().(valueInterface)
+		if vi.node == nil || !vi.value.IsValid() {
+			return nil, nil
+		}
+		name := vi.node.typ.id()
+		if !interp.isPredeclared(name) {
+			return nil, fmt.Errorf("interface holding %s is not serializable", name)
+		}
+		e, err := interp.encodeSnapshot(vi.value, seen)
+		return map[string]interface{}{"type": name, "value": e}, err
+	case t == funcNodeType:
+		if v.IsNil() {
+			return nil, nil
+		}
+		return nil, errors.New("function is not serializable")
+	case t.Implements(textMarshalerType) && reflect.PtrTo(t).Implements(textUnmarshalerType):
+		if t.Kind() == reflect.Ptr && v.IsNil() {
+			return nil, nil
+		}
+		b, err := v.Interface().(encoding.TextMarshaler).MarshalText()
+		return string(b), err
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return v.Bool(), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.Int(), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return v.Uint(), nil
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(v.Float(), 'g', -1, t.Bits()), nil
+	case reflect.Complex64, reflect.Complex128:
+		c := v.Complex()
+		return []string{
+			strconv.FormatFloat(real(c), 'g', -1, t.Bits()/2),
+			strconv.FormatFloat(imag(c), 'g', -1, t.Bits()/2),
+		}, nil
+	case reflect.String:
+		return v.String(), nil
+	case reflect.Slice:
+		if v.IsNil() {
+			return nil, nil
+		}
+		if t.Elem().Kind() == reflect.Uint8 {
+			return base64.StdEncoding.EncodeToString(v.Bytes()), nil
+		}
+		fallthrough
+	case reflect.Array:
+		l := make([]interface{}, v.Len())
+		for i := range l {
+			e, err := interp.encodeSnapshot(v.Index(i), seen)
+			if err != nil {
+				return nil, err
+			}
+			l[i] = e
+		}
+		return l, nil
+	case reflect.Map:
+		if v.IsNil() {
+			return nil, nil
+		}
+		type entry struct {
+			key  string // encoded key, to sort entries
+			pair []interface{}
+		}
+		entries := make([]entry, 0, v.Len())
+		iter := v.MapRange()
+		for iter.Next() {
+			k, err := interp.encodeSnapshot(iter.Key(), seen)
+			if err != nil {
+				return nil, err
+			}
+			e, err := interp.encodeSnapshot(iter.Value(), seen)
+			if err != nil {
+				return nil, err
+			}
+			key, err := json.Marshal(k)
+			if err != nil {
+				return nil, err
+			}
+			entries = append(entries, entry{string(key), []interface{}{k, e}})
+		}
+		sort.Slice(entries, func(i, j int) bool { return entries[i].key < entries[j].key })
+		l := make([]interface{}, len(entries))
+		for i, e := range entries {
+			l[i] = e.pair
+		}
+		return l, nil
+	case reflect.Struct:
+		m := make(map[string]interface{}, t.NumField())
+		for i := 0; i < t.NumField(); i++ {
+			if t.Field(i).PkgPath != "" {
+				return nil, fmt.Errorf("%v with unexported fields is not serializable", t)
+			}
+			e, err := interp.encodeSnapshot(v.Field(i), seen)
+			if err != nil {
+				return nil, err
+			}
+			m[t.Field(i).Name] = e
+		}
+		return m, nil
+	case reflect.Ptr:
+		if v.IsNil() {
+			return nil, nil
+		}
+		p := v.Pointer()
+		if seen[p] {
+			return nil, fmt.Errorf("cyclic %v is not serializable", t)
+		}
+		seen[p] = true
+		defer delete(seen, p)
+		return interp.encodeSnapshot(v.Elem(), seen)
+	case reflect.Interface:
+		if v.IsNil() {
+			return nil, nil
+		}
+		e := v.Elem()
+		name := e.Type().String()
+		if e.Type().PkgPath() != "" || !interp.isPredeclared(name) {
+			return nil, fmt.Errorf("interface holding %v is not serializable", e.Type())
+		}
+		ev, err := interp.encodeSnapshot(e, seen)
+		return map[string]interface{}{"type": name, "value": ev}, err
+	}
+	return nil, errNotSerializable(t)
+}
+
+// isPredeclared returns true if name is the one of a predeclared type, other
+// than an interface.
+func (interp *Interpreter) isPredeclared(name string) bool {
+	sym := interp.universe.sym[name]
+	return sym != nil && sym.kind == typeSym && sym.typ.cat != interfaceT && sym.typ.cat != errorT
+}
+
+// decodeSnapshot returns the value of type t represented by data, as encoded
+// by encodeSnapshot and decoded from JSON.
+func (interp *Interpreter) decodeSnapshot(data interface{}, t reflect.Type) (reflect.Value, error) {
+	v := reflect.New(t).Elem()
+	if data == nil {
+		return v, nil
+	}
+	invalid := func() (reflect.Value, error) {
+		return reflect.Value{}, fmt.Errorf("invalid %v value %v", t, data)
+	}
+
+	switch {
+	case t == valueInterfaceType, t.Kind() == reflect.Interface:
+		m, ok := data.(map[string]interface{})
+		name, _ := m["type"].(string)
+		if !ok || !interp.isPredeclared(name) {
+			return invalid()
+		}
+		typ := interp.universe.sym[name].typ
+		e, err := interp.decodeSnapshot(m["value"], typ.TypeOf())
+		if err != nil {
+			return e, err
+		}
+		switch {
+		case t == valueInterfaceType:
+			v.Set(reflect.ValueOf(valueInterface{&node{typ: typ}, e}))
+		case e.Type().AssignableTo(t):
+			v.Set(e)
+		default:
+			return invalid()
+		}
+		return v, nil
+	case t == funcNodeType:
+		return invalid()
+	case t.Implements(textMarshalerType) && reflect.PtrTo(t).Implements(textUnmarshalerType):
+		s, ok := data.(string)
+		if !ok {
+			return invalid()
+		}
+		if t.Kind() == reflect.Ptr {
+			v.Set(reflect.New(t.Elem()))
+			return v, v.Interface().(encoding.TextUnmarshaler).UnmarshalText([]byte(s))
+		}
+		return v, v.Addr().Interface().(encoding.TextUnmarshaler).UnmarshalText([]byte(s))
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		b, ok := data.(bool)
+		if !ok {
+			return invalid()
+		}
+		v.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, ok := data.(json.Number)
+		i, err := strconv.ParseInt(string(n), 10, t.Bits())
+		if !ok || err != nil {
+			return invalid()
+		}
+		v.SetInt(i)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		n, ok := data.(json.Number)
+		u, err := strconv.ParseUint(string(n), 10, t.Bits())
+		if !ok || err != nil {
+			return invalid()
+		}
+		v.SetUint(u)
+	case reflect.Float32, reflect.Float64:
+		s, ok := data.(string)
+		f, err := strconv.ParseFloat(s, t.Bits())
+		if !ok || err != nil {
+			return invalid()
+		}
+		v.SetFloat(f)
+	case reflect.Complex64, reflect.Complex128:
+		l, ok := data.([]interface{})
+		if !ok || len(l) != 2 {
+			return invalid()
+		}
+		re, ok1 := l[0].(string)
+		im, ok2 := l[1].(string)
+		r, err1 := strconv.ParseFloat(re, t.Bits()/2)
+		i, err2 := strconv.ParseFloat(im, t.Bits()/2)
+		if !ok1 || !ok2 || err1 != nil || err2 != nil {
+			return invalid()
+		}
+		v.SetComplex(complex(r, i))
+	case reflect.String:
+		s, ok := data.(string)
+		if !ok {
+			return invalid()
+		}
+		v.SetString(s)
+	case reflect.Slice:
+		if t.Elem().Kind() == reflect.Uint8 {
+			s, ok := data.(string)
+			b, err := base64.StdEncoding.DecodeString(s)
+			if !ok || err != nil {
+				return invalid()
+			}
+			v.Set(reflect.MakeSlice(t, len(b), len(b)))
+			reflect.Copy(v, reflect.ValueOf(b))
+			return v, nil
+		}
+		l, ok := data.([]interface{})
+		if !ok {
+			return invalid()
+		}
+		v.Set(reflect.MakeSlice(t, len(l), len(l)))
+		return v, interp.decodeElems(v, l)
+	case reflect.Array:
+		l, ok := data.([]interface{})
+		if !ok || len(l) != v.Len() {
+			return invalid()
+		}
+		return v, interp.decodeElems(v, l)
+	case reflect.Map:
+		l, ok := data.([]interface{})
+		if !ok {
+			return invalid()
+		}
+		v.Set(reflect.MakeMapWithSize(t, len(l)))
+		for _, d := range l {
+			pair, ok := d.([]interface{})
+			if !ok || len(pair) != 2 {
+				return invalid()
+			}
+			k, err := interp.decodeSnapshot(pair[0], t.Key())
+			if err != nil {
+				return v, err
+			}
+			e, err := interp.decodeSnapshot(pair[1], t.Elem())
+			if err != nil {
+				return v, err
+			}
+			v.SetMapIndex(k, e)
+		}
+	case reflect.Struct:
+		m, ok := data.(map[string]interface{})
+		if !ok {
+			return invalid()
+		}
+		for i := 0; i < t.NumField(); i++ {
+			if t.Field(i).PkgPath != "" {
+				return invalid()
+			}
+			e, err := interp.decodeSnapshot(m[t.Field(i).Name], t.Field(i).Type)
+			if err != nil {
+				return v, err
+			}
+			v.Field(i).Set(e)
+		}
+	case reflect.Ptr:
+		e, err := interp.decodeSnapshot(data, t.Elem())
+		if err != nil {
+			return v, err
+		}
+		v.Set(reflect.New(t.Elem()))
+		v.Elem().Set(e)
+	default:
+		return reflect.Value{}, errNotSerializable(t)
+	}
+	return v, nil
+}
+
+// decodeElems sets the elements of the slice or array v from l.
+func (interp *Interpreter) decodeElems(v reflect.Value, l []interface{}) error {
+	for i, d := range l {
+		e, err := interp.decodeSnapshot(d, v.Type().Elem())
+		if err != nil {
+			return err
+		}
+		v.Index(i).Set(e)
+	}
+	return nil
+}
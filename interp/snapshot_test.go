@@ -0,0 +1,120 @@
+package interp
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestSnapshot(t *testing.T) {
+	calls := 0
+	host := Exports{"host": {
+		"Call": reflect.ValueOf(func() int { calls++; return calls }),
+		"Date": reflect.ValueOf(time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)),
+		"Time": reflect.ValueOf((*time.Time)(nil)),
+	}}
+
+	i := New(Options{})
+	i.Use(host)
+	for _, src := range []string{
+		`import "host"`,
+		`type T struct{ Name string; Tags []string; Score float64 }`,
+		`func (t T) String() string { return t.Name }`,
+		`var t = T{Name: "a"}`,
+		`p := &t; n := host.Call()`,
+		`n += 41; t.Tags = append(t.Tags, "x"); big := 1e308; t.Score = big * 10`,
+		`var x interface{} = "any"; var e error; var raw = []byte("raw")`,
+		`var m = map[int]*T{1: &T{Name: "b"}}; var c complex128 = 1+2i; var d host.Time = host.Date`,
+		`ch := make(chan int, 1); f := func() int { return n }`,
+		`package main; func main() { host.Call() }`,
+	} {
+		if _, err := i.Eval(src); err != nil {
+			t.Fatal(src, err)
+		}
+	}
+
+	data, err := i.Snapshot()
+	serr, ok := err.(*SnapshotError)
+	if !ok || len(serr.Skipped) != 2 || serr.Skipped[0].Name != "ch" || serr.Skipped[1].Name != "f" {
+		t.Fatalf("got error %v", err)
+	}
+	if calls != 2 {
+		t.Fatalf("got %d calls, want 2", calls)
+	}
+	calls = 0
+
+	r := New(Options{})
+	r.Use(host)
+	if err := r.RestoreSnapshot(data); err != nil {
+		t.Fatal(err)
+	}
+	if calls != 1 {
+		t.Errorf("got %d calls by restore, want 1", calls)
+	}
+	for src, want := range map[string]interface{}{
+		`n`:                  42,
+		`p.Name + t.Tags[0]`: "ax",
+		`t.Score > 1e308`:    true,
+		`x.(string)`:         "any",
+		`e == nil`:           true,
+		`string(raw)`:        "raw",
+		`m[1].String()`:      "b",
+		`c`:                  complex(1, 2),
+		`d.Year()`:           2020,
+		`f() + cap(ch)`:      43,
+	} {
+		v, err := r.Eval(src)
+		if err != nil {
+			t.Errorf("%s: %v", src, err)
+			continue
+		}
+		if got := v.Interface(); got != want {
+			t.Errorf("%s: got %v, want %v", src, got, want)
+		}
+	}
+
+	// A restored interpreter can be saved again.
+	if _, err := r.Snapshot(); err == nil {
+		t.Error("missing error for the values of ch and f")
+	}
+}
+
+func TestSnapshotErrors(t *testing.T) {
+	i := New(Options{})
+	if _, err := i.Eval(`var s = "a"`); err != nil {
+		t.Fatal(err)
+	}
+	data, err := i.Snapshot()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, test := range []struct{ desc, data, err string }{
+		{"json", "{", "invalid snapshot: unexpected EOF"},
+		{"version", `{"version":2}`, "invalid snapshot: unsupported version 2"},
+		{"type", strings.Replace(string(data), `"type":"string"`, `"type":"int"`, 1), "snapshot: main.s is of type string, not int"},
+		{"value", strings.Replace(string(data), `"value":"a"`, `"value":1`, 1), "snapshot: main.s: invalid string value 1"},
+		{"package", strings.Replace(string(data), `"path":"main"`, `"path":"p"`, 1), "snapshot: package p not imported"},
+	} {
+		t.Run(test.desc, func(t *testing.T) {
+			err := New(Options{}).RestoreSnapshot([]byte(test.data))
+			if err == nil || err.Error() != test.err {
+				t.Errorf("got error %v, want %q", err, test.err)
+			}
+		})
+	}
+}
+
+func TestDeclarations(t *testing.T) {
+	for src, want := range map[string]string{
+		`x := 1; x++; var y = x; fmt.Println(y)`: `x := 1;      var y = x;               `,
+		"for i := 0; i < 2; i++ {\n}":            "",
+		`a, b := 1, 2`:                           `a, b := 1, 2`,
+		`func f() {}; f()`:                       `func f() {};    `,
+	} {
+		if got := declarations(src); got != want {
+			t.Errorf("%s: got %q, want %q", src, got, want)
+		}
+	}
+}
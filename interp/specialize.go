@@ -0,0 +1,267 @@
+package interp
+
+import (
+	"fmt"
+	"go/ast"
+	"go/constant"
+	"go/parser"
+	"go/token"
+	"reflect"
+	"sort"
+)
+
+// Specialize returns a new Program, compiled from the source of p with the
+// package level variables named in globals fixed to the given values, as
+// constants. The branches depending only on them are resolved at compile
+// time, so that the specialized program skips their tests and the code
+// which can not be reached, for example to run at a high rate rules with a
+// mostly static configuration, such as feature flags.
+//
+// The fixed variables must be of boolean, numeric or string types, and must
+// not be redeclared nor assigned in the function. Their later changes are not
+// seen by the specialized program, nor by the functions it calls, which are
+// not specialized.
+func (p *Program) Specialize(globals map[string]interface{}) (*Program, error) {
+	interp := p.interp
+	interp.evalMutex.Lock()
+	defer interp.evalMutex.Unlock()
+
+	decl, err := interp.funcSource(p.def)
+	if err != nil {
+		return nil, err
+	}
+
+	interp.mutex.RLock()
+	sc := interp.scopes[p.path]
+	interp.mutex.RUnlock()
+
+	names := make([]string, 0, len(globals))
+	for name := range globals {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	if name := redeclared(decl, globals); name != "" {
+		return nil, fmt.Errorf("cannot specialize %s: %s is redeclared", p.name, name)
+	}
+
+	// The specialized function and its constants are declared under a name
+	// which can not be the one of a Go symbol.
+	interp.specialized++
+	spec := fmt.Sprintf("%s#%d", decl.Name.Name, interp.specialized)
+	consts := make(map[string]string, len(globals))
+	for _, name := range names {
+		sym := sc.sym[name]
+		if sym == nil || sym.kind != varSym || sym.index < 0 {
+			return nil, fmt.Errorf("cannot specialize %s: %s is not a package variable", p.name, name)
+		}
+		t := sym.typ.TypeOf()
+		if !isConstKind(t.Kind()) {
+			return nil, fmt.Errorf("cannot specialize %s: %s of type %s can not be constant", p.name, name, sym.typ.id())
+		}
+		v := reflect.ValueOf(globals[name])
+		switch {
+		case !v.IsValid():
+			return nil, fmt.Errorf("cannot specialize %s: invalid nil value for %s", p.name, name)
+		case v.Type().AssignableTo(t), v.Type().ConvertibleTo(t) && isConstKind(v.Kind()):
+		default:
+			return nil, fmt.Errorf("cannot specialize %s: invalid type %v for %s, want %s", p.name, v.Type(), name, sym.typ.id())
+		}
+		consts[name] = spec + "." + name
+		sc.sym[consts[name]] = &symbol{kind: constSym, typ: sym.typ, rval: v.Convert(t), index: sc.add(sym.typ)}
+	}
+
+	subst := map[ast.Node]string{decl.Name: spec}
+	paramRefs(decl.Body, consts, subst)
+	_, root, err := interp.astTree(decl, subst)
+	if err != nil {
+		return nil, err
+	}
+	root.anc = p.def.anc
+	if _, err := interp.gta(root, p.path, p.path); err != nil {
+		return nil, err
+	}
+	if _, err := interp.cfg(root, p.path); err != nil {
+		return nil, err
+	}
+	if err := genRun(root); err != nil {
+		return nil, err
+	}
+
+	name := spec
+	if p.path != mainID {
+		name = p.path + "." + spec
+	}
+	sp, err := interp.Program(name)
+	if err != nil {
+		return nil, err
+	}
+	sp.Parallelism = p.Parallelism
+	sp.name = p.name
+	return sp, nil
+}
+
+// isConstKind returns true if the values of kind k can be constants.
+func isConstKind(k reflect.Kind) bool {
+	switch k {
+	case reflect.Bool, reflect.String,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64, reflect.Complex64, reflect.Complex128:
+		return true
+	}
+	return false
+}
+
+// funcSource returns the Go AST of the function declaration def, parsed
+// again from its source.
+func (interp *Interpreter) funcSource(def *node) (*ast.FuncDecl, error) {
+	file := interp.fset.File(def.pos)
+	var src string
+	if file != nil {
+		if s, ok := interp.fileSrc.Load(file); ok {
+			src = s.(string)
+		}
+	}
+	if src == "" {
+		return nil, fmt.Errorf("source of %s not found", def.child[1].ident)
+	}
+	f, err := parser.ParseFile(interp.fset, file.Name(), src, 0)
+	if err != nil {
+		return nil, err
+	}
+	offset := file.Offset(def.pos)
+	parsed := interp.fset.File(f.Pos())
+	for _, d := range f.Decls {
+		if fd, ok := d.(*ast.FuncDecl); ok && parsed.Offset(fd.Pos()) == offset {
+			return fd, nil
+		}
+	}
+	return nil, fmt.Errorf("source of %s not found", def.child[1].ident)
+}
+
+// redeclared returns the name of one of globals declared in the function
+// decl, as a parameter, a result or a local variable or constant, if any.
+func redeclared(decl *ast.FuncDecl, globals map[string]interface{}) (name string) {
+	check := func(ids ...ast.Expr) {
+		for _, e := range ids {
+			if id, ok := e.(*ast.Ident); ok && name == "" {
+				if _, ok := globals[id.Name]; ok {
+					name = id.Name
+				}
+			}
+		}
+	}
+	ast.Inspect(decl, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.Field:
+			for _, id := range n.Names {
+				check(id)
+			}
+		case *ast.ValueSpec:
+			for _, id := range n.Names {
+				check(id)
+			}
+		case *ast.TypeSpec:
+			check(n.Name)
+		case *ast.AssignStmt:
+			if n.Tok == token.DEFINE {
+				check(n.Lhs...)
+			}
+		case *ast.RangeStmt:
+			if n.Tok == token.DEFINE {
+				check(n.Key, n.Value)
+			}
+		}
+		return name == ""
+	})
+	return name
+}
+
+// compareTokens are the tokens of the comparison actions.
+var compareTokens = map[action]token.Token{
+	aEqual:        token.EQL,
+	aNotEqual:     token.NEQ,
+	aGreater:      token.GTR,
+	aGreaterEqual: token.GEQ,
+	aLower:        token.LSS,
+	aLowerEqual:   token.LEQ,
+}
+
+// compareConst computes the result of the comparison n of constant operands,
+// if they are booleans, numbers or strings.
+func compareConst(n *node) {
+	x, y := nodeConstant(n.child[0]), nodeConstant(n.child[1])
+	if x == nil || y == nil || x.Kind() == constant.Unknown || y.Kind() == constant.Unknown {
+		return
+	}
+	switch {
+	case x.Kind() == y.Kind():
+	case x.Kind() == constant.Bool, x.Kind() == constant.String, y.Kind() == constant.Bool, y.Kind() == constant.String:
+		return
+	}
+	n.rval = reflect.New(n.typ.rtype).Elem()
+	n.rval.SetBool(constant.Compare(x, compareTokens[n.action], y))
+}
+
+// logicalConst computes the result of the logical operation n, && if short
+// is false, || otherwise, if it is known at compile time: both operands are
+// constant, or the first one is short. It returns true if the result is
+// computed, so that n is not run.
+func logicalConst(n *node, short bool) bool {
+	x := nodeConstant(n.child[0])
+	if x == nil || x.Kind() != constant.Bool {
+		return false
+	}
+	res := short
+	if constant.BoolVal(x) != short {
+		y := nodeConstant(n.child[1])
+		if y == nil || y.Kind() != constant.Bool {
+			return false
+		}
+		res = constant.BoolVal(y)
+	}
+	n.rval = reflect.New(n.typ.TypeOf()).Elem()
+	n.rval.SetBool(res)
+	n.gen = nop
+	n.findex = -1
+	n.start = n
+	return true
+}
+
+// nodeConstant returns the constant value of n, a boolean, a number or a
+// string, or nil. The values of binary package variables are not constant.
+func nodeConstant(n *node) constant.Value {
+	for c := n; ; c = c.lastChild() {
+		if c.kind == selectorExpr && c.rval.CanAddr() {
+			return nil
+		}
+		if c.kind != parenExpr {
+			break
+		}
+	}
+	return constantOf(n.rval)
+}
+
+// constantOf returns the constant value of v, a boolean, a number or a
+// string, or nil.
+func constantOf(v reflect.Value) constant.Value {
+	if !v.IsValid() {
+		return nil
+	}
+	if isConstantValue(v.Type()) {
+		return vConstantValue(v)
+	}
+	switch v.Kind() {
+	case reflect.Bool:
+		return constant.MakeBool(v.Bool())
+	case reflect.String:
+		return constant.MakeString(v.String())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return constant.MakeInt64(v.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return constant.MakeUint64(v.Uint())
+	case reflect.Float32, reflect.Float64:
+		return constant.MakeFloat64(v.Float())
+	}
+	return nil
+}
@@ -0,0 +1,131 @@
+package interp
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSpecialize(t *testing.T) {
+	calls := 0
+	i := New(Options{})
+	i.Use(Exports{"host": {
+		"Trace": reflect.ValueOf(func() { calls++ }),
+	}})
+	if _, err := i.Eval(`
+import "host"
+
+var (
+	trace   bool
+	mode    = "fast"
+	limit   = 10
+	scale   float64
+	counter int
+)
+
+func rule(x int) int {
+	if trace && x > 0 {
+		host.Trace()
+	}
+	if mode == "slow" || x > limit {
+		return -x
+	}
+	counter++
+	return x * 2
+}
+
+func shadow(limit int) int { return limit }
+`); err != nil {
+		t.Fatal(err)
+	}
+
+	p, err := i.Program("rule")
+	if err != nil {
+		t.Fatal(err)
+	}
+	sp, err := p.Specialize(map[string]interface{}{"trace": false, "mode": "slow", "limit": 5})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := sp.Params(); !reflect.DeepEqual(got, []string{"x"}) {
+		t.Errorf("got params %v", got)
+	}
+
+	// Changes of the fixed variables are not seen by the specialized program.
+	if _, err := i.Eval(`trace, mode = true, "fast"`); err != nil {
+		t.Fatal(err)
+	}
+	for x, want := range map[int]int{1: -1, 20: -20} {
+		res, err := sp.Run(map[string]interface{}{"x": x})
+		if err != nil || res.Err != nil {
+			t.Fatal(err, res.Err)
+		}
+		if got := res.Values[0]; got != want {
+			t.Errorf("rule(%d): got %v, want %v", x, got, want)
+		}
+	}
+	if calls != 0 {
+		t.Errorf("got %d calls of host.Trace, want 0", calls)
+	}
+
+	// The original program sees them, and the other globals are shared.
+	res, err := p.Run(map[string]interface{}{"x": 3})
+	if err != nil || res.Err != nil {
+		t.Fatal(err, res.Err)
+	}
+	if res.Values[0] != 6 || calls != 1 {
+		t.Errorf("got %v and %d calls, want 6 and 1", res.Values[0], calls)
+	}
+	sp, err = p.Specialize(map[string]interface{}{"mode": "fast", "trace": true, "limit": int64(20)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res, _ = sp.Run(map[string]interface{}{"x": 4}); res.Values[0] != 8 || calls != 2 {
+		t.Errorf("got %v and %d calls, want 8 and 2", res.Values[0], calls)
+	}
+	if v, err := i.Eval(`counter`); err != nil || v.Interface() != 2 {
+		t.Errorf("got counter %v, %v, want 2", v, err)
+	}
+
+	s, err := i.Program("shadow")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, test := range []struct {
+		p       *Program
+		globals map[string]interface{}
+		err     string
+	}{
+		{p, map[string]interface{}{"rule": 1}, "cannot specialize rule: rule is not a package variable"},
+		{p, map[string]interface{}{"limit": "a"}, "cannot specialize rule: invalid type string for limit, want int"},
+		{p, map[string]interface{}{"limit": nil}, "cannot specialize rule: invalid nil value for limit"},
+		{s, map[string]interface{}{"limit": 1}, "cannot specialize shadow: limit is redeclared"},
+	} {
+		if _, err := test.p.Specialize(test.globals); err == nil || err.Error() != test.err {
+			t.Errorf("got error %v, want %q", err, test.err)
+		}
+	}
+}
+
+func TestFoldConditions(t *testing.T) {
+	i := New(Options{})
+	if _, err := i.Eval(`const c = "a"; const n int8 = 3`); err != nil {
+		t.Fatal(err)
+	}
+	for src, want := range map[string]interface{}{
+		`1 < 2`:         true,
+		`c == "b"`:      false,
+		`n >= 3.0`:      true,
+		`true && 1 > 2`: false,
+		`f := func() bool { panic(1) }; false && f()`:  false,
+		`g := func() bool { panic(1) }; 1 == 1 || g()`: true,
+	} {
+		v, err := i.Eval(src)
+		if err != nil {
+			t.Errorf("%s: %v", src, err)
+			continue
+		}
+		if got := v.Interface(); got != want {
+			t.Errorf("%s: got %v, want %v", src, got, want)
+		}
+	}
+}
@@ -1,24 +1,157 @@
 package interp
 
 import (
-	"archive/tar"
 	"bytes"
-	"compress/gzip"
+	"errors"
 	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/scanner"
+	"go/token"
 	"io"
 	"io/ioutil"
 	"os"
+	"path"
 	"path/filepath"
+	"runtime"
+	"sort"
 	"strings"
+	"sync"
+	"time"
 )
 
+// errCancelled is the error of imports whose execution was interrupted by
+// the cancellation of EvalWithContext.
+var errCancelled = errors.New("evaluation cancelled")
+
+// An ImportError reports the failed import of a source package, or the files
+// of a source package which could not be parsed when importing it in best
+// effort mode (see Options.BestEffort). The diagnostics are *CompileError for
+// compile errors, and go/scanner errors for syntax errors, with their
+// positions. The failed import of a dependency is reported by a
+// *CompileError of code CodeImport, at the position of the import, wrapping
+// the *ImportError of the dependency.
+type ImportError struct {
+	Path    string    // import path of the package
+	Code    ErrorCode // kind of error, the code of the first diagnostic
+	Errs    []error   // diagnostics, in file order
+	Missing []string  // symbols declared in skipped files, thus missing from the package
+	Chain   []string  // for import cycles, the import paths of the cycle, starting and ending with Path
+}
+
+func (e *ImportError) Error() string {
+	s := make([]string, len(e.Errs))
+	for i, err := range e.Errs {
+		s[i] = err.Error()
+	}
+	return strings.Join(s, "\n")
+}
+
+// Unwrap returns the first diagnostic.
+func (e *ImportError) Unwrap() error {
+	if len(e.Errs) == 0 {
+		return nil
+	}
+	return e.Errs[0]
+}
+
+// skip records err as the parse error of the source file name, whose
+// declared symbols are reported missing.
+func (e *ImportError) skip(name string, src []byte, err error) {
+	if el, ok := err.(scanner.ErrorList); ok {
+		for _, se := range el {
+			e.Errs = append(e.Errs, se)
+		}
+	} else {
+		e.Errs = append(e.Errs, err)
+	}
+
+	// The parser returns a partial AST on error, use it to locate declarations.
+	f, _ := parser.ParseFile(token.NewFileSet(), name, src, 0)
+	if f == nil {
+		return
+	}
+	for _, decl := range f.Decls {
+		switch d := decl.(type) {
+		case *ast.FuncDecl:
+			if d.Recv == nil && d.Name.Name != "init" {
+				e.Missing = append(e.Missing, d.Name.Name)
+			}
+		case *ast.GenDecl:
+			for _, spec := range d.Specs {
+				switch sp := spec.(type) {
+				case *ast.TypeSpec:
+					e.Missing = append(e.Missing, sp.Name.Name)
+				case *ast.ValueSpec:
+					for _, id := range sp.Names {
+						if id.Name != "_" {
+							e.Missing = append(e.Missing, id.Name)
+						}
+					}
+				}
+			}
+		}
+	}
+	sort.Strings(e.Missing)
+}
+
+// ImportErrors returns the errors of the source packages successfully imported
+// in best effort mode, despite some of their files being skipped, sorted by
+// import path.
+func (interp *Interpreter) ImportErrors() []*ImportError {
+	interp.mutex.RLock()
+	defer interp.mutex.RUnlock()
+
+	errs := make([]*ImportError, 0, len(interp.importErrs))
+	for _, e := range interp.importErrs {
+		errs = append(errs, e)
+	}
+	sort.Slice(errs, func(i, j int) bool { return errs[i].Path < errs[j].Path })
+	return errs
+}
+
+// srcDir returns the directory containing the source code of the package
+// importPath, imported from rPath, and the relative path to use for its own
+// imports.
+func (interp *Interpreter) srcDir(rPath, importPath string) (dir, root string, err error) {
+	// For relative import paths in the form "./xxx" or "../xxx", the initial
+	// base path is the directory of the interpreter input file, or "." if no file
+	// was provided.
+	// Packages of workspace modules are resolved from the module directories,
+	// so they have a single identity whatever the importing module.
+	// In all other cases, absolute import paths are resolved from the source
+	// roots, then from the GOPATH and the nested "vendor" directories.
+	if isPathRelative(importPath) {
+		if rPath == mainID {
+			rPath = "."
+		}
+		return filepath.Join(filepath.Dir(interp.name), rPath, importPath), rPath, nil
+	}
+	if dir, ok := anonymousDir(importPath); ok {
+		return dir, "", nil
+	}
+	if dir, ok := interp.workspaceDir(importPath); ok {
+		return dir, "", nil
+	}
+	if dir, ok := interp.rootDir(importPath); ok {
+		return dir, "", nil
+	}
+	if dir, root, err = pkgDir(interp.srcFS, interp.context.GOPATH, rPath, importPath); err == nil {
+		return dir, root, nil
+	}
+	// Try again, assuming a root dir at the source location. A source out of
+	// GOPATH only imports the packages of GOPATH, and the ones of its
+	// directory tree by relative paths.
+	if root, ok := interp.rootFromSourceLocation(); ok {
+		return pkgDir(interp.srcFS, interp.context.GOPATH, root, importPath)
+	}
+	return "", "", err
+}
+
 // importSrc calls gta on the source code for the package identified by
 // importPath. rPath is the relative path to the directory containing the source
 // code for the package. It can also be "main" as a special value.
 func (interp *Interpreter) importSrc(rPath, importPath string, skipTest bool) (string, error) {
-	var dir string
-	var err error
-
 	if interp.srcPkg[importPath] != nil {
 		name, ok := interp.pkgNames[importPath]
 		if !ok {
@@ -27,35 +160,50 @@ func (interp *Interpreter) importSrc(rPath, importPath string, skipTest bool) (s
 		return name, nil
 	}
 
-	// For relative import paths in the form "./xxx" or "../xxx", the initial
-	// base path is the directory of the interpreter input file, or "." if no file
-	// was provided.
-	// In all other cases, absolute import paths are resolved from the GOPATH
-	// and the nested "vendor" directories.
-	if isPathRelative(importPath) {
-		if rPath == mainID {
-			rPath = "."
-		}
-		dir = filepath.Join(filepath.Dir(interp.name), rPath, importPath)
-	} else if dir, rPath, err = pkgDir(interp.context.GOPATH, rPath, importPath); err != nil {
-		// Try again, assuming a root dir at the source location.
-		if rPath, err = interp.rootFromSourceLocation(); err != nil {
-			return "", err
+	dir, rPath, err := interp.srcDir(rPath, importPath)
+	if err != nil {
+		if name, ok, err := interp.importConstraints(importPath, skipTest); ok {
+			return name, err
 		}
-		if dir, rPath, err = pkgDir(interp.context.GOPATH, rPath, importPath); err != nil {
+		if err := unsupportedError(importPath); err != nil {
 			return "", err
 		}
+		return "", &ImportError{Path: importPath, Code: CodeImportNotFound, Errs: []error{err}}
 	}
+	return interp.importSrcDir(dir, rPath, importPath, skipTest)
+}
 
-	if interp.rdir[importPath] {
-		return "", fmt.Errorf("import cycle not allowed\n\timports %s", importPath)
+// importSrcDir calls gta on the source code of the package importPath, read
+// from dir. rPath is the relative path used to resolve its own imports.
+func (interp *Interpreter) importSrcDir(dir, rPath, importPath string, skipTest bool) (_ string, err error) {
+	if interp.targetErr != nil {
+		return "", interp.targetErr
+	}
+	if err := interp.importCycle(importPath); err != nil {
+		if name, ok := interp.lazyImport(importPath, err); ok {
+			return name, nil
+		}
+		return "", err
 	}
-	interp.rdir[importPath] = true
+	popImport := interp.pushImport(importPath)
+	defer func() {
+		popImport(err)
+		err = interp.importError(importPath, err)
+	}()
+
+	timer := interp.newImportTimer(importPath)
+	defer timer.done(&err)
+	slot := len(interp.universe.types)
+
+	// Execution stops if the evaluation is cancelled from now, see
+	// EvalWithContext.
+	id := interp.startRun()
 
-	files, err := ioutil.ReadDir(dir)
+	files, err := interp.readSrcDir(dir, importPath, skipTest)
 	if err != nil {
 		return "", err
 	}
+	timer.lap(&timer.stats.Read)
 
 	var initNodes []*node
 	var rootNodes []*node
@@ -63,49 +211,90 @@ func (interp *Interpreter) importSrc(rPath, importPath string, skipTest bool) (s
 
 	var root *node
 	var pkgName string
-
-	// Parse source files.
-	for _, file := range files {
-		name := file.Name()
-		if skipFile(&interp.context, name, skipTest) {
-			continue
+	var sources []srcFile
+
+	// In best effort mode, files which fail to parse are skipped. The import
+	// fails only if the remaining files can not be compiled, in which case the
+	// parse errors are also reported, as they are the likely cause.
+	ierr := &ImportError{Path: importPath}
+	defer func() {
+		if len(ierr.Errs) == 0 {
+			return
 		}
-
-		name = filepath.Join(dir, name)
-		var buf []byte
-		if buf, err = ioutil.ReadFile(name); err != nil {
-			return "", err
+		if err != nil {
+			ierr.Errs = append(ierr.Errs, err)
+			err = ierr
+			return
 		}
-
-		var pname string
-		if pname, root, err = interp.ast(string(buf), name, false); err != nil {
-			return "", err
+		interp.mutex.Lock()
+		if interp.importErrs == nil {
+			interp.importErrs = map[string]*ImportError{}
+		}
+		interp.importErrs[importPath] = ierr
+		interp.mutex.Unlock()
+	}()
+
+	// If Options.MaxErrors is set, the errors of the files are accumulated,
+	// so they are all reported, phase by phase.
+	errs := &errorList{max: interp.maxErrors}
+
+	// Parse source files concurrently, then process them in order.
+	parsed := interp.parseFiles(files)
+	timer.lap(&timer.stats.Parse)
+	timer.files = len(parsed)
+	for _, p := range parsed {
+		if p.err == nil {
+			timer.event(ImportParseFile, p.name, p.dur, nil)
+		}
+	}
+	for _, p := range parsed {
+		file, name, pname := p.srcFile, p.name, p.pkgName
+		if root, err = p.root, p.err; err != nil {
+			if interp.bestEffort {
+				ierr.skip(name, []byte(file.src), err)
+				continue
+			}
+			if errs.add(err) {
+				continue
+			}
+			return "", errs.error(importPath, err)
 		}
 		if root == nil {
 			continue
 		}
+		sources = append(sources, file)
 
-		if interp.astDot {
-			dotCmd := interp.dotCmd
-			if dotCmd == "" {
-				dotCmd = defaultDotCmd(name, "yaegi-ast-")
-			}
-			root.astDot(dotWriter(dotCmd), name)
-		}
+		interp.writeASTDot(root, name)
 		if pkgName == "" {
 			pkgName = pname
+			interp.setImportName(pname)
 		} else if pkgName != pname && skipTest {
-			return "", fmt.Errorf("found packages %s and %s in %s", pkgName, pname, dir)
+			err = fmt.Errorf("found packages %s and %s in %s", pkgName, pname, dir)
+			return "", &ImportError{Path: importPath, Code: CodeMultiplePackages, Errs: []error{err}}
 		}
 		rootNodes = append(rootNodes, root)
 
 		subRPath := effectivePkg(rPath, importPath)
+		if _, ok := anonymousDir(importPath); ok {
+			// Anonymous packages import from the GOPATH root.
+			subRPath = ""
+		}
 		var list []*node
 		list, err = interp.gta(root, subRPath, importPath)
 		if err != nil {
-			return "", err
+			if errs.add(err) {
+				continue
+			}
+			return "", errs.error(importPath, err)
 		}
 		revisit[subRPath] = append(revisit[subRPath], list...)
+		timer.lap(&timer.stats.GTA)
+	}
+	if err = errs.error(importPath, nil); err != nil {
+		return "", err
+	}
+	if len(rootNodes) == 0 {
+		return "", fmt.Errorf("no Go source files in %s", dir)
 	}
 
 	// Revisit incomplete nodes where GTA could not complete.
@@ -114,34 +303,71 @@ func (interp *Interpreter) importSrc(rPath, importPath string, skipTest bool) (s
 			return "", err
 		}
 	}
-
-	// Generate control flow graphs.
+	timer.lap(&timer.stats.GTA)
+
+	// Generate control flow graphs. The compilation of functions of imported
+	// packages is delayed until they are used, so only the code reachable from
+	// global variables and init functions is compiled at import. In eager mode,
+	// the remaining functions are compiled once imported, except for blank
+	// imports whose symbols are not accessible.
+	// When errors are accumulated, all the functions are compiled before
+	// init, to report their errors.
+	if pkgName != mainID || errs.max > 0 {
+		for _, root := range rootNodes {
+			interp.deferFuncs(root, importPath)
+		}
+	}
 	for _, root := range rootNodes {
 		var nodes []*node
 		if nodes, err = interp.cfg(root, importPath); err != nil {
-			return "", err
+			if errs.add(err) {
+				continue
+			}
+			return "", errs.error(importPath, err)
 		}
 		initNodes = append(initNodes, nodes...)
 	}
+	if errs.max > 0 {
+		interp.compileDeferredErrs(importPath, errs)
+		if err = errs.error(importPath, nil); err != nil {
+			return "", err
+		}
+	}
 
 	// Register source package in the interpreter. The package contains only
 	// the global symbols in the package scope.
 	interp.mutex.Lock()
+	interp.addRoots(importPath, rootNodes...)
 	gs := interp.scopes[importPath]
 	interp.srcPkg[importPath] = gs.sym
 	interp.pkgNames[importPath] = pkgName
+	interp.sources[importPath] = sources
+	interp.addPackageInfo(importPath, OriginDir, dir, sources)
+	interp.pkgInfo[importPath].root = rPath
+	interp.pkgInfo[importPath].slots = [2]int{slot, len(interp.universe.types)}
 
 	interp.frame.mutex.Lock()
 	interp.resizeFrame()
 	interp.frame.mutex.Unlock()
 	interp.mutex.Unlock()
+	timer.stats.Files = len(rootNodes)
+	timer.lap(&timer.stats.CFG)
+	timer.event(ImportCFGDone, "", timer.stats.GTA+timer.stats.CFG, nil)
 
 	// Once all package sources have been parsed, execute entry points then init functions.
 	for _, n := range rootNodes {
 		if err = genRun(n); err != nil {
 			return "", err
 		}
+		timer.lap(&timer.stats.CFG)
 		interp.run(n, nil)
+		timer.lap(&timer.stats.Init)
+	}
+
+	// Variables declared with go:embed directives are initialized to the
+	// files they embed, in place of their zero value.
+	if err = interp.embedFiles(importPath, sources, dirTree{interp.srcFS, dir}); err != nil {
+		return "", err
 	}
 
 	// Wire and execute global vars in global scope gs.
@@ -149,6 +375,7 @@ func (interp *Interpreter) importSrc(rPath, importPath string, skipTest bool) (s
 	if err != nil {
 		return "", err
 	}
+	timer.lap(&timer.stats.CFG)
 	interp.run(n, nil)
 
 	// Add main to list of functions to run, after all inits.
@@ -157,18 +384,224 @@ func (interp *Interpreter) importSrc(rPath, importPath string, skipTest bool) (s
 	}
 
 	for _, n := range initNodes {
+		if interp.runid() != id {
+			return "", interp.runErr()
+		}
 		interp.run(n, interp.frame)
 	}
+	timer.lap(&timer.stats.Init)
+	timer.event(ImportInitRun, "", timer.stats.Init, nil)
+	if interp.runid() != id {
+		return "", interp.runErr()
+	}
 
+	if interp.collectProvenance {
+		interp.addProvenance(importPath, dir)
+	}
 	return pkgName, nil
 }
 
-func (interp *Interpreter) importSrcArchive(reader io.Reader, skipTest bool) (string, error) {
-	var err error
+// compileImport compiles the functions of the imported package importPath
+// whose compilation was delayed, as required in eager mode. In best effort
+// mode, the errors of the skipped files are reported along with the
+// compilation error, as they are the likely cause.
+func (interp *Interpreter) compileImport(importPath string) error {
+	err := interp.compileDeferred(importPath, false)
+	if err == nil {
+		return nil
+	}
+	interp.mutex.RLock()
+	ierr := interp.importErrs[importPath]
+	interp.mutex.RUnlock()
+	if ierr == nil {
+		return err
+	}
+	errs := append(append([]error{}, ierr.Errs...), err)
+	return &ImportError{Path: importPath, Errs: errs, Missing: ierr.Missing}
+}
+
+// importSrcArchive reads the archive from reader, then imports the package at
+// its root, registered under importPath. The archive of a main program,
+// evaluated but not imported, has the import path archiveRoot.
+func (interp *Interpreter) importSrcArchive(importPath string, reader io.Reader, opts ArchiveOptions, skipTest bool) (string, error) {
+	if interp.srcPkg[importPath] != nil && importPath != archiveRoot {
+		return "", fmt.Errorf("package %s already imported", importPath)
+	}
+	// The archive is verified before any of its files is read.
+	if opts.Digest != "" || len(opts.Signature) > 0 {
+		b, err := ioutil.ReadAll(reader)
+		if err != nil {
+			return "", err
+		}
+		if err := verifyArchive(b, opts); err != nil {
+			return "", err
+		}
+		reader = bytes.NewReader(b)
+	}
+	files, err := readArchive(reader, opts.Format)
+	if err != nil {
+		return "", err
+	}
+	a := newArchiveFS(importPath, files)
+	var bundle *Bundle
+	if buf, ok := a.data[BundleFile]; ok {
+		if bundle, err = ParseBundle(buf); err != nil {
+			return "", fmt.Errorf("%s: %w", BundleFile, err)
+		}
+	}
+	if err := opts.Bundle.check(bundle); err != nil {
+		return "", err
+	}
+	interp.resetQuotas()
+	name, err := interp.importArchivePkg(a, ".", importPath, opts.Name, skipTest)
+	if err != nil || bundle == nil || importPath == archiveRoot {
+		return name, err
+	}
+	if err := interp.checkEntries(bundle, importPath); err != nil {
+		interp.Invalidate(importPath)
+		return "", err
+	}
+	interp.mutex.Lock()
+	if interp.bundles == nil {
+		interp.bundles = map[string]*Bundle{}
+	}
+	interp.bundles[importPath] = bundle
+	interp.mutex.Unlock()
+	return name, nil
+}
+
+// importSrcOf imports the source package importPath for the package importer,
+// from the archive of importer if it contains it, or as importSrc otherwise.
+func (interp *Interpreter) importSrcOf(importer, rPath, importPath string) (string, error) {
+	a, dir := interp.archiveDir(importer, importPath)
+	if a == nil {
+		return interp.importSrc(rPath, importPath, NoTest)
+	}
+	if interp.srcPkg[importPath] != nil {
+		return interp.pkgNames[importPath], nil
+	}
+	if err := interp.importCycle(importPath); err != nil {
+		if name, ok := interp.lazyImport(importPath, err); ok {
+			return name, nil
+		}
+		return "", err
+	}
+	return interp.importArchivePkg(a, dir, importPath, "", NoTest)
+}
+
+// readSrcDir returns the source files of the package importPath in dir, from
+// the compiled packages loaded by LoadCompiled if present, or read from dir.
+func (interp *Interpreter) readSrcDir(dir, importPath string, skipTest bool) ([]srcFile, error) {
+	if files := interp.compiledFiles(dir, importPath, skipTest); files != nil {
+		return files, nil
+	}
+	entries, err := interp.srcFS.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	var files []srcFile
+	for _, e := range entries {
+		name := e.Name()
+		if skipFile(&interp.context, name, skipTest) {
+			continue
+		}
+		name = filepath.Join(dir, name)
+		buf, err := interp.srcFS.ReadFile(name)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, srcFile{name: name, src: string(buf)})
+	}
+	// Files are processed in name order, which determines the order of
+	// initialization, whatever the order of the file system.
+	sort.Slice(files, func(i, j int) bool { return files[i].name < files[j].name })
+	return files, nil
+}
+
+// parsedFile is a source file parsed by parseFiles.
+type parsedFile struct {
+	srcFile
+	pkgName string
+	root    *node // nil if the file does not match the build constraints
+	err     error
+	dur     time.Duration // duration of the parsing
+}
+
+// parseFiles parses the source files of a package, concurrently unless they
+// set build tags with yaegi:tags comments, which change the constraints of
+// the following files. The parsed files are returned in the order of files.
+func (interp *Interpreter) parseFiles(files []srcFile) []parsedFile {
+	parsed := make([]parsedFile, len(files))
+	parse := func(i int) {
+		start := time.Now()
+		p := &parsed[i]
+		p.srcFile = files[i]
+		p.pkgName, p.root, p.err = interp.ast(p.src, p.name, false)
+		p.dur = time.Since(start)
+	}
+
+	workers := runtime.GOMAXPROCS(0)
+	if workers > len(files) {
+		workers = len(files)
+	}
+	for _, f := range files {
+		if strings.Contains(f.src, "yaegi:tags") {
+			workers = 1
+			break
+		}
+	}
+	if workers <= 1 {
+		for i := range files {
+			parse(i)
+		}
+		return parsed
+	}
+
+	next := make(chan int)
+	var wg sync.WaitGroup
+	wg.Add(workers)
+	for w := 0; w < workers; w++ {
+		go func() {
+			defer wg.Done()
+			for i := range next {
+				parse(i)
+			}
+		}()
+	}
+	for i := range files {
+		next <- i
+	}
+	close(next)
+	wg.Wait()
+	return parsed
+}
+
+// importArchivePkg calls gta on the source files of the archive directory dir,
+// and registers the package under importPath, with the package name alias if
+// not empty.
+func (interp *Interpreter) importArchivePkg(a *archiveFS, adir, importPath, alias string, skipTest bool) (_ string, err error) {
+	if interp.targetErr != nil {
+		return "", interp.targetErr
+	}
 	rPath := "."
-	importPath := "/"
 	dir := filepath.Join(rPath, importPath)
-	interp.rdir[importPath] = true
+	popImport := interp.pushImport(importPath)
+	defer func() {
+		popImport(err)
+		err = interp.importError(importPath, err)
+	}()
+	if interp.archivePkgs == nil {
+		interp.archivePkgs = map[string]*archiveFS{}
+	}
+	interp.archivePkgs[importPath] = a
+
+	timer := interp.newImportTimer(importPath)
+	defer timer.done(&err)
+	slot := len(interp.universe.types)
+
+	// Execution stops if the evaluation is cancelled from now, see
+	// EvalWithContext.
+	id := interp.startRun()
 
 	var initNodes []*node
 	var rootNodes []*node
@@ -176,68 +609,55 @@ func (interp *Interpreter) importSrcArchive(reader io.Reader, skipTest bool) (st
 
 	var root *node
 	var pkgName string
-
-	uncompressedStream, err := gzip.NewReader(reader)
-	if err != nil {
-		return "", err
-	}
-	tarReader := tar.NewReader(uncompressedStream)
+	var sources []srcFile
 
 	// Parse source files.
-	for {
-		header, err := tarReader.Next()
-		if err == io.EOF {
-			break
+	var bases []string
+	for _, base := range a.dirs[adir] {
+		if !skipFile(&interp.context, base, skipTest) {
+			bases = append(bases, base)
 		}
-		if err != nil {
-			fmt.Errorf("Not a tar file, %v", err)
-		}
-		switch header.Typeflag {
-		case tar.TypeDir:
-			//TODO: Need to see if this is necessary to implement
-		case tar.TypeReg:
-			name := header.Name
-			if skipFile(&interp.context, name, skipTest) {
-				continue
-			}
+	}
+	timer.files = len(bases)
+	for _, base := range bases {
+		data := a.data[path.Join(adir, base)]
+		name := filepath.Join(dir, base)
+		timer.lap(&timer.stats.Read)
 
-			name = filepath.Join(dir, name)
-			var buf bytes.Buffer
-			if _, err = buf.ReadFrom(tarReader); err != nil {
-				return "", err
-			}
+		var pname string
+		if pname, root, err = interp.ast(string(data), name, false); err != nil {
+			return "", err
+		}
+		timer.event(ImportParseFile, name, timer.lap(&timer.stats.Parse), nil)
+		if root == nil {
+			continue
+		}
+		sources = append(sources, srcFile{name: name, src: string(data)})
 
-			var pname string
-			if pname, root, err = interp.ast(string(buf.Bytes()), name, false); err != nil {
-				return "", err
-			}
-			if root == nil {
-				continue
-			}
+		interp.writeASTDot(root, name)
+		if pkgName == "" {
+			pkgName = pname
+			interp.setImportName(pname)
+		}
+		rootNodes = append(rootNodes, root)
 
-			if interp.astDot {
-				dotCmd := interp.dotCmd
-				if dotCmd == "" {
-					dotCmd = defaultDotCmd(name, "yaegi-ast-")
-				}
-				root.astDot(dotWriter(dotCmd), name)
-			}
-			if pkgName == "" {
-				pkgName = pname
-			} /*else if pkgName != pname && skipTest {
-				return "", fmt.Errorf("found packages %s and %s in %s", pkgName, pname, dir)
-			}*/
-			rootNodes = append(rootNodes, root)
-
-			subRPath := effectivePkg(rPath, importPath)
-			var list []*node
-			list, err = interp.gta(root, subRPath, importPath)
-			if err != nil {
-				return "", err
-			}
-			revisit[subRPath] = append(revisit[subRPath], list...)
+		subRPath := effectivePkg(rPath, importPath)
+		var list []*node
+		list, err = interp.gta(root, subRPath, importPath)
+		if err != nil {
+			return "", err
 		}
+		revisit[subRPath] = append(revisit[subRPath], list...)
+		timer.lap(&timer.stats.GTA)
+	}
 
+	if importPath != archiveRoot {
+		switch pkgName {
+		case "":
+			return "", fmt.Errorf("no Go source files in archive of %s", importPath)
+		case mainID:
+			return "", fmt.Errorf("import %q is a program, not an importable package", importPath)
+		}
 	}
 
 	// Revisit incomplete nodes where GTA could not complete.
@@ -246,6 +666,7 @@ func (interp *Interpreter) importSrcArchive(reader io.Reader, skipTest bool) (st
 			return "", err
 		}
 	}
+	timer.lap(&timer.stats.GTA)
 
 	// Generate control flow graphs.
 	for _, root := range rootNodes {
@@ -259,21 +680,37 @@ func (interp *Interpreter) importSrcArchive(reader io.Reader, skipTest bool) (st
 	// Register source package in the interpreter. The package contains only
 	// the global symbols in the package scope.
 	interp.mutex.Lock()
+	interp.addRoots(importPath, rootNodes...)
 	gs := interp.scopes[importPath]
 	interp.srcPkg[importPath] = gs.sym
+	if alias != "" {
+		pkgName = alias
+	}
 	interp.pkgNames[importPath] = pkgName
+	interp.sources[importPath] = sources
+	interp.addPackageInfo(importPath, OriginArchive, "", sources)
+	interp.pkgInfo[importPath].slots = [2]int{slot, len(interp.universe.types)}
 
 	interp.frame.mutex.Lock()
 	interp.resizeFrame()
 	interp.frame.mutex.Unlock()
 	interp.mutex.Unlock()
+	timer.stats.Files = len(rootNodes)
+	timer.lap(&timer.stats.CFG)
+	timer.event(ImportCFGDone, "", timer.stats.GTA+timer.stats.CFG, nil)
 
 	// Once all package sources have been parsed, execute entry points then init functions.
 	for _, n := range rootNodes {
 		if err = genRun(n); err != nil {
 			return "", err
 		}
+		timer.lap(&timer.stats.CFG)
 		interp.run(n, nil)
+		timer.lap(&timer.stats.Init)
+	}
+
+	if err = interp.embedFiles(importPath, sources, archiveTree{a, adir}); err != nil {
+		return "", err
 	}
 
 	// Wire and execute global vars in global scope gs.
@@ -281,6 +718,7 @@ func (interp *Interpreter) importSrcArchive(reader io.Reader, skipTest bool) (st
 	if err != nil {
 		return "", err
 	}
+	timer.lap(&timer.stats.CFG)
 	interp.run(n, nil)
 
 	// Add main to list of functions to run, after all inits.
@@ -289,45 +727,108 @@ func (interp *Interpreter) importSrcArchive(reader io.Reader, skipTest bool) (st
 	}
 
 	for _, n := range initNodes {
+		if interp.runid() != id {
+			return "", interp.runErr()
+		}
 		interp.run(n, interp.frame)
 	}
+	timer.lap(&timer.stats.Init)
+	timer.event(ImportInitRun, "", timer.stats.Init, nil)
+	if interp.runid() != id {
+		return "", interp.runErr()
+	}
 
 	return pkgName, nil
 }
 
 // rootFromSourceLocation returns the path to the directory containing the input
-// Go file given to the interpreter, relative to $GOPATH/src.
+// Go file given to the interpreter, relative to $GOPATH/src, and false if the
+// file is not in GOPATH.
 // It is meant to be called in the case when the initial input is a main package.
-func (interp *Interpreter) rootFromSourceLocation() (string, error) {
+func (interp *Interpreter) rootFromSourceLocation() (string, bool) {
 	sourceFile := interp.name
 	if sourceFile == DefaultSourceName {
-		return "", nil
+		return "", false
 	}
-	wd, err := os.Getwd()
-	if err != nil {
-		return "", err
+	return interp.gopathRelative(interp.absDir(filepath.Dir(sourceFile)))
+}
+
+// absDir returns the absolute path of the directory dir of the source
+// filesystem.
+func (interp *Interpreter) absDir(dir string) string {
+	if filepath.IsAbs(dir) {
+		return filepath.Clean(dir)
+	}
+	if _, ok := interp.srcFS.(osFS); ok {
+		if abs, err := filepath.Abs(dir); err == nil {
+			return abs
+		}
+	}
+	// The other filesystems are rooted at "/", which is also ".".
+	return filepath.Join(string(filepath.Separator), dir)
+}
+
+// gopathRelative returns the path of the absolute directory dir relative to
+// $GOPATH/src, and false if dir is not in GOPATH.
+func (interp *Interpreter) gopathRelative(dir string) (string, bool) {
+	src := filepath.Join(interp.absDir(interp.context.GOPATH), "src")
+	rel, err := filepath.Rel(src, dir)
+	if err != nil || rel == "." || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", false
+	}
+	return rel, true
+}
+
+// anonymousPrefix prefixes the import path of the packages out of GOPATH,
+// imported by relative paths, which is their absolute directory, as for the
+// go command.
+const anonymousPrefix = "_/"
+
+// localImportPath returns the import path registering the package imported
+// by the relative path importPath from the package importer, of root rPath:
+// the anonymous path of its directory if it is out of GOPATH, importPath
+// otherwise, or its path in GOPATH if importer is anonymous.
+func (interp *Interpreter) localImportPath(importer, rPath, importPath string) string {
+	base, anonymous := anonymousDir(importer)
+	if !anonymous {
+		if rPath == mainID {
+			rPath = "."
+		}
+		base = filepath.Join(filepath.Dir(interp.name), rPath)
 	}
-	pkgDir := filepath.Join(wd, filepath.Dir(sourceFile))
-	root := strings.TrimPrefix(pkgDir, filepath.Join(interp.context.GOPATH, "src")+"/")
-	if root == wd {
-		return "", fmt.Errorf("package location %s not in GOPATH", pkgDir)
+	dir := interp.absDir(filepath.Join(base, importPath))
+	if rel, ok := interp.gopathRelative(dir); ok {
+		if anonymous {
+			// Relative paths are resolved from the importing source file.
+			return filepath.ToSlash(rel)
+		}
+		return importPath
+	}
+	return anonymousPrefix + strings.TrimPrefix(filepath.ToSlash(dir), "/")
+}
+
+// anonymousDir returns the directory of the package of anonymous path
+// importPath, and false if importPath is not anonymous.
+func anonymousDir(importPath string) (string, bool) {
+	if !strings.HasPrefix(importPath, anonymousPrefix) {
+		return "", false
 	}
-	return root, nil
+	return filepath.FromSlash("/" + strings.TrimPrefix(importPath, anonymousPrefix)), true
 }
 
 // pkgDir returns the absolute path in filesystem for a package given its import path
 // and the root of the subtree dependencies.
-func pkgDir(goPath string, root, importPath string) (string, string, error) {
+func pkgDir(fsys filesystem, goPath string, root, importPath string) (string, string, error) {
 	rPath := filepath.Join(root, "vendor")
 	dir := filepath.Join(goPath, "src", rPath, importPath)
 
-	if _, err := os.Stat(dir); err == nil {
+	if _, err := fsys.Stat(dir); err == nil {
 		return dir, rPath, nil // found!
 	}
 
 	dir = filepath.Join(goPath, "src", effectivePkg(root, importPath))
 
-	if _, err := os.Stat(dir); err == nil {
+	if _, err := fsys.Stat(dir); err == nil {
 		return dir, root, nil // found!
 	}
 
@@ -336,18 +837,18 @@ func pkgDir(goPath string, root, importPath string) (string, string, error) {
 	}
 
 	rootPath := filepath.Join(goPath, "src", root)
-	prevRoot, err := previousRoot(rootPath, root)
+	prevRoot, err := previousRoot(fsys, rootPath, root)
 	if err != nil {
 		return "", "", err
 	}
 
-	return pkgDir(goPath, prevRoot, importPath)
+	return pkgDir(fsys, goPath, prevRoot, importPath)
 }
 
 const vendor = "vendor"
 
 // Find the previous source root (vendor > vendor > ... > GOPATH).
-func previousRoot(rootPath, root string) (string, error) {
+func previousRoot(fsys filesystem, rootPath, root string) (string, error) {
 	rootPath = filepath.Clean(rootPath)
 	parent, final := filepath.Split(rootPath)
 	parent = filepath.Clean(parent)
@@ -360,7 +861,7 @@ func previousRoot(rootPath, root string) (string, error) {
 		// look for the closest vendor in one of our direct ancestors, as it takes priority.
 		var vendored string
 		for {
-			fi, err := os.Lstat(filepath.Join(parent, vendor))
+			fi, err := fsys.Lstat(filepath.Join(parent, vendor))
 			if err == nil && fi.IsDir() {
 				vendored = strings.TrimPrefix(strings.TrimPrefix(parent, prefix), string(filepath.Separator))
 				break
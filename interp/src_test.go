@@ -1,10 +1,20 @@
 package interp
 
 import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"fmt"
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"reflect"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 )
 
 func Test_effectivePkg(t *testing.T) {
@@ -178,7 +188,7 @@ func Test_pkgDir(t *testing.T) {
 				}
 			}
 
-			dir, rPath, err := pkgDir(goPath, test.root, test.path)
+			dir, rPath, err := pkgDir(osFS{}, goPath, test.root, test.path)
 			if err != nil {
 				t.Fatal(err)
 			}
@@ -245,7 +255,7 @@ func Test_previousRoot(t *testing.T) {
 			} else {
 				rootPath = vendor
 			}
-			p, err := previousRoot(rootPath, test.root)
+			p, err := previousRoot(osFS{}, rootPath, test.root)
 			if err != nil {
 				t.Error(err)
 			}
@@ -256,3 +266,968 @@ func Test_previousRoot(t *testing.T) {
 		})
 	}
 }
+
+func TestImportBestEffort(t *testing.T) {
+	goPath, err := ioutil.TempDir("", "besteffort")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		_ = os.RemoveAll(goPath)
+	}()
+
+	files := map[string]string{
+		"foo/a.go": "package foo\n\nfunc A() string { return \"a\" }\n",
+		"foo/b.go": "package foo\n\ntype T int\n\nfunc B() {\n\treturn +\n}\n",
+		"foo/c.go": "package foo\n\nvar C = A() + \"c\"\n",
+		"bar/a.go": "package bar\n\nfunc A() { B() }\n",
+		"bar/b.go": "package bar\n\nfunc B( {}\n",
+	}
+	for name, src := range files {
+		name = filepath.Join(goPath, "src", name)
+		if err := os.MkdirAll(filepath.Dir(name), 0700); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(name, []byte(src), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	i := New(Options{GoPath: goPath})
+	if _, err := i.Eval(`import "foo"`); err == nil {
+		t.Fatal("expected error")
+	}
+
+	i = New(Options{GoPath: goPath, BestEffort: true, EagerCompile: true})
+	if _, err := i.Eval(`import "foo"`); err != nil {
+		t.Fatal(err)
+	}
+	v, err := i.Eval(`foo.C`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v.String() != "ac" {
+		t.Errorf("got %v, want ac", v)
+	}
+	ierrs := i.ImportErrors()
+	if len(ierrs) != 1 || ierrs[0].Path != "foo" {
+		t.Fatalf("unexpected import errors %v", ierrs)
+	}
+	if got := strings.Join(ierrs[0].Missing, " "); got != "B T" {
+		t.Errorf("got missing symbols %q, want %q", got, "B T")
+	}
+	if want := filepath.Join(goPath, "src", "foo", "b.go") + ":7:1: expected operand"; !strings.HasPrefix(ierrs[0].Error(), want) {
+		t.Errorf("got %q, want %q", ierrs[0].Error(), want)
+	}
+
+	// The parse errors of skipped files are reported along with the
+	// compilation errors they cause.
+	_, err = i.Eval(`import "bar"`)
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	for _, want := range []string{"b.go:3:9: expected ')'", "a.go:3:12: undefined: B"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("got %q, want %q", err, want)
+		}
+	}
+}
+
+func TestImportLazy(t *testing.T) {
+	goPath, err := ioutil.TempDir("", "lazy")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		_ = os.RemoveAll(goPath)
+	}()
+
+	dir := filepath.Join(goPath, "src", "foo")
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		t.Fatal(err)
+	}
+	src := `package foo
+
+var V = fib(10)
+
+func fib(n int) int {
+	if n < 2 {
+		return n
+	}
+	return fib(n-1) + fib(n-2)
+}
+
+func Fib(n int) int { return fib(n) }
+
+func Double(n int) int { return 2 * n }
+
+func Broken() int { return undefined }
+`
+	if err := ioutil.WriteFile(filepath.Join(dir, "foo.go"), []byte(src), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	i := New(Options{GoPath: goPath, EagerCompile: true})
+	if _, err := i.Eval(`import "foo"`); err == nil || !strings.Contains(err.Error(), "undefined: undefined") {
+		t.Errorf("got %v, want undefined error", err)
+	}
+
+	i = New(Options{GoPath: goPath})
+	if _, err := i.Eval(`import "foo"`); err != nil {
+		t.Fatal(err)
+	}
+	compiled := func(name string) bool {
+		return i.lazy[i.scopes["foo"].sym[name].node].state == funcCompiled
+	}
+	if !compiled("fib") || compiled("Fib") || compiled("Double") || compiled("Broken") {
+		t.Error("only functions used at import should be compiled")
+	}
+
+	v, err := i.Eval(`foo.V + foo.Fib(5)`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v.Int() != 60 {
+		t.Errorf("got %v, want 60", v)
+	}
+	if !compiled("Fib") || compiled("Double") {
+		t.Error("Fib should be compiled, Double should not")
+	}
+
+	if _, err := i.Eval(`foo.Broken()`); err == nil || !strings.Contains(err.Error(), "undefined: undefined") {
+		t.Errorf("got %v, want undefined error", err)
+	}
+
+	// Exported functions are compiled on demand, except if invalid.
+	syms := i.Symbols("foo")["foo"]
+	if _, ok := syms["Broken"]; ok {
+		t.Error("unexpected Broken symbol")
+	}
+	if f, ok := syms["Double"].Interface().(func(int) int); !ok || f(2) != 4 {
+		t.Errorf("unexpected Double symbol %v", syms["Double"])
+	}
+}
+
+func TestEvalPathWithContext(t *testing.T) {
+	goPath, err := ioutil.TempDir("", "context")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		_ = os.RemoveAll(goPath)
+	}()
+
+	files := map[string]string{
+		"loop/main.go": "package main\n\nimport \"reg\"\n\nfunc init() { for {} }\n\nfunc main() { reg.Register(\"loop\") }\n",
+		"ok/main.go":   "package main\n\nimport \"reg\"\n\nvar v = reg.Register(\"var\")\n\nfunc main() { reg.Register(\"main\") }\n",
+	}
+	for name, src := range files {
+		name = filepath.Join(goPath, "src", name)
+		if err := os.MkdirAll(filepath.Dir(name), 0700); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(name, []byte(src), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var mu sync.Mutex
+	var got []string
+	i := New(Options{GoPath: goPath})
+	i.Use(Exports{"reg": {"Register": reflect.ValueOf(func(s string) int {
+		mu.Lock()
+		defer mu.Unlock()
+		got = append(got, s)
+		return len(got)
+	})}})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if _, err := i.EvalPathWithContext(ctx, "loop"); err != context.DeadlineExceeded {
+		t.Fatalf("got %v, want %v", err, context.DeadlineExceeded)
+	}
+
+	// The cancelled evaluation does not proceed with main, and the next
+	// evaluations run normally.
+	time.Sleep(50 * time.Millisecond)
+	if _, err := i.EvalPath("ok"); err != nil {
+		t.Fatal(err)
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	if want := "var,main"; strings.Join(got, ",") != want {
+		t.Errorf("got %q, want %q", strings.Join(got, ","), want)
+	}
+}
+
+func TestImportBlank(t *testing.T) {
+	goPath, err := ioutil.TempDir("", "blank")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		_ = os.RemoveAll(goPath)
+	}()
+
+	files := map[string]string{
+		"drv/a.go": "package drv\n\nimport \"reg\"\n\nvar v = name(\"var\")\n\nfunc name(s string) string { reg.Register(s); return s }\n\nfunc init() { reg.Register(\"init a\") }\n",
+		"drv/b.go": "package drv\n\nimport \"reg\"\n\nfunc init() { reg.Register(\"init b\") }\n\nfunc Broken() int { return undefined }\n",
+	}
+	for name, src := range files {
+		name = filepath.Join(goPath, "src", name)
+		if err := os.MkdirAll(filepath.Dir(name), 0700); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(name, []byte(src), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var got []string
+	i := New(Options{GoPath: goPath, EagerCompile: true})
+	i.Use(Exports{"reg": {"Register": reflect.ValueOf(func(s string) { got = append(got, s) })}})
+
+	// Package variables are initialized before init functions, which run in
+	// file order. Unreachable functions are not compiled.
+	if _, err := i.Eval(`import _ "drv"`); err != nil {
+		t.Fatal(err)
+	}
+	if want := "var,init a,init b"; strings.Join(got, ",") != want {
+		t.Errorf("got %q, want %q", strings.Join(got, ","), want)
+	}
+
+	// The package is compiled if imported again with a name, in eager mode.
+	if _, err := i.Eval(`import "drv"`); err == nil || !strings.Contains(err.Error(), "undefined: undefined") {
+		t.Errorf("got %v, want undefined error", err)
+	}
+	if len(got) != 3 {
+		t.Errorf("package initialized again: %v", got)
+	}
+}
+
+func TestImportStats(t *testing.T) {
+	goPath, err := ioutil.TempDir("", "stats")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		_ = os.RemoveAll(goPath)
+	}()
+
+	files := map[string]string{
+		"foo/a.go": "package foo\n\nimport \"bar\"\n\nvar A = bar.B\n",
+		"foo/b.go": "package foo\n\nfunc F() int { return A }\n",
+		"bar/a.go": "package bar\n\nimport \"slow\"\n\nvar B = slow.Wait()\n",
+	}
+	for name, src := range files {
+		name = filepath.Join(goPath, "src", name)
+		if err := os.MkdirAll(filepath.Dir(name), 0700); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(name, []byte(src), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	const delay = 100 * time.Millisecond
+	i := New(Options{GoPath: goPath})
+	i.Use(Exports{"slow": {"Wait": reflect.ValueOf(func() int { time.Sleep(delay); return 1 })}})
+	if _, err := i.Eval(`import "foo"`); err != nil {
+		t.Fatal(err)
+	}
+
+	stats := i.Stats()
+	if len(stats) != 2 || stats[0].Path != "bar" || stats[1].Path != "foo" {
+		t.Fatalf("unexpected stats %v", stats)
+	}
+	bar, foo := stats[0], stats[1]
+	if bar.Files != 1 || foo.Files != 2 {
+		t.Errorf("got %d and %d files, want 1 and 2", bar.Files, foo.Files)
+	}
+	if bar.Init < delay {
+		t.Errorf("got init duration %v, want at least %v", bar.Init, delay)
+	}
+	// The import of bar happens during the GTA of foo, but is not accounted.
+	if foo.Total() >= delay {
+		t.Errorf("got total duration %v, want less than %v", foo.Total(), delay)
+	}
+}
+
+func TestImportWorkspace(t *testing.T) {
+	tmp, err := ioutil.TempDir("", "workspace")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		_ = os.RemoveAll(tmp)
+	}()
+
+	// The plugin vendors an obsolete copy of the host API, which is ignored
+	// in favor of the workspace module.
+	files := map[string]string{
+		"work/go.work":          "go 1.18\n\nuse ./host // host API\nuse (\n\t./plugin\n)\n",
+		"work/host/go.mod":      "module example.com/host\n",
+		"work/host/api/api.go":  "package api\n\ntype Event struct{ Name string }\n",
+		"work/plugin/go.mod":    "module \"example.com/plugin\"\n",
+		"work/plugin/plugin.go": "package plugin\n\nimport (\n\t\"example.com/host/api\"\n\t\"util\"\n)\n\nfunc New() api.Event { return api.Event{Name: util.Name} }\n",
+		"work/plugin/vendor/example.com/host/api/api.go": "package api\n\ntype Event struct{ ID int }\n",
+		"gopath/src/util/util.go":                        "package util\n\nconst Name = \"plugin\"\n",
+	}
+	for name, src := range files {
+		name = filepath.Join(tmp, name)
+		if err := os.MkdirAll(filepath.Dir(name), 0700); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(name, []byte(src), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	ws, err := ReadWorkspace(filepath.Join(tmp, "work", "go.work"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ws) != 2 || ws["example.com/host"] != filepath.Join(tmp, "work", "host") || ws["example.com/plugin"] != filepath.Join(tmp, "work", "plugin") {
+		t.Fatalf("unexpected workspace %v", ws)
+	}
+
+	i := New(Options{GoPath: filepath.Join(tmp, "gopath"), Workspace: ws})
+	if _, err := i.Eval(`import (
+	"example.com/host/api"
+	"example.com/plugin"
+)`); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := i.Eval(`var e api.Event = plugin.New()`); err != nil {
+		t.Fatal(err)
+	}
+	v, err := i.Eval(`e.Name`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v.String() != "plugin" {
+		t.Errorf("got %v, want plugin", v)
+	}
+}
+
+type bridgedEvent struct {
+	Name string
+	id   int
+}
+
+func (e bridgedEvent) String() string { return "binary " + e.Name }
+
+func TestImportPreferSource(t *testing.T) {
+	tmp, err := ioutil.TempDir("", "prefersource")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		_ = os.RemoveAll(tmp)
+	}()
+
+	// The source method refers to a field unknown to the binary type, and
+	// must not be compiled.
+	src := "package api\n\ntype Event struct{ Name string }\n\nfunc (e Event) String() string { return e.Label }\n\nfunc New(name string) Event { return Event{Name: name} }\n"
+	name := filepath.Join(tmp, "src", "example.com", "api", "api.go")
+	if err := os.MkdirAll(filepath.Dir(name), 0700); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(name, []byte(src), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	i := New(Options{GoPath: tmp, PreferSource: true, EagerCompile: true})
+	i.Use(Exports{
+		"example.com/api": {
+			"Event": reflect.ValueOf((*bridgedEvent)(nil)),
+			"New":   reflect.ValueOf(func(name string) bridgedEvent { return bridgedEvent{Name: "binary"} }),
+		},
+		"example.com/host": {
+			"Handle": reflect.ValueOf(func(e bridgedEvent) string { return e.String() }),
+		},
+	})
+	if _, err := i.Eval(`import (
+	"example.com/api"
+	"example.com/host"
+)`); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := i.Eval(`var e api.Event = api.New("source")`); err != nil {
+		t.Fatal(err)
+	}
+	v, err := i.Eval(`host.Handle(e)`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v.String() != "binary source" {
+		t.Errorf("got %v, want binary source", v)
+	}
+	v, err = i.Eval(`e.String()`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v.String() != "binary source" {
+		t.Errorf("got %v, want binary source", v)
+	}
+}
+
+func TestImportAmbiguity(t *testing.T) {
+	tmp, err := ioutil.TempDir("", "ambiguity")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		_ = os.RemoveAll(tmp)
+	}()
+
+	files := map[string]string{
+		"src/app/app.go":            "package app\n\nimport \"lib\"\n\nconst Name = lib.Name\n",
+		"src/app/vendor/lib/lib.go": "package lib\n\nconst Name = \"vendored\"\n",
+		"src/lib/lib.go":            "package lib\n\nconst Name = \"gopath\"\n",
+		"src/bin/bin.go":            "package bin\n",
+	}
+	for name, src := range files {
+		name = filepath.Join(tmp, name)
+		if err := os.MkdirAll(filepath.Dir(name), 0700); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(name, []byte(src), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var reports []AmbiguousImport
+	i := New(Options{GoPath: tmp, OnAmbiguousImport: func(a AmbiguousImport) { reports = append(reports, a) }})
+	i.Use(Exports{"bin": {"Name": reflect.ValueOf("binary")}})
+	if _, err := i.Eval(`import (
+	"app"
+	"bin"
+)`); err != nil {
+		t.Fatal(err)
+	}
+	v, err := i.Eval(`app.Name`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v.String() != "vendored" {
+		t.Errorf("got %v, want vendored", v)
+	}
+
+	want := []AmbiguousImport{
+		{Path: "lib", Importer: "app", Chosen: filepath.Join(tmp, "src/app/vendor/lib"), Candidates: []string{filepath.Join(tmp, "src/app/vendor/lib"), filepath.Join(tmp, "src/lib")}},
+		{Path: "bin", Importer: mainID, Chosen: BinaryLocation, Candidates: []string{BinaryLocation, filepath.Join(tmp, "src/bin")}},
+	}
+	if !reflect.DeepEqual(reports, want) {
+		t.Errorf("got %v, want %v", reports, want)
+	}
+}
+
+func TestReadModule(t *testing.T) {
+	tmp, err := ioutil.TempDir("", "module")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		_ = os.RemoveAll(tmp)
+	}()
+
+	files := map[string]string{
+		"app/go.mod":                           "module example.com/app\n\ngo 1.16\n\nrequire (\n\texample.com/Dep v1.0.0\n\texample.com/local v0.1.0 // indirect\n\texample.com/missing v1.2.0\n)\n\nreplace example.com/local => ../local\n",
+		"app/main.go":                          "package main\n",
+		"app/util/util.go":                     "package util\n\nimport \"example.com/Dep/dep\"\n\nconst Name = dep.Name\n",
+		"local/go.mod":                         "module example.com/local\n",
+		"local/local.go":                       "package local\n\nconst Name = \"local\"\n",
+		"cache/example.com/!dep@v1.0.0/go.mod": "module example.com/Dep\n",
+		"cache/example.com/!dep@v1.0.0/dep/dep.go": "package dep\n\nconst Name = \"dep\"\n",
+	}
+	for name, src := range files {
+		name = filepath.Join(tmp, name)
+		if err := os.MkdirAll(filepath.Dir(name), 0700); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(name, []byte(src), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	depDir := filepath.Join(tmp, "cache", "example.com", "!dep@v1.0.0")
+	sum, err := hashDir(depDir, "example.com/Dep@v1.0.0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	goSum := filepath.Join(tmp, "app", "go.sum")
+	if err := ioutil.WriteFile(goSum, []byte("example.com/Dep v1.0.0 "+sum+"\nexample.com/Dep v1.0.0/go.mod h1:xxx\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	defer os.Setenv("GOMODCACHE", os.Getenv("GOMODCACHE"))
+	if err := os.Setenv("GOMODCACHE", filepath.Join(tmp, "cache")); err != nil {
+		t.Fatal(err)
+	}
+
+	modules, err := ReadModule(filepath.Join(tmp, "app", "go.mod"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]string{
+		"example.com/app":   filepath.Join(tmp, "app"),
+		"example.com/Dep":   depDir,
+		"example.com/local": filepath.Join(tmp, "local"),
+	}
+	if !reflect.DeepEqual(modules, want) {
+		t.Fatalf("got %v, want %v", modules, want)
+	}
+
+	i := New(Options{Workspace: modules})
+	if _, err := i.Eval(`import (
+	"example.com/app/util"
+	"example.com/local"
+)`); err != nil {
+		t.Fatal(err)
+	}
+	v, err := i.Eval(`util.Name + local.Name`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v.String() != "deplocal" {
+		t.Errorf("got %v, want deplocal", v)
+	}
+
+	// A module modified in the cache does not match its go.sum checksum.
+	if err := ioutil.WriteFile(filepath.Join(depDir, "dep", "dep.go"), []byte("package dep\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ReadModule(filepath.Join(tmp, "app", "go.mod")); err == nil || !strings.Contains(err.Error(), "checksum mismatch") {
+		t.Errorf("got %v, want checksum mismatch error", err)
+	}
+
+	// The hash recorded by the go command in the module cache is trusted,
+	// instead of hashing the module content.
+	zipHash := filepath.Join(tmp, "cache", "cache", "download", "example.com", "!dep", "@v", "v1.0.0.ziphash")
+	if err := os.MkdirAll(filepath.Dir(zipHash), 0700); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(zipHash, []byte(sum+"\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ReadModule(filepath.Join(tmp, "app", "go.mod")); err != nil {
+		t.Errorf("got %v, want no error", err)
+	}
+	if err := ioutil.WriteFile(zipHash, []byte("h1:xxx\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ReadModule(filepath.Join(tmp, "app", "go.mod")); err == nil || !strings.Contains(err.Error(), "checksum mismatch") {
+		t.Errorf("got %v, want checksum mismatch error", err)
+	}
+}
+
+func TestPackagesInvalidate(t *testing.T) {
+	tmp, err := ioutil.TempDir("", "invalidate")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		_ = os.RemoveAll(tmp)
+	}()
+
+	name := filepath.Join(tmp, "src", "plugin", "plugin.go")
+	write := func(src string) {
+		if err := ioutil.WriteFile(name, []byte(src), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := os.MkdirAll(filepath.Dir(name), 0700); err != nil {
+		t.Fatal(err)
+	}
+	write("package plugin\n\nconst Version = 1\n")
+
+	i := New(Options{GoPath: tmp})
+	i.Use(Exports{"host": {"Name": reflect.ValueOf("host")}})
+	if _, err := i.Eval(`import "plugin"`); err != nil {
+		t.Fatal(err)
+	}
+
+	pkgs := map[string]PackageInfo{}
+	for _, p := range i.Packages() {
+		pkgs[p.Path] = p
+	}
+	if p := pkgs["host"]; p.Origin != OriginBinary || p.Loaded.IsZero() || p.Hash != "" {
+		t.Errorf("unexpected binary package %+v", p)
+	}
+	p := pkgs["plugin"]
+	if p.Name != "plugin" || p.Origin != OriginDir || p.Dir != filepath.Dir(name) || p.Loaded.IsZero() || len(p.Hash) != 64 {
+		t.Errorf("unexpected source package %+v", p)
+	}
+
+	write("package plugin\n\nconst Version = 2\n")
+	if !i.Invalidate("plugin") {
+		t.Fatal("plugin not invalidated")
+	}
+	if i.Invalidate("plugin") {
+		t.Error("plugin invalidated twice")
+	}
+	if _, err := i.Eval(`import v2 "plugin"`); err != nil {
+		t.Fatal(err)
+	}
+	v, err := i.Eval(`v2.Version`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v.Int() != 2 {
+		t.Errorf("got %v, want 2", v)
+	}
+	for _, q := range i.Packages() {
+		if q.Path == "plugin" && q.Hash == p.Hash {
+			t.Error("hash not updated")
+		}
+	}
+
+	// Binary packages are kept, as they can not be read again.
+	if _, err := i.Eval(`import "host"`); err != nil {
+		t.Fatal(err)
+	}
+	if i.Invalidate("host") {
+		t.Error("binary package invalidated")
+	}
+	if _, err := i.Eval(`import h2 "host"`); err != nil {
+		t.Fatal(err)
+	}
+	if v, err := i.Eval(`h2.Name`); err != nil || v.String() != "host" {
+		t.Errorf("got %v, %v, want host", v, err)
+	}
+}
+
+// makeArchives returns the tar, zip and gzip compressed tar archives of files.
+func makeArchives(t *testing.T, files map[string]string) (tarData, zipData, tgzData []byte) {
+	var tarBuf bytes.Buffer
+	tw := tar.NewWriter(&tarBuf)
+	var zipBuf bytes.Buffer
+	zw := zip.NewWriter(&zipBuf)
+	for name, src := range files {
+		if err := tw.WriteHeader(&tar.Header{Name: name, Mode: 0600, Size: int64(len(src)), Typeflag: tar.TypeReg}); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := tw.Write([]byte(src)); err != nil {
+			t.Fatal(err)
+		}
+		w, err := zw.Create(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := w.Write([]byte(src)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := tw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	var tgzBuf bytes.Buffer
+	gw := gzip.NewWriter(&tgzBuf)
+	if _, err := gw.Write(tarBuf.Bytes()); err != nil {
+		t.Fatal(err)
+	}
+	if err := gw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return tarBuf.Bytes(), zipBuf.Bytes(), tgzBuf.Bytes()
+}
+
+func TestEvalArchive(t *testing.T) {
+	tarData, zipData, tgzData := makeArchives(t, map[string]string{
+		"main.go":   "package main\n\nimport \"host\"\n\nfunc main() { host.Set(name) }\n",
+		"name.go":   "package main\n\nconst name = \"archive\"\n",
+		"README.md": "not a source file\n",
+	})
+
+	for _, test := range []struct {
+		desc   string
+		data   []byte
+		format ArchiveFormat
+	}{
+		{desc: "zip", data: zipData, format: ArchiveFormatOf("plugin.zip")},
+		{desc: "tar", data: tarData, format: ArchiveFormatOf("plugin.tar")},
+		{desc: "tgz", data: tgzData, format: ArchiveFormatOf("plugin.tgz")},
+		{desc: "detect zip", data: zipData},
+		{desc: "detect tar", data: tarData},
+		{desc: "detect tar.gz", data: tgzData},
+	} {
+		t.Run(test.desc, func(t *testing.T) {
+			var got string
+			i := New(Options{})
+			i.Use(Exports{"host": {"Set": reflect.ValueOf(func(s string) { got = s })}})
+			if _, err := i.EvalArchive(bytes.NewReader(test.data), test.format); err != nil {
+				t.Fatal(err)
+			}
+			if got != "archive" {
+				t.Errorf("got %q, want archive", got)
+			}
+		})
+	}
+
+	if _, err := New(Options{}).EvalArchive(strings.NewReader("package main\n"), ArchiveDetect); err == nil || err.Error() != "unknown archive format" {
+		t.Errorf("got %v, want unknown archive format error", err)
+	}
+}
+
+func TestImportArchive(t *testing.T) {
+	_, _, api := makeArchives(t, map[string]string{
+		"api.go": "package apiv1\n\ntype Event struct{ Name string }\n",
+	})
+	_, plugin, _ := makeArchives(t, map[string]string{
+		"plugin.go": "package plugin\n\nimport \"example.com/api\"\n\nfunc New() api.Event { return api.Event{Name: \"plugin\"} }\n",
+	})
+
+	i := New(Options{})
+	name, err := i.ImportArchive("example.com/api", bytes.NewReader(api), ArchiveOptions{Name: "api"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if name != "api" {
+		t.Errorf("got package name %q, want api", name)
+	}
+	if name, err = i.ImportArchive("example.com/plugin", bytes.NewReader(plugin), ArchiveOptions{Format: ArchiveZip}); err != nil {
+		t.Fatal(err)
+	}
+	if name != "plugin" {
+		t.Errorf("got package name %q, want plugin", name)
+	}
+	if _, ok := i.Symbols("example.com/plugin")["example.com/plugin"]["New"]; !ok {
+		t.Error("plugin.New not found in symbols")
+	}
+
+	if _, err := i.Eval(`import (
+	"example.com/api"
+	"example.com/plugin"
+)`); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := i.Eval(`var e api.Event = plugin.New()`); err != nil {
+		t.Fatal(err)
+	}
+	v, err := i.Eval(`e.Name`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v.String() != "plugin" {
+		t.Errorf("got %v, want plugin", v)
+	}
+
+	if _, err := i.ImportArchive("example.com/api", bytes.NewReader(api), ArchiveOptions{}); err == nil {
+		t.Error("want error on archive imported twice")
+	}
+}
+
+func TestCompilePackage(t *testing.T) {
+	i := New(Options{})
+	name, err := i.CompilePackage("db/scripts/greet", map[string]string{
+		"greet.go":     "package greet\n\nimport \"db/scripts/greet/util\"\n\nfunc Hello(s string) string { return util.Prefix + s }\n",
+		"util/util.go": "package util\n\nconst Prefix = \"hello \"\n",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if name != "greet" {
+		t.Errorf("got package name %q, want greet", name)
+	}
+	if _, err := i.Eval(`import "db/scripts/greet"`); err != nil {
+		t.Fatal(err)
+	}
+	v, err := i.Eval(`greet.Hello("db")`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v.String() != "hello db" {
+		t.Errorf("got %v, want hello db", v)
+	}
+
+	if _, err := i.CompilePackage("db/scripts/greet", map[string]string{"greet.go": "package greet\n"}); err == nil {
+		t.Error("want error on package compiled twice")
+	}
+	if _, err := i.CompilePackage("db/scripts/bad", map[string]string{"bad.go": "package bad\n\nvar X int = \"\"\n"}); err == nil {
+		t.Error("want compile error")
+	}
+}
+
+func TestArchivePackages(t *testing.T) {
+	_, _, tgz := makeArchives(t, map[string]string{
+		"go.mod":            "module example.com/bundle\n",
+		"main.go":           "package main\n\nimport (\n\t\"example.com/bundle/util\"\n\t\"host\"\n)\n\nfunc main() { host.Set(util.Greet(\"bundle\")) }\n",
+		"util/util.go":      "package util\n\nimport (\n\t\"dep\"\n\t\"strings\"\n)\n\nfunc Greet(s string) string { return dep.Hello + \" \" + strings.ToUpper(s) }\n",
+		"vendor/dep/dep.go": "package dep\n\nconst Hello = \"hello\"\n",
+	})
+
+	var got string
+	i := New(Options{})
+	i.Use(Exports{
+		"host":    {"Set": reflect.ValueOf(func(s string) { got = s })},
+		"dep":     {"Hello": reflect.ValueOf("binary")},
+		"strings": {"ToUpper": reflect.ValueOf(strings.ToUpper)},
+	})
+	if _, err := i.EvalArchive(bytes.NewReader(tgz), ArchiveDetect); err != nil {
+		t.Fatal(err)
+	}
+	if got != "hello BUNDLE" {
+		t.Errorf("got %q, want hello BUNDLE", got)
+	}
+
+	_, plugin, _ := makeArchives(t, map[string]string{
+		"plugin.go":  "package plugin\n\nimport \"example.com/plugin/sub\"\n\nvar Name = sub.Name\n",
+		"sub/sub.go": "package sub\n\nconst Name = \"sub\"\n",
+	})
+	if _, err := i.ImportArchive("example.com/plugin", bytes.NewReader(plugin), ArchiveOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := i.Symbols("example.com/plugin/sub")["example.com/plugin/sub"]["Name"]; !ok {
+		t.Error("sub.Name not found in symbols")
+	}
+}
+
+func TestImportArchiveVersions(t *testing.T) {
+	archive := func(version string) []byte {
+		_, zip, _ := makeArchives(t, map[string]string{
+			"go.mod":             "module example.com/plugin\n",
+			"plugin.go":          "package plugin\n\nimport (\n\t\"dep\"\n\t\"example.com/plugin/state\"\n)\n\nfunc Incr() int { state.N++; return state.N }\n\nfunc Version() string { return dep.Version }\n",
+			"state/state.go":     "package state\n\nvar N int\n",
+			"vendor/dep/dep.go":  "package dep\n\nconst Version = \"" + version + "\"\n",
+			"vendor/modules.txt": "# dep " + version + "\n",
+		})
+		return zip
+	}
+
+	i := New(Options{})
+	for _, v := range []string{"v1", "v2"} {
+		if _, err := i.ImportArchive("example.com/plugin@"+v, bytes.NewReader(archive(v)), ArchiveOptions{}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := i.Eval(`import (
+	p1 "example.com/plugin@v1"
+	p2 "example.com/plugin@v2"
+)`); err != nil {
+		t.Fatal(err)
+	}
+
+	// Each version has its own dependencies and globals.
+	for _, test := range []struct {
+		expr string
+		want interface{}
+	}{
+		{`p1.Version()`, "v1"},
+		{`p2.Version()`, "v2"},
+		{`p1.Incr()`, 1},
+		{`p1.Incr()`, 2},
+		{`p2.Incr()`, 1},
+	} {
+		v, err := i.Eval(test.expr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if v.Interface() != test.want {
+			t.Errorf("%s: got %v, want %v", test.expr, v, test.want)
+		}
+	}
+	for _, path := range []string{"example.com/plugin@v1/state", "example.com/plugin@v2/vendor/dep"} {
+		if i.Symbols(path)[path] == nil {
+			t.Errorf("package %s not found", path)
+		}
+	}
+}
+
+func TestImportManyFiles(t *testing.T) {
+	goPath, err := ioutil.TempDir("", "manyfiles")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		_ = os.RemoveAll(goPath)
+	}()
+
+	// The files are initialized in name order, whatever the order of parsing.
+	dir := filepath.Join(goPath, "src", "many")
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		t.Fatal(err)
+	}
+	var want []string
+	for n := 0; n < 100; n++ {
+		name := fmt.Sprintf("f%03d", n)
+		want = append(want, name)
+		src := fmt.Sprintf("package many\n\nfunc init() { Order = append(Order, %q) }\n", name)
+		if n == 0 {
+			src += "\nvar Order []string\n"
+		}
+		if err := ioutil.WriteFile(filepath.Join(dir, name+".go"), []byte(src), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	i := New(Options{GoPath: goPath})
+	if _, err := i.Eval(`import "many"`); err != nil {
+		t.Fatal(err)
+	}
+	res, err := i.Eval(`many.Order`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := res.Interface(); !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestImportAnonymous(t *testing.T) {
+	tmp, err := ioutil.TempDir("", "anonymous")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		_ = os.RemoveAll(tmp)
+	}()
+
+	// Two scripts out of GOPATH import packages of the same relative paths.
+	files := map[string]string{
+		"a/a.go":                            "package main\n\nimport \"./lib\"\n\nvar A = lib.Name()\n",
+		"a/lib/lib.go":                      "package lib\n\nimport (\n\t\"example.com/dep\"\n\n\t\"../util\"\n)\n\nfunc Name() string { return \"a\" + util.Sep + dep.Name }\n",
+		"a/util/util.go":                    "package util\n\nconst Sep = \"-\"\n",
+		"b/b.go":                            "package main\n\nimport \"./lib\"\n\nvar B = lib.Name()\n",
+		"b/lib/lib.go":                      "package lib\n\nfunc Name() string { return \"b\" }\n",
+		"c/main.go":                         "package main\n\nimport \"example.com/missing\"\n\nvar Name = missing.Name\n",
+		"gopath/src/example.com/dep/dep.go": "package dep\n\nconst Name = \"dep\"\n",
+	}
+	for name, src := range files {
+		name = filepath.Join(tmp, name)
+		if err := os.MkdirAll(filepath.Dir(name), 0700); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(name, []byte(src), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	i := New(Options{GoPath: filepath.Join(tmp, "gopath")})
+	for _, test := range []struct{ file, name string }{{"a/a.go", "a-dep"}, {"b/b.go", "b"}} {
+		if _, err := i.EvalPath(filepath.Join(tmp, test.file)); err != nil {
+			t.Fatal(err)
+		}
+		v, err := i.Eval(strings.ToUpper(test.file[:1]))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if v.String() != test.name {
+			t.Errorf("got %v, want %s", v, test.name)
+		}
+	}
+	names := i.PackageNames()
+	for _, dir := range []string{"a/lib", "a/util", "b/lib"} {
+		path := "_/" + strings.TrimPrefix(filepath.ToSlash(filepath.Join(tmp, dir)), "/")
+		if names[path] == "" {
+			t.Errorf("package %s not registered in %v", path, names)
+		}
+	}
+
+	_, err = i.EvalPath(filepath.Join(tmp, "c", "main.go"))
+	if err == nil || !strings.Contains(err.Error(), `unable to find source related to: "example.com/missing"`) {
+		t.Errorf("got error %v", err)
+	}
+}
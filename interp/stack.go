@@ -0,0 +1,102 @@
+package interp
+
+import (
+	"bytes"
+	"runtime"
+	"strconv"
+)
+
+// Stack returns the interpreted calls of the calling goroutine, innermost
+// first, up to the first call from binary code or the start of the goroutine.
+// It is intended to be called by the binary functions called from interpreted
+// code, such as a logger, and returns nil if Options.CallStack is not set or
+// the caller is not called from interpreted code. The position of each frame
+// is the one of the call it is executing.
+func (interp *Interpreter) Stack() []DebugFrame {
+	if !interp.callStack {
+		return nil
+	}
+	f, ok := interp.stacks.Load(goroutineID())
+	if !ok {
+		return nil
+	}
+	return interp.frameStack(f.(*frame))
+}
+
+// frameStack returns the calls of frame f and its callers, innermost first.
+func (interp *Interpreter) frameStack(f *frame) []DebugFrame {
+	var stack []DebugFrame
+	for f != nil {
+		fd := f.debug
+		if fd == nil {
+			break
+		}
+		df := DebugFrame{Pos: fd.pos}
+		if fd.def != nil {
+			df.Func = interp.defName(fd.def)
+		}
+		stack = append(stack, df)
+		f = fd.caller
+	}
+	return stack
+}
+
+// trackFrames returns true if the calling frames of interpreted functions
+// are recorded, for the debugger or Options.CallStack.
+func (interp *Interpreter) trackFrames() bool {
+	return interp.debugger != nil || interp.callStack
+}
+
+// wrapCall makes the call expression n, just compiled, record its position
+// in the calling frame and, for a call to a binary function, the calling
+// frame of the goroutine, so that Stack can walk the interpreted calls.
+func (interp *Interpreter) wrapCall(n *node) {
+	if n.kind != callExpr || !isCall(n) || n.exec == nil || !n.pos.IsValid() {
+		return
+	}
+	if k := n.anc.kind; k == deferStmt || k == goStmt {
+		return
+	}
+	exec := n.exec
+	pos := interp.fset.Position(n.pos)
+	if !isBinCall(n) {
+		n.exec = func(f *frame) bltn {
+			if f.debug == nil {
+				f.debug = &frameDebug{}
+			}
+			f.debug.pos = pos
+			return exec(f)
+		}
+		return
+	}
+	n.exec = func(f *frame) bltn {
+		if f.debug == nil {
+			f.debug = &frameDebug{}
+		}
+		f.debug.pos = pos
+		id := goroutineID()
+		prev, ok := interp.stacks.Load(id)
+		interp.stacks.Store(id, f)
+		defer func() {
+			if ok {
+				interp.stacks.Store(id, prev)
+			} else {
+				interp.stacks.Delete(id)
+			}
+		}()
+		return exec(f)
+	}
+}
+
+// goroutineID returns the id of the calling goroutine, as printed in its
+// stack trace.
+func goroutineID() uint64 {
+	var buf [64]byte
+	b := buf[:runtime.Stack(buf[:], false)]
+	b = bytes.TrimPrefix(b, []byte("goroutine "))
+	if i := bytes.IndexByte(b, ' '); i > 0 {
+		b = b[:i]
+	}
+	id, _ := strconv.ParseUint(string(b), 10, 64)
+	return id
+}
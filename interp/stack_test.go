@@ -0,0 +1,86 @@
+package interp
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestStack(t *testing.T) {
+	i := New(Options{CallStack: true})
+	var stack []DebugFrame
+	i.Use(Exports{"host": {"Where": reflect.ValueOf(func() { stack = i.Stack() })}})
+	if _, err := i.Eval(`
+import "host"
+
+type T struct{}
+
+func (T) m() { host.Where() }
+
+func f(x int) {
+	if x > 0 {
+		f(x - 1)
+		return
+	}
+	func() { T{}.m() }()
+}`); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := i.Eval("f(1)"); err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, f := range stack {
+		got = append(got, fmt.Sprintf("%s %s:%d", f.Func, f.Pos.Filename, f.Pos.Line))
+	}
+	want := []string{
+		"(main.T).m _.go:6",
+		"main.f.func1 _.go:13",
+		"main.f _.go:13",
+		"main.f _.go:10",
+		" _.go:1",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+
+	if s := New(Options{}).Stack(); s != nil {
+		t.Fatalf("got %v, want no stack", s)
+	}
+	if s := i.Stack(); s != nil {
+		t.Fatalf("got %v outside interpreted code, want no stack", s)
+	}
+}
+
+func TestPanicFormat(t *testing.T) {
+	i := New(Options{CallStack: true})
+	if _, err := i.Eval(`
+func g() { panic("boom") }
+
+func f() { g() }`); err != nil {
+		t.Fatal(err)
+	}
+	_, err := i.Eval("f()")
+	var p Panic
+	if !errors.As(err, &p) {
+		t.Fatalf("got %v, want a Panic", err)
+	}
+	if s := fmt.Sprintf("%v", err); s != "boom" {
+		t.Fatalf("got %q, want boom", s)
+	}
+	want := "boom\ng\n\t_.go:2:12\nf\n\t_.go:4:12\n\t_.go:1:1\n"
+	if s := fmt.Sprintf("%+v", err); s != want {
+		t.Fatalf("got %q, want %q", s, want)
+	}
+
+	_, err = i.Eval("s := []int{}; s[1] = 0")
+	var re *RuntimeError
+	if !errors.As(err, &re) {
+		t.Fatalf("got %v, want a *RuntimeError", err)
+	}
+	if s := fmt.Sprintf("%+v", re); !strings.HasPrefix(s, "runtime error: index out of range\n\t_.go:") {
+		t.Fatalf("unexpected trace %q", s)
+	}
+}
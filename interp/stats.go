@@ -0,0 +1,191 @@
+package interp
+
+import (
+	"reflect"
+	"sort"
+	"sync/atomic"
+	"time"
+)
+
+// PackageStats reports the time spent in each phase of the import of a source
+// package. Durations exclude the import of its dependencies, which have their
+// own statistics.
+type PackageStats struct {
+	Path  string // import path of the package
+	Files int    // number of source files compiled
+
+	Read  time.Duration // reading source files
+	Parse time.Duration // parsing source files
+	GTA   time.Duration // global types analysis: declarations and signatures
+	CFG   time.Duration // compilation of package level code and function bodies
+	Init  time.Duration // initialization of package variables and init functions
+}
+
+// Total returns the total time spent importing the package, excluding its
+// dependencies.
+func (s PackageStats) Total() time.Duration {
+	return s.Read + s.Parse + s.GTA + s.CFG + s.Init
+}
+
+// Stats returns the statistics of the source packages imported so far by the
+// interpreter, sorted by import path. Function bodies compiled on first use
+// (see Options.EagerCompile) are accounted in the CFG duration of the package
+// using them. See CallStats for the statistics of calls to binary functions.
+func (interp *Interpreter) Stats() []PackageStats {
+	interp.mutex.RLock()
+	defer interp.mutex.RUnlock()
+
+	stats := make([]PackageStats, 0, len(interp.stats))
+	for _, s := range interp.stats {
+		stats = append(stats, *s)
+	}
+	sort.Slice(stats, func(i, j int) bool { return stats[i].Path < stats[j].Path })
+	return stats
+}
+
+// CallStats reports the calls made by interpreted code to a function or
+// method of a binary package, to tell the time spent in host code from the
+// time spent interpreting.
+type CallStats struct {
+	Name  string        // qualified name of the function, such as "strings.Split" or "(*bytes.Buffer).Write"
+	Calls int64         // number of calls
+	Time  time.Duration // total duration of the calls, including the functions they call back
+}
+
+// callStats are the counters of the calls to a binary function.
+type callStats struct {
+	calls int64 // updated atomically
+	time  int64 // nanoseconds, updated atomically
+}
+
+// CallStats returns the statistics of the calls from interpreted code to
+// binary functions and methods, if Options.CollectCallStats is set, sorted by
+// decreasing total duration. Only calls whose callee is known at compile time
+// are accounted, not calls of binary function values.
+func (interp *Interpreter) CallStats() []CallStats {
+	interp.mutex.RLock()
+	defer interp.mutex.RUnlock()
+
+	stats := make([]CallStats, 0, len(interp.callStats))
+	for name, c := range interp.callStats {
+		stats = append(stats, CallStats{
+			Name:  name,
+			Calls: atomic.LoadInt64(&c.calls),
+			Time:  time.Duration(atomic.LoadInt64(&c.time)),
+		})
+	}
+	sort.Slice(stats, func(i, j int) bool {
+		if stats[i].Time != stats[j].Time {
+			return stats[i].Time > stats[j].Time
+		}
+		return stats[i].Name < stats[j].Name
+	})
+	return stats
+}
+
+// timeCalls returns callFn, the call of the binary function of the call node
+// n, measuring the calls in the statistics of the function.
+func (interp *Interpreter) timeCalls(n *node, callFn func(reflect.Value, []reflect.Value) []reflect.Value) func(reflect.Value, []reflect.Value) []reflect.Value {
+	name := binCallName(n.child[0])
+	interp.mutex.Lock()
+	if interp.callStats == nil {
+		interp.callStats = map[string]*callStats{}
+	}
+	c, ok := interp.callStats[name]
+	if !ok {
+		c = &callStats{}
+		interp.callStats[name] = c
+	}
+	interp.mutex.Unlock()
+
+	return func(v reflect.Value, in []reflect.Value) []reflect.Value {
+		start := time.Now()
+		defer func() {
+			atomic.AddInt64(&c.calls, 1)
+			atomic.AddInt64(&c.time, int64(time.Since(start)))
+		}()
+		return callFn(v, in)
+	}
+}
+
+// binCallName returns the qualified name of the binary function or method
+// designated by the callee node n.
+func binCallName(n *node) string {
+	if n.kind != selectorExpr {
+		return n.ident
+	}
+	name := n.child[1].ident
+	if x := n.child[0]; x.typ != nil && x.typ.cat == binPkgT {
+		return x.typ.path + "." + name
+	}
+	if n.recv == nil || n.recv.node.typ == nil {
+		return name
+	}
+	t := n.recv.node.typ.TypeOf()
+	if t == nil {
+		return name
+	}
+	if t.Kind() == reflect.Ptr {
+		return "(" + t.String() + ")." + name
+	}
+	return t.String() + "." + name
+}
+
+// importTimer measures the phases of the import of a package.
+type importTimer struct {
+	interp *Interpreter
+	stats  PackageStats
+	start  time.Time     // start of the import
+	last   time.Time     // end of the last phase
+	base   time.Duration // imports time at start, see Interpreter.importTime
+	nested time.Duration // imports time at end of the last phase
+	depth  int           // depth of the package in the import, see ImportProgress
+	files  int           // number of source files, once read, see ImportEvent
+}
+
+func (interp *Interpreter) newImportTimer(importPath string) *importTimer {
+	now := time.Now()
+	t := &importTimer{
+		interp: interp,
+		stats:  PackageStats{Path: importPath},
+		start:  now,
+		last:   now,
+		base:   interp.importTime,
+		nested: interp.importTime,
+	}
+	t.startProgress()
+	t.event(ImportStart, "", 0, nil)
+	return t
+}
+
+// lap adds to d the time elapsed since the end of the last phase, minus the
+// time spent importing dependencies meanwhile, and returns this time.
+func (t *importTimer) lap(d *time.Duration) time.Duration {
+	now := time.Now()
+	elapsed := now.Sub(t.last) - (t.interp.importTime - t.nested)
+	*d += elapsed
+	t.last, t.nested = now, t.interp.importTime
+	return elapsed
+}
+
+// done ends the import with the error *err, journals it if Options.Audit is
+// set, and records its statistics if *err is nil. Imports are nested, so the
+// total time of imports is restored to its value at start, plus the duration
+// of this import and its dependencies.
+func (t *importTimer) done(err *error) {
+	t.interp.importTime = t.base + time.Since(t.start)
+	if t.interp.audit != nil {
+		t.interp.auditImport(t, err)
+	}
+	t.event(ImportDone, "", t.stats.Total(), *err)
+	if *err != nil {
+		return
+	}
+
+	t.interp.mutex.Lock()
+	if t.interp.stats == nil {
+		t.interp.stats = map[string]*PackageStats{}
+	}
+	t.interp.stats[t.stats.Path] = &t.stats
+	t.interp.mutex.Unlock()
+}
@@ -0,0 +1,186 @@
+package interp
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"reflect"
+	"testing"
+)
+
+func TestStdioRedirection(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+	i := New(Options{Stdout: &stdout, Stderr: &stderr})
+
+	// The stdio packages are redirected even when exported separately.
+	i.Use(Exports{
+		"log": {
+			"Println":   reflect.ValueOf(log.Println),
+			"SetFlags":  reflect.ValueOf(log.SetFlags),
+			"SetPrefix": reflect.ValueOf(log.SetPrefix),
+		},
+		"os": {
+			"Stdout": reflect.ValueOf(&os.Stdout).Elem(),
+			"Stderr": reflect.ValueOf(&os.Stderr).Elem(),
+		},
+	})
+	if _, err := i.Eval(`
+import (
+	"log"
+	"os"
+)
+
+func init() {
+	log.SetFlags(0)
+	log.SetPrefix("script: ")
+	os.Stdout.Write([]byte("out\n"))
+	log.Println("err")
+}
+`); err != nil {
+		t.Fatal(err)
+	}
+
+	// Using another package preserves the state of the redirected ones.
+	i.Use(Exports{"fmt": {"Sprint": reflect.ValueOf(fmt.Sprint)}})
+	for _, src := range []string{`import "fmt"`, `fmt.Println("fmt")`, `log.Println("log")`} {
+		if _, err := i.Eval(src); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if got, want := stdout.String(), "out\nfmt\n"; got != want {
+		t.Errorf("got stdout %q, want %q", got, want)
+	}
+	if got, want := stderr.String(), "script: err\nscript: log\n"; got != want {
+		t.Errorf("got stderr %q, want %q", got, want)
+	}
+}
+
+func globalsExports() Exports {
+	return Exports{
+		"flag": {
+			"CommandLine": reflect.ValueOf(&flag.CommandLine).Elem(),
+			"Lookup":      reflect.ValueOf(flag.Lookup),
+			"String":      reflect.ValueOf(flag.String),
+			"Usage":       reflect.ValueOf(&flag.Usage).Elem(),
+		},
+		"log": {
+			"Fatal":     reflect.ValueOf(log.Fatal),
+			"Println":   reflect.ValueOf(log.Println),
+			"SetFlags":  reflect.ValueOf(log.SetFlags),
+			"SetPrefix": reflect.ValueOf(log.SetPrefix),
+		},
+	}
+}
+
+func TestGlobalsVirtualization(t *testing.T) {
+	var stderr bytes.Buffer
+	i := New(Options{Stderr: &stderr})
+	i.Use(globalsExports())
+	if _, err := i.Eval(`
+import (
+	"flag"
+	"log"
+)
+
+var name = flag.String("yaegi-name", "x", "name")
+
+func init() {
+	flag.Usage = func() {}
+	log.SetFlags(0)
+	log.SetPrefix("script: ")
+	log.Println("hello", flag.CommandLine.Lookup("yaegi-name") != nil, flag.Lookup("yaegi-name") != nil)
+}
+`); err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := stderr.String(), "script: hello true true\n"; got != want {
+		t.Errorf("got stderr %q, want %q", got, want)
+	}
+	if flag.Lookup("yaegi-name") != nil {
+		t.Error("flag defined in the host")
+	}
+	if flag.Usage == nil || log.Prefix() == "script: " {
+		t.Error("globals of the host modified")
+	}
+	if _, err := i.Eval(`log.Fatal("fatal")`); err == nil {
+		t.Error("expected a panic")
+	}
+}
+
+func TestSharedGlobals(t *testing.T) {
+	i := New(Options{SharedGlobals: true})
+	i.Use(globalsExports())
+	for _, src := range []string{`import "flag"`, `import "log"`, `flag.String("yaegi-shared", "", "")`} {
+		if _, err := i.Eval(src); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if flag.Lookup("yaegi-shared") == nil {
+		t.Error("flag not defined in the host")
+	}
+	// log.Fatal still panics instead of exiting.
+	if _, err := i.Eval(`log.Fatal("fatal")`); err == nil {
+		t.Error("expected a panic")
+	}
+}
+
+func TestFlagParse(t *testing.T) {
+	exports := Exports{
+		"flag": {
+			"Args":  reflect.ValueOf(flag.Args),
+			"Int":   reflect.ValueOf(flag.Int),
+			"Parse": reflect.ValueOf(flag.Parse),
+		},
+		"os": {
+			"Args": reflect.ValueOf(&os.Args).Elem(),
+		},
+	}
+	src := `
+import (
+	"flag"
+	"os"
+)
+
+var n = flag.Int("n", 1, "n")
+
+func f() (string, int, []string) {
+	flag.Parse()
+	return os.Args[0], *n, flag.Args()
+}
+`
+	for _, test := range []struct {
+		args []string
+		name string
+		n    int
+		rest []string
+	}{
+		// The flags of the test binary are ignored.
+		{nil, os.Args[0], 1, nil},
+		{[]string{"prog", "-n", "3", "a"}, "prog", 3, []string{"a"}},
+		{[]string{"prog", "-n=4"}, "prog", 4, nil},
+	} {
+		i := New(Options{Args: test.args})
+		i.Use(exports)
+		if _, err := i.Eval(src); err != nil {
+			t.Fatal(err)
+		}
+		v, err := i.Eval(`f`)
+		if err != nil {
+			t.Fatal(err)
+		}
+		out := v.Call(nil)
+		if name := out[0].String(); name != test.name {
+			t.Errorf("got os.Args[0] %q, want %q", name, test.name)
+		}
+		if n := int(out[1].Int()); n != test.n {
+			t.Errorf("got n %d, want %d", n, test.n)
+		}
+		if rest := out[2].Interface().([]string); fmt.Sprint(rest) != fmt.Sprint(test.rest) {
+			t.Errorf("got args %q, want %q", rest, test.rest)
+		}
+	}
+}
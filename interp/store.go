@@ -0,0 +1,106 @@
+package interp
+
+import (
+	"reflect"
+	"sync"
+	"time"
+)
+
+// StorePath is the import path of the package giving interpreted code access
+// to the key-value store set in Options.
+const StorePath = "yaegi/kv"
+
+// Store is a key-value store implemented by the host, for example in memory
+// or on top of a database, to let interpreted code persist state without
+// access to the file system or network.
+//
+// From interpreted code, it is used as follows:
+//
+//	import "yaegi/kv"
+//
+//	err := kv.Set("counter", []byte("1"), time.Hour)
+//	v, ok, err := kv.Get("counter")
+//	err = kv.Delete("counter")
+//
+type Store interface {
+	// Get returns the value of key, and false if the key does not exist or has expired.
+	Get(key string) (value []byte, ok bool, err error)
+
+	// Set sets the value of key. If ttl is greater than zero, the key expires
+	// after this duration.
+	Set(key string, value []byte, ttl time.Duration) error
+
+	// Delete removes key. Deleting a non existing key is not an error.
+	Delete(key string) error
+}
+
+// storeExports returns the symbols of the store package bound to s.
+func storeExports(s Store) Exports {
+	return Exports{
+		StorePath: {
+			"Get":    reflect.ValueOf(s.Get),
+			"Set":    reflect.ValueOf(s.Set),
+			"Delete": reflect.ValueOf(s.Delete),
+		},
+	}
+}
+
+// MemStore is a Store holding values in memory. The zero value is ready to use.
+type MemStore struct {
+	mu   sync.Mutex
+	data map[string]memEntry
+	now  func() time.Time // for testing, defaults to time.Now
+}
+
+type memEntry struct {
+	value  []byte
+	expire time.Time // zero if no expiration
+}
+
+func (m *MemStore) time() time.Time {
+	if m.now != nil {
+		return m.now()
+	}
+	return time.Now()
+}
+
+// Get implements Store.
+func (m *MemStore) Get(key string) ([]byte, bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	e, ok := m.data[key]
+	if !ok {
+		return nil, false, nil
+	}
+	if !e.expire.IsZero() && !m.time().Before(e.expire) {
+		delete(m.data, key)
+		return nil, false, nil
+	}
+	return append([]byte(nil), e.value...), true, nil
+}
+
+// Set implements Store.
+func (m *MemStore) Set(key string, value []byte, ttl time.Duration) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.data == nil {
+		m.data = map[string]memEntry{}
+	}
+	e := memEntry{value: append([]byte(nil), value...)}
+	if ttl > 0 {
+		e.expire = m.time().Add(ttl)
+	}
+	m.data[key] = e
+	return nil
+}
+
+// Delete implements Store.
+func (m *MemStore) Delete(key string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	delete(m.data, key)
+	return nil
+}
@@ -0,0 +1,61 @@
+package interp_test
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/traefik/yaegi/interp"
+	"github.com/traefik/yaegi/stdlib"
+)
+
+func TestStore(t *testing.T) {
+	store := &interp.MemStore{}
+	now := time.Now()
+	store.SetClock(func() time.Time { return now })
+
+	var out bytes.Buffer
+	i := interp.New(interp.Options{Store: store, Stdout: &out})
+	i.Use(stdlib.Symbols)
+
+	eval(t, i, `
+import (
+	"fmt"
+	"time"
+	"yaegi/kv"
+)
+
+func incr(key string) {
+	n := 0
+	if v, ok, _ := kv.Get(key); ok {
+		fmt.Sscan(string(v), &n)
+	}
+	n++
+	kv.Set(key, []byte(fmt.Sprint(n)), time.Minute)
+}
+`)
+	eval(t, i, `incr("count"); incr("count")`)
+
+	if v, ok, _ := store.Get("count"); !ok || string(v) != "2" {
+		t.Fatalf("got %q %v, want %q", v, ok, "2")
+	}
+
+	now = now.Add(2 * time.Minute)
+	if _, ok, _ := store.Get("count"); ok {
+		t.Fatal("key should have expired")
+	}
+
+	eval(t, i, `incr("count"); kv.Set("x", []byte("y"), 0); kv.Delete("x")`)
+	if v, ok, _ := store.Get("count"); !ok || string(v) != "1" {
+		t.Fatalf("got %q %v, want %q", v, ok, "1")
+	}
+	if _, ok, _ := store.Get("x"); ok {
+		t.Fatal("key should have been deleted")
+	}
+
+	// The store package is not available if no store is set.
+	i = interp.New(interp.Options{})
+	if _, err := i.Eval(`import "yaegi/kv"`); err == nil {
+		t.Fatal("expected import error")
+	}
+}
@@ -0,0 +1,95 @@
+package interp
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sync"
+)
+
+// StreamPath is the import path of the package allowing interpreted code to
+// send intermediate results to the host during EvalStream.
+const StreamPath = "yaegi/stream"
+
+// ErrNoStream is returned by stream.Yield when called outside of EvalStream.
+var ErrNoStream = errors.New("no stream consumer")
+
+// A Stream delivers the values yielded by interpreted code as they are produced.
+type Stream struct {
+	// C receives the values passed to stream.Yield by interpreted code.
+	// It is closed when the evaluation completes.
+	C <-chan interface{}
+
+	done chan struct{}
+	res  reflect.Value
+	err  error
+}
+
+// Wait discards the values remaining in the stream, waits for the end of the
+// evaluation and returns its result.
+func (s *Stream) Wait() (reflect.Value, error) {
+	for range s.C {
+	}
+	<-s.done
+	return s.res, s.err
+}
+
+// EvalStream evaluates Go code represented as a string, like EvalWithContext,
+// in a separate goroutine. Interpreted code sends intermediate results to the
+// returned Stream by calling Yield from the "yaegi/stream" package:
+//
+//	import "yaegi/stream"
+//
+//	for _, v := range data {
+//		if err := stream.Yield(transform(v)); err != nil {
+//			return
+//		}
+//	}
+//
+// Yield blocks until the value is received from Stream.C, or ctx is done, in
+// which case it returns the context error. Only one EvalStream must be active
+// at a time on an interpreter.
+func (interp *Interpreter) EvalStream(ctx context.Context, src string) *Stream {
+	c := make(chan interface{})
+	s := &Stream{C: c, done: make(chan struct{})}
+
+	// The evaluation may still be running when EvalWithContext returns on
+	// cancellation, the stream is closed only once no Yield is in progress.
+	var mu sync.Mutex
+	closed, quit := false, make(chan struct{})
+
+	interp.yield.Store(func(v interface{}) error {
+		mu.Lock()
+		defer mu.Unlock()
+		if closed {
+			return ErrNoStream
+		}
+		select {
+		case c <- v:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-quit:
+			return ErrNoStream
+		}
+	})
+
+	go func() {
+		s.res, s.err = interp.EvalWithContext(ctx, src)
+		close(quit)
+		mu.Lock()
+		closed = true
+		close(c)
+		mu.Unlock()
+		close(s.done)
+	}()
+	return s
+}
+
+// yieldValue implements stream.Yield.
+func (interp *Interpreter) yieldValue(v interface{}) error {
+	if y, ok := interp.yield.Load().(func(interface{}) error); ok {
+		return y(v)
+	}
+	return ErrNoStream
+}
@@ -0,0 +1,54 @@
+package interp_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/traefik/yaegi/interp"
+)
+
+func TestEvalStream(t *testing.T) {
+	i := interp.New(interp.Options{})
+
+	eval(t, i, `import "yaegi/stream"`)
+	assertEval(t, i, `stream.Yield(1) == stream.ErrNoStream`, "", "true")
+	if _, err := i.Eval(`stream.ErrNoStream = nil`); err == nil || interp.ErrNoStream == nil {
+		t.Fatal("stream.ErrNoStream is settable by interpreted code")
+	}
+
+	s := i.EvalStream(context.Background(), `
+n := 0
+for k := 0; k < 5; k++ {
+	stream.Yield(k * k)
+	n++
+}
+n`)
+	var got []int
+	for v := range s.C {
+		got = append(got, v.(int))
+	}
+	if len(got) != 5 || got[4] != 16 {
+		t.Errorf("got %v, want squares", got)
+	}
+	res, err := s.Wait()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Interface() != 5 {
+		t.Errorf("got %v, want 5", res)
+	}
+
+	// The evaluation completes even if the stream is not consumed.
+	ctx, cancel := context.WithCancel(context.Background())
+	s = i.EvalStream(ctx, `
+err := stream.Yield("x")
+for err == nil {
+	err = stream.Yield("x")
+}
+err`)
+	<-s.C
+	cancel()
+	if _, err := s.Wait(); err != context.Canceled {
+		t.Errorf("got error %v, want %v", err, context.Canceled)
+	}
+}
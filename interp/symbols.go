@@ -0,0 +1,195 @@
+package interp
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+)
+
+// Kinds of the symbols exported by a package, see SymbolInfo.
+const (
+	KindConst = "const"
+	KindFunc  = "func"
+	KindType  = "type"
+	KindVar   = "var"
+)
+
+// SymbolInfo describes a symbol exported by a package.
+type SymbolInfo struct {
+	Name  string        // symbol name
+	Kind  string        // KindConst, KindFunc, KindType or KindVar
+	Value reflect.Value // symbol value, as returned by Symbols
+}
+
+// SymbolMatch is a symbol found by Lookup.
+type SymbolMatch struct {
+	Path string // import path of the package
+	SymbolInfo
+
+	// Signature is the type of the symbol, such as "func(string) *Handler",
+	// with the types of its package unqualified. The one of a type symbol is
+	// its underlying type, where struct and interface types are elided as
+	// "struct{...}" and "interface{...}".
+	Signature string
+}
+
+// infoKinds maps the kinds of source symbols to the kinds of SymbolInfo.
+var infoKinds = map[sKind]string{
+	constSym: KindConst,
+	funcSym:  KindFunc,
+	typeSym:  KindType,
+	varSym:   KindVar,
+}
+
+// PackageSymbols returns the symbols exported by the package importPath,
+// source or binary, sorted by name. The values are the same as returned by
+// Symbols: a pointer to a zero value for a type, and an addressable value
+// for a variable. It returns an error if the package is not loaded.
+func (interp *Interpreter) PackageSymbols(importPath string) ([]SymbolInfo, error) {
+	values := interp.Symbols(importPath)[importPath]
+
+	interp.mutex.RLock()
+	src, isSrc := interp.srcPkg[importPath]
+	_, isBin := interp.binPkg[importPath]
+	interp.mutex.RUnlock()
+	if !isSrc && !isBin {
+		return nil, fmt.Errorf("package %s not loaded", importPath)
+	}
+
+	syms := make([]SymbolInfo, 0, len(values))
+	for name, v := range values {
+		if !canExport(name) {
+			// Skip the interface wrappers of binary packages.
+			continue
+		}
+		kind := binKind(v)
+		if s, ok := src[name]; ok {
+			kind = infoKinds[s.kind]
+		}
+		syms = append(syms, SymbolInfo{Name: name, Kind: kind, Value: v})
+	}
+	sort.Slice(syms, func(i, j int) bool { return syms[i].Name < syms[j].Name })
+	return syms, nil
+}
+
+// binKind returns the kind of the binary symbol value v, following the
+// conventions of the extracted symbols.
+func binKind(v reflect.Value) string {
+	switch {
+	case v.CanAddr():
+		return KindVar
+	case v.Kind() == reflect.Ptr && v.IsNil():
+		return KindType
+	case v.Kind() == reflect.Func:
+		return KindFunc
+	}
+	return KindConst
+}
+
+// Lookup returns the symbols exported by the loaded packages, source or
+// binary, whose name or qualified name "path.Name" matches the regular
+// expression pattern, sorted by package then name. Matching is case
+// sensitive, unless pattern starts with the "(?i)" flag.
+func (interp *Interpreter) Lookup(pattern string) ([]SymbolMatch, error) {
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, err
+	}
+
+	interp.mutex.RLock()
+	paths := make([]string, 0, len(interp.srcPkg)+len(interp.binPkg))
+	for p := range interp.srcPkg {
+		paths = append(paths, p)
+	}
+	for p := range interp.binPkg {
+		if _, ok := interp.srcPkg[p]; !ok {
+			paths = append(paths, p)
+		}
+	}
+	interp.mutex.RUnlock()
+	sort.Strings(paths)
+
+	var matches []SymbolMatch
+	for _, p := range paths {
+		syms, err := interp.PackageSymbols(p)
+		if err != nil {
+			continue
+		}
+		g := &typeGen{pkg: p, imports: map[string]string{}}
+		for _, sym := range syms {
+			if !re.MatchString(sym.Name) && !re.MatchString(p+"."+sym.Name) {
+				continue
+			}
+			interp.mutex.RLock()
+			s := interp.srcPkg[p][sym.Name]
+			interp.mutex.RUnlock()
+			var sig string
+			if s != nil && s.typ != nil {
+				sig = g.symbolType(s.typ, sym.Kind)
+			} else {
+				sig = g.rsymbolType(sym.Value, sym.Kind)
+			}
+			matches = append(matches, SymbolMatch{Path: p, SymbolInfo: sym, Signature: sig})
+		}
+	}
+	return matches, nil
+}
+
+// symbolType returns the signature of a source symbol of type t and kind.
+func (g *typeGen) symbolType(t *itype, kind string) string {
+	if kind != KindType {
+		return g.expr(t)
+	}
+	for t.cat == aliasT && t.name == "" {
+		t = t.val
+	}
+	switch t.cat {
+	case structT:
+		return "struct{...}"
+	case interfaceT:
+		return "interface{...}"
+	case valueT:
+		return g.rsymbolType(reflect.New(t.rtype), kind)
+	}
+	return g.underlying(t)
+}
+
+// rsymbolType returns the signature of a binary symbol of value v and kind.
+func (g *typeGen) rsymbolType(v reflect.Value, kind string) string {
+	if kind != KindType {
+		return g.rexpr(v.Type())
+	}
+	t := v.Type().Elem()
+	switch t.Kind() {
+	case reflect.Struct:
+		return "struct{...}"
+	case reflect.Interface:
+		return "interface{...}"
+	case reflect.Array:
+		t = reflect.ArrayOf(t.Len(), t.Elem())
+	case reflect.Chan:
+		t = reflect.ChanOf(t.ChanDir(), t.Elem())
+	case reflect.Func:
+		in := make([]reflect.Type, t.NumIn())
+		for i := range in {
+			in[i] = t.In(i)
+		}
+		out := make([]reflect.Type, t.NumOut())
+		for i := range out {
+			out[i] = t.Out(i)
+		}
+		t = reflect.FuncOf(in, out, t.IsVariadic())
+	case reflect.Map:
+		t = reflect.MapOf(t.Key(), t.Elem())
+	case reflect.Ptr:
+		t = reflect.PtrTo(t.Elem())
+	case reflect.Slice:
+		t = reflect.SliceOf(t.Elem())
+	case reflect.UnsafePointer:
+		return "unsafe.Pointer"
+	default:
+		return t.Kind().String()
+	}
+	return g.rexpr(t)
+}
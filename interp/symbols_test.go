@@ -0,0 +1,132 @@
+package interp
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestPackageSymbols(t *testing.T) {
+	goPath, err := ioutil.TempDir("", "symbols")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		_ = os.RemoveAll(goPath)
+	}()
+
+	name := filepath.Join(goPath, "src", "plugin", "plugin.go")
+	if err := os.MkdirAll(filepath.Dir(name), 0700); err != nil {
+		t.Fatal(err)
+	}
+	src := "package plugin\n\nconst Version = \"1.0\"\n\nvar Count = 3\n\ntype Handler struct{ Name string }\n\nfunc New(name string) *Handler { return &Handler{Name: name} }\n\nfunc helper() {}\n"
+	if err := ioutil.WriteFile(name, []byte(src), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	i := New(Options{GoPath: goPath})
+	i.Use(Exports{"host": {
+		"Limit":   reflect.ValueOf(10),
+		"Logger":  reflect.ValueOf((*strings.Builder)(nil)),
+		"Prefix":  reflect.ValueOf(new(string)).Elem(),
+		"Run":     reflect.ValueOf(func() {}),
+		"_host_I": reflect.ValueOf((*struct{})(nil)),
+	}})
+	if _, err := i.Eval(`import "plugin"`); err != nil {
+		t.Fatal(err)
+	}
+
+	kinds := func(path string) string {
+		t.Helper()
+		syms, err := i.PackageSymbols(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var s []string
+		for _, sym := range syms {
+			s = append(s, sym.Name+":"+sym.Kind)
+		}
+		return strings.Join(s, " ")
+	}
+	if got, want := kinds("plugin"), "Count:var Handler:type New:func Version:const"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+	if got, want := kinds("host"), "Limit:const Logger:type Prefix:var Run:func"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+
+	syms, err := i.PackageSymbols("plugin")
+	if err != nil {
+		t.Fatal(err)
+	}
+	h := syms[2].Value.Call([]reflect.Value{reflect.ValueOf("x")})[0]
+	if got := h.Elem().Field(0).String(); got != "x" {
+		t.Errorf("got %q, want %q", got, "x")
+	}
+
+	if _, err := i.PackageSymbols("missing"); err == nil || err.Error() != "package missing not loaded" {
+		t.Errorf("got error %v", err)
+	}
+}
+
+func TestLookup(t *testing.T) {
+	goPath, err := ioutil.TempDir("", "lookup")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		_ = os.RemoveAll(goPath)
+	}()
+
+	name := filepath.Join(goPath, "src", "plugin", "plugin.go")
+	if err := os.MkdirAll(filepath.Dir(name), 0700); err != nil {
+		t.Fatal(err)
+	}
+	src := "package plugin\n\nimport \"host\"\n\ntype Handler struct{ Name string }\n\ntype Handlers []*Handler\n\nfunc NewHandler(name string, l *host.Logger) (*Handler, error) { return &Handler{Name: name}, nil }\n\nvar DefaultHandler = &Handler{}\n"
+	if err := ioutil.WriteFile(name, []byte(src), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	type Logger struct{}
+	i := New(Options{GoPath: goPath})
+	i.Use(Exports{"host": {
+		"Logger":      reflect.ValueOf((*Logger)(nil)),
+		"Level":       reflect.ValueOf((*map[string]int)(nil)),
+		"Handle":      reflect.ValueOf(func(string, ...int) {}),
+		"LogHandler":  reflect.ValueOf(new(func() error)).Elem(),
+		"MaxHandlers": reflect.ValueOf(10),
+	}})
+	if _, err := i.Eval(`import "plugin"`); err != nil {
+		t.Fatal(err)
+	}
+
+	matches, err := i.Lookup("(?i)handler")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, m := range matches {
+		got = append(got, m.Path+"."+m.Name+" "+m.Kind+" "+m.Signature)
+	}
+	want := []string{
+		"host.LogHandler var func() error",
+		"host.MaxHandlers const int",
+		"plugin.DefaultHandler var *Handler",
+		"plugin.Handler type struct{...}",
+		"plugin.Handlers type []*Handler",
+		"plugin.NewHandler func func(string, *interp.Logger) (*Handler, error)",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %q, want %q", got, want)
+	}
+
+	if matches, err = i.Lookup(`^host\.L`); err != nil || len(matches) != 3 {
+		t.Errorf("got %v %v, want 3 matches", matches, err)
+	}
+	if _, err := i.Lookup("("); err == nil {
+		t.Error("got no error for an invalid pattern")
+	}
+}
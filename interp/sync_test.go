@@ -0,0 +1,298 @@
+package interp
+
+import (
+	"reflect"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+// syncExports provides the subset of sync and sync/atomic used by the
+// concurrency conformance tests.
+var syncExports = Exports{
+	"sync": {
+		"Mutex":     reflect.ValueOf((*sync.Mutex)(nil)),
+		"Once":      reflect.ValueOf((*sync.Once)(nil)),
+		"RWMutex":   reflect.ValueOf((*sync.RWMutex)(nil)),
+		"WaitGroup": reflect.ValueOf((*sync.WaitGroup)(nil)),
+	},
+	"sync/atomic": {
+		"AddInt32":            reflect.ValueOf(atomic.AddInt32),
+		"AddInt64":            reflect.ValueOf(atomic.AddInt64),
+		"CompareAndSwapInt64": reflect.ValueOf(atomic.CompareAndSwapInt64),
+		"LoadInt32":           reflect.ValueOf(atomic.LoadInt32),
+		"LoadInt64":           reflect.ValueOf(atomic.LoadInt64),
+		"StoreInt64":          reflect.ValueOf(atomic.StoreInt64),
+		"Value":               reflect.ValueOf((*atomic.Value)(nil)),
+	},
+}
+
+// TestSyncConformance checks that the sync primitives used by interpreted
+// code guard state living in interpreter frames. It is meant to be run with
+// the race detector.
+func TestSyncConformance(t *testing.T) {
+	tests := []struct {
+		desc string
+		src  string
+		want interface{}
+	}{
+		{
+			desc: "mutex on global",
+			src: `
+import "sync"
+
+var (
+	mu sync.Mutex
+	n  int
+)
+
+func run() int {
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				mu.Lock()
+				n++
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+	return n
+}`,
+			want: 1000,
+		},
+		{
+			desc: "mutex on local",
+			src: `
+import "sync"
+
+func run() int {
+	var (
+		mu sync.Mutex
+		wg sync.WaitGroup
+		n  int
+	)
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				mu.Lock()
+				n += 2
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+	return n
+}`,
+			want: 2000,
+		},
+		{
+			desc: "mutex in struct",
+			src: `
+import "sync"
+
+type counter struct {
+	sync.Mutex
+	m map[string]int
+}
+
+func (c *counter) inc(k string) {
+	c.Lock()
+	defer c.Unlock()
+	c.m[k]++
+}
+
+func run() int {
+	c := &counter{m: map[string]int{}}
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				c.inc(string(rune('a' + i%3)))
+			}
+		}(i)
+	}
+	wg.Wait()
+	return c.m["a"] + c.m["b"] + c.m["c"]
+}`,
+			want: 1000,
+		},
+		{
+			desc: "rwmutex",
+			src: `
+import "sync"
+
+type store struct {
+	mu sync.RWMutex
+	v  []int
+}
+
+func run() int {
+	s := &store{}
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(2)
+		go func(i int) {
+			defer wg.Done()
+			s.mu.Lock()
+			s.v = append(s.v, i)
+			s.mu.Unlock()
+		}(i)
+		go func() {
+			defer wg.Done()
+			s.mu.RLock()
+			_ = len(s.v)
+			s.mu.RUnlock()
+		}()
+	}
+	wg.Wait()
+	sum := 0
+	for _, x := range s.v {
+		sum += x
+	}
+	return sum
+}`,
+			want: 45,
+		},
+		{
+			desc: "once",
+			src: `
+import "sync"
+
+var (
+	once  sync.Once
+	calls int
+)
+
+func run() int {
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			once.Do(func() { calls++ })
+		}()
+	}
+	wg.Wait()
+	return calls
+}`,
+			want: 1,
+		},
+		{
+			desc: "atomic on global",
+			src: `
+import (
+	"sync"
+	"sync/atomic"
+)
+
+var n int64
+
+func run() int64 {
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				atomic.AddInt64(&n, 1)
+			}
+		}()
+	}
+	wg.Wait()
+	return atomic.LoadInt64(&n)
+}`,
+			want: int64(1000),
+		},
+		{
+			desc: "atomic on local and field",
+			src: `
+import (
+	"sync"
+	"sync/atomic"
+)
+
+type stats struct {
+	hits int32
+	max  int64
+}
+
+func run() int64 {
+	var (
+		wg sync.WaitGroup
+		n  int64
+	)
+	s := &stats{}
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func(i int64) {
+			defer wg.Done()
+			atomic.AddInt64(&n, i)
+			atomic.AddInt32(&s.hits, 1)
+			for {
+				old := atomic.LoadInt64(&s.max)
+				if i <= old || atomic.CompareAndSwapInt64(&s.max, old, i) {
+					break
+				}
+			}
+		}(int64(i))
+	}
+	wg.Wait()
+	return atomic.LoadInt64(&n)*100 + int64(atomic.LoadInt32(&s.hits))*10 + atomic.LoadInt64(&s.max)
+}`,
+			want: int64(4500 + 100 + 9),
+		},
+		{
+			desc: "atomic value",
+			src: `
+import (
+	"sync"
+	"sync/atomic"
+)
+
+var v atomic.Value
+
+func run() bool {
+	v.Store(0)
+	var wg sync.WaitGroup
+	for i := 1; i <= 10; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			_ = v.Load().(int)
+			v.Store(i)
+		}(i)
+	}
+	wg.Wait()
+	last := v.Load().(int)
+	return last >= 1 && last <= 10
+}`,
+			want: true,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.desc, func(t *testing.T) {
+			i := New(Options{})
+			i.Use(syncExports)
+			if _, err := i.Eval(test.src); err != nil {
+				t.Fatal(err)
+			}
+			res, err := i.Eval("run()")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := res.Interface(); got != test.want {
+				t.Errorf("got %v, want %v", got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,152 @@
+package interp
+
+import (
+	"fmt"
+	"go/constant"
+	"go/types"
+	"reflect"
+)
+
+// target describes the platform seen by interpreted code, when it differs from
+// the host one.
+type target struct {
+	goos, goarch string
+	sizes        types.Sizes // sizes and alignments of the gc compiler for goarch
+	wordSize     int64       // size of int, uint, uintptr and pointers, in bytes
+}
+
+// newTarget returns the target platform for goos and goarch, or nil if both are
+// empty. Empty values default to the host platform. An error is returned if
+// goarch is not supported.
+func newTarget(goos, goarch, hostOS, hostArch string) (*target, error) {
+	if goos == "" && goarch == "" {
+		return nil, nil
+	}
+	if goos == "" {
+		goos = hostOS
+	}
+	if goarch == "" {
+		goarch = hostArch
+	}
+	sizes := types.SizesFor("gc", goarch)
+	if sizes == nil {
+		return nil, fmt.Errorf("unsupported GOARCH %q", goarch)
+	}
+	return &target{
+		goos:     goos,
+		goarch:   goarch,
+		sizes:    sizes,
+		wordSize: sizes.Sizeof(types.Typ[types.Uintptr]),
+	}, nil
+}
+
+// representable returns true if the constant c, already known to fit in the
+// host type t, fits in t on the target. Only int, uint and uintptr have a
+// platform dependent size.
+func (t *target) representable(c constant.Value, typ reflect.Type) bool {
+	if t == nil || t.wordSize == 8 {
+		return true
+	}
+	x := constant.ToInt(c)
+	switch typ.Kind() {
+	case reflect.Int:
+		v, ok := constant.Int64Val(x)
+		return ok && v >= -1<<31 && v <= 1<<31-1
+	case reflect.Uint, reflect.Uintptr:
+		v, ok := constant.Uint64Val(x)
+		return ok && v <= 1<<32-1
+	}
+	return true
+}
+
+// sizeof returns the size in bytes of a value of type typ on the target.
+func (t *target) sizeof(typ reflect.Type) uintptr {
+	return uintptr(t.sizes.Sizeof(t.typeOf(typ)))
+}
+
+// alignof returns the alignment in bytes of a value of type typ on the target.
+func (t *target) alignof(typ reflect.Type) uintptr {
+	return uintptr(t.sizes.Alignof(t.typeOf(typ)))
+}
+
+// typeOf returns a go/types type with the same memory layout as typ. Only the
+// layout matters: all pointer like types are represented by unsafe.Pointer,
+// and element types of slices are dropped.
+func (t *target) typeOf(typ reflect.Type) types.Type {
+	switch typ.Kind() {
+	case reflect.Bool:
+		return types.Typ[types.Bool]
+	case reflect.Int:
+		return types.Typ[types.Int]
+	case reflect.Int8:
+		return types.Typ[types.Int8]
+	case reflect.Int16:
+		return types.Typ[types.Int16]
+	case reflect.Int32:
+		return types.Typ[types.Int32]
+	case reflect.Int64:
+		return types.Typ[types.Int64]
+	case reflect.Uint:
+		return types.Typ[types.Uint]
+	case reflect.Uint8:
+		return types.Typ[types.Uint8]
+	case reflect.Uint16:
+		return types.Typ[types.Uint16]
+	case reflect.Uint32:
+		return types.Typ[types.Uint32]
+	case reflect.Uint64:
+		return types.Typ[types.Uint64]
+	case reflect.Uintptr:
+		return types.Typ[types.Uintptr]
+	case reflect.Float32:
+		return types.Typ[types.Float32]
+	case reflect.Float64:
+		return types.Typ[types.Float64]
+	case reflect.Complex64:
+		return types.Typ[types.Complex64]
+	case reflect.Complex128:
+		return types.Typ[types.Complex128]
+	case reflect.String:
+		return types.Typ[types.String]
+	case reflect.Slice:
+		return types.NewSlice(types.Typ[types.Uint8])
+	case reflect.Interface:
+		return types.NewInterfaceType(nil, nil)
+	case reflect.Array:
+		return types.NewArray(t.typeOf(typ.Elem()), int64(typ.Len()))
+	case reflect.Struct:
+		fields := make([]*types.Var, typ.NumField())
+		for i := range fields {
+			fields[i] = types.NewField(0, nil, typ.Field(i).Name, t.typeOf(typ.Field(i).Type), false)
+		}
+		return types.NewStruct(fields, nil)
+	default:
+		// Pointers, maps, channels, functions and unsafe pointers.
+		return types.Typ[types.UnsafePointer]
+	}
+}
+
+// fixTarget redefines the interpreter stdlib symbols describing the platform,
+// so they match the target one.
+func fixTarget(interp *Interpreter) {
+	t := interp.target
+	if t == nil {
+		return
+	}
+	bits := constant.MakeInt64(8 * t.wordSize)
+
+	if p := interp.binPkg["runtime"]; p != nil {
+		p["GOOS"] = reflect.ValueOf(t.goos)
+		p["GOARCH"] = reflect.ValueOf(t.goarch)
+	}
+	if p := interp.binPkg["strconv"]; p != nil {
+		p["IntSize"] = reflect.ValueOf(bits)
+	}
+	if p := interp.binPkg["math/bits"]; p != nil {
+		p["UintSize"] = reflect.ValueOf(bits)
+	}
+	if p := interp.binPkg["unsafe"]; p != nil {
+		p["Sizeof"] = reflect.ValueOf(func(i interface{}) uintptr { return t.sizeof(reflect.TypeOf(i)) })
+		p["Alignof"] = reflect.ValueOf(func(i interface{}) uintptr { return t.alignof(reflect.TypeOf(i)) })
+	}
+}
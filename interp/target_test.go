@@ -0,0 +1,51 @@
+package interp_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/traefik/yaegi/interp"
+	"github.com/traefik/yaegi/stdlib"
+	"github.com/traefik/yaegi/stdlib/unsafe"
+)
+
+func TestTarget(t *testing.T) {
+	i := interp.New(interp.Options{GOOS: "linux", GOARCH: "386"})
+	i.Use(stdlib.Symbols)
+	i.Use(unsafe.Symbols)
+	eval(t, i, `
+import (
+	"math/bits"
+	"runtime"
+	"strconv"
+	"unsafe"
+)
+
+type T struct {
+	a int8
+	b int64
+	c []int
+	d *T
+}
+`)
+
+	for src, want := range map[string]interface{}{
+		`runtime.GOOS + "/" + runtime.GOARCH`: "linux/386",
+		`strconv.IntSize`:                    32,
+		`bits.UintSize`:                      32,
+		`unsafe.Sizeof(0)`:                   uintptr(4),
+		`unsafe.Sizeof(T{})`:                 uintptr(28),
+		`unsafe.Alignof(T{})`:                uintptr(4),
+		`unsafe.Sizeof([3]int16{})`:          uintptr(6),
+	} {
+		if got := eval(t, i, src).Interface(); got != want {
+			t.Errorf("%s: got %v (%T), want %v (%T)", src, got, got, want, want)
+		}
+	}
+
+	_, err := i.Eval(`var x int = 1 << 40`)
+	if err == nil || !strings.Contains(err.Error(), "1099511627776 overflows int") {
+		t.Errorf("got %v, want overflow error", err)
+	}
+	eval(t, i, `var y int64 = 1 << 40`)
+}
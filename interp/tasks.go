@@ -0,0 +1,183 @@
+package interp
+
+import (
+	"context"
+	"reflect"
+	"sync"
+)
+
+// TasksPath is the import path of the package of structured concurrency of
+// interpreted code, always available. It runs functions in goroutines
+// scoped to a group, whose context is cancelled by the first failure or by
+// the cancellation of the evaluation, and collects their errors, as an
+// alternative to go statements. From interpreted code, it is used as
+// follows:
+//
+//	import "yaegi/tasks"
+//
+//	g := tasks.New()
+//	g.SetLimit(4)
+//	for _, u := range urls {
+//		u := u
+//		g.Go(func(ctx context.Context) error { return fetch(ctx, u) })
+//	}
+//	if err := g.Wait(); err != nil {
+//		...
+//	}
+//
+// New returns a group bound to the evaluation, and WithContext a group bound
+// to a context. The groups are of type *TaskGroup.
+const TasksPath = "yaegi/tasks"
+
+// A TaskGroup is a group of goroutines of interpreted code, see TasksPath.
+// The goroutines are counted as the ones of go statements by
+// Options.MaxGoroutines, Options.MaxSpawnedGoroutines and the tenant quotas.
+// The panics of the functions are recovered, and reported as errors.
+type TaskGroup struct {
+	interp *Interpreter
+	ctx    context.Context
+	cancel func()
+	sem    chan struct{} // running tasks, if limited
+	wg     sync.WaitGroup
+
+	mu   sync.Mutex
+	errs []error
+}
+
+// tasksExports returns the symbols of the tasks package.
+func (interp *Interpreter) tasksExports() Exports {
+	return Exports{TasksPath: {
+		"Group":       reflect.ValueOf((*TaskGroup)(nil)),
+		"New":         reflect.ValueOf(interp.newTaskGroup),
+		"WithContext": reflect.ValueOf(interp.taskGroupWithContext),
+	}}
+}
+
+// newTaskGroup returns a group whose context is cancelled by the
+// cancellation of the current evaluation.
+func (interp *Interpreter) newTaskGroup() *TaskGroup {
+	g := interp.taskGroupWithContext(context.Background())
+	interp.mutex.RLock()
+	done := interp.done
+	inContext := len(interp.ctxEvals) > 0
+	interp.mutex.RUnlock()
+	if done != nil && inContext {
+		go func() {
+			select {
+			case <-done:
+				g.fail(errCancelled)
+			case <-g.ctx.Done():
+			}
+		}()
+	}
+	return g
+}
+
+func (interp *Interpreter) taskGroupWithContext(ctx context.Context) *TaskGroup {
+	ctx, cancel := context.WithCancel(ctx)
+	return &TaskGroup{interp: interp, ctx: ctx, cancel: cancel}
+}
+
+// Context returns the context of the group, cancelled by the first failure
+// of its functions, or when Wait returns.
+func (g *TaskGroup) Context() context.Context { return g.ctx }
+
+// SetLimit limits the number of functions of the group running at the same
+// time to n, if positive, so that Go waits for the end of a running one. It
+// must not be called while functions are running.
+func (g *TaskGroup) SetLimit(n int) {
+	if n <= 0 {
+		g.sem = nil
+		return
+	}
+	g.sem = make(chan struct{}, n)
+}
+
+// Go runs fn in a new goroutine with the context of the group. A non nil
+// error returned by fn, a panic, or the refusal of the goroutine by a quota,
+// cancels the context of the group. Once the context is cancelled, Go does
+// not run fn anymore.
+func (g *TaskGroup) Go(fn func(ctx context.Context) error) {
+	if g.sem != nil {
+		select {
+		case g.sem <- struct{}{}:
+		case <-g.ctx.Done():
+			return
+		}
+	}
+	if g.ctx.Err() != nil {
+		g.release()
+		return
+	}
+	interp := g.interp
+	q := interp.quotas
+	if q != nil && !q.startGoroutine(interp) {
+		g.release()
+		g.fail(interp.runErr())
+		return
+	}
+
+	g.wg.Add(1)
+	go func() {
+		defer g.wg.Done()
+		defer g.release()
+		if q != nil {
+			defer q.endGoroutine()
+		}
+		if interp.vos != nil {
+			defer interp.catchExit()
+		}
+		defer func() {
+			r := recover()
+			if r == nil {
+				return
+			}
+			if v, _ := untrace(r); interp.vos != nil {
+				if e, ok := v.(*ExitError); ok {
+					g.fail(e)
+					panic(r)
+				}
+			}
+			g.fail(interp.newPanic(r))
+		}()
+		if err := fn(g.ctx); err != nil {
+			g.fail(err)
+		}
+	}()
+}
+
+// Wait waits for the end of the functions of the group, cancels its context,
+// and returns the first error, or nil.
+func (g *TaskGroup) Wait() error {
+	g.wg.Wait()
+	g.cancel()
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if len(g.errs) == 0 {
+		return nil
+	}
+	return g.errs[0]
+}
+
+// Errors returns the errors of the functions of the group, in the order of
+// their occurrence.
+func (g *TaskGroup) Errors() []error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return append([]error(nil), g.errs...)
+}
+
+// fail records err and cancels the context of the group.
+func (g *TaskGroup) fail(err error) {
+	g.mu.Lock()
+	g.errs = append(g.errs, err)
+	g.mu.Unlock()
+	g.cancel()
+}
+
+// release releases the slot of a running function, if limited.
+func (g *TaskGroup) release() {
+	if g.sem != nil {
+		<-g.sem
+	}
+}
@@ -0,0 +1,149 @@
+package interp
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func tasksInterp(t *testing.T, opts Options, exports Exports) *Interpreter {
+	t.Helper()
+	i := New(opts)
+	i.Use(Exports{
+		"context": {"Context": reflect.ValueOf((*context.Context)(nil))},
+		"errors":  {"New": reflect.ValueOf(errors.New)},
+	})
+	i.Use(exports)
+	if _, err := i.Eval(`import (
+	"context"
+	"errors"
+	"yaegi/tasks"
+)`); err != nil {
+		t.Fatal(err)
+	}
+	return i
+}
+
+func TestTasks(t *testing.T) {
+	i := tasksInterp(t, Options{}, nil)
+	if _, err := i.Eval(`
+func squares(n int) (int, error) {
+	res := make([]int, n)
+	g := tasks.New()
+	g.SetLimit(3)
+	for k := 0; k < n; k++ {
+		k := k
+		g.Go(func(ctx context.Context) error {
+			res[k] = k * k
+			return nil
+		})
+	}
+	err := g.Wait()
+	sum := 0
+	for _, v := range res {
+		sum += v
+	}
+	return sum, err
+}
+
+var nerrs int
+
+func failing() error {
+	g := tasks.New()
+	g.Go(func(ctx context.Context) error { return errors.New("first") })
+	g.Go(func(ctx context.Context) error {
+		<-ctx.Done()
+		return ctx.Err()
+	})
+	err := g.Wait()
+	nerrs = len(g.Errors())
+	return err
+}
+
+func panicking() error {
+	g := tasks.New()
+	g.Go(func(ctx context.Context) error {
+		var m map[string]int
+		m["a"] = 1
+		return nil
+	})
+	return g.Wait()
+}
+`); err != nil {
+		t.Fatal(err)
+	}
+
+	if v, err := i.Eval(`squares(10)`); err != nil || v.Interface() != 285 {
+		t.Errorf("got %v, %v, want 285", v, err)
+	}
+	var ferr error
+	if err := i.EvalInto(`failing()`, &ferr); err != nil {
+		t.Fatal(err)
+	}
+	if n, err := i.Eval(`nerrs`); err != nil || ferr == nil || ferr.Error() != "first" || n.Interface() != 2 {
+		t.Errorf("got %v and %v errors, want first and 2", ferr, n)
+	}
+	var perr error
+	if err := i.EvalInto(`panicking()`, &perr); err != nil {
+		t.Fatal(err)
+	}
+	var p Panic
+	if !errors.As(perr, &p) || !strings.Contains(perr.Error(), "nil map") {
+		t.Errorf("got %v, want a panic error", perr)
+	}
+}
+
+func TestTasksQuotaAndCancel(t *testing.T) {
+	i := tasksInterp(t, Options{MaxSpawnedGoroutines: 2}, nil)
+	_, err := i.Eval(`
+func spawn() error {
+	g := tasks.New()
+	for k := 0; k < 3; k++ {
+		g.Go(func(ctx context.Context) error { return nil })
+	}
+	return g.Wait()
+}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := i.Eval(`spawn()`); !errors.Is(err, ErrLimitExceeded) {
+		t.Errorf("got %v, want ErrLimitExceeded", err)
+	}
+
+	groups := make(chan *TaskGroup, 1)
+	i = tasksInterp(t, Options{}, Exports{"host": {
+		"Keep": reflect.ValueOf(func(g *TaskGroup) { groups <- g }),
+	}})
+	if _, err := i.Eval(`import "host"`); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := i.Eval(`
+func block() {
+	g := tasks.New()
+	host.Keep(g)
+	g.Go(func(ctx context.Context) error {
+		<-ctx.Done()
+		return nil
+	})
+	g.Wait()
+}`); err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if _, err := i.EvalWithContext(ctx, `block()`); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("got %v, want DeadlineExceeded", err)
+	}
+	g := <-groups
+	select {
+	case <-g.Context().Done():
+		if err := g.Wait(); err != errCancelled {
+			t.Errorf("got %v, want %v", err, errCancelled)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("tasks not cancelled")
+	}
+}
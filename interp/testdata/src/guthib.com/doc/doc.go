@@ -0,0 +1,11 @@
+// Package doc is a documented package.
+package doc
+
+// Answer is the answer.
+const Answer = 42
+
+// Hello returns a greeting for name.
+func Hello(name string) string { return "Hello " + name }
+
+// Greeter greets people.
+type Greeter struct{ Name string }
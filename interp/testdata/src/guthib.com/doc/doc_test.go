@@ -0,0 +1,12 @@
+package doc_test
+
+import (
+	"fmt"
+
+	"guthib.com/doc"
+)
+
+func ExampleHello() {
+	fmt.Println(doc.Hello("world"))
+	// Output: Hello world
+}
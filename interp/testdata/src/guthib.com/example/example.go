@@ -0,0 +1,6 @@
+package example
+
+import "fmt"
+
+// Hello prints a greeting for name.
+func Hello(name string) { fmt.Println("Hello", name) }
@@ -0,0 +1,25 @@
+package example
+
+import "fmt"
+
+func ExampleHello() {
+	Hello("world")
+	// Output: Hello world
+}
+
+func ExampleHello_unordered() {
+	Hello("a")
+	Hello("b")
+	// Unordered output:
+	// Hello b
+	// Hello a
+}
+
+func ExampleHello_wrong() {
+	Hello("you")
+	// Output: Hello me
+}
+
+func ExampleHello_noOutput() {
+	fmt.Println("not checked")
+}
@@ -0,0 +1,5 @@
+package once
+
+import "guthib.com/host"
+
+var runs = host.Init()
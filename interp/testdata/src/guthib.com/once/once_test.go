@@ -0,0 +1,8 @@
+package once
+
+import "fmt"
+
+func ExampleRuns() {
+	fmt.Println(runs)
+	// Output: 1
+}
@@ -0,0 +1,410 @@
+package interp
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"reflect"
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ErrTestFailed is returned by Interpreter.Test if a test fails.
+var ErrTestFailed = errors.New("test failed")
+
+// Test evaluates the package importPath, including its test files, then runs
+// its Test, Benchmark and Example functions, and writes the results to w, in
+// the format of "go test -v". It returns ErrTestFailed if a test fails, or the
+// error of the evaluation of the package.
+//
+// The package is evaluated in a new interpreter using the same settings and
+// binary symbols as interp, as by RunExamples, so the state of interp is not
+// modified, and with its standard output written to w, except for the output
+// of the examples, which is compared to the expected one. The "testing" package
+// of this interpreter is a shim, implementing the methods of testing.T,
+// testing.B and testing.TB, and the functions Short and Verbose: tests can not
+// pass their *testing.T to binary code, nor define a TestMain function.
+//
+// Tests are run sequentially in source order, including the ones calling
+// t.Parallel, and a panic fails the test instead of stopping the run.
+// Benchmarks are run once, with b.N equal to 1, as by "go test -benchtime=1x",
+// to check that they work.
+func (interp *Interpreter) Test(importPath string, w io.Writer) error {
+	start := time.Now()
+	i := New(Options{
+		GoPath:    interp.context.GOPATH,
+		BuildTags: interp.context.BuildTags,
+		Stdin:     interp.stdin,
+		Stdout:    w,
+		Stderr:    interp.stderr,
+	})
+	i.coverage = interp.coverage
+	i.Use(interp.binPkg)
+	delete(i.binPkg, "testing")
+	i.Use(testingExports)
+	if err := i.EvalTest(importPath); err != nil {
+		return err
+	}
+
+	// Functions are run in source order.
+	i.mutex.RLock()
+	var names []string
+	pos := map[string]int{}
+	for name, sym := range i.srcPkg[importPath] {
+		if sym.kind == funcSym && sym.node != nil {
+			names = append(names, name)
+			pos[name] = int(sym.node.pos)
+		}
+	}
+	i.mutex.RUnlock()
+	sort.Slice(names, func(a, b int) bool { return pos[names[a]] < pos[names[b]] })
+
+	failed := false
+	syms := i.Symbols(importPath)[importPath]
+	for _, name := range names {
+		if !isTestFunc(name, "Test") {
+			continue
+		}
+		if fn, ok := syms[name].Interface().(func(*testT)); ok {
+			t := &testT{testCommon: testCommon{name: name, w: w, chatty: w}}
+			t.run(func() { fn(t) })
+			failed = failed || t.Failed()
+		}
+	}
+	for _, name := range names {
+		if !isTestFunc(name, "Benchmark") {
+			continue
+		}
+		if fn, ok := syms[name].Interface().(func(*testB)); ok {
+			b := &testB{testCommon: testCommon{name: name, w: w, bench: true}, N: 1}
+			b.run(func() { fn(b) })
+			failed = failed || b.Failed()
+		}
+	}
+
+	examples, err := i.Examples(importPath)
+	if err != nil {
+		return err
+	}
+	for _, e := range examples {
+		fmt.Fprintf(w, "=== RUN   %s\n", e.Name)
+		switch {
+		case e.Err != nil:
+			fmt.Fprintf(w, "--- FAIL: %s (0.00s)\n%v\n", e.Name, e.Err)
+		case !e.Passed():
+			fmt.Fprintf(w, "--- FAIL: %s (0.00s)\ngot:\n%s\nwant:\n%s\n", e.Name, e.Got, e.Want)
+		default:
+			fmt.Fprintf(w, "--- PASS: %s (0.00s)\n", e.Name)
+			continue
+		}
+		failed = true
+	}
+
+	elapsed := time.Since(start).Seconds()
+	if failed {
+		fmt.Fprintf(w, "FAIL\nFAIL\t%s\t%.3fs\n", importPath, elapsed)
+		return ErrTestFailed
+	}
+	fmt.Fprintf(w, "PASS\nok  \t%s\t%.3fs\n", importPath, elapsed)
+	return nil
+}
+
+// isTestFunc returns true if name is the name of a test function with
+// prefix, such as "TestXxx" for "Test".
+func isTestFunc(name, prefix string) bool {
+	if !strings.HasPrefix(name, prefix) {
+		return false
+	}
+	if len(name) == len(prefix) {
+		return true
+	}
+	c := name[len(prefix)]
+	return !('a' <= c && c <= 'z')
+}
+
+// testingExports are the symbols of the "testing" shim of Interpreter.Test.
+var testingExports = Exports{
+	"testing": {
+		"B":       reflect.ValueOf((*testB)(nil)),
+		"Short":   reflect.ValueOf(func() bool { return false }),
+		"T":       reflect.ValueOf((*testT)(nil)),
+		"TB":      reflect.ValueOf((*testTB)(nil)),
+		"Verbose": reflect.ValueOf(func() bool { return true }),
+	},
+}
+
+// testTB is the interface common to testT and testB, as testing.TB.
+type testTB interface {
+	Cleanup(func())
+	Error(args ...interface{})
+	Errorf(format string, args ...interface{})
+	Fail()
+	FailNow()
+	Failed() bool
+	Fatal(args ...interface{})
+	Fatalf(format string, args ...interface{})
+	Helper()
+	Log(args ...interface{})
+	Logf(format string, args ...interface{})
+	Name() string
+	Skip(args ...interface{})
+	SkipNow()
+	Skipf(format string, args ...interface{})
+	Skipped() bool
+	TempDir() string
+}
+
+// testCommon is the state of a test or benchmark run by Interpreter.Test.
+type testCommon struct {
+	mu       sync.Mutex
+	name     string
+	w        io.Writer    // destination of the report, the output of the parent for subtests
+	chatty   io.Writer    // destination of the "=== RUN" lines
+	bench    bool         // true for a benchmark
+	output   bytes.Buffer // log of the test, and reports of subtests
+	failed   bool
+	skipped  bool
+	cleanups []func()
+	tempDirs []string
+}
+
+// run runs f, the body of the test, in a new goroutine, then writes the
+// report of the test.
+func (c *testCommon) run(f func()) {
+	if !c.bench {
+		fmt.Fprintf(c.chatty, "=== RUN   %s\n", c.name)
+	}
+	start := time.Now()
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		defer c.cleanup()
+		defer func() {
+			if r := recover(); r != nil {
+				c.log(fmt.Sprintf("panic: %v", r))
+				c.Fail()
+			}
+		}()
+		f()
+	}()
+	<-done
+	elapsed := time.Since(start)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	status := "PASS"
+	switch {
+	case c.failed:
+		status = "FAIL"
+	case c.skipped:
+		status = "SKIP"
+	}
+	if c.bench && !c.failed && !c.skipped {
+		fmt.Fprintf(c.w, "%s\t%8d\t%10d ns/op\n", c.name, 1, elapsed.Nanoseconds())
+		status = ""
+	}
+	if status != "" {
+		fmt.Fprintf(c.w, "--- %s: %s (%.2fs)\n", status, c.name, elapsed.Seconds())
+	}
+	_, _ = c.w.Write(indent(c.output.String()))
+}
+
+// runSub runs the subtest sub of c, and returns true if it did not fail.
+func (c *testCommon) runSub(sub *testCommon, f func()) bool {
+	sub.name = c.name + "/" + strings.Replace(sub.name, " ", "_", -1)
+	// The reports of subtests are part of the output of their parent, the
+	// results of sub-benchmarks are listed as benchmarks.
+	sub.w, sub.chatty, sub.bench = &c.output, c.chatty, c.bench
+	if c.bench {
+		sub.w = c.w
+	}
+	sub.run(f)
+	if sub.failed {
+		c.Fail()
+	}
+	return !sub.failed
+}
+
+// cleanup calls the cleanup functions in reverse order, then removes the
+// temporary directories.
+func (c *testCommon) cleanup() {
+	c.mu.Lock()
+	cleanups := c.cleanups
+	c.cleanups = nil
+	c.mu.Unlock()
+	for k := len(cleanups) - 1; k >= 0; k-- {
+		cleanups[k]()
+	}
+	for _, dir := range c.tempDirs {
+		_ = os.RemoveAll(dir)
+	}
+}
+
+func (c *testCommon) log(s string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.output.WriteString(s)
+	if !strings.HasSuffix(s, "\n") {
+		c.output.WriteByte('\n')
+	}
+}
+
+// indent returns s with its lines indented by 4 spaces.
+func indent(s string) []byte {
+	if s == "" {
+		return nil
+	}
+	lines := strings.SplitAfter(strings.TrimSuffix(s, "\n"), "\n")
+	return []byte("    " + strings.Join(lines, "    ") + "\n")
+}
+
+// Cleanup registers f to be called when the test completes.
+func (c *testCommon) Cleanup(f func()) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.cleanups = append(c.cleanups, f)
+}
+
+// Error is equivalent to Log followed by Fail.
+func (c *testCommon) Error(args ...interface{}) {
+	c.log(fmt.Sprintln(args...))
+	c.Fail()
+}
+
+// Errorf is equivalent to Logf followed by Fail.
+func (c *testCommon) Errorf(format string, args ...interface{}) {
+	c.log(fmt.Sprintf(format, args...))
+	c.Fail()
+}
+
+// Fail marks the test as failed, and continues its execution.
+func (c *testCommon) Fail() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.failed = true
+}
+
+// FailNow marks the test as failed, and stops its execution.
+func (c *testCommon) FailNow() {
+	c.Fail()
+	runtime.Goexit()
+}
+
+// Failed returns true if the test failed.
+func (c *testCommon) Failed() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.failed
+}
+
+// Fatal is equivalent to Log followed by FailNow.
+func (c *testCommon) Fatal(args ...interface{}) {
+	c.log(fmt.Sprintln(args...))
+	c.FailNow()
+}
+
+// Fatalf is equivalent to Logf followed by FailNow.
+func (c *testCommon) Fatalf(format string, args ...interface{}) {
+	c.log(fmt.Sprintf(format, args...))
+	c.FailNow()
+}
+
+// Helper does nothing, as logs are not prefixed by the caller position.
+func (c *testCommon) Helper() {}
+
+// Log records its arguments, formatted as by fmt.Println, in the test log.
+func (c *testCommon) Log(args ...interface{}) { c.log(fmt.Sprintln(args...)) }
+
+// Logf records its arguments, formatted as by fmt.Printf, in the test log.
+func (c *testCommon) Logf(format string, args ...interface{}) { c.log(fmt.Sprintf(format, args...)) }
+
+// Name returns the name of the test.
+func (c *testCommon) Name() string { return c.name }
+
+// Skip is equivalent to Log followed by SkipNow.
+func (c *testCommon) Skip(args ...interface{}) {
+	c.log(fmt.Sprintln(args...))
+	c.SkipNow()
+}
+
+// Skipf is equivalent to Logf followed by SkipNow.
+func (c *testCommon) Skipf(format string, args ...interface{}) {
+	c.log(fmt.Sprintf(format, args...))
+	c.SkipNow()
+}
+
+// SkipNow marks the test as skipped, and stops its execution.
+func (c *testCommon) SkipNow() {
+	c.mu.Lock()
+	c.skipped = true
+	c.mu.Unlock()
+	runtime.Goexit()
+}
+
+// Skipped returns true if the test was skipped.
+func (c *testCommon) Skipped() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.skipped
+}
+
+// TempDir returns a new temporary directory, removed when the test completes.
+func (c *testCommon) TempDir() string {
+	dir, err := ioutil.TempDir("", "yaegi-test")
+	if err != nil {
+		c.Fatal(err)
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.tempDirs = append(c.tempDirs, dir)
+	return dir
+}
+
+// testT is the shim of testing.T.
+type testT struct {
+	testCommon
+}
+
+// Parallel does nothing, as tests are run sequentially.
+func (t *testT) Parallel() {}
+
+// Run runs f as a subtest of t called name, and returns true if it did not
+// fail.
+func (t *testT) Run(name string, f func(t *testT)) bool {
+	sub := &testT{testCommon: testCommon{name: name}}
+	return t.runSub(&sub.testCommon, func() { f(sub) })
+}
+
+// testB is the shim of testing.B.
+type testB struct {
+	testCommon
+	N int
+}
+
+// ReportAllocs does nothing.
+func (b *testB) ReportAllocs() {}
+
+// ResetTimer does nothing, as benchmarks are run once.
+func (b *testB) ResetTimer() {}
+
+// SetBytes does nothing.
+func (b *testB) SetBytes(n int64) {}
+
+// StartTimer does nothing, as benchmarks are run once.
+func (b *testB) StartTimer() {}
+
+// StopTimer does nothing, as benchmarks are run once.
+func (b *testB) StopTimer() {}
+
+// Run runs f as a sub-benchmark of b called name, and returns true if it did
+// not fail.
+func (b *testB) Run(name string, f func(b *testB)) bool {
+	sub := &testB{testCommon: testCommon{name: name}, N: 1}
+	return b.runSub(&sub.testCommon, func() { f(sub) })
+}
@@ -0,0 +1,124 @@
+package interp
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+)
+
+func TestInterpreterTest(t *testing.T) {
+	goPath, err := ioutil.TempDir("", "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		_ = os.RemoveAll(goPath)
+	}()
+
+	files := map[string]string{
+		"p/p.go": "package p\n\nfunc Add(a, b int) int { return a + b }\n",
+		"p/p_test.go": `package p
+
+import "testing"
+
+func TestAdd(t *testing.T) {
+	if Add(1, 2) != 3 {
+		t.Fatal("wrong sum")
+	}
+	t.Log("sum ok")
+}
+
+func TestSub(t *testing.T) {
+	for _, name := range []string{"one", "two"} {
+		t.Run(name, func(t *testing.T) {
+			if name == "two" {
+				t.Errorf("failed %s", t.Name())
+			}
+		})
+	}
+}
+
+func TestFatal(t *testing.T) {
+	defer t.Log("deferred")
+	t.Fatal("stop")
+	t.Log("not reached")
+}
+
+func TestSkip(t *testing.T) { t.Skip("skipped") }
+
+func TestPanic(t *testing.T) { panic("boom") }
+
+func helper(tb testing.TB) { tb.Helper() }
+
+func Testlower(t *testing.T) { t.Fatal("not a test") }
+
+func BenchmarkAdd(b *testing.B) {
+	helper(b)
+	for i := 0; i < b.N; i++ {
+		Add(i, i)
+	}
+}
+`,
+		"q/q.go":      "package q\n",
+		"q/q_test.go": "package q\n\nimport \"testing\"\n\nfunc TestOK(t *testing.T) {}\n",
+	}
+	for name, src := range files {
+		name = filepath.Join(goPath, "src", name)
+		if err := os.MkdirAll(filepath.Dir(name), 0700); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(name, []byte(src), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	i := New(Options{GoPath: goPath})
+	var out bytes.Buffer
+	if err := i.Test("p", &out); err != ErrTestFailed {
+		t.Fatalf("got error %v, want %v", err, ErrTestFailed)
+	}
+	got := regexp.MustCompile(`\d+\.\d+s| *\d+ ns/op`).ReplaceAllString(out.String(), "X")
+	want := `=== RUN   TestAdd
+--- PASS: TestAdd (X)
+    sum ok
+=== RUN   TestSub
+=== RUN   TestSub/one
+=== RUN   TestSub/two
+--- FAIL: TestSub (X)
+    --- PASS: TestSub/one (X)
+    --- FAIL: TestSub/two (X)
+        failed TestSub/two
+=== RUN   TestFatal
+--- FAIL: TestFatal (X)
+    stop
+    deferred
+=== RUN   TestSkip
+--- SKIP: TestSkip (X)
+    skipped
+=== RUN   TestPanic
+--- FAIL: TestPanic (X)
+    panic: boom
+BenchmarkAdd	       1	X
+FAIL
+FAIL	p	X
+`
+	if got != want {
+		t.Errorf("got:\n%s\nwant:\n%s", got, want)
+	}
+
+	out.Reset()
+	if err := i.Test("q", &out); err != nil {
+		t.Fatal(err)
+	}
+	if got := regexp.MustCompile(`\d+\.\d+s`).ReplaceAllString(out.String(), "X"); got != "=== RUN   TestOK\n--- PASS: TestOK (X)\nPASS\nok  \tq\tX\n" {
+		t.Errorf("unexpected output:\n%s", got)
+	}
+
+	// The interpreter running the tests is not modified.
+	if i.Scopes()["p"] != nil {
+		t.Error("package p imported in the interpreter")
+	}
+}
@@ -0,0 +1,119 @@
+package interp
+
+import (
+	"context"
+	"reflect"
+	"runtime"
+	"strings"
+	"sync"
+)
+
+type pinThreadKey struct{}
+
+// PinThread returns a copy of ctx making the evaluations with this context,
+// such as by EvalWithContext, run on the OS thread of the pinned functions of
+// the interpreter, see Options.PinnedFuncs, for example to create the
+// objects of a GUI toolkit in an init function. The goroutines started by
+// the evaluation run on other threads, except for their calls of pinned
+// functions.
+func PinThread(ctx context.Context) context.Context {
+	return context.WithValue(ctx, pinThreadKey{}, true)
+}
+
+// osThread is a goroutine locked to its OS thread, running the functions
+// sent to it in sequence. It is started on first use, and stopped when no
+// longer referenced.
+type osThread struct {
+	once  sync.Once
+	id    uint64      // id of the goroutine
+	calls chan func() // functions to run
+}
+
+func (t *osThread) start() {
+	t.once.Do(func() {
+		calls := make(chan func())
+		ids := make(chan uint64)
+		go func() {
+			runtime.LockOSThread()
+			ids <- goroutineID()
+			for fn := range calls {
+				fn()
+			}
+		}()
+		t.calls = calls
+		t.id = <-ids
+		runtime.SetFinalizer(t, func(t *osThread) { close(t.calls) })
+	})
+}
+
+// run runs fn on the thread, and returns when it is done. A panic of fn is
+// propagated to the caller. The calls made from the thread itself, such as
+// by callbacks, run directly.
+func (t *osThread) run(fn func()) {
+	t.start()
+	if goroutineID() == t.id {
+		fn()
+		return
+	}
+	var p interface{}
+	panicked := true
+	done := make(chan struct{})
+	t.calls <- func() {
+		defer close(done)
+		defer func() {
+			if panicked {
+				p = recover()
+			}
+		}()
+		fn()
+		panicked = false
+	}
+	<-done
+	if panicked {
+		panic(p)
+	}
+}
+
+// pinned returns the binary symbol name of the package importPath, of value
+// v, wrapped to run on the pinned thread if it is one of the pinned
+// functions.
+func (interp *Interpreter) pinned(importPath, name string, v reflect.Value) reflect.Value {
+	if v.Kind() != reflect.Func || v.IsNil() || !interp.isPinned(importPath+"."+name) {
+		return v
+	}
+	t := v.Type()
+	return reflect.MakeFunc(t, func(in []reflect.Value) (out []reflect.Value) {
+		interp.thread.run(func() {
+			if t.IsVariadic() {
+				out = v.CallSlice(in)
+			} else {
+				out = v.Call(in)
+			}
+		})
+		return out
+	})
+}
+
+func (interp *Interpreter) isPinned(name string) bool {
+	for _, s := range interp.pinnedFuncs {
+		if s == name {
+			return true
+		}
+	}
+	return false
+}
+
+// fixPinned wraps the pinned functions of the used values.
+func fixPinned(interp *Interpreter, values Exports) {
+	for _, s := range interp.pinnedFuncs {
+		i := strings.LastIndex(s, ".")
+		if i < 0 || values[s[:i]] == nil {
+			continue
+		}
+		if pkg := interp.binPkg[s[:i]]; pkg != nil {
+			if v, ok := pkg[s[i+1:]]; ok {
+				pkg[s[i+1:]] = interp.pinned(s[:i], s[i+1:], v)
+			}
+		}
+	}
+}
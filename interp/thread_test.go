@@ -0,0 +1,79 @@
+package interp
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestPinnedFuncs(t *testing.T) {
+	i := New(Options{PinnedFuncs: []string{"host.Where", "host.Call", "host.Fail", "host.Sum"}})
+	i.Use(Exports{"host": {
+		"Where": reflect.ValueOf(goroutineID),
+		"Free":  reflect.ValueOf(goroutineID),
+		"Call":  reflect.ValueOf(func(f func() uint64) uint64 { return f() }),
+		"Fail":  reflect.ValueOf(func() { panic("fail") }),
+		"Sum": reflect.ValueOf(func(a ...int) (s int) {
+			for _, v := range a {
+				s += v
+			}
+			return s
+		}),
+	}})
+	if _, err := i.Eval(`import "host"`); err != nil {
+		t.Fatal(err)
+	}
+
+	id := func(src string) uint64 {
+		t.Helper()
+		v, err := i.Eval(src)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return v.Interface().(uint64)
+	}
+	pinned := id(`host.Where()`)
+	if pinned != i.thread.id || pinned == goroutineID() {
+		t.Fatalf("got goroutine %d, want %d", pinned, i.thread.id)
+	}
+	if _, err := i.Eval(`
+func inGoroutine() uint64 {
+	c := make(chan uint64)
+	go func() {
+		w := host.Where()
+		c <- w
+	}()
+	w := <-c
+	return w
+}`); err != nil {
+		t.Fatal(err)
+	}
+	if got := id(`inGoroutine()`); got != pinned {
+		t.Errorf("got goroutine %d in goroutine, want %d", got, pinned)
+	}
+	if got := id(`host.Call(func() uint64 { return host.Where() + host.Free() })`); got != 2*pinned {
+		t.Errorf("got goroutines %d in callback, want %d", got, 2*pinned)
+	}
+	if got := id(`host.Free()`); got == pinned {
+		t.Errorf("got pinned goroutine for a function not pinned")
+	}
+	if v, err := i.Eval(`host.Sum(1, 2, 3) + host.Sum([]int{4}...)`); err != nil || v.Interface() != 10 {
+		t.Errorf("got %v, %v, want 10", v, err)
+	}
+
+	if _, err := i.Eval(`host.Fail()`); err == nil || !strings.Contains(err.Error(), "fail") {
+		t.Errorf("got error %v, want fail", err)
+	}
+	if got := id(`host.Where()`); got != pinned {
+		t.Errorf("got goroutine %d after a panic, want %d", got, pinned)
+	}
+
+	v, err := i.EvalWithContext(PinThread(context.Background()), `host.Free()`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := v.Interface(); got != pinned {
+		t.Errorf("got goroutine %d with PinThread, want %d", got, pinned)
+	}
+}
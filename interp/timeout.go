@@ -0,0 +1,81 @@
+package interp
+
+import (
+	"net"
+	"reflect"
+	"time"
+)
+
+// Timeouts are the default limits applied to blocking calls of the standard
+// library made by interpreted code, as binary calls are not interrupted by the
+// interpreter. A zero value means no limit.
+type Timeouts struct {
+	// Dial limits the time to establish connections with net.Dial and
+	// net.DialTimeout.
+	Dial time.Duration
+
+	// IO limits the duration of each read or write on the connections
+	// established with net.Dial and net.DialTimeout.
+	IO time.Duration
+
+	// Sleep is the maximum duration of a call to time.Sleep.
+	Sleep time.Duration
+}
+
+// deadlineConn is a network connection which sets a deadline before each
+// read or write.
+type deadlineConn struct {
+	net.Conn
+	timeout time.Duration
+}
+
+func (c deadlineConn) Read(b []byte) (int, error) {
+	if err := c.SetReadDeadline(time.Now().Add(c.timeout)); err != nil {
+		return 0, err
+	}
+	return c.Conn.Read(b)
+}
+
+func (c deadlineConn) Write(b []byte) (int, error) {
+	if err := c.SetWriteDeadline(time.Now().Add(c.timeout)); err != nil {
+		return 0, err
+	}
+	return c.Conn.Write(b)
+}
+
+// minTimeout returns the smallest non zero duration of a and b.
+func minTimeout(a, b time.Duration) time.Duration {
+	if a == 0 || b > 0 && b < a {
+		return b
+	}
+	return a
+}
+
+// fixTimeouts redefines the interpreter stdlib symbols performing blocking
+// calls, to apply the configured timeouts.
+func fixTimeouts(interp *Interpreter) {
+	t := interp.timeouts
+
+	if p := interp.binPkg["net"]; p != nil && (t.Dial > 0 || t.IO > 0) {
+		dial := func(network, address string, timeout time.Duration) (net.Conn, error) {
+			c, err := net.DialTimeout(network, address, minTimeout(timeout, t.Dial))
+			if err != nil || t.IO == 0 {
+				return c, err
+			}
+			return deadlineConn{c, t.IO}, nil
+		}
+		p["Dial"] = reflect.ValueOf(func(network, address string) (net.Conn, error) {
+			return dial(network, address, 0)
+		})
+		p["DialTimeout"] = reflect.ValueOf(dial)
+	}
+
+	if p := interp.binPkg["time"]; p != nil && t.Sleep > 0 {
+		p["Sleep"] = reflect.ValueOf(func(d time.Duration) {
+			if d > t.Sleep {
+				d = t.Sleep
+			}
+			time.Sleep(d)
+		})
+	}
+}
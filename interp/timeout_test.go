@@ -0,0 +1,60 @@
+package interp_test
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/traefik/yaegi/interp"
+	"github.com/traefik/yaegi/stdlib"
+)
+
+func TestTimeouts(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = l.Close() }()
+	go func() {
+		// Accept connections but never write to them.
+		for {
+			c, err := l.Accept()
+			if err != nil {
+				return
+			}
+			defer func() { _ = c.Close() }()
+		}
+	}()
+
+	i := interp.New(interp.Options{Timeouts: interp.Timeouts{IO: 50 * time.Millisecond, Sleep: 10 * time.Millisecond}})
+	i.Use(stdlib.Symbols)
+	eval(t, i, `
+import (
+	"net"
+	"time"
+)
+
+func read(addr string) error {
+	c, err := net.Dial("tcp", addr)
+	if err != nil {
+		return err
+	}
+	defer c.Close()
+	_, err = c.Read(make([]byte, 1))
+	return err
+}
+
+func sleep() { time.Sleep(time.Hour) }
+`)
+
+	start := time.Now()
+	eval(t, i, `sleep()`)
+	if d := time.Since(start); d > time.Second {
+		t.Errorf("sleep not limited: %v", d)
+	}
+
+	v := eval(t, i, `read("`+l.Addr().String()+`")`)
+	if err, ok := v.Interface().(net.Error); !ok || !err.Timeout() {
+		t.Errorf("got %v, want a timeout error", v)
+	}
+}
@@ -0,0 +1,102 @@
+package interp
+
+import (
+	"reflect"
+	"sync"
+	"time"
+)
+
+// timers are the pending timers and tickers created by interpreted code
+// through the time package, which are stopped at the end of each evaluation
+// if Options.EvalTimers is set.
+type timers struct {
+	mu      sync.Mutex
+	timers  map[*time.Timer]bool
+	tickers map[*time.Ticker]bool
+}
+
+func newTimers() *timers {
+	return &timers{timers: map[*time.Timer]bool{}, tickers: map[*time.Ticker]bool{}}
+}
+
+// afterFunc returns a timer calling f after d, registered until it fires.
+func (ts *timers) afterFunc(d time.Duration, f func()) *time.Timer {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+	var t *time.Timer
+	t = time.AfterFunc(d, func() {
+		ts.mu.Lock()
+		delete(ts.timers, t)
+		ts.mu.Unlock()
+		f()
+	})
+	ts.timers[t] = true
+	return t
+}
+
+// newTimer returns a timer sending the current time on its channel after d,
+// as time.NewTimer, registered until it fires.
+func (ts *timers) newTimer(d time.Duration) *time.Timer {
+	c := make(chan time.Time, 1)
+	t := ts.afterFunc(d, func() {
+		select {
+		case c <- time.Now():
+		default:
+		}
+	})
+	t.C = c
+	return t
+}
+
+// newTicker returns a ticker, as time.NewTicker, registered until the end
+// of the evaluation.
+func (ts *timers) newTicker(d time.Duration) *time.Ticker {
+	t := time.NewTicker(d)
+	ts.mu.Lock()
+	ts.tickers[t] = true
+	ts.mu.Unlock()
+	return t
+}
+
+// stop stops all the registered timers and tickers.
+func (ts *timers) stop() {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+	for t := range ts.timers {
+		t.Stop()
+	}
+	for t := range ts.tickers {
+		t.Stop()
+	}
+	ts.timers = map[*time.Timer]bool{}
+	ts.tickers = map[*time.Ticker]bool{}
+}
+
+// stopTimers stops the timers and tickers created by interpreted code, if
+// they are bound to evaluations.
+func (interp *Interpreter) stopTimers() {
+	if interp.timers != nil {
+		interp.timers.stop()
+	}
+}
+
+// fixTimers redefines the interpreter stdlib symbols creating timers and
+// tickers, to register them, so they are stopped at the end of evaluations.
+func fixTimers(interp *Interpreter) {
+	ts := interp.timers
+	p := interp.binPkg["time"]
+	if ts == nil || p == nil {
+		return
+	}
+
+	p["After"] = reflect.ValueOf(func(d time.Duration) <-chan time.Time { return ts.newTimer(d).C })
+	p["AfterFunc"] = reflect.ValueOf(ts.afterFunc)
+	p["NewTimer"] = reflect.ValueOf(ts.newTimer)
+	p["NewTicker"] = reflect.ValueOf(ts.newTicker)
+	p["Tick"] = reflect.ValueOf(func(d time.Duration) <-chan time.Time {
+		if d <= 0 {
+			return nil
+		}
+		return ts.newTicker(d).C
+	})
+}
@@ -0,0 +1,50 @@
+package interp_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/traefik/yaegi/interp"
+)
+
+func TestEvalTimers(t *testing.T) {
+	fired := make(chan string, 10)
+	i := interp.New(interp.Options{EvalTimers: true})
+	i.Use(interp.Exports{
+		"time": {
+			"After":       reflect.ValueOf(time.After),
+			"AfterFunc":   reflect.ValueOf(time.AfterFunc),
+			"Millisecond": reflect.ValueOf(time.Millisecond),
+			"NewTicker":   reflect.ValueOf(time.NewTicker),
+			"Duration":    reflect.ValueOf((*time.Duration)(nil)),
+		},
+		"host": {"Fire": reflect.ValueOf(func(s string) { fired <- s })},
+	})
+	eval(t, i, `import (
+	"host"
+	"time"
+)`)
+
+	// Timers work during their evaluation.
+	eval(t, i, `<-time.After(time.Millisecond)`)
+	eval(t, i, `
+t := time.NewTicker(time.Millisecond)
+<-t.C
+<-t.C
+`)
+
+	// Pending timers are stopped at the end of their evaluation.
+	eval(t, i, `time.AfterFunc(20*time.Millisecond, func() { host.Fire("late") })`)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if _, err := i.EvalWithContext(ctx, `time.AfterFunc(20*time.Millisecond, func() { host.Fire("cancelled") }); for {}`); err != context.DeadlineExceeded {
+		t.Fatalf("got %v, want %v", err, context.DeadlineExceeded)
+	}
+	select {
+	case s := <-fired:
+		t.Errorf("timer %s fired", s)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
@@ -0,0 +1,294 @@
+package interp
+
+import (
+	"context"
+	"fmt"
+	"go/ast"
+	"reflect"
+	"sync"
+)
+
+// Bus is a publish/subscribe bus of the host, on which the host and the
+// interpreted code of any number of interpreters exchange messages by topic,
+// each topic having a message type. See UseTopics. The zero value is ready to
+// use.
+//
+// Messages are delivered to the subscribers of their topic in turn, with
+// backpressure: publishing waits while the buffer of a subscriber is full.
+type Bus struct {
+	mu     sync.Mutex
+	topics map[string]*topic
+}
+
+// topic is a topic of a Bus.
+type topic struct {
+	typ  reflect.Type
+	subs map[*subscription]bool
+}
+
+// subscription is a subscriber of a topic, receiving messages on ch until
+// cancelled.
+type subscription struct {
+	ch   reflect.Value // chan of the message type of the topic
+	quit chan struct{} // closed on cancellation, to interrupt the publishers
+	once sync.Once
+
+	mu     sync.RWMutex // held for reading by the publishers sending on ch
+	closed bool
+}
+
+// topic returns the topic name of message type typ, created if needed.
+func (b *Bus) topic(name string, typ reflect.Type) (*topic, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	t := b.topics[name]
+	if t == nil {
+		if b.topics == nil {
+			b.topics = map[string]*topic{}
+		}
+		t = &topic{typ: typ, subs: map[*subscription]bool{}}
+		b.topics[name] = t
+	}
+	if t.typ != typ {
+		return nil, fmt.Errorf("bus: topic %q of type %s used with type %s", name, t.typ, typ)
+	}
+	return t, nil
+}
+
+// subscribe subscribes to topic t with channel ch, and returns a function
+// cancelling the subscription and closing ch.
+func (b *Bus) subscribe(t *topic, ch reflect.Value) func() {
+	s := &subscription{ch: ch, quit: make(chan struct{})}
+	b.mu.Lock()
+	t.subs[s] = true
+	b.mu.Unlock()
+	return func() {
+		b.mu.Lock()
+		delete(t.subs, s)
+		b.mu.Unlock()
+		// The publishers sending to s are interrupted before closing ch.
+		s.once.Do(func() { close(s.quit) })
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		if !s.closed {
+			s.closed = true
+			s.ch.Close()
+		}
+	}
+}
+
+// publish delivers msg to the subscribers of topic t, waiting for room in
+// their buffer, until done is closed, in which case it returns false.
+func (b *Bus) publish(t *topic, msg reflect.Value, done <-chan struct{}) bool {
+	b.mu.Lock()
+	subs := make([]*subscription, 0, len(t.subs))
+	for s := range t.subs {
+		subs = append(subs, s)
+	}
+	b.mu.Unlock()
+
+	for _, s := range subs {
+		if !s.send(msg, done) {
+			return false
+		}
+	}
+	return true
+}
+
+// send sends msg to s, unless it is cancelled meanwhile, and returns false if
+// done is closed first.
+func (s *subscription) send(msg reflect.Value, done <-chan struct{}) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.closed {
+		return true
+	}
+	chosen, _, _ := reflect.Select([]reflect.SelectCase{
+		{Dir: reflect.SelectSend, Chan: s.ch, Send: msg},
+		{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(s.quit)},
+		{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(done)},
+	})
+	return chosen != 2
+}
+
+// Publish publishes msg on topic name, whose message type must be the one
+// of msg, until it is received by the buffers of all the subscribers, or ctx
+// is done, in which case it returns the context error.
+func (b *Bus) Publish(ctx context.Context, name string, msg interface{}) error {
+	if msg == nil {
+		return fmt.Errorf("bus: nil message published on topic %q", name)
+	}
+	t, err := b.topic(name, reflect.TypeOf(msg))
+	if err != nil {
+		return err
+	}
+	if !b.publish(t, reflect.ValueOf(msg), ctx.Done()) {
+		return ctx.Err()
+	}
+	return nil
+}
+
+// Subscribe subscribes the host to topic name, receiving its messages on ch,
+// a channel of the message type of the topic whose buffer size is the one of
+// the subscription. It returns a function cancelling the subscription, which
+// closes ch.
+func (b *Bus) Subscribe(name string, ch interface{}) (cancel func(), err error) {
+	c := reflect.ValueOf(ch)
+	if c.Kind() != reflect.Chan || c.Type().ChanDir() != reflect.BothDir {
+		return nil, fmt.Errorf("bus: invalid channel of type %T", ch)
+	}
+	t, err := b.topic(name, c.Type().Elem())
+	if err != nil {
+		return nil, err
+	}
+	return b.subscribe(t, c), nil
+}
+
+// subscriptions are the subscriptions of interpreted code to buses of the
+// host, see UseTopics.
+type subscriptions struct {
+	mu     sync.Mutex
+	cancel map[int]func()
+	next   int
+}
+
+// add registers the cancellation function of a subscription, and returns the
+// function cancelling and unregistering it.
+func (s *subscriptions) add(cancel func()) func() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.cancel == nil {
+		s.cancel = map[int]func(){}
+	}
+	id := s.next
+	s.next++
+	s.cancel[id] = cancel
+	return func() {
+		s.mu.Lock()
+		delete(s.cancel, id)
+		s.mu.Unlock()
+		cancel()
+	}
+}
+
+// CloseSubscriptions cancels the subscriptions of interpreted code to the
+// buses of the host, closing their channels, so that an interpreter no longer
+// used does not hold back the publishers. It returns the number of cancelled
+// subscriptions. A Manager closing an interpreter calls it.
+func (interp *Interpreter) CloseSubscriptions() int {
+	s := &interp.subs
+	s.mu.Lock()
+	cancel := s.cancel
+	s.cancel = nil
+	s.mu.Unlock()
+	for _, c := range cancel {
+		c()
+	}
+	return len(cancel)
+}
+
+// UseTopics makes the package importPath available to interpreted code,
+// generated from schema to publish and subscribe to the topics of bus with
+// typed functions. The schema is a struct, or a pointer to a struct, whose
+// exported fields are the topics, of the message type of the field, named
+// by their "topic" tag, or else by the field name. The fields tagged "-"
+// are ignored. For example:
+//
+//	type Events struct {
+//		Orders Order  `topic:"orders"`
+//		Alerts string `topic:"alerts"`
+//	}
+//
+//	i.UseTopics("host/events", bus, Events{})
+//
+// gives the following functions for each topic field F of type T, and the
+// exported named message types, such as Order:
+//
+//	func PublishF(msg T) error
+//	func SubscribeF(buffer int) (ch <-chan T, cancel func())
+//
+// PublishF waits while the buffer of a subscriber is full, and returns an
+// error if the evaluation is cancelled meanwhile. SubscribeF returns a
+// channel of the given buffer size receiving the messages of the topic, and a
+// function cancelling the subscription, which closes the channel. The
+// subscriptions are also cancelled by CloseSubscriptions.
+//
+// It returns an error if schema is not a struct, or if a topic is used with
+// a different message type on bus.
+func (interp *Interpreter) UseTopics(importPath string, bus *Bus, schema interface{}) error {
+	st := reflect.TypeOf(schema)
+	if st != nil && st.Kind() == reflect.Ptr {
+		st = st.Elem()
+	}
+	if st == nil || st.Kind() != reflect.Struct {
+		return fmt.Errorf("topics: invalid schema of type %T, want struct", schema)
+	}
+
+	syms := map[string]reflect.Value{}
+	for i := 0; i < st.NumField(); i++ {
+		f := st.Field(i)
+		name := f.Tag.Get("topic")
+		if f.PkgPath != "" || name == "-" {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		t, err := bus.topic(name, f.Type)
+		if err != nil {
+			return err
+		}
+		syms["Publish"+f.Name] = interp.topicPublish(bus, t)
+		syms["Subscribe"+f.Name] = interp.topicSubscribe(bus, t)
+		typ := f.Type
+		if typ.Kind() == reflect.Ptr {
+			typ = typ.Elem()
+		}
+		if ast.IsExported(typ.Name()) && typ.PkgPath() != "" {
+			syms[typ.Name()] = reflect.Zero(reflect.PtrTo(typ))
+		}
+	}
+	interp.Use(Exports{importPath: syms})
+	return nil
+}
+
+// topicPublish returns the publish function of topic t of bus, interrupted by
+// the cancellation of the evaluation.
+func (interp *Interpreter) topicPublish(bus *Bus, t *topic) reflect.Value {
+	ft := reflect.FuncOf([]reflect.Type{t.typ}, []reflect.Type{errorType}, false)
+	return reflect.MakeFunc(ft, func(in []reflect.Value) []reflect.Value {
+		id := interp.runid()
+		interp.mutex.RLock()
+		done := interp.done
+		interp.mutex.RUnlock()
+		if done != nil && interp.runid() == id {
+			select {
+			case <-done:
+				// The channel is the one of a previous evaluation.
+				done = nil
+			default:
+			}
+		}
+		err := reflect.Zero(errorType)
+		if !bus.publish(t, in[0], done) {
+			err = reflect.ValueOf(&errCancelled).Elem()
+		}
+		return []reflect.Value{err}
+	})
+}
+
+// topicSubscribe returns the subscribe function of topic t of bus.
+func (interp *Interpreter) topicSubscribe(bus *Bus, t *topic) reflect.Value {
+	recv := reflect.ChanOf(reflect.RecvDir, t.typ)
+	cancelType := reflect.TypeOf(func() {})
+	ft := reflect.FuncOf([]reflect.Type{reflect.TypeOf(0)}, []reflect.Type{recv, cancelType}, false)
+	return reflect.MakeFunc(ft, func(in []reflect.Value) []reflect.Value {
+		buffer := int(in[0].Int())
+		if buffer < 0 {
+			buffer = 0
+		}
+		ch := reflect.MakeChan(reflect.ChanOf(reflect.BothDir, t.typ), buffer)
+		cancel := interp.subs.add(bus.subscribe(t, ch))
+		return []reflect.Value{ch.Convert(recv), reflect.ValueOf(cancel)}
+	})
+}
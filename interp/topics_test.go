@@ -0,0 +1,103 @@
+package interp
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+)
+
+type BusOrder struct {
+	ID    int
+	Items []string
+}
+
+type busEvents struct {
+	Orders  BusOrder `topic:"orders"`
+	Alerts  string
+	Ignored int `topic:"-"`
+}
+
+func TestUseTopics(t *testing.T) {
+	var bus Bus
+	i := New(Options{})
+	if err := i.UseTopics("host/events", &bus, busEvents{}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := i.Eval(`import "host/events"`); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := i.Eval(`var orders, cancelOrders = events.SubscribeOrders(1)`); err != nil {
+		t.Fatal(err)
+	}
+
+	// The host publishes to interpreted code, with backpressure.
+	if err := bus.Publish(context.Background(), "orders", BusOrder{ID: 1, Items: []string{"a"}}); err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if err := bus.Publish(ctx, "orders", BusOrder{ID: 2}); err != context.DeadlineExceeded {
+		t.Fatalf("got error %v, want %v", err, context.DeadlineExceeded)
+	}
+	v, err := i.Eval(`o := <-orders; o.ID*10 + len(o.Items)`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := v.Interface(); got != 11 {
+		t.Fatalf("got %v, want 11", got)
+	}
+
+	// Interpreted code publishes to the host.
+	alerts := make(chan string, 1)
+	cancelAlerts, err := bus.Subscribe("Alerts", alerts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cancelAlerts()
+	if _, err := i.Eval(`events.PublishAlerts("low stock")`); err != nil {
+		t.Fatal(err)
+	}
+	if got := <-alerts; got != "low stock" {
+		t.Fatalf("got %q, want %q", got, "low stock")
+	}
+
+	// The message types are exported, and the ignored fields are not.
+	if _, err := i.Eval(`events.PublishOrders(events.BusOrder{ID: 3})`); err != nil {
+		t.Fatal(err)
+	}
+	if syms := i.Symbols("host/events")["host/events"]; syms["PublishIgnored"].IsValid() || len(syms) != 5 {
+		t.Fatalf("unexpected symbols %v", reflect.ValueOf(syms).MapKeys())
+	}
+
+	// The subscriptions of an interpreter are cancelled by CloseSubscriptions,
+	// closing their channel, so the messages published afterwards are dropped.
+	if _, err := i.Eval(`_, _ = events.SubscribeAlerts(0)`); err != nil {
+		t.Fatal(err)
+	}
+	if n := i.CloseSubscriptions(); n != 2 {
+		t.Fatalf("got %d cancelled subscriptions, want 2", n)
+	}
+	if err := bus.Publish(context.Background(), "orders", BusOrder{ID: 4}); err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []int{3, 0} {
+		v, err = i.Eval(`(<-orders).ID`)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := v.Interface(); got != want {
+			t.Fatalf("got %v, want %v", got, want)
+		}
+	}
+
+	// A topic has a single message type.
+	if err := New(Options{}).UseTopics("other", &bus, struct{ Orders int }{}); err != nil {
+		t.Fatal(err)
+	}
+	if err := New(Options{}).UseTopics("other", &bus, struct {
+		Orders int `topic:"orders"`
+	}{}); err == nil {
+		t.Fatal("missing error for a topic of another type")
+	}
+}
@@ -0,0 +1,155 @@
+package interp
+
+import (
+	"strconv"
+	"sync"
+	"time"
+)
+
+// Tracer is notified of the calls of interpreted functions, if set in
+// Options.Tracer. Its methods are called in the goroutine of the call, and
+// must be safe for concurrent use.
+type Tracer interface {
+	// Enter is called before the execution of the body of a function.
+	Enter(c *TraceCall)
+
+	// Exit is called after the execution of the body of a function, including
+	// on panic, with the time elapsed since the call of Enter, and the part of
+	// it not spent in the interpreted functions it called.
+	Exit(c *TraceCall, total, self time.Duration)
+}
+
+// NodeTracer is a Tracer also notified of the execution of each node of the
+// compiled code, several of which are usually executed per line.
+type NodeTracer interface {
+	Tracer
+
+	// Node is called before the execution of a node at line of file, in the
+	// function call c, or with a nil c for package level code.
+	Node(c *TraceCall, file string, line int)
+}
+
+// TraceCall is a call of an interpreted function, reported to a Tracer.
+type TraceCall struct {
+	Func   string     // qualified function name, such as "main.main" or "(*example.com/p.T).M"
+	File   string     // file of the function definition
+	Line   int        // line of the function definition
+	Caller *TraceCall // calling interpreted function, nil if called from binary code or in a new goroutine
+
+	inner time.Duration // time spent in the callees
+}
+
+// traceFunc is the identity of a traced function.
+type traceFunc struct {
+	name string
+	file string
+	line int
+}
+
+// tracing is the state of the tracing of the calls of an interpreter.
+type tracing struct {
+	tracer Tracer
+	funcs  sync.Map // *traceFunc, indexed by function definition node
+}
+
+// runFunc runs the body of the function def in frame f, called from the
+// frame caller, or nil if called from binary code or in a new goroutine. The
+// call is reported to the tracer if Options.Tracer is set, and its frame
+// counted in FrameStats.
+func runFunc(def *node, f, caller *frame) {
+	fc := &def.interp.frames
+	defer fc.leave(fc.enter(f))
+
+	t := def.interp.tracing
+	if t == nil {
+		runCfg(def.child[3].start, f)
+		return
+	}
+
+	tf := t.traceFunc(def)
+	c := &TraceCall{Func: tf.name, File: tf.file, Line: tf.line}
+	if caller != nil {
+		c.Caller = caller.trace
+	}
+	f.trace = c
+	t.tracer.Enter(c)
+	start := time.Now()
+	defer func() {
+		total := time.Since(start)
+		if c.Caller != nil {
+			c.Caller.inner += total
+		}
+		t.tracer.Exit(c, total, total-c.inner)
+	}()
+	runCfg(def.child[3].start, f)
+}
+
+// traceFunc returns the identity of the function def.
+func (t *tracing) traceFunc(def *node) *traceFunc {
+	if tf, ok := t.funcs.Load(def); ok {
+		return tf.(*traceFunc)
+	}
+	pos := def.interp.fset.Position(def.pos)
+	tf := &traceFunc{name: def.interp.defName(def), file: pos.Filename, line: pos.Line}
+	t.funcs.Store(def, tf)
+	return tf
+}
+
+// wrap makes the execution of node n, just compiled, reported to the tracer
+// if it is a NodeTracer.
+func (t *tracing) wrap(n *node) {
+	nt, ok := t.tracer.(NodeTracer)
+	if !ok || n.exec == nil || !n.pos.IsValid() {
+		return
+	}
+	exec := n.exec
+	pos := n.interp.fset.Position(n.pos)
+	n.exec = func(f *frame) bltn {
+		nt.Node(f.trace, pos.Filename, pos.Line)
+		return exec(f)
+	}
+}
+
+// defName returns the qualified name of the function defined by node def,
+// named as by the Go runtime: function literals are numbered in their
+// enclosing function, such as "main.main.func1".
+func (interp *Interpreter) defName(def *node) string {
+	root := def
+	for root.anc != nil {
+		root = root.anc
+	}
+	path := mainID
+	interp.mutex.RLock()
+	for p, roots := range interp.roots {
+		for _, r := range roots {
+			if r == root {
+				path = p
+			}
+		}
+	}
+	interp.mutex.RUnlock()
+
+	if def.kind == funcDecl {
+		return funcName(path, def)
+	}
+
+	// Function literal: find the enclosing declaration, and number the
+	// literals in it.
+	outer := def.anc
+	for outer.anc != nil && outer.kind != funcDecl {
+		outer = outer.anc
+	}
+	prefix := path + ".glob."
+	if outer.kind == funcDecl {
+		prefix = funcName(path, outer) + "."
+	}
+	k, found := 0, false
+	outer.Walk(func(n *node) bool {
+		if !found && n.kind == funcLit {
+			k++
+			found = n == def
+		}
+		return !found
+	}, nil)
+	return prefix + "func" + strconv.Itoa(k)
+}
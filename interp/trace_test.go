@@ -0,0 +1,130 @@
+package interp
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io/ioutil"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// testTracer records the calls and the lines executed.
+type testTracer struct {
+	mu    sync.Mutex
+	calls []string
+	lines map[int]bool
+}
+
+func (t *testTracer) Enter(c *TraceCall) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	var stack []string
+	for ; c != nil; c = c.Caller {
+		stack = append(stack, c.Func)
+	}
+	t.calls = append(t.calls, strings.Join(stack, "<"))
+}
+
+func (t *testTracer) Exit(c *TraceCall, total, self time.Duration) {
+	if self > total {
+		panic("self time greater than total")
+	}
+}
+
+func (t *testTracer) Node(c *TraceCall, file string, line int) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.lines[line] = true
+}
+
+func TestTracer(t *testing.T) {
+	src := `package main
+
+type T struct{}
+
+func (t *T) M() int { return fib(5) }
+
+func fib(n int) int {
+	if n < 2 {
+		return n
+	}
+	return fib(n-1) + fib(n-2)
+}
+
+func main() {
+	f := func() int { return new(T).M() }
+	_ = f()
+}
+`
+	tracer := &testTracer{lines: map[int]bool{}}
+	i := New(Options{Tracer: tracer})
+	if _, err := i.Eval(src); err != nil {
+		t.Fatal(err)
+	}
+	want := []string{
+		"main.main",
+		"main.main.func1<main.main",
+		"(*main.T).M<main.main.func1<main.main",
+		"main.fib<(*main.T).M<main.main.func1<main.main",
+		"main.fib<main.fib<(*main.T).M<main.main.func1<main.main",
+	}
+	if !reflect.DeepEqual(tracer.calls[:len(want)], want) {
+		t.Errorf("got %q, want %q", tracer.calls[:len(want)], want)
+	}
+	if len(tracer.calls) != 3+15 {
+		t.Errorf("got %d calls, want 18", len(tracer.calls))
+	}
+	for _, line := range []int{5, 8, 9, 11, 15, 16} {
+		if !tracer.lines[line] {
+			t.Errorf("line %d not traced", line)
+		}
+	}
+
+	// Hot calls, specialized by a profile, are traced as well.
+	i = New(Options{CollectProfile: true})
+	if _, err := i.Eval(src); err != nil {
+		t.Fatal(err)
+	}
+	hot := i.Profile()
+	for pos := range hot.Calls {
+		hot.Calls[pos] = hotCalls
+	}
+	tracer = &testTracer{lines: map[int]bool{}}
+	i = New(Options{Tracer: tracer, Profile: hot})
+	if _, err := i.Eval(src); err != nil {
+		t.Fatal(err)
+	}
+	if len(tracer.calls) != 3+15 {
+		t.Errorf("got %d hot calls, want 18", len(tracer.calls))
+	}
+
+	p := NewCPUProfile()
+	i = New(Options{Tracer: p})
+	if _, err := i.Eval(src); err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	if _, err := p.WriteTo(&buf); err != nil {
+		t.Fatal(err)
+	}
+	zr, err := gzip.NewReader(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := ioutil.ReadAll(zr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, s := range []string{"main.fib", "(*main.T).M", "main.main.func1", "cpu", "nanoseconds", DefaultSourceName} {
+		if !bytes.Contains(b, []byte(s)) {
+			t.Errorf("%q not found in profile", s)
+		}
+	}
+	// Each stack of fib, up to 5 calls deep, is a sample.
+	if n := len(p.samples); n != 3+5 {
+		t.Errorf("got %d samples, want 8", n)
+	}
+}
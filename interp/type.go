@@ -1,6 +1,7 @@
 package interp
 
 import (
+	"errors"
 	"fmt"
 	"go/constant"
 	"path/filepath"
@@ -30,6 +31,7 @@ const (
 	float32T
 	float64T
 	funcT
+	genericT
 	interfaceT
 	intT
 	int8T
@@ -67,6 +69,7 @@ var cats = [...]string{
 	float32T:    "float32",
 	float64T:    "float64T",
 	funcT:       "funcT",
+	genericT:    "genericT",
 	interfaceT:  "interfaceT",
 	intT:        "intT",
 	int8T:       "int8T",
@@ -134,8 +137,86 @@ func untypedInt() *itype     { return &itype{cat: intT, name: "int", untyped: tr
 func untypedFloat() *itype   { return &itype{cat: float64T, name: "float64", untyped: true} }
 func untypedComplex() *itype { return &itype{cat: complex128T, name: "complex128", untyped: true} }
 
+// untypedConst returns the untyped type of constant c, or nil if not supported.
+func untypedConst(c constant.Value) *itype {
+	switch c.Kind() {
+	case constant.Bool:
+		return untypedBool()
+	case constant.String:
+		return untypedString()
+	case constant.Int:
+		return untypedInt()
+	case constant.Float:
+		return untypedFloat()
+	case constant.Complex:
+		return untypedComplex()
+	}
+	return nil
+}
+
 // nodeType returns a type definition for the corresponding AST subtree.
 func nodeType(interp *Interpreter, sc *scope, n *node) (*itype, error) {
+	var r typeResolution
+	if typeName(n) != "" {
+		r.decls = []*node{n}
+	}
+	return r.nodeType(interp, sc, n)
+}
+
+// defaultMaxTypeDepth is the default of Options.MaxTypeDepth.
+const defaultMaxTypeDepth = 10000
+
+// errTypeDepth is the underlying error of the types nested deeper than
+// Options.MaxTypeDepth.
+var errTypeDepth = errors.New("type nested too deeply")
+
+// typeResolution is the state of the resolution of a type by nodeType,
+// passed by value to the nested resolutions.
+type typeResolution struct {
+	depth int     // number of nested type expressions and declarations
+	decls []*node // declarations of the incomplete types being resolved
+}
+
+// cycle returns an error if the incomplete type declared by decl is being
+// resolved, as its resolution would never end.
+func (r typeResolution) cycle(decl *node) error {
+	for i, d := range r.decls {
+		if d != decl {
+			continue
+		}
+		tc := &TypeCycleError{}
+		for _, d := range append(r.decls[i:len(r.decls):len(r.decls)], decl) {
+			name := typeName(d)
+			if name == "" {
+				name = d.ident
+			}
+			tc.Chain = append(tc.Chain, name)
+		}
+		if decl.anc.kind == typeSpec {
+			decl = decl.anc
+		}
+		err := decl.cfgErrorf("%v", tc)
+		err.error.(*CompileError).Code = CodeTypeCycle
+		err.error.(*CompileError).Err = tc
+		return err
+	}
+	return nil
+}
+
+// isTypeResolutionError returns true if err is an error of the resolution of
+// a type which can not be resolved by retrying later, once more types are
+// declared.
+func isTypeResolutionError(err error) bool {
+	var tc *TypeCycleError
+	return errors.As(err, &tc) || errors.Is(err, errTypeDepth)
+}
+
+func (r typeResolution) nodeType(interp *Interpreter, sc *scope, n *node) (*itype, error) {
+	if r.depth++; r.depth > interp.maxTypeDepth {
+		err := n.cfgErrorf("%v: more than %d levels", errTypeDepth, interp.maxTypeDepth)
+		err.error.(*CompileError).Err = errTypeDepth
+		return nil, err
+	}
 	if n.typ != nil && !n.typ.incomplete {
 		if n.kind == sliceExpr {
 			n.typ.sizedef = false
@@ -159,7 +240,7 @@ func nodeType(interp *Interpreter, sc *scope, n *node) (*itype, error) {
 	switch n.kind {
 	case addressExpr, starExpr:
 		t.cat = ptrT
-		if t.val, err = nodeType(interp, sc, n.child[0]); err != nil {
+		if t.val, err = r.nodeType(interp, sc, n.child[0]); err != nil {
 			return nil, err
 		}
 		t.incomplete = t.val.incomplete
@@ -169,7 +250,7 @@ func nodeType(interp *Interpreter, sc *scope, n *node) (*itype, error) {
 		c0 := n.child[0]
 		if len(n.child) == 1 {
 			// Array size is not defined.
-			if t.val, err = nodeType(interp, sc, c0); err != nil {
+			if t.val, err = r.nodeType(interp, sc, c0); err != nil {
 				return nil, err
 			}
 			t.incomplete = t.val.incomplete
@@ -220,7 +301,7 @@ func nodeType(interp *Interpreter, sc *scope, n *node) (*itype, error) {
 			}
 			t.size = constToInt(v)
 		}
-		if t.val, err = nodeType(interp, sc, n.child[1]); err != nil {
+		if t.val, err = r.nodeType(interp, sc, n.child[1]); err != nil {
 			return nil, err
 		}
 		t.sizedef = true
@@ -237,18 +318,7 @@ func nodeType(interp *Interpreter, sc *scope, n *node) (*itype, error) {
 			n.rval = reflect.ValueOf(constant.MakeInt64(int64(v)))
 			t = untypedRune()
 		case constant.Value:
-			switch v.Kind() {
-			case constant.Bool:
-				t = untypedBool()
-			case constant.String:
-				t = untypedString()
-			case constant.Int:
-				t = untypedInt()
-			case constant.Float:
-				t = untypedFloat()
-			case constant.Complex:
-				t = untypedComplex()
-			default:
+			if t = untypedConst(v); t == nil {
 				err = n.cfgErrorf("missing support for type %v", n.rval)
 			}
 		default:
@@ -256,17 +326,17 @@ func nodeType(interp *Interpreter, sc *scope, n *node) (*itype, error) {
 		}
 
 	case unaryExpr:
-		t, err = nodeType(interp, sc, n.child[0])
+		t, err = r.nodeType(interp, sc, n.child[0])
 
 	case binaryExpr:
 		// Get type of first operand.
-		if t, err = nodeType(interp, sc, n.child[0]); err != nil {
+		if t, err = r.nodeType(interp, sc, n.child[0]); err != nil {
 			return nil, err
 		}
 		// For operators other than shift, get the type from the 2nd operand if the first is untyped.
 		if t.untyped && !isShiftNode(n) {
 			var t1 *itype
-			t1, err = nodeType(interp, sc, n.child[1])
+			t1, err = r.nodeType(interp, sc, n.child[1])
 			if !(t1.untyped && isInt(t1.TypeOf()) && isFloat(t.TypeOf())) {
 				t = t1
 			}
@@ -275,7 +345,7 @@ func nodeType(interp *Interpreter, sc *scope, n *node) (*itype, error) {
 		dt := t
 		switch a := n.anc; {
 		case a.kind == defineStmt && len(a.child) > a.nleft+a.nright:
-			if dt, err = nodeType(interp, sc, a.child[a.nleft]); err != nil {
+			if dt, err = r.nodeType(interp, sc, a.child[a.nleft]); err != nil {
 				return nil, err
 			}
 		case a.kind == returnStmt:
@@ -293,10 +363,10 @@ func nodeType(interp *Interpreter, sc *scope, n *node) (*itype, error) {
 			switch n.child[0].ident {
 			case bltnComplex:
 				var nt0, nt1 *itype
-				if nt0, err = nodeType(interp, sc, n.child[1]); err != nil {
+				if nt0, err = r.nodeType(interp, sc, n.child[1]); err != nil {
 					return nil, err
 				}
-				if nt1, err = nodeType(interp, sc, n.child[2]); err != nil {
+				if nt1, err = r.nodeType(interp, sc, n.child[2]); err != nil {
 					return nil, err
 				}
 				if nt0.incomplete || nt1.incomplete {
@@ -321,7 +391,7 @@ func nodeType(interp *Interpreter, sc *scope, n *node) (*itype, error) {
 					}
 				}
 			case bltnReal, bltnImag:
-				if t, err = nodeType(interp, sc, n.child[1]); err != nil {
+				if t, err = r.nodeType(interp, sc, n.child[1]); err != nil {
 					return nil, err
 				}
 				if !t.incomplete {
@@ -339,9 +409,9 @@ func nodeType(interp *Interpreter, sc *scope, n *node) (*itype, error) {
 			case bltnCap, bltnCopy, bltnLen:
 				t = sc.getType("int")
 			case bltnAppend, bltnMake:
-				t, err = nodeType(interp, sc, n.child[1])
+				t, err = r.nodeType(interp, sc, n.child[1])
 			case bltnNew:
-				t, err = nodeType(interp, sc, n.child[1])
+				t, err = r.nodeType(interp, sc, n.child[1])
 				t = &itype{cat: ptrT, val: t, incomplete: t.incomplete, scope: sc}
 			case bltnRecover:
 				t = sc.getType("interface{}")
@@ -349,8 +419,30 @@ func nodeType(interp *Interpreter, sc *scope, n *node) (*itype, error) {
 			if err != nil {
 				return nil, err
 			}
+		} else if g := interp.genericOf(sc, n.child[0]); g != nil {
+			// Call of a generic function, typed by its instance.
+			args := make([]*itype, len(n.child)-1)
+			for i, c := range n.child[1:] {
+				if args[i], err = r.nodeType(interp, sc, c); err != nil {
+					return nil, err
+				}
+				if args[i].incomplete {
+					t.incomplete = true
+					break
+				}
+			}
+			if t.incomplete {
+				break
+			}
+			var sym *symbol
+			if sym, err = interp.instantiateCall(n, g, args); err != nil {
+				return nil, err
+			}
+			if t = sym.typ; len(t.ret) == 1 {
+				t = t.ret[0]
+			}
 		} else {
-			if t, err = nodeType(interp, sc, n.child[0]); err != nil {
+			if t, err = r.nodeType(interp, sc, n.child[0]); err != nil {
 				return nil, err
 			}
 			switch t.cat {
@@ -366,45 +458,45 @@ func nodeType(interp *Interpreter, sc *scope, n *node) (*itype, error) {
 		}
 
 	case compositeLitExpr:
-		t, err = nodeType(interp, sc, n.child[0])
+		t, err = r.nodeType(interp, sc, n.child[0])
 
 	case chanType:
 		t.cat = chanT
-		if t.val, err = nodeType(interp, sc, n.child[0]); err != nil {
+		if t.val, err = r.nodeType(interp, sc, n.child[0]); err != nil {
 			return nil, err
 		}
 		t.incomplete = t.val.incomplete
 
 	case chanTypeRecv:
 		t.cat = chanRecvT
-		if t.val, err = nodeType(interp, sc, n.child[0]); err != nil {
+		if t.val, err = r.nodeType(interp, sc, n.child[0]); err != nil {
 			return nil, err
 		}
 		t.incomplete = t.val.incomplete
 
 	case chanTypeSend:
 		t.cat = chanSendT
-		if t.val, err = nodeType(interp, sc, n.child[0]); err != nil {
+		if t.val, err = r.nodeType(interp, sc, n.child[0]); err != nil {
 			return nil, err
 		}
 		t.incomplete = t.val.incomplete
 
 	case ellipsisExpr:
 		t.cat = variadicT
-		if t.val, err = nodeType(interp, sc, n.child[0]); err != nil {
+		if t.val, err = r.nodeType(interp, sc, n.child[0]); err != nil {
 			return nil, err
 		}
 		t.incomplete = t.val.incomplete
 
 	case funcLit:
-		t, err = nodeType(interp, sc, n.child[2])
+		t, err = r.nodeType(interp, sc, n.child[2])
 
 	case funcType:
 		t.cat = funcT
 		// Handle input parameters
 		for _, arg := range n.child[0].child {
 			cl := len(arg.child) - 1
-			typ, err := nodeType(interp, sc, arg.child[cl])
+			typ, err := r.nodeType(interp, sc, arg.child[cl])
 			if err != nil {
 				return nil, err
 			}
@@ -419,7 +511,7 @@ func nodeType(interp *Interpreter, sc *scope, n *node) (*itype, error) {
 			// Handle returned values
 			for _, ret := range n.child[1].child {
 				cl := len(ret.child) - 1
-				typ, err := nodeType(interp, sc, ret.child[cl])
+				typ, err := r.nodeType(interp, sc, ret.child[cl])
 				if err != nil {
 					return nil, err
 				}
@@ -446,9 +538,17 @@ func nodeType(interp *Interpreter, sc *scope, n *node) (*itype, error) {
 			}
 		}
 		t = sym.typ
+		if t.cat == genericT {
+			g := t.node.val.(*generic)
+			return nil, n.cfgErrorf("cannot use generic %s %s without instantiation", g.kind(), n.ident)
+		}
 		if t.incomplete && t.node != n {
+			if err = r.cycle(t.node); err != nil {
+				return nil, err
+			}
+			r.decls = append(r.decls, t.node)
 			m := t.method
-			if t, err = nodeType(interp, sc, t.node); err != nil {
+			if t, err = r.nodeType(interp, sc, t.node); err != nil {
 				return nil, err
 			}
 			t.method = m
@@ -459,8 +559,19 @@ func nodeType(interp *Interpreter, sc *scope, n *node) (*itype, error) {
 		}
 
 	case indexExpr:
+		if g := interp.genericOf(sc, n.child[0]); g != nil {
+			var ok bool
+			if ok, err = interp.instantiateIndex(r, sc, n, g); err != nil {
+				return nil, err
+			}
+			if !ok {
+				t.incomplete = true
+				break
+			}
+			return r.nodeType(interp, sc, n)
+		}
 		var lt *itype
-		if lt, err = nodeType(interp, sc, n.child[0]); err != nil {
+		if lt, err = r.nodeType(interp, sc, n.child[0]); err != nil {
 			return nil, err
 		}
 		if lt.incomplete {
@@ -477,19 +588,22 @@ func nodeType(interp *Interpreter, sc *scope, n *node) (*itype, error) {
 		var incomplete bool
 		if sname := typeName(n); sname != "" {
 			if sym, _, found := sc.lookup(sname); found && sym.kind == typeSym {
+				// The type is resolved from now, which ends the cycles
+				// through its declaration.
 				sym.typ = t
+				r.decls = nil
 			}
 		}
 		for _, field := range n.child[0].child {
 			if len(field.child) == 1 {
-				typ, err := nodeType(interp, sc, field.child[0])
+				typ, err := r.nodeType(interp, sc, field.child[0])
 				if err != nil {
 					return nil, err
 				}
 				t.field = append(t.field, structField{name: fieldName(field.child[0]), embed: true, typ: typ})
 				incomplete = incomplete || typ.incomplete
 			} else {
-				typ, err := nodeType(interp, sc, field.child[1])
+				typ, err := r.nodeType(interp, sc, field.child[1])
 				if err != nil {
 					return nil, err
 				}
@@ -504,16 +618,16 @@ func nodeType(interp *Interpreter, sc *scope, n *node) (*itype, error) {
 
 	case mapType:
 		t.cat = mapT
-		if t.key, err = nodeType(interp, sc, n.child[0]); err != nil {
+		if t.key, err = r.nodeType(interp, sc, n.child[0]); err != nil {
 			return nil, err
 		}
-		if t.val, err = nodeType(interp, sc, n.child[1]); err != nil {
+		if t.val, err = r.nodeType(interp, sc, n.child[1]); err != nil {
 			return nil, err
 		}
 		t.incomplete = t.key.incomplete || t.val.incomplete
 
 	case parenExpr:
-		t, err = nodeType(interp, sc, n.child[0])
+		t, err = r.nodeType(interp, sc, n.child[0])
 
 	case selectorExpr:
 		// Resolve the left part of selector, then lookup the right part on it
@@ -539,7 +653,7 @@ func nodeType(interp *Interpreter, sc *scope, n *node) (*itype, error) {
 			}
 		}
 
-		if lt, err = nodeType(interp, localScope, n.child[0]); err != nil {
+		if lt, err = r.nodeType(interp, localScope, n.child[0]); err != nil {
 			return nil, err
 		}
 
@@ -569,7 +683,7 @@ func nodeType(interp *Interpreter, sc *scope, n *node) (*itype, error) {
 			}
 		default:
 			if m, _ := lt.lookupMethod(name); m != nil {
-				t, err = nodeType(interp, sc, m.child[2])
+				t, err = r.nodeType(interp, sc, m.child[2])
 			} else if bm, _, _, ok := lt.lookupBinMethod(name); ok {
 				t = &itype{cat: valueT, rtype: bm.Type, recv: lt, isBinMethod: true, scope: sc}
 			} else if ti := lt.lookupField(name); len(ti) > 0 {
@@ -582,7 +696,7 @@ func nodeType(interp *Interpreter, sc *scope, n *node) (*itype, error) {
 		}
 
 	case sliceExpr:
-		t, err = nodeType(interp, sc, n.child[0])
+		t, err = r.nodeType(interp, sc, n.child[0])
 		if t.cat == ptrT {
 			t = t.val
 		}
@@ -599,13 +713,16 @@ func nodeType(interp *Interpreter, sc *scope, n *node) (*itype, error) {
 		var incomplete bool
 		if sname := typeName(n); sname != "" {
 			if sym, _, found := sc.lookup(sname); found && sym.kind == typeSym {
+				// The type is resolved from now, which ends the cycles
+				// through its declaration.
 				sym.typ = t
+				r.decls = nil
 			}
 		}
 		for _, c := range n.child[0].child {
 			switch {
 			case len(c.child) == 1:
-				typ, err := nodeType(interp, sc, c.child[0])
+				typ, err := r.nodeType(interp, sc, c.child[0])
 				if err != nil {
 					return nil, err
 				}
@@ -613,7 +730,7 @@ func nodeType(interp *Interpreter, sc *scope, n *node) (*itype, error) {
 				incomplete = incomplete || typ.incomplete
 			case len(c.child) == 2 && c.child[1].kind == basicLit:
 				tag := vString(c.child[1].rval)
-				typ, err := nodeType(interp, sc, c.child[0])
+				typ, err := r.nodeType(interp, sc, c.child[0])
 				if err != nil {
 					return nil, err
 				}
@@ -626,7 +743,7 @@ func nodeType(interp *Interpreter, sc *scope, n *node) (*itype, error) {
 					tag = vString(c.lastChild().rval)
 					l--
 				}
-				typ, err := nodeType(interp, sc, c.child[l-1])
+				typ, err := r.nodeType(interp, sc, c.child[l-1])
 				if err != nil {
 					return nil, err
 				}
@@ -1313,8 +1430,9 @@ func exportName(s string) string {
 
 var (
 	// TODO(mpl): generators.
-	interf   = reflect.TypeOf((*interface{})(nil)).Elem()
-	constVal = reflect.TypeOf((*constant.Value)(nil)).Elem()
+	interf    = reflect.TypeOf((*interface{})(nil)).Elem()
+	constVal  = reflect.TypeOf((*constant.Value)(nil)).Elem()
+	errorType = reflect.TypeOf((*error)(nil)).Elem()
 )
 
 // RefType returns a reflect.Type representation from an interpreter type.
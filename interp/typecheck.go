@@ -5,6 +5,7 @@ import (
 	"go/constant"
 	"math"
 	"reflect"
+	"sort"
 )
 
 type opPredicates map[action]func(reflect.Type) bool
@@ -54,9 +55,48 @@ func (check typecheck) assignment(n *node, typ *itype, context string) error {
 		}
 		return n.cfgErrorf("cannot use type %s as type %s in %s", n.typ.id(), typ.id(), context)
 	}
+	if name := ptrRecvMethod(n.typ, typ); name != "" {
+		if context == "" {
+			return n.cfgErrorf("cannot use type %s as type %s (method %s has pointer receiver)", n.typ.id(), typ.id(), name)
+		}
+		return n.cfgErrorf("cannot use type %s as type %s in %s (method %s has pointer receiver)", n.typ.id(), typ.id(), context, name)
+	}
 	return nil
 }
 
+// ptrRecvMethod returns the name of a method of the interface it, which is
+// not in the method set of the non pointer type t as it has a pointer
+// receiver, or "" if there is none.
+func ptrRecvMethod(t, it *itype) string {
+	if !isInterface(it) || isInterface(t) || t.cat == ptrT || t.cat == valueT || t.cat == nilT {
+		return ""
+	}
+	var names []string
+	for name := range it.methods() {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		m, index := t.lookupMethod(name)
+		if m == nil {
+			continue
+		}
+		if r := defRecvType(m); r == nil || r.cat != ptrT {
+			continue
+		}
+		// The method is promoted through an embedded pointer.
+		ptr := false
+		for typ := t; len(index) > 0 && !ptr; index = index[1:] {
+			typ = typ.field[index[0]].typ
+			ptr = typ.cat == ptrT
+		}
+		if !ptr {
+			return name
+		}
+	}
+	return ""
+}
+
 // assignExpr type checks an assign expression.
 //
 // This is done per pair of assignments.
@@ -190,7 +230,7 @@ func (check typecheck) comparison(n *node) error {
 		if typ.isNil() {
 			typ = c1.typ
 		}
-		return n.cfgErrorf("invalid operation: operator %v not defined on %s", n.action, typ.id(), ".")
+		return n.cfgErrorf("invalid operation: operator %v not defined on %s", n.action, typ.id())
 	}
 	return nil
 }
@@ -959,6 +999,27 @@ func (check typecheck) argument(p param, ftyp *itype, i, l int, ellipsis bool) e
 	return check.assignment(p.nod, atyp, "")
 }
 
+// result checks that the value of n, the last statement of an evaluated
+// source, can be assigned to a variable of runtime type t.
+func (check typecheck) result(n *node, t reflect.Type) error {
+	for (n.kind == fileStmt || n.kind == blockStmt) && len(n.child) > 0 {
+		n = n.lastChild()
+	}
+	if n.typ == nil {
+		return n.cfgErrorf("no value to assign to %s", t)
+	}
+
+	typ := &itype{cat: valueT, rtype: t}
+	if t.Kind() == reflect.Interface && n.typ.cat != valueT && !n.typ.untyped {
+		// Interpreted values are wrapped at runtime to implement the interface.
+		if !n.typ.implements(typ) {
+			return n.cfgErrorf("cannot use type %s as type %s in assignment", n.typ.id(), t)
+		}
+		return nil
+	}
+	return check.assignment(n, typ, "assignment")
+}
+
 func getArg(ftyp *itype, i int) *itype {
 	l := ftyp.numIn()
 	switch {
@@ -1065,7 +1126,7 @@ func (check typecheck) representable(n *node, t reflect.Type) error {
 		return nil
 	}
 
-	if !representableConst(c, t) {
+	if !representableConst(c, t) || !n.interp.target.representable(c, t) {
 		typ := n.typ.TypeOf()
 		if isNumber(typ) && isNumber(t) {
 			// numeric conversion : error msg
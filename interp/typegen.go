@@ -0,0 +1,303 @@
+package interp
+
+import (
+	"bytes"
+	"fmt"
+	"go/constant"
+	"go/format"
+	"io"
+	"math"
+	"path"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ExportTypes writes to w a Go source file declaring the types and constants
+// defined by the interpreted package importPath ("main" for the code evaluated
+// with Eval). Functions, methods and variables are not exported.
+// The produced code can be compiled, so types defined in scripts can be turned
+// into regular Go code, for example to be exposed back with Use.
+func (interp *Interpreter) ExportTypes(w io.Writer, importPath string) error {
+	interp.mutex.RLock()
+	sc, ok := interp.scopes[importPath]
+	if !ok {
+		interp.mutex.RUnlock()
+		return fmt.Errorf("package not found: %s", importPath)
+	}
+
+	// Symbols imported from other source packages (i.e. dot imports) are skipped.
+	foreign := map[*symbol]bool{}
+	for k, v := range interp.srcPkg {
+		if k == importPath {
+			continue
+		}
+		for _, s := range v {
+			foreign[s] = true
+		}
+	}
+
+	g := &typeGen{imports: map[string]string{}}
+	var types, consts []string
+	for name, s := range sc.sym {
+		if foreign[s] || strings.Contains(name, "/") || isInstanceName(name) || s.typ == nil {
+			continue
+		}
+		switch s.kind {
+		case typeSym:
+			g.pkg = s.typ.path
+			types = append(types, name)
+		case constSym:
+			consts = append(consts, name)
+		}
+	}
+	pkgName := interp.pkgNames[importPath]
+	if pkgName == "" {
+		pkgName = path.Base(importPath)
+	}
+	sort.Strings(types)
+	sort.Strings(consts)
+
+	var body bytes.Buffer
+	for _, name := range types {
+		t := sc.sym[name].typ
+		if t.cat == aliasT {
+			fmt.Fprintf(&body, "type %s %s\n\n", name, g.expr(t.val))
+		} else {
+			fmt.Fprintf(&body, "type %s %s\n\n", name, g.underlying(t))
+		}
+	}
+	if len(consts) > 0 {
+		body.WriteString("const (\n")
+		for _, name := range consts {
+			s := sc.sym[name]
+			if s.typ.untyped {
+				fmt.Fprintf(&body, "%s = %s\n", name, constLiteral(s.rval))
+			} else {
+				fmt.Fprintf(&body, "%s %s = %s\n", name, g.expr(s.typ), constLiteral(s.rval))
+			}
+		}
+		body.WriteString(")\n")
+	}
+	interp.mutex.RUnlock()
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by yaegi from interpreted package %s. DO NOT EDIT.\n\n", importPath)
+	fmt.Fprintf(&buf, "package %s\n\n", pkgName)
+	if len(g.imports) > 0 {
+		paths := make([]string, 0, len(g.imports))
+		for p := range g.imports {
+			paths = append(paths, p)
+		}
+		sort.Strings(paths)
+		buf.WriteString("import (\n")
+		for _, p := range paths {
+			if name := g.imports[p]; name != path.Base(p) {
+				fmt.Fprintf(&buf, "%s ", name)
+			}
+			fmt.Fprintf(&buf, "%q\n", p)
+		}
+		buf.WriteString(")\n\n")
+	}
+	buf.Write(body.Bytes())
+
+	b, err := format.Source(buf.Bytes())
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(b)
+	return err
+}
+
+// typeGen produces Go type expressions from interpreter types.
+type typeGen struct {
+	pkg     string            // path of types defined in the generated package
+	imports map[string]string // package names indexed by import path
+}
+
+// qualify returns the qualified name of a type defined in package pkgPath,
+// registering the corresponding import if necessary.
+func (g *typeGen) qualify(pkgPath, name string) string {
+	if pkgPath == "" || pkgPath == g.pkg {
+		return name
+	}
+	if _, ok := g.imports[pkgPath]; !ok {
+		g.imports[pkgPath] = identifier.FindString(pkgPath)
+	}
+	return g.imports[pkgPath] + "." + name
+}
+
+// expr returns the Go expression of type t, using its name if it is defined.
+func (g *typeGen) expr(t *itype) string {
+	if t.cat == valueT {
+		return g.rexpr(t.rtype)
+	}
+	if t.name != "" {
+		return g.qualify(t.path, t.name)
+	}
+	return g.underlying(t)
+}
+
+// underlying returns the Go expression of the structure of type t, ignoring its name.
+func (g *typeGen) underlying(t *itype) string {
+	switch t.cat {
+	case aliasT:
+		return g.expr(t.val)
+	case arrayT:
+		if t.size == 0 && !t.sizedef {
+			return "[]" + g.expr(t.val)
+		}
+		return "[" + strconv.Itoa(t.size) + "]" + g.expr(t.val)
+	case variadicT:
+		return "..." + g.expr(t.val)
+	case chanT:
+		return "chan " + g.expr(t.val)
+	case chanSendT:
+		return "chan<- " + g.expr(t.val)
+	case chanRecvT:
+		return "<-chan " + g.expr(t.val)
+	case funcT:
+		return "func" + g.signature(t)
+	case interfaceT:
+		s := "interface {\n"
+		for _, f := range t.field {
+			if f.embed {
+				s += g.expr(f.typ) + "\n"
+			} else {
+				s += f.name + g.signature(f.typ) + "\n"
+			}
+		}
+		return s + "}"
+	case mapT:
+		return "map[" + g.expr(t.key) + "]" + g.expr(t.val)
+	case ptrT:
+		return "*" + g.expr(t.val)
+	case structT:
+		s := "struct {\n"
+		for _, f := range t.field {
+			if f.embed {
+				s += g.expr(f.typ)
+			} else {
+				s += f.name + " " + g.expr(f.typ)
+			}
+			if f.tag != "" {
+				s += " " + tagLiteral(f.tag)
+			}
+			s += "\n"
+		}
+		return s + "}"
+	case valueT:
+		return g.rexpr(t.rtype)
+	}
+	return t.name
+}
+
+// signature returns the parameters and results of function type t.
+func (g *typeGen) signature(t *itype) string {
+	args := make([]string, len(t.arg))
+	for i, a := range t.arg {
+		args[i] = g.expr(a)
+	}
+	rets := make([]string, len(t.ret))
+	for i, r := range t.ret {
+		rets[i] = g.expr(r)
+	}
+	s := "(" + strings.Join(args, ", ") + ")"
+	switch len(rets) {
+	case 0:
+	case 1:
+		s += " " + rets[0]
+	default:
+		s += " (" + strings.Join(rets, ", ") + ")"
+	}
+	return s
+}
+
+// rexpr returns the Go expression of a runtime type.
+func (g *typeGen) rexpr(t reflect.Type) string {
+	if t.Name() != "" {
+		return g.qualify(t.PkgPath(), t.Name())
+	}
+	switch t.Kind() {
+	case reflect.Array:
+		return "[" + strconv.Itoa(t.Len()) + "]" + g.rexpr(t.Elem())
+	case reflect.Slice:
+		return "[]" + g.rexpr(t.Elem())
+	case reflect.Chan:
+		switch t.ChanDir() {
+		case reflect.SendDir:
+			return "chan<- " + g.rexpr(t.Elem())
+		case reflect.RecvDir:
+			return "<-chan " + g.rexpr(t.Elem())
+		}
+		return "chan " + g.rexpr(t.Elem())
+	case reflect.Map:
+		return "map[" + g.rexpr(t.Key()) + "]" + g.rexpr(t.Elem())
+	case reflect.Ptr:
+		return "*" + g.rexpr(t.Elem())
+	case reflect.Func:
+		args := make([]string, t.NumIn())
+		for i := range args {
+			if t.IsVariadic() && i == len(args)-1 {
+				args[i] = "..." + g.rexpr(t.In(i).Elem())
+			} else {
+				args[i] = g.rexpr(t.In(i))
+			}
+		}
+		rets := make([]string, t.NumOut())
+		for i := range rets {
+			rets[i] = g.rexpr(t.Out(i))
+		}
+		s := "func(" + strings.Join(args, ", ") + ")"
+		switch len(rets) {
+		case 0:
+		case 1:
+			s += " " + rets[0]
+		default:
+			s += " (" + strings.Join(rets, ", ") + ")"
+		}
+		return s
+	}
+	// Remaining unnamed types (structs, interfaces) are rare in exported
+	// symbols, fallback to the runtime representation.
+	return t.String()
+}
+
+// tagLiteral returns a Go string literal for a struct tag.
+func tagLiteral(tag string) string {
+	if strings.Contains(tag, "`") {
+		return strconv.Quote(tag)
+	}
+	return "`" + tag + "`"
+}
+
+// constLiteral returns a Go literal for a constant value.
+func constLiteral(v reflect.Value) string {
+	if !v.IsValid() {
+		return "0"
+	}
+	if c, ok := v.Interface().(constant.Value); ok {
+		if c.Kind() != constant.Float {
+			return c.ExactString()
+		}
+		// The exact representation of a float is a fraction, which would be
+		// an integer division.
+		f, _ := constant.Float64Val(c)
+		if math.IsInf(f, 0) {
+			return c.ExactString()
+		}
+		s := strconv.FormatFloat(f, 'g', -1, 64)
+		if !strings.ContainsAny(s, ".e") {
+			s += ".0"
+		}
+		return s
+	}
+	switch v.Kind() {
+	case reflect.String:
+		return strconv.Quote(v.String())
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(v.Float(), 'g', -1, 64)
+	}
+	return fmt.Sprint(v)
+}
@@ -0,0 +1,88 @@
+package interp_test
+
+import (
+	"bytes"
+	"go/ast"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"strings"
+	"testing"
+
+	"github.com/traefik/yaegi/interp"
+	"github.com/traefik/yaegi/stdlib"
+)
+
+func TestExportTypes(t *testing.T) {
+	i := interp.New(interp.Options{})
+	i.Use(stdlib.Symbols)
+
+	eval(t, i, `
+import "time"
+
+type Kind int
+
+const (
+	KindA Kind = iota
+	KindB
+)
+
+const Name = "model"
+
+const (
+	Half = 0.5
+	Two  = 2.0
+)
+
+type Point struct {
+	X, Y float64 `+"`json:\"x\"`"+`
+}
+
+type Shape interface {
+	Area() float64
+	Move(dx, dy float64) Shape
+}
+
+type Event struct {
+	Point
+	Kind  Kind
+	At    time.Time
+	Tags  map[string][]string
+	Next  *Event
+	Delay time.Duration
+}
+
+func (p Point) Norm() float64 { return p.X*p.X + p.Y*p.Y }
+
+var v = 3
+`)
+
+	var buf bytes.Buffer
+	if err := i.ExportTypes(&buf, "main"); err != nil {
+		t.Fatal(err)
+	}
+	src := buf.String()
+
+	for _, s := range []string{"type Kind int", "KindB Kind = 1", `= "model"`, "= 0.5\n", "= 2.0\n", "type Event struct", "At    time.Time", "Next  *Event", "Area() float64", `json:"x"`} {
+		if !strings.Contains(src, s) {
+			t.Errorf("missing %q in generated code:\n%s", s, src)
+		}
+	}
+	for _, s := range []string{"Norm", "v ="} {
+		if strings.Contains(src, s) {
+			t.Errorf("unexpected %q in generated code:\n%s", s, src)
+		}
+	}
+
+	// The generated code must be valid Go.
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, "gen.go", src, 0)
+	if err != nil {
+		t.Fatalf("%v\n%s", err, src)
+	}
+	conf := types.Config{Importer: importer.Default()}
+	if _, err := conf.Check("main", fset, []*ast.File{f}, nil); err != nil {
+		t.Fatalf("%v\n%s", err, src)
+	}
+}
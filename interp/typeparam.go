@@ -0,0 +1,1036 @@
+package interp
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+	"path"
+	"path/filepath"
+	"reflect"
+	"strings"
+)
+
+// generic is a generic function or type, or a constraint interface, declared
+// in interpreted code. Its declaration is kept as Go AST, and compiled for each
+// instantiation: the instance is declared in the package scope under the name
+// of the generic followed by its type arguments, as "Max[int]", with its type
+// parameters bound to the type arguments under the instance name followed by
+// their own, as "Max[int].T".
+type generic struct {
+	decl      ast.Node            // *ast.FuncDecl or *ast.TypeSpec
+	node      *node               // genericDecl node
+	name      string              // name of the function or type
+	path      string              // import path of the package
+	rpath     string              // relative path of the package, see gta
+	sc        *scope              // package scope
+	methods   []*ast.FuncDecl     // methods of a generic type
+	instances map[string][]*itype // type arguments of the instances, by instance name
+}
+
+// instantiateGeneric is generic.instantiate, set at initialization to break
+// the initialization cycle of builtin, whose actions may resolve the types of
+// instances, compiled from their Go AST.
+var instantiateGeneric func(g *generic, args []*itype) (string, error)
+
+func init() { instantiateGeneric = (*generic).instantiate }
+
+// isGenericDecl returns true if the Go declaration n is a generic function or
+// type, a method of a generic type, or a constraint interface, which can only
+// be used once instantiated.
+func isGenericDecl(n ast.Node) bool {
+	switch n := n.(type) {
+	case *ast.FuncDecl:
+		if n.Type.TypeParams != nil {
+			return true
+		}
+		if n.Recv != nil && len(n.Recv.List) == 1 {
+			_, ok := recvIndex(n.Recv.List[0].Type)
+			return ok
+		}
+	case *ast.TypeSpec:
+		return n.TypeParams != nil || isTypeSet(n.Type)
+	}
+	return false
+}
+
+// recvIndex returns the instantiation of the generic type of receiver type e,
+// as in func (s *Stack[T]) Push(v T), and true, or false if not generic.
+func recvIndex(e ast.Expr) (ast.Expr, bool) {
+	if s, ok := e.(*ast.StarExpr); ok {
+		e = s.X
+	}
+	switch e.(type) {
+	case *ast.IndexExpr, *ast.IndexListExpr:
+		return e, true
+	}
+	return nil, false
+}
+
+// basicTypes are the names of the predeclared basic types.
+var basicTypes = map[string]bool{
+	"bool": true, "byte": true, "complex64": true, "complex128": true, "float32": true, "float64": true,
+	"int": true, "int8": true, "int16": true, "int32": true, "int64": true, "rune": true, "string": true,
+	"uint": true, "uint8": true, "uint16": true, "uint32": true, "uint64": true, "uintptr": true,
+}
+
+// isTypeSet returns true if e is an interface with type elements, as
+// interface{ ~int | ~float64 }, which is only valid as a constraint.
+func isTypeSet(e ast.Expr) bool {
+	it, ok := e.(*ast.InterfaceType)
+	if !ok {
+		return false
+	}
+	for _, f := range it.Methods.List {
+		if len(f.Names) > 0 {
+			continue
+		}
+		switch t := f.Type.(type) {
+		case *ast.Ident:
+			if basicTypes[t.Name] {
+				return true
+			}
+		case *ast.SelectorExpr:
+		default:
+			return true
+		}
+	}
+	return false
+}
+
+// declare registers the generic declaration n of the package importPath in
+// its scope sc, during the global types analysis. It returns false if the
+// declaration must be revisited, for a method whose type is not yet declared.
+func (g *generic) declare(sc *scope, n *node, rpath, importPath string) (bool, error) {
+	g.node, g.rpath, g.path, g.sc = n, rpath, importPath, sc
+	switch d := g.decl.(type) {
+	case *ast.TypeSpec:
+		g.name = d.Name.Name
+	case *ast.FuncDecl:
+		if d.Recv == nil {
+			g.name = d.Name.Name
+			break
+		}
+		// Method of a generic type, instantiated with it.
+		x, _ := recvIndex(d.Recv.List[0].Type)
+		tn, ok := indexBase(x).(*ast.Ident)
+		if !ok {
+			return true, n.cfgErrorf("invalid receiver type")
+		}
+		sym, found := sc.sym[tn.Name]
+		if !found {
+			return false, nil
+		}
+		var t *generic
+		if sym.kind == genericSym {
+			t, _ = sym.node.val.(*generic)
+		}
+		if t == nil || !t.isType() {
+			return true, n.cfgErrorf("cannot use type parameters on a method of non generic type %s", tn.Name)
+		}
+		for _, m := range t.methods {
+			if m == d || d.Name.Name == "_" {
+				return true, nil
+			}
+		}
+		t.methods = append(t.methods, d)
+		for name, args := range t.instances {
+			if err := t.instantiateMethod(name, args, d); err != nil {
+				return true, err
+			}
+		}
+		return true, nil
+	}
+	if s, ok := sc.sym[g.name]; ok && s.kind == genericSym {
+		// A generic redeclared incrementally keeps the methods of the
+		// previous declaration.
+		if prev, ok := s.node.val.(*generic); ok && prev != g {
+			g.methods = append(g.methods, prev.methods...)
+		}
+	}
+	sc.sym[g.name] = &symbol{kind: genericSym, typ: &itype{cat: genericT, name: g.name, path: rpath, node: n, scope: sc}, node: n, index: -1}
+	return true, nil
+}
+
+// isType returns true if g is a generic type, usable as a type once
+// instantiated, and not a function or a constraint.
+func (g *generic) isType() bool {
+	s, ok := g.decl.(*ast.TypeSpec)
+	return ok && !isTypeSet(s.Type)
+}
+
+// kind returns the kind of generic declaration, for error messages.
+func (g *generic) kind() string {
+	if _, ok := g.decl.(*ast.FuncDecl); ok {
+		return "function"
+	}
+	return "type"
+}
+
+// typeParams returns the type parameters of g and their constraints.
+func (g *generic) typeParams() (names []string, constraints []ast.Expr) {
+	var fl *ast.FieldList
+	switch d := g.decl.(type) {
+	case *ast.FuncDecl:
+		fl = d.Type.TypeParams
+	case *ast.TypeSpec:
+		fl = d.TypeParams
+	}
+	if fl == nil {
+		return nil, nil
+	}
+	for _, f := range fl.List {
+		for _, id := range f.Names {
+			names = append(names, id.Name)
+			constraints = append(constraints, f.Type)
+		}
+	}
+	return names, constraints
+}
+
+// genericOf returns the generic referred to by node n, an identifier or a
+// selector of an imported source package, or nil.
+func (interp *Interpreter) genericOf(sc *scope, n *node) *generic {
+	var sym *symbol
+	switch n.kind {
+	case identExpr:
+		sym, _, _ = sc.lookup(n.ident)
+	case selectorExpr:
+		if n.child[0].kind != identExpr {
+			return nil
+		}
+		baseName := filepath.Base(interp.fset.Position(n.pos).Filename)
+		pkg, _, ok := sc.lookup(filepath.Join(n.child[0].ident, baseName))
+		if !ok || pkg.kind != pkgSym || pkg.typ.cat != srcPkgT {
+			return nil
+		}
+		sym = interp.srcPkg[pkg.typ.path][n.child[1].ident]
+	}
+	if sym == nil || sym.kind != genericSym {
+		return nil
+	}
+	g, _ := sym.node.val.(*generic)
+	return g
+}
+
+// instantiateIndex instantiates the generic referred to by the first child
+// of n, an index expression, with the other ones as type arguments, then
+// replaces n by the reference to the instance. It returns false if the type
+// arguments are not yet complete.
+func (interp *Interpreter) instantiateIndex(r typeResolution, sc *scope, n *node, g *generic) (bool, error) {
+	params, _ := g.typeParams()
+	if len(n.child)-1 != len(params) {
+		return false, n.cfgErrorf("got %d type arguments but %s has %d type parameters", len(n.child)-1, g.name, len(params))
+	}
+	args := make([]*itype, len(params))
+	for i, c := range n.child[1:] {
+		t, err := r.nodeType(interp, sc, c)
+		if err != nil {
+			return false, err
+		}
+		if t.incomplete {
+			return false, nil
+		}
+		args[i] = t
+	}
+	name, err := instantiateGeneric(g, args)
+	if err != nil {
+		return false, n.cfgErrorf("%v", err)
+	}
+	c0 := n.child[0]
+	setInstanceName(c0, name)
+	n.kind, n.action, n.gen, n.ident, n.child = c0.kind, c0.action, c0.gen, c0.ident, c0.child
+	for _, c := range n.child {
+		c.anc = n
+	}
+	return true, nil
+}
+
+// instantiateCall instantiates the generic function called by n for the
+// types of the arguments args, and returns the symbol of the instance.
+func (interp *Interpreter) instantiateCall(n *node, g *generic, args []*itype) (*symbol, error) {
+	if _, ok := g.decl.(*ast.FuncDecl); !ok {
+		return nil, n.cfgErrorf("cannot use generic type %s without instantiation", g.name)
+	}
+	targs, err := g.infer(args, n.action == aCallSlice)
+	if err != nil {
+		return nil, n.cfgErrorf("in call to %s, %v", g.name, err)
+	}
+	name, err := instantiateGeneric(g, targs)
+	if err != nil {
+		return nil, n.cfgErrorf("%v", err)
+	}
+	return g.sc.sym[name], nil
+}
+
+// setInstanceName renames the reference n to a generic, an identifier or a
+// selector, to the instance name.
+func setInstanceName(n *node, name string) {
+	if n.kind == selectorExpr {
+		n.child[1].ident = name
+		return
+	}
+	n.ident = name
+}
+
+// isInstanceName returns true if the symbol name is the one of an instance of
+// a generic, or of one of its type parameters.
+func isInstanceName(name string) bool { return strings.Contains(name, "[") }
+
+// instanceName returns the name of the instance of g for the type arguments.
+func (g *generic) instanceName(args []*itype) string {
+	ids := make([]string, len(args))
+	for i, t := range args {
+		ids[i] = t.id()
+	}
+	return g.name + "[" + strings.Join(ids, ",") + "]"
+}
+
+// instantiate declares the instance of g for the type arguments, if not
+// already done, and returns its name. The bodies of the functions and
+// methods are compiled later, by compileInstances, once all the
+// declarations of the package are known.
+func (g *generic) instantiate(args []*itype) (string, error) {
+	name := g.instanceName(args)
+	if _, ok := g.instances[name]; ok {
+		return name, nil
+	}
+	params, constraints := g.typeParams()
+	g.bind(name, params, args)
+	for i, c := range constraints {
+		if err := g.satisfies(name, args[i], c); err != nil {
+			for _, p := range params {
+				delete(g.sc.sym, name+"."+p)
+			}
+			return "", err
+		}
+	}
+	if g.instances == nil {
+		g.instances = map[string][]*itype{}
+	}
+	g.instances[name] = args
+
+	subst := map[ast.Node]string{}
+	var decl ast.Node
+	switch d := g.decl.(type) {
+	case *ast.FuncDecl:
+		subst[d.Type.TypeParams] = ""
+		subst[d.Name] = name
+		decl = d
+	case *ast.TypeSpec:
+		subst[d.TypeParams] = ""
+		subst[d.Name] = name
+		decl = d
+	}
+	paramRefs(decl, instanceParams(name, params), subst)
+	root, err := g.compile(decl, subst)
+	if err != nil {
+		return "", err
+	}
+	if root.kind == funcDecl {
+		g.node.interp.addInstance(root, g.path)
+		return name, nil
+	}
+	for _, m := range g.methods {
+		if err := g.instantiateMethod(name, args, m); err != nil {
+			return "", err
+		}
+	}
+	return name, nil
+}
+
+// instantiateMethod declares the method m of the instance name of the
+// generic type g, for the type arguments args.
+func (g *generic) instantiateMethod(name string, args []*itype, m *ast.FuncDecl) error {
+	x, _ := recvIndex(m.Recv.List[0].Type)
+	idx := indexArgs(x)
+	if len(idx) != len(args) {
+		return fmt.Errorf("%s: got %d type parameters, but receiver base type declares %d", g.node.interp.fset.Position(x.Pos()), len(idx), len(args))
+	}
+	params := make([]string, len(idx))
+	for i, e := range idx {
+		id, ok := e.(*ast.Ident)
+		if !ok {
+			return fmt.Errorf("%s: receiver type parameter %s must be an identifier", g.node.interp.fset.Position(e.Pos()), types.ExprString(e))
+		}
+		params[i] = id.Name
+	}
+	g.bind(name, params, args)
+	subst := map[ast.Node]string{x: name}
+	paramRefs(m, instanceParams(name, params), subst)
+	root, err := g.compile(m, subst)
+	if err != nil {
+		return err
+	}
+	g.node.interp.addInstance(root, g.path)
+	return nil
+}
+
+// bind declares the type parameters of the instance name in the package scope.
+func (g *generic) bind(name string, params []string, args []*itype) {
+	for i, p := range params {
+		g.sc.sym[name+"."+p] = &symbol{kind: typeSym, typ: args[i]}
+	}
+}
+
+// compile converts the declaration decl of an instance, with the
+// substitutions subst, and performs its global types analysis.
+func (g *generic) compile(decl ast.Node, subst map[ast.Node]string) (*node, error) {
+	interp := g.node.interp
+	_, root, err := interp.astTree(decl, subst)
+	if err != nil {
+		return nil, err
+	}
+	// The instance belongs to the file of the declaration, without being one
+	// of its nodes.
+	root.anc = g.node.anc
+	revisit, err := interp.gta(root, g.rpath, g.path)
+	if err != nil {
+		return nil, err
+	}
+	if len(revisit) > 0 {
+		if err := interp.gtaRetry(revisit, g.path); err != nil {
+			return nil, err
+		}
+	}
+	return root, nil
+}
+
+// instanceParams returns the names of the type parameters params bound for
+// the instance name.
+func instanceParams(name string, params []string) map[string]string {
+	m := make(map[string]string, len(params))
+	for _, p := range params {
+		m[p] = name + "." + p
+	}
+	return m
+}
+
+// paramRefs adds to subst the identifiers of n referring to the type
+// parameters names, replaced by their bound names. Field, method and label
+// names, and selected names, are not references.
+func paramRefs(n ast.Node, names map[string]string, subst map[ast.Node]string) {
+	skip := map[*ast.Ident]bool{}
+	ast.Inspect(n, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.SelectorExpr:
+			skip[n.Sel] = true
+		case *ast.Field:
+			for _, id := range n.Names {
+				skip[id] = true
+			}
+		case *ast.KeyValueExpr:
+			if id, ok := n.Key.(*ast.Ident); ok {
+				skip[id] = true
+			}
+		case *ast.LabeledStmt:
+			skip[n.Label] = true
+		case *ast.BranchStmt:
+			if n.Label != nil {
+				skip[n.Label] = true
+			}
+		case *ast.Ident:
+			if name, ok := names[n.Name]; ok && !skip[n] {
+				if _, done := subst[n]; !done {
+					subst[n] = name
+				}
+			}
+		}
+		return true
+	})
+}
+
+// indexBase returns the indexed expression of x, an index expression.
+func indexBase(x ast.Expr) ast.Expr {
+	switch x := x.(type) {
+	case *ast.IndexExpr:
+		return x.X
+	case *ast.IndexListExpr:
+		return x.X
+	}
+	return nil
+}
+
+// indexArgs returns the indices of x, an index expression.
+func indexArgs(x ast.Expr) []ast.Expr {
+	switch x := x.(type) {
+	case *ast.IndexExpr:
+		return []ast.Expr{x.Index}
+	case *ast.IndexListExpr:
+		return x.Indices
+	}
+	return nil
+}
+
+// infer returns the type arguments of the generic function g called with
+// arguments of types args, inferred by unification of its parameter types
+// with them, then with the core types of the constraints. Untyped constants
+// take the default type of the first or largest kind, as int < rune <
+// float64 < complex128.
+func (g *generic) infer(args []*itype, spread bool) ([]*itype, error) {
+	params, constraints := g.typeParams()
+	u := &unifier{g: g, bound: map[string]*itype{}}
+	for _, p := range params {
+		u.bound[p] = nil
+	}
+
+	var ptypes []ast.Expr
+	fd := g.decl.(*ast.FuncDecl)
+	for _, f := range fd.Type.Params.List {
+		for i := 0; i < len(f.Names) || i == 0 && len(f.Names) == 0; i++ {
+			ptypes = append(ptypes, f.Type)
+		}
+	}
+	paramOf := func(i int) ast.Expr {
+		if len(ptypes) == 0 {
+			return nil
+		}
+		if last := len(ptypes) - 1; i >= last {
+			if e, ok := ptypes[last].(*ast.Ellipsis); ok {
+				if spread {
+					return &ast.ArrayType{Elt: e.Elt}
+				}
+				return e.Elt
+			}
+		}
+		if i < len(ptypes) {
+			return ptypes[i]
+		}
+		return nil
+	}
+
+	for i, t := range args {
+		if e := paramOf(i); e != nil && t != nil && !t.untyped && t.cat != nilT {
+			if err := u.unify(e, t); err != nil {
+				return nil, err
+			}
+		}
+	}
+	untyped := map[string]*itype{}
+	for i, t := range args {
+		id, ok := paramOf(i).(*ast.Ident)
+		if !ok || t == nil || !t.untyped {
+			continue
+		}
+		if b, isParam := u.bound[id.Name]; isParam && b == nil {
+			if d := untyped[id.Name]; d == nil || untypedRank(t) > untypedRank(d) {
+				untyped[id.Name] = t
+			}
+		}
+	}
+	for name, t := range untyped {
+		u.bound[name] = t.defaultType()
+	}
+
+	// Core type inference, as for func Sort[S ~[]E, E cmp.Ordered](s S).
+	for changed := true; changed; {
+		changed = false
+		for i, p := range params {
+			t := u.bound[p]
+			core := coreTerm(constraints[i])
+			if t == nil || core == nil {
+				continue
+			}
+			n := u.count()
+			if err := u.unify(core, underlying(t)); err != nil {
+				return nil, err
+			}
+			changed = changed || u.count() > n
+		}
+	}
+
+	res := make([]*itype, len(params))
+	for i, p := range params {
+		if res[i] = u.bound[p]; res[i] == nil {
+			return nil, fmt.Errorf("cannot infer %s", p)
+		}
+	}
+	return res, nil
+}
+
+// untypedRank returns the rank of the kind of the untyped constant type t,
+// as int < rune < float < complex.
+func untypedRank(t *itype) int {
+	switch t.cat {
+	case int32T:
+		return 1
+	case float32T, float64T:
+		return 2
+	case complex64T, complex128T:
+		return 3
+	}
+	return 0
+}
+
+// coreTerm returns the single type term of the constraint e, without tilde,
+// as []E for ~[]E, or nil.
+func coreTerm(e ast.Expr) ast.Expr {
+	if it, ok := e.(*ast.InterfaceType); ok {
+		if len(it.Methods.List) != 1 || len(it.Methods.List[0].Names) > 0 {
+			return nil
+		}
+		e = it.Methods.List[0].Type
+	}
+	if u, ok := e.(*ast.UnaryExpr); ok && u.Op == token.TILDE {
+		e = u.X
+	}
+	switch e.(type) {
+	case *ast.ArrayType, *ast.MapType, *ast.ChanType, *ast.FuncType, *ast.StarExpr:
+		return e
+	}
+	return nil
+}
+
+// underlying returns the underlying type of t, for a type declared as another
+// named type.
+func underlying(t *itype) *itype {
+	for t.cat == aliasT {
+		t = t.val
+	}
+	return t
+}
+
+// unifier binds the type parameters of a generic function by unification of
+// type expressions with types.
+type unifier struct {
+	g     *generic
+	bound map[string]*itype // bound types, or nil, by type parameter
+}
+
+// count returns the number of bound type parameters.
+func (u *unifier) count() (n int) {
+	for _, t := range u.bound {
+		if t != nil {
+			n++
+		}
+	}
+	return n
+}
+
+// unify unifies the type expression e with the type t. Only the type
+// parameters are bound: the compatibility of the other types is checked
+// once the instance is compiled.
+func (u *unifier) unify(e ast.Expr, t *itype) error {
+	switch e := e.(type) {
+	case *ast.Ident:
+		b, ok := u.bound[e.Name]
+		switch {
+		case !ok:
+		case b == nil:
+			u.bound[e.Name] = t
+		case b.id() != t.id():
+			return fmt.Errorf("type %s does not match inferred type %s for %s", t.id(), b.id(), e.Name)
+		}
+	case *ast.ParenExpr:
+		return u.unify(e.X, t)
+	case *ast.StarExpr:
+		if v := elemType(t, ptrT, reflect.Ptr); v != nil {
+			return u.unify(e.X, v)
+		}
+	case *ast.Ellipsis:
+		if v := elemType(t, arrayT, reflect.Slice); v != nil {
+			return u.unify(e.Elt, v)
+		}
+	case *ast.ArrayType:
+		if v := elemType(t, arrayT, reflect.Slice, reflect.Array); v != nil {
+			return u.unify(e.Elt, v)
+		}
+	case *ast.ChanType:
+		if v := elemType(t, chanT, reflect.Chan); v != nil {
+			return u.unify(e.Value, v)
+		}
+	case *ast.MapType:
+		t = underlying(t)
+		switch {
+		case t.cat == mapT:
+			if err := u.unify(e.Key, t.key); err != nil {
+				return err
+			}
+			return u.unify(e.Value, t.val)
+		case t.cat == valueT && t.rtype.Kind() == reflect.Map:
+			if err := u.unify(e.Key, &itype{cat: valueT, rtype: t.rtype.Key()}); err != nil {
+				return err
+			}
+			return u.unify(e.Value, &itype{cat: valueT, rtype: t.rtype.Elem()})
+		}
+	case *ast.FuncType:
+		if t = underlying(t); t.cat != funcT {
+			break
+		}
+		var args, rets []ast.Expr
+		for _, f := range e.Params.List {
+			for i := 0; i < len(f.Names) || i == 0 && len(f.Names) == 0; i++ {
+				args = append(args, f.Type)
+			}
+		}
+		if e.Results != nil {
+			for _, f := range e.Results.List {
+				for i := 0; i < len(f.Names) || i == 0 && len(f.Names) == 0; i++ {
+					rets = append(rets, f.Type)
+				}
+			}
+		}
+		for i, a := range args {
+			if i < len(t.arg) {
+				if err := u.unify(a, t.arg[i]); err != nil {
+					return err
+				}
+			}
+		}
+		for i, r := range rets {
+			if i < len(t.ret) {
+				if err := u.unify(r, t.ret[i]); err != nil {
+					return err
+				}
+			}
+		}
+	case *ast.IndexExpr, *ast.IndexListExpr:
+		// Instance of a generic type of the package.
+		id, ok := indexBase(e).(*ast.Ident)
+		if !ok {
+			break
+		}
+		sym, ok := u.g.sc.sym[id.Name]
+		if !ok || sym.kind != genericSym {
+			break
+		}
+		gt, _ := sym.node.val.(*generic)
+		targs := gt.instances[t.name]
+		if t.path != gt.rpath || targs == nil {
+			break
+		}
+		for i, x := range indexArgs(e) {
+			if i < len(targs) {
+				if err := u.unify(x, targs[i]); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// elemType returns the element type of t if it is of category cat, or of a
+// binary type of one of the kinds, or nil.
+func elemType(t *itype, cat tcat, kinds ...reflect.Kind) *itype {
+	t = underlying(t)
+	if t.cat == cat {
+		return t.val
+	}
+	if t.cat == valueT {
+		for _, k := range kinds {
+			if t.rtype.Kind() == k {
+				return &itype{cat: valueT, rtype: t.rtype.Elem()}
+			}
+		}
+	}
+	return nil
+}
+
+// satisfies returns an error if the type argument t of the instance name does
+// not satisfy the constraint c.
+func (g *generic) satisfies(name string, t *itype, c ast.Expr) error {
+	ok, err := g.inTypeSet(name, t, c)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return fmt.Errorf("%s does not satisfy %s", t.id(), types.ExprString(c))
+	}
+	return nil
+}
+
+// inTypeSet returns true if the type t belongs to the type set of the
+// constraint c of the instance name. Generic constraints, with type
+// parameters of their own, are not checked.
+func (g *generic) inTypeSet(name string, t *itype, c ast.Expr) (bool, error) {
+	switch c := c.(type) {
+	case *ast.ParenExpr:
+		return g.inTypeSet(name, t, c.X)
+	case *ast.BinaryExpr:
+		if c.Op != token.OR {
+			break
+		}
+		if ok, err := g.inTypeSet(name, t, c.X); ok || err != nil {
+			return ok, err
+		}
+		return g.inTypeSet(name, t, c.Y)
+	case *ast.UnaryExpr:
+		if c.Op != token.TILDE {
+			break
+		}
+		term, err := g.typeOf(name, c.X)
+		if err != nil {
+			return false, err
+		}
+		return sameUnderlying(t, term), nil
+	case *ast.InterfaceType:
+		var methods []*ast.Field
+		for _, f := range c.Methods.List {
+			if len(f.Names) > 0 {
+				methods = append(methods, f)
+				continue
+			}
+			if ok, err := g.inTypeSet(name, t, f.Type); !ok || err != nil {
+				return ok, err
+			}
+		}
+		if len(methods) == 0 {
+			return true, nil
+		}
+		it, err := g.typeOf(name, &ast.InterfaceType{Interface: c.Interface, Methods: &ast.FieldList{List: methods}})
+		if err != nil {
+			return false, err
+		}
+		return implements(t, it), nil
+	case *ast.Ident:
+		sym, _, found := g.sc.lookup(c.Name)
+		switch {
+		case !found && c.Name == "comparable":
+			return t.comparable(), nil
+		case found && sym.kind == genericSym:
+			return constraintOf(c.Name, sym).inTypeSet(t)
+		}
+	case *ast.SelectorExpr:
+		if x, ok := c.X.(*ast.Ident); ok {
+			baseName := filepath.Base(g.node.interp.fset.Position(c.Pos()).Filename)
+			if pkg, _, ok := g.sc.lookup(filepath.Join(x.Name, baseName)); ok && pkg.kind == pkgSym && pkg.typ.cat == srcPkgT {
+				if sym := g.node.interp.srcPkg[pkg.typ.path][c.Sel.Name]; sym != nil && sym.kind == genericSym {
+					return constraintOf(types.ExprString(c), sym).inTypeSet(t)
+				}
+			}
+		}
+	case *ast.IndexExpr, *ast.IndexListExpr:
+		return true, nil
+	}
+
+	// A type term, or an interface type.
+	term, err := g.typeOf(name, c)
+	if err != nil {
+		return false, err
+	}
+	if isInterface(term) {
+		return implements(t, term), nil
+	}
+	return t.id() == term.id(), nil
+}
+
+// constraint is a constraint interface declared in interpreted code.
+type constraint struct {
+	name string
+	g    *generic
+	err  error
+}
+
+// constraintOf returns the constraint interface name declared by the
+// generic symbol sym.
+func constraintOf(name string, sym *symbol) constraint {
+	g, _ := sym.node.val.(*generic)
+	if s, ok := g.decl.(*ast.TypeSpec); !ok || s.TypeParams != nil || !isInterfaceExpr(s.Type) {
+		return constraint{name: name, err: fmt.Errorf("cannot use generic %s %s as constraint", g.kind(), name)}
+	}
+	return constraint{name: name, g: g}
+}
+
+// inTypeSet returns true if the type t belongs to the type set of the
+// constraint c.
+func (c constraint) inTypeSet(t *itype) (bool, error) {
+	if c.err != nil {
+		return false, c.err
+	}
+	return c.g.inTypeSet("", t, c.g.decl.(*ast.TypeSpec).Type)
+}
+
+// isInterfaceExpr returns true if e is an interface type literal.
+func isInterfaceExpr(e ast.Expr) bool {
+	_, ok := e.(*ast.InterfaceType)
+	return ok
+}
+
+// typeOf returns the type of the type expression e of the declaration of g,
+// with the type parameters bound for the instance name.
+func (g *generic) typeOf(name string, e ast.Expr) (*itype, error) {
+	subst := map[ast.Node]string{}
+	if name != "" {
+		params, _ := g.typeParams()
+		paramRefs(e, instanceParams(name, params), subst)
+	}
+	interp := g.node.interp
+	_, root, err := interp.astTree(e, subst)
+	if err != nil {
+		return nil, err
+	}
+	root.anc = g.node
+	return nodeType(interp, g.sc, root)
+}
+
+// sameUnderlying returns true if the types t and o have the same underlying
+// type.
+func sameUnderlying(t, o *itype) bool {
+	t, o = underlying(t), underlying(o)
+	if isBasic(o) {
+		return t.TypeOf().Kind() == o.TypeOf().Kind()
+	}
+	ut, uo := *t, *o
+	ut.name, ut.path, uo.name, uo.path = "", "", "", ""
+	return ut.id() == uo.id()
+}
+
+// isBasic returns true if t is a predeclared type other than error.
+func isBasic(t *itype) bool {
+	switch t.cat {
+	case boolT, complex64T, complex128T, float32T, float64T, intT, int8T, int16T, int32T, int64T,
+		stringT, uintT, uint8T, uint16T, uint32T, uint64T, uintptrT:
+		return true
+	case valueT:
+		k := t.rtype.Kind()
+		return k >= reflect.Bool && k <= reflect.Complex128 || k == reflect.String
+	}
+	return false
+}
+
+// implements returns true if t implements the interface it.
+func implements(t, it *itype) bool {
+	if it.cat == errorT {
+		if t.cat == valueT || t.cat == errorT {
+			return t.TypeOf().Implements(it.TypeOf())
+		}
+		_, ok := t.methods()["Error"]
+		return ok
+	}
+	return t.implements(it)
+}
+
+// addInstance adds the declaration n of an instance of a generic function or
+// method of the package importPath to the ones to compile.
+func (interp *Interpreter) addInstance(n *node, importPath string) {
+	interp.lazyMutex.Lock()
+	interp.instances = append(interp.instances, lazyInstance{n, importPath})
+	interp.lazyMutex.Unlock()
+}
+
+// lazyInstance is a function declaration of an instance to compile.
+type lazyInstance struct {
+	node *node
+	path string
+}
+
+// compileInstances compiles the declared instances of generic functions and
+// methods of the package importPath, whose declarations are all known, and
+// of the packages already imported. The compilation of an instance may
+// declare new ones, compiled as well.
+func (interp *Interpreter) compileInstances(importPath string) error {
+	for {
+		var inst *lazyInstance
+		interp.lazyMutex.Lock()
+		for i, l := range interp.instances {
+			if l.path == importPath || interp.srcPkg[l.path] != nil {
+				inst = &l
+				interp.instances = append(interp.instances[:i], interp.instances[i+1:]...)
+				break
+			}
+		}
+		interp.lazyMutex.Unlock()
+		if inst == nil {
+			return nil
+		}
+		if _, err := interp.cfg(inst.node, inst.path); err != nil {
+			return err
+		}
+		if err := genRun(inst.node); err != nil {
+			return err
+		}
+	}
+}
+
+// constraintPkgs are the sources of the packages of constraints, imported
+// from there if not found in the sources nor in the binary symbols, as their
+// generic declarations can not be exported by the host.
+var constraintPkgs = map[string]string{
+	"cmp": `package cmp
+
+type Ordered interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64 |
+		~string
+}
+
+func Less[T Ordered](x, y T) bool {
+	return (isNaN(x) && !isNaN(y)) || x < y
+}
+
+func Compare[T Ordered](x, y T) int {
+	xNaN := isNaN(x)
+	yNaN := isNaN(y)
+	if xNaN {
+		if yNaN {
+			return 0
+		}
+		return -1
+	}
+	if yNaN {
+		return +1
+	}
+	if x < y {
+		return -1
+	}
+	if x > y {
+		return +1
+	}
+	return 0
+}
+
+func Or[T comparable](vals ...T) T {
+	var zero T
+	for _, v := range vals {
+		if v != zero {
+			return v
+		}
+	}
+	return zero
+}
+
+func isNaN[T Ordered](x T) bool {
+	return x != x
+}
+`,
+	"golang.org/x/exp/constraints": `package constraints
+
+type Signed interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64
+}
+
+type Unsigned interface {
+	~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr
+}
+
+type Integer interface {
+	Signed | Unsigned
+}
+
+type Float interface {
+	~float32 | ~float64
+}
+
+type Complex interface {
+	~complex64 | ~complex128
+}
+
+type Ordered interface {
+	Integer | Float | ~string
+}
+`,
+}
+
+// importConstraints imports the package importPath from constraintPkgs, and
+// returns false if it is not one of them.
+func (interp *Interpreter) importConstraints(importPath string, skipTest bool) (string, bool, error) {
+	src, ok := constraintPkgs[importPath]
+	if !ok {
+		return "", false, nil
+	}
+	a := newArchiveFS(importPath, []archiveFile{{name: path.Base(importPath) + ".go", data: []byte(src)}})
+	name, err := interp.importArchivePkg(a, ".", importPath, "", skipTest)
+	return name, true, err
+}
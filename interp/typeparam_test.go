@@ -0,0 +1,134 @@
+package interp
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestTypeParams(t *testing.T) {
+	i := New(Options{})
+	if _, err := i.Eval(`package main
+
+import (
+	"cmp"
+
+	"golang.org/x/exp/constraints"
+)
+
+type Number interface {
+	~int | ~int64 | ~float64
+}
+
+func Sum[T Number](v ...T) (s T) {
+	for _, x := range v {
+		s += x
+	}
+	return s
+}
+
+func Map[S ~[]E, E, R any](s S, f func(E) R) []R {
+	r := make([]R, 0, len(s))
+	for _, v := range s {
+		r = append(r, f(v))
+	}
+	return r
+}
+
+type Stack[T any] struct {
+	items []T
+}
+
+func (s *Stack[T]) Push(v T) { s.items = append(s.items, v) }
+
+func (s *Stack[T]) Pop() T {
+	v := s.items[len(s.items)-1]
+	s.items = s.items[:len(s.items)-1]
+	return v
+}
+
+type List[T any] struct {
+	next *List[T]
+	val  T
+}
+
+func (l *List[T]) Last() T {
+	if l.next == nil {
+		return l.val
+	}
+	return l.next.Last()
+}
+
+type Stringer interface {
+	String() string
+}
+
+type ID int
+
+func (i ID) String() string { return "#" }
+
+func Join[T Stringer](v []T) (s string) {
+	for _, x := range v {
+		s += x.String()
+	}
+	return s
+}
+
+func Max[T cmp.Ordered](v ...T) T {
+	m := v[0]
+	for _, x := range v[1:] {
+		if cmp.Less(m, x) {
+			m = x
+		}
+	}
+	return m
+}
+
+func Half[T constraints.Integer](x T) T { return x / 2 }
+
+type MyInt int
+`); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, test := range []struct{ src, res string }{
+		{src: `Sum(1, 2, 3)`, res: "6"},
+		{src: `Sum(1, 2.5)`, res: "3.5"},
+		{src: `Sum(MyInt(1), 2)`, res: "3"},
+		{src: `Sum[float64]()`, res: "0"},
+		{src: `f := Sum[int64]; f(4, 5)`, res: "9"},
+		{src: `Map([]int{1, 2}, func(i int) string { return string(rune('a' + i)) })`, res: "[b c]"},
+		{src: `s := &Stack[string]{}; s.Push("a"); s.Push("b"); s.Pop()`, res: "b"},
+		{src: `l := &List[string]{val: "a", next: &List[string]{val: "b"}}; l.Last()`, res: "b"},
+		{src: `Join([]ID{1, 2})`, res: "##"},
+		{src: `Max("x", "z", "y")`, res: "z"},
+		{src: `Max[MyInt](1, 2)`, res: "2"},
+		{src: `cmp.Compare(2.5, 1)`, res: "1"},
+		{src: `Half(MyInt(7))`, res: "3"},
+	} {
+		v, err := i.Eval(test.src)
+		if err != nil {
+			t.Errorf("%s: %v", test.src, err)
+			continue
+		}
+		if s := fmt.Sprint(v); s != test.res {
+			t.Errorf("%s: got %s, want %s", test.src, s, test.res)
+		}
+	}
+
+	for _, test := range []struct{ src, err string }{
+		{src: `Sum("a")`, err: "string does not satisfy Number"},
+		{src: `Join([]int{1})`, err: "int does not satisfy Stringer"},
+		{src: `Max[bool](true)`, err: "bool does not satisfy cmp.Ordered"},
+		{src: `Half(1.5)`, err: "float64 does not satisfy constraints.Integer"},
+		{src: `Map(1, 2)`, err: "in call to Map, cannot infer"},
+		{src: `Sum[int, int](1)`, err: "got 2 type arguments but Sum has 1 type parameters"},
+		{src: `f := Sum`, err: "cannot use generic function Sum without instantiation"},
+		{src: `var s Stack`, err: "cannot use generic type Stack without instantiation"},
+		{src: `f := cmp.Less`, err: "cannot use generic function cmp.Less without instantiation"},
+	} {
+		if _, err := i.Eval(test.src); err == nil || !strings.Contains(err.Error(), test.err) {
+			t.Errorf("%s: got error %v, want %q", test.src, err, test.err)
+		}
+	}
+}
@@ -0,0 +1,38 @@
+package interp
+
+import (
+	"errors"
+	"strings"
+)
+
+// unsupportedPkgs are the standard packages which can not be interpreted
+// from source, with the reason and the alternative suggested to scripts
+// importing them, when they are not provided as binary symbols by the host.
+var unsupportedPkgs = map[string]string{
+	"C":             "cgo is not supported by the interpreter: call C code from the host, and export it as binary symbols",
+	"embed":         "the host must use the symbols of github.com/traefik/yaegi/stdlib, which export the embed package used by go:embed directives",
+	"plugin":        "Go plugins can not be loaded by interpreted code: import the sources of the plugin, or load it from the host with Interpreter.UsePlugin",
+	"runtime":       "the Go runtime can not be interpreted: the host must use the symbols of github.com/traefik/yaegi/stdlib, which export a subset of it",
+	"runtime/cgo":   "cgo is not supported by the interpreter: call C code from the host, and export it as binary symbols",
+	"runtime/debug": "the Go runtime can not be interpreted: the host must use the symbols of github.com/traefik/yaegi/stdlib",
+	"runtime/pprof": "the Go runtime can not be interpreted: the host must use the symbols of github.com/traefik/yaegi/stdlib, or profile interpreted code with Options.Tracer and CPUProfile",
+	"runtime/race":  "the race detector can not be used by interpreted code",
+	"runtime/trace": "the Go runtime can not be interpreted: the host must use the symbols of github.com/traefik/yaegi/stdlib",
+	"syscall":       "system calls are not available by default: the host must use the symbols of github.com/traefik/yaegi/stdlib/syscall, or of golang.org/x/sys exported with yaegi extract",
+	"syscall/js":    "package syscall/js is only available to WebAssembly hosts, exporting it as binary symbols",
+	"unsafe":        "unsafe operations are not available by default: the host must use the symbols of github.com/traefik/yaegi/stdlib/unsafe",
+}
+
+// unsupportedError returns the error of the import of the package importPath,
+// not found in sources nor in binary symbols, if it is a standard package not
+// supported by the interpreter, or nil.
+func unsupportedError(importPath string) error {
+	reason, ok := unsupportedPkgs[importPath]
+	if !ok && strings.HasPrefix(importPath, "internal/") {
+		reason, ok = "internal packages of the standard library can not be imported", true
+	}
+	if !ok {
+		return nil
+	}
+	return &ImportError{Path: importPath, Code: CodeUnsupported, Errs: []error{errors.New("package " + importPath + " is not supported: " + reason)}}
+}
@@ -6,6 +6,9 @@ import (
 )
 
 func valueGenerator(n *node, i int) func(*frame) reflect.Value {
+	if frameChecks {
+		return checkedValue(n, i)
+	}
 	switch n.level {
 	case 0:
 		return func(f *frame) reflect.Value { return valueOf(f.data, i) }
@@ -123,6 +126,12 @@ func genValue(n *node) func(*frame) reflect.Value {
 			}
 			i := n.sym.index
 			if n.sym.global {
+				if frameChecks {
+					want := slotType(n)
+					return func(f *frame) reflect.Value {
+						return checkSlot(n, n.interp.frame.data, i, want)
+					}
+				}
 				return func(f *frame) reflect.Value {
 					return n.interp.frame.data[i]
 				}
@@ -0,0 +1,179 @@
+package interp
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"syscall"
+)
+
+// ExitError is the error returned by an evaluation terminated by a call to
+// os.Exit, with Options.VirtualOS.
+type ExitError struct {
+	Code int // exit code
+}
+
+func (e *ExitError) Error() string { return "exit status " + strconv.Itoa(e.Code) }
+
+// vos is the virtualized process state of interpreted code, see
+// Options.VirtualOS.
+type vos struct {
+	mu   sync.RWMutex
+	wd   string     // working directory
+	exit *ExitError // termination of the current evaluation by a goroutine, or nil
+}
+
+// newVOS returns a virtualized process state with the working directory wd,
+// or the process one if empty.
+func newVOS(wd string) *vos {
+	if wd == "" {
+		wd, _ = os.Getwd()
+	}
+	if abs, err := filepath.Abs(wd); err == nil {
+		wd = abs
+	}
+	return &vos{wd: wd}
+}
+
+// abs returns the path name relative to the working directory.
+func (v *vos) abs(name string) string {
+	if name == "" || filepath.IsAbs(name) {
+		return name
+	}
+	v.mu.RLock()
+	defer v.mu.RUnlock()
+	return filepath.Join(v.wd, name)
+}
+
+func (v *vos) getwd() (string, error) {
+	v.mu.RLock()
+	defer v.mu.RUnlock()
+	return v.wd, nil
+}
+
+func (v *vos) chdir(dir string) error {
+	dir = v.abs(dir)
+	fi, err := os.Stat(dir)
+	if err != nil {
+		return &os.PathError{Op: "chdir", Path: dir, Err: unwrapPathError(err)}
+	}
+	if !fi.IsDir() {
+		return &os.PathError{Op: "chdir", Path: dir, Err: syscall.ENOTDIR}
+	}
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	v.wd = dir
+	return nil
+}
+
+func unwrapPathError(err error) error {
+	if pe, ok := err.(*os.PathError); ok {
+		return pe.Err
+	}
+	return err
+}
+
+// exitErr returns the termination of the current evaluation by os.Exit in a
+// goroutine, or nil.
+func (interp *Interpreter) exitErr() error {
+	v := interp.vos
+	if v == nil {
+		return nil
+	}
+	v.mu.RLock()
+	defer v.mu.RUnlock()
+	if v.exit == nil {
+		return nil
+	}
+	return v.exit
+}
+
+// resetExit clears the termination of a previous evaluation.
+func (interp *Interpreter) resetExit() {
+	if v := interp.vos; v != nil {
+		v.mu.Lock()
+		v.exit = nil
+		v.mu.Unlock()
+	}
+}
+
+// catchExit, deferred in goroutines of interpreted code, recovers a call to
+// os.Exit, which aborts the current evaluation as a cancellation does. Other
+// panics are propagated.
+func (interp *Interpreter) catchExit() {
+	r := recover()
+	if r == nil {
+		return
+	}
+	val, _ := untrace(r)
+	e, ok := val.(*ExitError)
+	if !ok {
+		panic(r)
+	}
+	v := interp.vos
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	if v.exit == nil {
+		v.exit = e
+		atomic.AddUint64(&interp.id, 1)
+	}
+}
+
+// vosPaths lists the arguments of the stdlib functions which are path names,
+// resolved relative to the virtual working directory.
+var vosPaths = map[string]map[string][]int{
+	"os": {
+		"Chmod": {0}, "Chown": {0}, "Chtimes": {0}, "Create": {0}, "CreateTemp": {0},
+		"DirFS": {0}, "Lchown": {0}, "Link": {0, 1}, "Lstat": {0}, "Mkdir": {0},
+		"MkdirAll": {0}, "MkdirTemp": {0}, "Open": {0}, "OpenFile": {0}, "ReadDir": {0},
+		"ReadFile": {0}, "Readlink": {0}, "Remove": {0}, "RemoveAll": {0}, "Rename": {0, 1},
+		"Stat": {0}, "Symlink": {1}, "Truncate": {0}, "WriteFile": {0},
+	},
+	"io/ioutil": {
+		"ReadDir": {0}, "ReadFile": {0}, "TempDir": {0}, "TempFile": {0}, "WriteFile": {0},
+	},
+	"path/filepath": {
+		"Abs": {0}, "EvalSymlinks": {0},
+	},
+}
+
+// fixVOS redefines the stdlib symbols of the used values which access the
+// process state, so they operate on the virtualized state of the interpreter.
+func fixVOS(interp *Interpreter, values Exports) {
+	v := interp.vos
+	for path, funcs := range vosPaths {
+		p := interp.binPkg[path]
+		if p == nil {
+			continue
+		}
+		for name, args := range funcs {
+			// Only the used symbols are wrapped, the others already are.
+			if fn, ok := values[path][name]; ok && fn.Kind() == reflect.Func {
+				p[name] = v.wrapPaths(fn, args)
+			}
+		}
+	}
+
+	if p := interp.binPkg["os"]; p != nil && values["os"] != nil {
+		p["Chdir"] = reflect.ValueOf(v.chdir)
+		p["Exit"] = reflect.ValueOf(func(code int) { panic(&ExitError{Code: code}) })
+		p["Getwd"] = reflect.ValueOf(v.getwd)
+	}
+}
+
+// wrapPaths returns a function calling fn, with its arguments of index args
+// resolved relative to the working directory.
+func (v *vos) wrapPaths(fn reflect.Value, args []int) reflect.Value {
+	return reflect.MakeFunc(fn.Type(), func(in []reflect.Value) []reflect.Value {
+		for _, i := range args {
+			in[i] = reflect.ValueOf(v.abs(in[i].String())).Convert(in[i].Type())
+		}
+		if fn.Type().IsVariadic() {
+			return fn.CallSlice(in)
+		}
+		return fn.Call(in)
+	})
+}
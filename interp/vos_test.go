@@ -0,0 +1,107 @@
+package interp
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestVirtualOS(t *testing.T) {
+	dir, err := ioutil.TempDir("", "vos")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+	if err := os.MkdirAll(filepath.Join(dir, "d"), 0700); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, "d", "f.txt"), []byte("hello"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	i := New(Options{VirtualOS: true, WorkDir: dir})
+	i.Use(Exports{
+		"os": {
+			"Chdir": reflect.ValueOf(os.Chdir),
+			"Exit":  reflect.ValueOf(os.Exit),
+			"Getwd": reflect.ValueOf(os.Getwd),
+			"Stat":  reflect.ValueOf(os.Stat),
+		},
+		"io/ioutil": {
+			"ReadFile":  reflect.ValueOf(ioutil.ReadFile),
+			"WriteFile": reflect.ValueOf(ioutil.WriteFile),
+		},
+	})
+	if _, err := i.Eval(`
+import (
+	"io/ioutil"
+	"os"
+)
+
+var done bool
+
+func read() string {
+	if err := os.Chdir("d"); err != nil {
+		return err.Error()
+	}
+	b, err := ioutil.ReadFile("f.txt")
+	if err != nil {
+		return err.Error()
+	}
+	if err := ioutil.WriteFile("g.txt", b, 0600); err != nil {
+		return err.Error()
+	}
+	wd, _ := os.Getwd()
+	return wd + ": " + string(b)
+}
+
+func exit() {
+	defer func() { done = true }()
+	os.Exit(3)
+}
+
+func exitGoroutine() {
+	go func() { os.Exit(4) }()
+	for {
+	}
+}`); err != nil {
+		t.Fatal(err)
+	}
+
+	res, err := i.Eval(`read()`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := res.String(), filepath.Join(dir, "d")+": hello"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "d", "g.txt")); err != nil {
+		t.Errorf("file not written in working directory: %v", err)
+	}
+	if res, err := i.Eval(`os.Chdir("f.txt")`); err != nil || res.IsNil() {
+		t.Errorf("got %v, %v, want a chdir error", res, err)
+	}
+	if got, err := os.Getwd(); err != nil || got != wd {
+		t.Errorf("process working directory changed to %q", got)
+	}
+
+	for src, code := range map[string]int{"exit()": 3, "exitGoroutine()": 4} {
+		_, err := i.Eval(src)
+		var e *ExitError
+		if !errors.As(err, &e) || e.Code != code {
+			t.Errorf("%s: got %v, want exit status %d", src, err, code)
+		}
+	}
+	if res, err := i.Eval(`done`); err != nil || !res.Bool() {
+		t.Errorf("got %v, %v, want deferred functions run", res, err)
+	}
+}
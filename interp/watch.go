@@ -0,0 +1,144 @@
+package interp
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+)
+
+// watch is a function watching a global variable, see Watch.
+type watch struct {
+	fn  func(old, new reflect.Value)
+	mu  sync.Mutex
+	old reflect.Value // value at the last notification
+}
+
+// Watch calls fn each time interpreted code assigns the package level
+// variable name of the package importPath, or one of its fields or elements,
+// with the values before and after the assignment, for example to apply a
+// configuration updated by a script without polling it. It returns a
+// function to stop watching.
+//
+// fn is called synchronously by the goroutine which assigned the variable,
+// after the assignment. The old value is a copy of the one at the previous
+// notification, which shares its elements with the new one if it is a slice
+// or a map. The values of interface variables are the ones they hold,
+// invalid if nil. Assignments made through pointers, or by binary code, are
+// not reported.
+func (interp *Interpreter) Watch(importPath, name string, fn func(old, new reflect.Value)) (stop func(), err error) {
+	interp.mutex.RLock()
+	sc := interp.scopes[importPath]
+	var sym *symbol
+	if sc != nil {
+		sym = sc.sym[name]
+	}
+	interp.mutex.RUnlock()
+	if sc == nil {
+		return nil, fmt.Errorf("package %s not found", importPath)
+	}
+	if sym == nil || sym.kind != varSym || sym.index < 0 {
+		return nil, fmt.Errorf("%s.%s is not a package variable", importPath, name)
+	}
+
+	w := &watch{fn: fn, old: interp.watchedValue(sym)}
+	interp.watchMutex.Lock()
+	defer interp.watchMutex.Unlock()
+	watches := interp.copyWatches()
+	watches[sym] = append(watches[sym], w)
+	interp.watches.Store(watches)
+
+	return func() {
+		interp.watchMutex.Lock()
+		defer interp.watchMutex.Unlock()
+		watches := interp.copyWatches()
+		for i, v := range watches[sym] {
+			if v == w {
+				watches[sym] = append(watches[sym][:i:i], watches[sym][i+1:]...)
+				break
+			}
+		}
+		if len(watches[sym]) == 0 {
+			delete(watches, sym)
+		}
+		interp.watches.Store(watches)
+	}, nil
+}
+
+// copyWatches returns a copy of the watches, to be modified and stored.
+func (interp *Interpreter) copyWatches() map[*symbol][]*watch {
+	watches, _ := interp.watches.Load().(map[*symbol][]*watch)
+	res := make(map[*symbol][]*watch, len(watches)+1)
+	for s, l := range watches {
+		res[s] = l
+	}
+	return res
+}
+
+// watchedValue returns a copy of the value of the global variable sym.
+func (interp *Interpreter) watchedValue(sym *symbol) reflect.Value {
+	f := interp.frame
+	f.mutex.RLock()
+	if sym.index >= len(f.data) {
+		f.mutex.RUnlock()
+		return reflect.Value{}
+	}
+	v := f.data[sym.index]
+	f.mutex.RUnlock()
+	c := reflect.New(v.Type()).Elem()
+	c.Set(v)
+	if c.Type() == valueInterfaceType {
+		return c.Interface().(valueInterface).value
+	}
+	return c
+}
+
+// assignedGlobal returns the global variable assigned by dest, the variable,
+// or one of its fields or elements, or nil.
+func assignedGlobal(dest *node) *symbol {
+	for {
+		if s := dest.sym; s != nil && (dest.kind == identExpr || dest.kind == selectorExpr) {
+			if s.kind == varSym && s.global {
+				return s
+			}
+			return nil
+		}
+		switch dest.kind {
+		case selectorExpr, indexExpr, parenExpr:
+			dest = dest.child[0]
+			if dest.typ != nil && dest.typ.cat == ptrT {
+				// The pointed value is assigned, not the pointer.
+				return nil
+			}
+		default:
+			return nil
+		}
+	}
+}
+
+// notifyWatches wraps the generator gen of an assignment of the global
+// variables syms, so that their watches, if any, are notified once assigned.
+func notifyWatches(gen bltnGenerator, syms []*symbol) bltnGenerator {
+	return func(n *node) {
+		gen(n)
+		exec := n.exec
+		interp := n.interp
+		n.exec = func(f *frame) bltn {
+			next := exec(f)
+			watches, _ := interp.watches.Load().(map[*symbol][]*watch)
+			if len(watches) == 0 {
+				return next
+			}
+			for _, s := range syms {
+				for _, w := range watches[s] {
+					v := interp.watchedValue(s)
+					w.mu.Lock()
+					old := w.old
+					w.old = v
+					w.mu.Unlock()
+					w.fn(old, v)
+				}
+			}
+			return next
+		}
+	}
+}
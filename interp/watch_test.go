@@ -0,0 +1,91 @@
+package interp
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestWatch(t *testing.T) {
+	i := New(Options{})
+	if _, err := i.Eval(`
+type Config struct{ Port int; Tags map[string]string }
+
+var (
+	port   = 80
+	config = Config{Port: 80}
+	any    interface{}
+	other  int
+	ptr    = &config
+)
+
+func setPort(p int) { port = p }
+
+func get() int { return 7 }
+`); err != nil {
+		t.Fatal(err)
+	}
+
+	var events []string
+	record := func(name string) func(old, new reflect.Value) {
+		return func(old, new reflect.Value) {
+			events = append(events, fmt.Sprintf("%s %v -> %v", name, old, new))
+		}
+	}
+	stop, err := i.Watch("main", "port", record("port"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range []string{"config", "any"} {
+		if _, err := i.Watch("main", name, record(name)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for _, src := range []string{
+		`port = 8080`,
+		`setPort(8081)`,
+		`port++`,
+		`port += 2`,
+		`port, other = 1, 2`,
+		`other = 3`,
+		`config.Port = 443`,
+		`config.Tags = map[string]string{}`,
+		`ptr.Port = 444`,
+		`any = "x"`,
+		`port = get()`,
+		`done := make(chan bool); go func() { port = 9; done <- true }(); <-done`,
+	} {
+		if _, err := i.Eval(src); err != nil {
+			t.Fatal(src, err)
+		}
+	}
+	stop()
+	if _, err := i.Eval(`port = 10`); err != nil {
+		t.Fatal(err)
+	}
+
+	want := []string{
+		"port 80 -> 8080",
+		"port 8080 -> 8081",
+		"port 8081 -> 8082",
+		"port 8082 -> 8084",
+		"port 8084 -> 1",
+		"config {80 map[]} -> {443 map[]}",
+		"config {443 map[]} -> {443 map[]}",
+		"any <invalid reflect.Value> -> x",
+		"port 1 -> 7",
+		"port 7 -> 9",
+	}
+	if got := strings.Join(events, "\n"); got != strings.Join(want, "\n") {
+		t.Errorf("got events:\n%s\nwant:\n%s", got, strings.Join(want, "\n"))
+	}
+
+	if _, err := i.Watch("main", "setPort", record("f")); err == nil || err.Error() != "main.setPort is not a package variable" {
+		t.Errorf("got error %v", err)
+	}
+	if _, err := i.Watch("nothere", "x", record("x")); err == nil || err.Error() != "package nothere not found" {
+		t.Errorf("got error %v", err)
+	}
+}
@@ -0,0 +1,144 @@
+package interp
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// ReadWorkspace reads the go.work like manifest at path, and returns the
+// module paths of the modules it uses, mapped to their directories, suitable
+// for Options.Workspace. Only the "use" directives are considered. The path
+// of each module is read from the go.mod file of its directory.
+func ReadWorkspace(path string) (map[string]string, error) {
+	buf, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	dirs, err := parseUse(buf)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", path, err)
+	}
+
+	modules := map[string]string{}
+	for _, dir := range dirs {
+		if !filepath.IsAbs(dir) {
+			dir = filepath.Join(filepath.Dir(path), dir)
+		}
+		mod, err := ioutil.ReadFile(filepath.Join(dir, "go.mod"))
+		if err != nil {
+			return nil, err
+		}
+		name := modulePath(mod)
+		if name == "" {
+			return nil, fmt.Errorf("%s: no module directive", filepath.Join(dir, "go.mod"))
+		}
+		if d, ok := modules[name]; ok {
+			return nil, fmt.Errorf("%s: module %s appears in both %s and %s", path, name, d, dir)
+		}
+		modules[name] = dir
+	}
+	return modules, nil
+}
+
+// parseUse returns the directories of the "use" directives of a go.work file.
+func parseUse(buf []byte) ([]string, error) {
+	entries, err := parseDirectives(buf, "use")
+	if err != nil {
+		return nil, err
+	}
+	dirs := make([]string, 0, len(entries))
+	for _, e := range entries {
+		if len(e.args) != 1 {
+			return nil, fmt.Errorf("%d: invalid use directive", e.line)
+		}
+		dirs = append(dirs, e.args[0])
+	}
+	return dirs, nil
+}
+
+// directive is an entry of a go.mod or go.work directive, with its unquoted
+// arguments.
+type directive struct {
+	line int
+	args []string
+}
+
+// parseDirectives returns the entries of the directives verb of a go.mod or
+// go.work file, in single line or block form.
+func parseDirectives(buf []byte, verb string) ([]directive, error) {
+	var entries []directive
+	inBlock := false
+	s := bufio.NewScanner(bytes.NewReader(buf))
+	for line := 1; s.Scan(); line++ {
+		l := s.Text()
+		if i := strings.Index(l, "//"); i >= 0 {
+			l = l[:i]
+		}
+		f := strings.Fields(l)
+		switch {
+		case len(f) == 0:
+			continue
+		case inBlock && f[0] == ")":
+			inBlock = false
+			continue
+		case inBlock:
+		case f[0] != verb:
+			continue
+		case len(f) == 2 && f[1] == "(":
+			inBlock = true
+			continue
+		default:
+			f = f[1:]
+		}
+		for i, a := range f {
+			var err error
+			if f[i], err = unquote(a); err != nil {
+				return nil, fmt.Errorf("%d: %v", line, err)
+			}
+		}
+		entries = append(entries, directive{line: line, args: f})
+	}
+	return entries, s.Err()
+}
+
+// modulePath returns the module path declared in the go.mod content, or an
+// empty string if not found.
+func modulePath(mod []byte) string {
+	s := bufio.NewScanner(bytes.NewReader(mod))
+	for s.Scan() {
+		f := strings.Fields(s.Text())
+		if len(f) >= 2 && f[0] == "module" {
+			p, _ := unquote(f[1])
+			return p
+		}
+	}
+	return ""
+}
+
+func unquote(s string) (string, error) {
+	if strings.HasPrefix(s, `"`) || strings.HasPrefix(s, "`") {
+		return strconv.Unquote(s)
+	}
+	return s, nil
+}
+
+// workspaceDir returns the directory of the package importPath if it belongs
+// to a module of the workspace, using the longest matching module path.
+func (interp *Interpreter) workspaceDir(importPath string) (string, bool) {
+	mod := ""
+	for m := range interp.workspace {
+		if (importPath == m || strings.HasPrefix(importPath, m+"/")) && len(m) > len(mod) {
+			mod = m
+		}
+	}
+	if mod == "" {
+		return "", false
+	}
+	return filepath.Join(interp.workspace[mod], filepath.FromSlash(strings.TrimPrefix(importPath, mod))), true
+}
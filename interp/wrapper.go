@@ -0,0 +1,96 @@
+package interp
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// UseWrapper registers wrapper as the wrapper of the interface iface, so the
+// values of interpreted types implementing iface can be passed to compiled
+// code expecting iface, which calls their methods in the interpreter. Both are
+// given as nil pointers to their types, as in Exports, for example:
+//
+//	i.UseWrapper((*io.Reader)(nil), (*_io_Reader)(nil))
+//
+// Reflect can not create methods at runtime, so the wrapper is a compiled
+// struct type with a field of function type per method of iface, in the
+// order of the methods, named after the method prefixed by "W". Its methods
+// call these fields, so it implements iface. Such wrappers are generated by
+// the extract command for all the interfaces of a package, and registered by
+// Use when exported as the interface name prefixed by "_".
+func (interp *Interpreter) UseWrapper(iface, wrapper interface{}) error {
+	it := reflect.TypeOf(iface)
+	if it == nil || it.Kind() != reflect.Ptr || it.Elem().Kind() != reflect.Interface || it.Elem().NumMethod() == 0 {
+		return fmt.Errorf("use wrapper: invalid interface %T: not a pointer to an interface type with methods", iface)
+	}
+	wt := reflect.TypeOf(wrapper)
+	if wt == nil || wt.Kind() != reflect.Ptr {
+		return fmt.Errorf("use wrapper: invalid wrapper %T: not a pointer", wrapper)
+	}
+	it, wt = it.Elem(), wt.Elem()
+	if err := checkWrapper(it, wt); err != nil {
+		return fmt.Errorf("use wrapper: %v", err)
+	}
+
+	interp.evalMutex.Lock()
+	defer interp.evalMutex.Unlock()
+	interp.addWrapper(it, wt)
+	return nil
+}
+
+// checkWrapper returns an error if wt is not a wrapper of the interface it.
+func checkWrapper(it, wt reflect.Type) error {
+	if wt.Kind() != reflect.Struct || wt.NumField() != it.NumMethod() {
+		return fmt.Errorf("%v is not a struct with a field per method of %v", wt, it)
+	}
+	for i := 0; i < it.NumMethod(); i++ {
+		m, f := it.Method(i), wt.Field(i)
+		if f.Name != "W"+m.Name || f.Type != m.Type {
+			return fmt.Errorf("field %d of %v is %s %v, want W%s %v", i, wt, f.Name, f.Type, m.Name, m.Type)
+		}
+	}
+	if !wt.Implements(it) {
+		return fmt.Errorf("%v does not implement %v", wt, it)
+	}
+	return nil
+}
+
+func (interp *Interpreter) addWrapper(it, wt reflect.Type) {
+	if interp.wrappers == nil {
+		interp.wrappers = map[reflect.Type]reflect.Type{}
+	}
+	interp.wrappers[it] = wt
+}
+
+// useWrappers registers the valid interface wrappers of values, exported as
+// the interface name prefixed by "_", whatever the import path of the package.
+func (interp *Interpreter) useWrappers(values Exports) {
+	for _, syms := range values {
+		for name, w := range syms {
+			if !strings.HasPrefix(name, "_") || w.Kind() != reflect.Ptr || w.Type().Elem().Kind() != reflect.Struct {
+				continue
+			}
+			i, ok := syms[name[1:]]
+			if !ok || i.Kind() != reflect.Ptr || i.Type().Elem().Kind() != reflect.Interface {
+				continue
+			}
+			if it, wt := i.Type().Elem(), w.Type().Elem(); checkWrapper(it, wt) == nil {
+				interp.addWrapper(it, wt)
+			}
+		}
+	}
+}
+
+// getWrapper returns the wrapper type of the corresponding interface, or nil if not found.
+func (interp *Interpreter) getWrapper(t reflect.Type) reflect.Type {
+	if wt, ok := interp.wrappers[t]; ok {
+		return wt
+	}
+	if p, ok := interp.binPkg[t.PkgPath()]; ok {
+		if w, ok := p["_"+t.Name()]; ok {
+			return w.Type().Elem()
+		}
+	}
+	return nil
+}
@@ -0,0 +1,109 @@
+package interp
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+type shape interface {
+	Area() int
+	Name() string
+}
+
+type _shape struct {
+	WArea func() int
+	WName func() string
+}
+
+func (W _shape) Area() int    { return W.WArea() }
+func (W _shape) Name() string { return W.WName() }
+
+func describe(s shape) string { return s.Name() + ":" + strings.Repeat("#", s.Area()) }
+
+const shapeSrc = `
+import "host"
+
+type square struct{ side int }
+
+func (s square) Area() int     { return s.side * s.side }
+func (s square) Name() string { return "square" }
+`
+
+func TestUseWrapper(t *testing.T) {
+	i := New(Options{})
+	i.Use(Exports{"host": {
+		"Describe": reflect.ValueOf(describe),
+		"Shape":    reflect.ValueOf((*shape)(nil)),
+	}})
+	if _, err := i.Eval(shapeSrc); err != nil {
+		t.Fatal(err)
+	}
+
+	// Without wrapper, the conversion is rejected at compile time.
+	if _, err := i.Eval(`host.Describe(square{2})`); err == nil || !strings.Contains(err.Error(), "no wrapper of the interface") {
+		t.Fatalf("got error %v, want no wrapper", err)
+	}
+
+	if err := i.UseWrapper((*shape)(nil), (*_shape)(nil)); err != nil {
+		t.Fatal(err)
+	}
+	res, err := i.Eval(`host.Describe(square{2})`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s := res.Interface(); s != "square:####" {
+		t.Errorf("got %v, want square:####", s)
+	}
+
+	// Compiled code also obtains the interpreted values as the interface.
+	var s shape
+	if err := i.EvalInto(`square{1}`, &s); err != nil {
+		t.Fatal(err)
+	}
+	if got := describe(s); got != "square:#" {
+		t.Errorf("got %v, want square:#", got)
+	}
+}
+
+func TestUseWrapperExports(t *testing.T) {
+	// A wrapper exported by Use is registered whatever the import path.
+	i := New(Options{})
+	i.Use(Exports{"host": {
+		"Describe": reflect.ValueOf(describe),
+		"Shape":    reflect.ValueOf((*shape)(nil)),
+		"_Shape":   reflect.ValueOf((*_shape)(nil)),
+	}})
+	if _, err := i.Eval(shapeSrc); err != nil {
+		t.Fatal(err)
+	}
+	if res, err := i.Eval(`host.Describe(square{1})`); err != nil || res.Interface() != "square:#" {
+		t.Fatalf("got %v %v, want square:#", res, err)
+	}
+}
+
+func TestUseWrapperErrors(t *testing.T) {
+	type badOrder struct {
+		WName func() string
+		WArea func() int
+	}
+	type noMethods struct {
+		WArea func() int
+		WName func() string
+	}
+	i := New(Options{})
+	for _, test := range []struct {
+		iface, wrapper interface{}
+		err            string
+	}{
+		{iface: shape(nil), wrapper: (*_shape)(nil), err: "use wrapper: invalid interface <nil>: not a pointer to an interface type with methods"},
+		{iface: (*interface{})(nil), wrapper: (*_shape)(nil), err: "use wrapper: invalid interface *interface {}: not a pointer to an interface type with methods"},
+		{iface: (*shape)(nil), wrapper: _shape{}, err: "use wrapper: invalid wrapper interp._shape: not a pointer"},
+		{iface: (*shape)(nil), wrapper: (*badOrder)(nil), err: "use wrapper: field 0 of interp.badOrder is WName func() string, want WArea func() int"},
+		{iface: (*shape)(nil), wrapper: (*noMethods)(nil), err: "use wrapper: interp.noMethods does not implement interp.shape"},
+	} {
+		if err := i.UseWrapper(test.iface, test.wrapper); err == nil || err.Error() != test.err {
+			t.Errorf("got error %v, want %s", err, test.err)
+		}
+	}
+}
@@ -0,0 +1,461 @@
+package server
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+)
+
+// maxCBORDepth is the maximum nesting of the values encoded or decoded in
+// CBOR, which also stops the encoding of cyclic values.
+const maxCBORDepth = 512
+
+var (
+	timeType = reflect.TypeOf(time.Time{})
+
+	errCBOREOF   = errors.New("cbor: unexpected end of data")
+	errCBORDepth = errors.New("cbor: value too deeply nested")
+)
+
+// Major types of CBOR data items.
+const (
+	cborUint   = 0
+	cborNegInt = 1
+	cborBytes  = 2
+	cborText   = 3
+	cborArray  = 4
+	cborMap    = 5
+	cborTag    = 6
+	cborSimple = 7
+)
+
+// cborMarshal returns the CBOR encoding, as defined by RFC 8949, of v. Structs
+// are encoded as maps of their exported fields, named by their json tag if
+// any, map keys are sorted as by the core deterministic encoding, and
+// time.Time values as date and time strings (tag 0).
+func cborMarshal(v reflect.Value) ([]byte, error) {
+	var e cborEncoder
+	if err := e.encode(v, 0); err != nil {
+		return nil, err
+	}
+	return e.buf, nil
+}
+
+type cborEncoder struct {
+	buf []byte
+}
+
+// head appends the head of a data item of type major and argument n.
+func (e *cborEncoder) head(major byte, n uint64) {
+	major <<= 5
+	switch {
+	case n < 24:
+		e.buf = append(e.buf, major|byte(n))
+	case n <= math.MaxUint8:
+		e.buf = append(e.buf, major|24, byte(n))
+	case n <= math.MaxUint16:
+		e.buf = append(e.buf, major|25)
+		e.buf = binary.BigEndian.AppendUint16(e.buf, uint16(n))
+	case n <= math.MaxUint32:
+		e.buf = append(e.buf, major|26)
+		e.buf = binary.BigEndian.AppendUint32(e.buf, uint32(n))
+	default:
+		e.buf = append(e.buf, major|27)
+		e.buf = binary.BigEndian.AppendUint64(e.buf, n)
+	}
+}
+
+func (e *cborEncoder) text(s string) {
+	e.head(cborText, uint64(len(s)))
+	e.buf = append(e.buf, s...)
+}
+
+func (e *cborEncoder) encode(v reflect.Value, depth int) error {
+	if depth > maxCBORDepth {
+		return errCBORDepth
+	}
+	if !v.IsValid() {
+		e.buf = append(e.buf, 0xf6)
+		return nil
+	}
+	if v.Type() == timeType {
+		e.head(cborTag, 0)
+		e.text(v.Interface().(time.Time).Format(time.RFC3339Nano))
+		return nil
+	}
+
+	switch v.Kind() {
+	case reflect.Bool:
+		if v.Bool() {
+			e.buf = append(e.buf, 0xf5)
+		} else {
+			e.buf = append(e.buf, 0xf4)
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if n := v.Int(); n >= 0 {
+			e.head(cborUint, uint64(n))
+		} else {
+			e.head(cborNegInt, uint64(-1-n))
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		e.head(cborUint, v.Uint())
+	case reflect.Float32:
+		e.buf = append(e.buf, 0xfa)
+		e.buf = binary.BigEndian.AppendUint32(e.buf, math.Float32bits(float32(v.Float())))
+	case reflect.Float64:
+		e.buf = append(e.buf, 0xfb)
+		e.buf = binary.BigEndian.AppendUint64(e.buf, math.Float64bits(v.Float()))
+	case reflect.String:
+		e.text(v.String())
+	case reflect.Slice:
+		if v.IsNil() {
+			e.buf = append(e.buf, 0xf6)
+			return nil
+		}
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			e.head(cborBytes, uint64(v.Len()))
+			e.buf = append(e.buf, v.Bytes()...)
+			return nil
+		}
+		return e.array(v, depth)
+	case reflect.Array:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			e.head(cborBytes, uint64(v.Len()))
+			for i := 0; i < v.Len(); i++ {
+				e.buf = append(e.buf, byte(v.Index(i).Uint()))
+			}
+			return nil
+		}
+		return e.array(v, depth)
+	case reflect.Map:
+		if v.IsNil() {
+			e.buf = append(e.buf, 0xf6)
+			return nil
+		}
+		return e.mapping(v, depth)
+	case reflect.Struct:
+		return e.structure(v, depth)
+	case reflect.Ptr, reflect.Interface:
+		if v.IsNil() {
+			e.buf = append(e.buf, 0xf6)
+			return nil
+		}
+		return e.encode(v.Elem(), depth+1)
+	default:
+		return fmt.Errorf("cbor: unsupported type %s", v.Type())
+	}
+	return nil
+}
+
+func (e *cborEncoder) array(v reflect.Value, depth int) error {
+	e.head(cborArray, uint64(v.Len()))
+	for i := 0; i < v.Len(); i++ {
+		if err := e.encode(v.Index(i), depth+1); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// mapping encodes the map v, sorted by the bytes of the encoded keys.
+func (e *cborEncoder) mapping(v reflect.Value, depth int) error {
+	type entry struct{ key, val []byte }
+	entries := make([]entry, 0, v.Len())
+	iter := v.MapRange()
+	for iter.Next() {
+		var k, val cborEncoder
+		if err := k.encode(iter.Key(), depth+1); err != nil {
+			return err
+		}
+		if err := val.encode(iter.Value(), depth+1); err != nil {
+			return err
+		}
+		entries = append(entries, entry{k.buf, val.buf})
+	}
+	sort.Slice(entries, func(i, j int) bool { return bytes.Compare(entries[i].key, entries[j].key) < 0 })
+
+	e.head(cborMap, uint64(len(entries)))
+	for _, en := range entries {
+		e.buf = append(append(e.buf, en.key...), en.val...)
+	}
+	return nil
+}
+
+// structure encodes the struct v as a map of its exported fields.
+func (e *cborEncoder) structure(v reflect.Value, depth int) error {
+	t := v.Type()
+	var names []string
+	var fields []int
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.PkgPath != "" {
+			continue
+		}
+		name := f.Name
+		if tag := f.Tag.Get("json"); tag != "" {
+			if tag = strings.Split(tag, ",")[0]; tag == "-" {
+				continue
+			} else if tag != "" {
+				name = tag
+			}
+		}
+		names = append(names, name)
+		fields = append(fields, i)
+	}
+
+	e.head(cborMap, uint64(len(fields)))
+	for i, f := range fields {
+		e.text(names[i])
+		if err := e.encode(v.Field(f), depth+1); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// cborUnmarshal returns the value of the CBOR data item data. Integers are
+// decoded as int64, or uint64 if too large, floating point numbers as
+// float64, byte and text strings as []byte and string, arrays as
+// []interface{}, maps as map[string]interface{} if all their keys are text
+// strings or else map[interface{}]interface{}, dates (tags 0 and 1) as
+// time.Time, and null and undefined as nil. Other tags are ignored.
+func cborUnmarshal(data []byte) (interface{}, error) {
+	d := cborDecoder{data: data}
+	v, err := d.decode(0)
+	if err == nil && d.off < len(d.data) {
+		err = errors.New("cbor: unexpected data after value")
+	}
+	return v, err
+}
+
+type cborDecoder struct {
+	data []byte
+	off  int
+}
+
+// head reads the head of a data item: its major type, its additional
+// information, and its argument, if not of indefinite length.
+func (d *cborDecoder) head() (major, info byte, n uint64, err error) {
+	if d.off >= len(d.data) {
+		return 0, 0, 0, errCBOREOF
+	}
+	b := d.data[d.off]
+	d.off++
+	major, info = b>>5, b&0x1f
+	switch {
+	case info < 24:
+		n = uint64(info)
+	case info <= 27:
+		size := 1 << (info - 24)
+		if len(d.data)-d.off < size {
+			return 0, 0, 0, errCBOREOF
+		}
+		for _, c := range d.data[d.off : d.off+size] {
+			n = n<<8 | uint64(c)
+		}
+		d.off += size
+	case info == 31 && (major >= cborBytes && major <= cborMap || major == cborSimple):
+		// Indefinite length, or break stop code.
+	default:
+		return 0, 0, 0, fmt.Errorf("cbor: invalid data item header 0x%02x", b)
+	}
+	return major, info, n, nil
+}
+
+// isBreak returns true, skipping it, if the next byte is the break stop code
+// ending the items of indefinite length.
+func (d *cborDecoder) isBreak() bool {
+	if d.off < len(d.data) && d.data[d.off] == 0xff {
+		d.off++
+		return true
+	}
+	return false
+}
+
+// count checks that n items, of at least one byte, can be read.
+func (d *cborDecoder) count(n uint64) (int, error) {
+	if n > uint64(len(d.data)-d.off) {
+		return 0, errCBOREOF
+	}
+	return int(n), nil
+}
+
+func (d *cborDecoder) decode(depth int) (interface{}, error) {
+	if depth > maxCBORDepth {
+		return nil, errCBORDepth
+	}
+	major, info, n, err := d.head()
+	if err != nil {
+		return nil, err
+	}
+
+	switch major {
+	case cborUint:
+		if n > math.MaxInt64 {
+			return n, nil
+		}
+		return int64(n), nil
+	case cborNegInt:
+		if n > math.MaxInt64 {
+			return nil, errors.New("cbor: integer overflows int64")
+		}
+		return -1 - int64(n), nil
+	case cborBytes, cborText:
+		b, err := d.str(major, info, n)
+		if err != nil {
+			return nil, err
+		}
+		if major == cborText {
+			return string(b), nil
+		}
+		return b, nil
+	case cborArray:
+		a := []interface{}{}
+		l, err := d.count(n)
+		for i := 0; err == nil && (info == 31 && !d.isBreak() || info != 31 && i < l); i++ {
+			var v interface{}
+			if v, err = d.decode(depth + 1); err == nil {
+				a = append(a, v)
+			}
+		}
+		return a, err
+	case cborMap:
+		return d.mapping(info, n, depth)
+	case cborTag:
+		v, err := d.decode(depth + 1)
+		if err != nil {
+			return nil, err
+		}
+		switch t := v.(type) {
+		case string:
+			if n == 0 {
+				return time.Parse(time.RFC3339Nano, t)
+			}
+		case int64:
+			if n == 1 {
+				return time.Unix(t, 0), nil
+			}
+		case float64:
+			if n == 1 {
+				sec, frac := math.Modf(t)
+				return time.Unix(int64(sec), int64(frac*1e9)), nil
+			}
+		}
+		return v, nil
+	}
+
+	switch info {
+	case 20:
+		return false, nil
+	case 21:
+		return true, nil
+	case 22, 23:
+		return nil, nil
+	case 25:
+		return halfFloat(uint16(n)), nil
+	case 26:
+		return float64(math.Float32frombits(uint32(n))), nil
+	case 27:
+		return math.Float64frombits(n), nil
+	case 31:
+		return nil, errors.New("cbor: unexpected break")
+	}
+	return nil, fmt.Errorf("cbor: unsupported simple value %d", n)
+}
+
+// str reads the content of a byte or text string, of definite length n or
+// made of chunks of the same major type if indefinite.
+func (d *cborDecoder) str(major, info byte, n uint64) ([]byte, error) {
+	if info != 31 {
+		l, err := d.count(n)
+		if err != nil {
+			return nil, err
+		}
+		b := d.data[d.off : d.off+l]
+		d.off += l
+		return append([]byte(nil), b...), nil
+	}
+	var b []byte
+	for !d.isBreak() {
+		m, info, n, err := d.head()
+		if err != nil {
+			return nil, err
+		}
+		if m != major || info == 31 {
+			return nil, errors.New("cbor: invalid chunk of indefinite length string")
+		}
+		chunk, err := d.str(m, info, n)
+		if err != nil {
+			return nil, err
+		}
+		b = append(b, chunk...)
+	}
+	return b, nil
+}
+
+func (d *cborDecoder) mapping(info byte, n uint64, depth int) (interface{}, error) {
+	var keys, vals []interface{}
+	l, err := d.count(n)
+	for i := 0; err == nil && (info == 31 && !d.isBreak() || info != 31 && i < l); i++ {
+		var k, v interface{}
+		if k, err = d.decode(depth + 1); err != nil {
+			break
+		}
+		if v, err = d.decode(depth + 1); err == nil {
+			keys, vals = append(keys, k), append(vals, v)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	texts := true
+	for _, k := range keys {
+		if _, ok := k.(string); !ok {
+			texts = false
+		}
+	}
+	if texts {
+		m := make(map[string]interface{}, len(keys))
+		for i, k := range keys {
+			m[k.(string)] = vals[i]
+		}
+		return m, nil
+	}
+	m := make(map[interface{}]interface{}, len(keys))
+	for i, k := range keys {
+		if k != nil && !reflect.TypeOf(k).Comparable() {
+			return nil, fmt.Errorf("cbor: invalid map key of type %T", k)
+		}
+		m[k] = vals[i]
+	}
+	return m, nil
+}
+
+// halfFloat returns the value of the IEEE 754 half-precision number h.
+func halfFloat(h uint16) float64 {
+	exp, mant := int(h>>10)&0x1f, float64(h&0x3ff)
+	var v float64
+	switch exp {
+	case 0:
+		v = math.Ldexp(mant, -24)
+	case 31:
+		if mant == 0 {
+			v = math.Inf(1)
+		} else {
+			v = math.NaN()
+		}
+	default:
+		v = math.Ldexp(mant+1024, exp-25)
+	}
+	if h&0x8000 != 0 {
+		return -v
+	}
+	return v
+}
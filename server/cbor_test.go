@@ -0,0 +1,102 @@
+package server
+
+import (
+	"encoding/hex"
+	"math"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestCBOR(t *testing.T) {
+	date := time.Date(2013, 3, 21, 20, 4, 0, 0, time.UTC)
+
+	// Examples of RFC 8949, appendix A.
+	for _, test := range []struct {
+		val interface{}
+		hex string
+	}{
+		{val: int64(0), hex: "00"},
+		{val: int64(23), hex: "17"},
+		{val: int64(24), hex: "1818"},
+		{val: int64(1000), hex: "1903e8"},
+		{val: int64(1000000000000), hex: "1b000000e8d4a51000"},
+		{val: uint64(18446744073709551615), hex: "1bffffffffffffffff"},
+		{val: int64(-1), hex: "20"},
+		{val: int64(-1000), hex: "3903e7"},
+		{val: 1.1, hex: "fb3ff199999999999a"},
+		{val: false, hex: "f4"},
+		{val: true, hex: "f5"},
+		{val: nil, hex: "f6"},
+		{val: date, hex: "c074323031332d30332d32315432303a30343a30305a"},
+		{val: []byte{1, 2, 3, 4}, hex: "4401020304"},
+		{val: "IETF", hex: "6449455446"},
+		{val: "ü", hex: "62c3bc"},
+		{val: []interface{}{}, hex: "80"},
+		{val: []interface{}{int64(1), []interface{}{int64(2), int64(3)}}, hex: "8201820203"},
+		{val: map[string]interface{}{"a": int64(1), "b": []interface{}{int64(2)}}, hex: "a261610161628102"},
+		{val: map[interface{}]interface{}{int64(1): int64(2), int64(3): int64(4)}, hex: "a201020304"},
+	} {
+		want := test.hex
+		b, err := cborMarshal(reflect.ValueOf(test.val))
+		if err != nil {
+			t.Errorf("%v: %v", test.val, err)
+			continue
+		}
+		if got := hex.EncodeToString(b); got != want {
+			t.Errorf("%v: got %s, want %s", test.val, got, want)
+		}
+		v, err := cborUnmarshal(b)
+		if err != nil {
+			t.Errorf("%s: %v", want, err)
+			continue
+		}
+		if !reflect.DeepEqual(v, test.val) {
+			t.Errorf("%s: got %#v, want %#v", want, v, test.val)
+		}
+	}
+
+	// Encodings produced by other encoders.
+	for _, test := range []struct {
+		hex string
+		val interface{}
+	}{
+		{hex: "f93c00", val: 1.0},
+		{hex: "f9c400", val: -4.0},
+		{hex: "f97c00", val: math.Inf(1)},
+		{hex: "fa47c35000", val: 100000.0},
+		{hex: "c11a514b67b0", val: time.Unix(1363896240, 0)},
+		{hex: "5f42010243030405ff", val: []byte{1, 2, 3, 4, 5}},
+		{hex: "7f657374726561646d696e67ff", val: "streaming"},
+		{hex: "9f018202039f0405ffff", val: []interface{}{int64(1), []interface{}{int64(2), int64(3)}, []interface{}{int64(4), int64(5)}}},
+		{hex: "bf61610161629f0203ffff", val: map[string]interface{}{"a": int64(1), "b": []interface{}{int64(2), int64(3)}}},
+		{hex: "d82076687474703a2f2f7777772e6578616d706c652e636f6d", val: "http://www.example.com"},
+	} {
+		b, _ := hex.DecodeString(test.hex)
+		v, err := cborUnmarshal(b)
+		if err != nil {
+			t.Errorf("%s: %v", test.hex, err)
+			continue
+		}
+		if !reflect.DeepEqual(v, test.val) {
+			t.Errorf("%s: got %#v, want %#v", test.hex, v, test.val)
+		}
+	}
+
+	for _, h := range []string{"", "18", "62c3", "9f01", "a1", "0001", "1c", "f818", "ff", "a14101f6", "3bffffffffffffffff"} {
+		b, _ := hex.DecodeString(h)
+		if v, err := cborUnmarshal(b); err == nil {
+			t.Errorf("%s: got %#v, want an error", h, v)
+		}
+	}
+
+	type cyclic struct{ Next *cyclic }
+	c := &cyclic{}
+	c.Next = c
+	if _, err := cborMarshal(reflect.ValueOf(c)); err != errCBORDepth {
+		t.Errorf("got error %v for a cyclic value", err)
+	}
+	if _, err := cborMarshal(reflect.ValueOf(make(chan int))); err == nil {
+		t.Error("got no error for a channel")
+	}
+}
@@ -0,0 +1,61 @@
+package server
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"fmt"
+	"reflect"
+)
+
+// Encodings of the arguments and results of Eval, see EvalParams.Encoding.
+const (
+	EncodingJSON = "json" // encoding/json, numbers of arguments are float64
+	EncodingCBOR = "cbor" // RFC 8949, for binary data
+	EncodingGob  = "gob"  // encoding/gob, for the fidelity of Go types
+)
+
+// validEncoding returns an error if encoding is not supported.
+func validEncoding(encoding string) error {
+	switch encoding {
+	case "", EncodingJSON, EncodingCBOR, EncodingGob:
+		return nil
+	}
+	return fmt.Errorf("unknown encoding %q", encoding)
+}
+
+// encodeValue returns the encoding of the value v. In gob, v is encoded by
+// itself, to be decoded into a value of its type.
+func encodeValue(encoding string, v reflect.Value) ([]byte, error) {
+	switch encoding {
+	case EncodingCBOR:
+		return cborMarshal(v)
+	case EncodingGob:
+		for v.Kind() == reflect.Interface && !v.IsNil() {
+			v = v.Elem()
+		}
+		var buf bytes.Buffer
+		if err := gob.NewEncoder(&buf).EncodeValue(v); err != nil {
+			return nil, err
+		}
+		return buf.Bytes(), nil
+	}
+	return json.Marshal(v.Interface())
+}
+
+// decodeArg returns the value of an argument, encoded in encoding or JSON if
+// empty. In gob, the argument is the encoding of an interface value, as by
+// Encoder.Encode(&arg) with arg of type interface{}, so its concrete type
+// must be registered by gob.Register in the server, as are the basic types.
+func decodeArg(encoding string, data []byte) (interface{}, error) {
+	var v interface{}
+	switch encoding {
+	case EncodingCBOR:
+		return cborUnmarshal(data)
+	case EncodingGob:
+		err := gob.NewDecoder(bytes.NewReader(data)).Decode(&v)
+		return v, err
+	}
+	err := json.Unmarshal(data, &v)
+	return v, err
+}
@@ -0,0 +1,108 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"sort"
+	"sync"
+	"time"
+)
+
+// A Record is a request processed by a session, with its result and the
+// output of the interpreted code.
+type Record struct {
+	Session  string          `json:"session"` // id of the session, unique for a Server
+	ID       json.RawMessage `json:"id,omitempty"`
+	Method   string          `json:"method"`
+	Params   json.RawMessage `json:"params,omitempty"`
+	Result   json.RawMessage `json:"result,omitempty"`
+	Error    *Error          `json:"error,omitempty"`
+	Output   []Output        `json:"output,omitempty"`
+	Start    time.Time       `json:"start"`
+	Duration time.Duration   `json:"duration"`
+}
+
+// A Store records the requests of sessions. Add is called concurrently by
+// the sessions of a Server, once per processed request, in order within a
+// session. Cancel requests are not recorded.
+type Store interface {
+	Add(r Record)
+}
+
+// MemStore is a Store keeping the records in memory. The zero value is ready
+// to use.
+type MemStore struct {
+	mu      sync.Mutex
+	records map[string][]Record
+}
+
+// Add implements Store.
+func (m *MemStore) Add(r Record) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.records == nil {
+		m.records = map[string][]Record{}
+	}
+	m.records[r.Session] = append(m.records[r.Session], r)
+}
+
+// Sessions returns the ids of the recorded sessions, sorted.
+func (m *MemStore) Sessions() []string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	ids := make([]string, 0, len(m.records))
+	for id := range m.records {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// Records returns the records of the session id, in order.
+func (m *MemStore) Records(id string) []Record {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]Record(nil), m.records[id]...)
+}
+
+// record adds the record of the processed request req to the store.
+func (ss *session) record(req *request, res interface{}, err *Error, output []Output, start time.Time, duration time.Duration) {
+	r := Record{
+		Session:  ss.id,
+		ID:       req.ID,
+		Method:   req.Method,
+		Params:   req.Params,
+		Error:    err,
+		Output:   output,
+		Start:    start,
+		Duration: duration,
+	}
+	if err == nil {
+		// The results are always encodable, as they are sent in responses.
+		r.Result, _ = json.Marshal(res)
+	}
+	ss.store.Add(r)
+}
+
+// Replay processes again the requests of records, in order, in a new session
+// whose interpreter is returned by s.New. It returns the records of the
+// replay, to be compared with the originals, for example to investigate the
+// behavior of a session or to check for regressions. The replay is not
+// recorded in s.Store. Replay stops when ctx is done, and then returns the
+// records processed so far with the error of ctx.
+func (s *Server) Replay(ctx context.Context, records []Record) ([]Record, error) {
+	store := &MemStore{}
+	ss := s.newSession(ctx, ioutil.Discard, store)
+	defer ss.stop()
+
+	for _, r := range records {
+		if ctx.Err() != nil {
+			return store.Records(ss.id), ctx.Err()
+		}
+		req := &request{Version: "2.0", ID: r.ID, Method: r.Method, Params: r.Params}
+		ss.start(req)
+		ss.handle(req)
+	}
+	return store.Records(ss.id), ctx.Err()
+}
@@ -0,0 +1,80 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"reflect"
+	"testing"
+)
+
+func TestRecordReplay(t *testing.T) {
+	store := &MemStore{}
+	s := &Server{New: newInterp, Store: store}
+
+	conn, sconn := net.Pipe()
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		s.ServeConn(sconn)
+	}()
+	c := &client{t: t, enc: json.NewEncoder(conn), dec: json.NewDecoder(conn)}
+	c.call("Eval", EvalParams{Src: "x := 2"})
+	c.call("Import", PathParams{Path: "fmt"})
+	c.call("Eval", EvalParams{Src: `fmt.Println("hello", x)`})
+	c.call("Eval", EvalParams{Src: "y"})
+	c.call("Cancel", CancelParams{ID: json.RawMessage("1")})
+	_ = conn.Close()
+	<-done
+
+	sessions := store.Sessions()
+	if len(sessions) != 1 {
+		t.Fatalf("got sessions %v, want 1", sessions)
+	}
+	records := store.Records(sessions[0])
+	if len(records) != 4 {
+		t.Fatalf("got %d records, want 4", len(records))
+	}
+	r := records[2]
+	if r.Method != "Eval" || string(r.ID) != "3" || string(r.Params) != `{"src":"fmt.Println(\"hello\", x)"}` {
+		t.Errorf("got record %s %s %s", r.Method, r.ID, r.Params)
+	}
+	if string(r.Result) != `{"value":"8","type":"int"}` || r.Error != nil {
+		t.Errorf("got result %s %v", r.Result, r.Error)
+	}
+	if want := []Output{{ID: json.RawMessage("3"), Stream: "stdout", Data: "hello 2\n"}}; !reflect.DeepEqual(r.Output, want) {
+		t.Errorf("got output %v, want %v", r.Output, want)
+	}
+	if r.Start.IsZero() || r.Duration <= 0 {
+		t.Errorf("got start %v, duration %v", r.Start, r.Duration)
+	}
+	if r := records[3]; r.Result != nil || r.Error == nil || r.Error.Code != CodeEvalError {
+		t.Errorf("got result %s %v, want an error", r.Result, r.Error)
+	}
+
+	replay, err := s.Replay(context.Background(), records)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(replay) != len(records) {
+		t.Fatalf("got %d records, want %d", len(replay), len(records))
+	}
+	for k, r := range replay {
+		if r.Session == records[k].Session {
+			t.Errorf("replay in session %s, want a new one", r.Session)
+		}
+		o := records[k]
+		if string(r.Result) != string(o.Result) || !reflect.DeepEqual(r.Error, o.Error) || !reflect.DeepEqual(r.Output, o.Output) {
+			t.Errorf("%s %s: got %s %v %v, want %s %v %v", r.Method, r.Params, r.Result, r.Error, r.Output, o.Result, o.Error, o.Output)
+		}
+	}
+	if got := store.Sessions(); len(got) != 1 {
+		t.Errorf("got sessions %v, want the replay not recorded", got)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if replay, err := s.Replay(ctx, records); err != context.Canceled || len(replay) != 0 {
+		t.Errorf("got %d records, error %v", len(replay), err)
+	}
+}
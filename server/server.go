@@ -0,0 +1,436 @@
+// Package server provides an evaluation server, running a long-lived
+// interpreter per connection behind a JSON-RPC 2.0 protocol. It is suitable to
+// back a Jupyter Go kernel, or remote scripting consoles.
+//
+// Requests, responses and notifications are JSON objects, one per line. The
+// methods are:
+//
+//	Eval        {"src": "x := 1"}        -> {"value": "1", "type": "int"}
+//	Import      {"path": "strings"}      -> {}
+//	ListSymbols {"path": "strings"}      -> [{"name": "Join", "kind": "func", "type": "..."}]
+//	Cancel      {"id": 3}                -> true if request 3 was running
+//
+// Eval can also declare arguments, and return its result encoded as JSON,
+// CBOR or gob, the encoding being selected per request, see EvalParams:
+//
+//	Eval        {"src": "n.(int64) * 2", "encoding": "cbor", "args": {"n": "Cg=="}}
+//	            -> {"value": "20", "type": "int64", "data": "FA=="}
+//
+// Eval, Import and ListSymbols are processed in order. Cancel is processed
+// as soon as it is received, to interrupt a running evaluation. The output of
+// interpreted code is streamed during evaluations by "output" notifications,
+// holding the id of the request, the stream name ("stdout" or "stderr") and
+// the data.
+//
+// The requests of sessions can be recorded in a Store, and replayed later in
+// a new interpreter, see Server.Replay.
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"go/token"
+	"io"
+	"net"
+	"reflect"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/traefik/yaegi/interp"
+)
+
+// Error codes of responses, the negative ones are defined by JSON-RPC 2.0.
+const (
+	CodeParseError     = -32700
+	CodeInvalidRequest = -32600
+	CodeMethodNotFound = -32601
+	CodeInvalidParams  = -32602
+	CodeEvalError      = 1 // the evaluation failed
+	CodeCancelled      = 2 // the evaluation was cancelled
+)
+
+// Error is the error of a response.
+type Error struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+func (e *Error) Error() string { return e.Message }
+
+// EvalParams are the parameters of Eval.
+type EvalParams struct {
+	Src string `json:"src"`
+
+	// Encoding, if not empty, is the encoding of Args, and of the result
+	// returned in EvalResult.Data: EncodingJSON, EncodingCBOR or EncodingGob.
+	Encoding string `json:"encoding,omitempty"`
+
+	// Args are values declared as variables of type interface{} before the
+	// evaluation of Src, indexed by name, encoded in Encoding, or JSON if not
+	// set. They remain declared in the session, until replaced by arguments
+	// of the same name.
+	Args map[string][]byte `json:"args,omitempty"`
+}
+
+// EvalResult is the result of Eval. Value is the formatted result of the
+// evaluation, empty if the evaluation has no result. Data is the result
+// encoded in EvalParams.Encoding, if set.
+type EvalResult struct {
+	Value string `json:"value,omitempty"`
+	Type  string `json:"type,omitempty"`
+	Data  []byte `json:"data,omitempty"`
+}
+
+// PathParams are the parameters of Import and ListSymbols.
+type PathParams struct {
+	Path string `json:"path"`
+}
+
+// Symbol describes a symbol exported by a package, in the result of
+// ListSymbols.
+type Symbol struct {
+	Name string `json:"name"`
+	Kind string `json:"kind"` // interp.KindConst, KindFunc, KindType or KindVar
+	Type string `json:"type"`
+}
+
+// CancelParams are the parameters of Cancel.
+type CancelParams struct {
+	ID json.RawMessage `json:"id"`
+}
+
+// Output is the parameter of the notifications of the output of interpreted
+// code.
+type Output struct {
+	ID     json.RawMessage `json:"id"`
+	Stream string          `json:"stream"`
+	Data   string          `json:"data"`
+}
+
+type request struct {
+	Version string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id,omitempty"`
+	Method  string          `json:"method"`
+	Params  json.RawMessage `json:"params,omitempty"`
+
+	ctx    context.Context // cancelled by Cancel, or at the end of the session
+	cancel context.CancelFunc
+}
+
+type response struct {
+	Version string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Result  interface{}     `json:"result,omitempty"`
+	Error   *Error          `json:"error,omitempty"`
+}
+
+type notification struct {
+	Version string      `json:"jsonrpc"`
+	Method  string      `json:"method"`
+	Params  interface{} `json:"params"`
+}
+
+// A Server serves evaluation sessions.
+type Server struct {
+	// lastID is the id of the last session, accessed atomically: keep it
+	// first to be aligned on 64 bits boundary.
+	lastID uint64
+
+	// New returns the interpreter of a new session, whose interpreted code
+	// writes its output to stdout and stderr. If nil, the interpreter only
+	// has the symbols of the interp package.
+	New func(stdout, stderr io.Writer) *interp.Interpreter
+
+	// Store, if not nil, records the requests processed by sessions.
+	Store Store
+}
+
+// Serve accepts connections on l, and serves a session on each one in a new
+// goroutine. It returns the error of Accept, when l is closed.
+func (s *Server) Serve(l net.Listener) error {
+	for {
+		conn, err := l.Accept()
+		if err != nil {
+			return err
+		}
+		go s.ServeConn(conn)
+	}
+}
+
+// ServeConn serves a session on conn, until the end of its input. The running
+// evaluations are then cancelled, and conn is closed.
+func (s *Server) ServeConn(conn io.ReadWriteCloser) {
+	ss := s.newSession(context.Background(), conn, s.Store)
+
+	queue := make(chan *request, 16)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for req := range queue {
+			ss.handle(req)
+		}
+	}()
+
+	dec := json.NewDecoder(conn)
+	for {
+		req := &request{}
+		if err := dec.Decode(req); err != nil {
+			if !errors.Is(err, io.EOF) {
+				ss.send(response{ID: json.RawMessage("null"), Error: &Error{CodeParseError, err.Error()}})
+			}
+			break
+		}
+		if req.Version != "2.0" || req.Method == "" {
+			ss.reply(req, nil, &Error{CodeInvalidRequest, "invalid request"})
+			continue
+		}
+		if req.Method == "Cancel" {
+			ss.cancel(req)
+			continue
+		}
+		ss.start(req)
+		queue <- req
+	}
+
+	close(queue)
+	ss.stop()
+	<-done
+	_ = conn.Close()
+}
+
+// newSession returns a session writing its messages to w, and recording its
+// requests in store if not nil.
+func (s *Server) newSession(ctx context.Context, w io.Writer, store Store) *session {
+	ss := &session{
+		id:      strconv.FormatUint(atomic.AddUint64(&s.lastID, 1), 10),
+		store:   store,
+		enc:     json.NewEncoder(w),
+		running: map[string]context.CancelFunc{},
+	}
+	ss.ctx, ss.stop = context.WithCancel(ctx)
+	newInterp := s.New
+	if newInterp == nil {
+		newInterp = defaultInterp
+	}
+	ss.interp = newInterp(&output{ss, "stdout"}, &output{ss, "stderr"})
+	return ss
+}
+
+func defaultInterp(stdout, stderr io.Writer) *interp.Interpreter {
+	i := interp.New(interp.Options{Stdout: stdout, Stderr: stderr})
+	i.Use(interp.Symbols)
+	return i
+}
+
+// session is the state of a connection.
+type session struct {
+	id     string
+	interp *interp.Interpreter
+	ctx    context.Context // parent of the contexts of requests
+	stop   func()          // cancels ctx, at the end of the session
+	store  Store           // recorder of requests, or nil
+
+	mu      sync.Mutex                    // protects the fields below, and enc
+	enc     *json.Encoder                 // writer of messages
+	running map[string]context.CancelFunc // cancellation of requests, indexed by id
+	current json.RawMessage               // id of the running request
+	output  []Output                      // output of the running request, if recorded
+}
+
+// start registers the cancellation of req, before it is queued.
+func (ss *session) start(req *request) {
+	req.ctx, req.cancel = context.WithCancel(ss.ctx)
+	if req.ID == nil {
+		// A notification can not be cancelled by id.
+		return
+	}
+	ss.mu.Lock()
+	ss.running[string(req.ID)] = req.cancel
+	ss.mu.Unlock()
+}
+
+func (ss *session) cancel(req *request) {
+	var p CancelParams
+	if err := json.Unmarshal(req.Params, &p); err != nil || p.ID == nil {
+		ss.reply(req, nil, &Error{CodeInvalidParams, "invalid params"})
+		return
+	}
+	ss.mu.Lock()
+	cancel, ok := ss.running[string(p.ID)]
+	ss.mu.Unlock()
+	if ok {
+		cancel()
+	}
+	ss.reply(req, ok, nil)
+}
+
+// handle processes a queued request.
+func (ss *session) handle(req *request) {
+	ss.mu.Lock()
+	ss.current = req.ID
+	ss.output = nil
+	ss.mu.Unlock()
+
+	start := time.Now()
+	res, err := ss.call(req)
+	duration := time.Since(start)
+
+	req.cancel()
+	ss.mu.Lock()
+	delete(ss.running, string(req.ID))
+	output := ss.output
+	ss.output = nil
+	ss.mu.Unlock()
+	ss.reply(req, res, err)
+
+	if ss.store != nil {
+		ss.record(req, res, err, output, start, duration)
+	}
+}
+
+func (ss *session) call(req *request) (interface{}, *Error) {
+	switch req.Method {
+	case "Eval":
+		var p EvalParams
+		if err := json.Unmarshal(req.Params, &p); err != nil {
+			return nil, &Error{CodeInvalidParams, err.Error()}
+		}
+		if err := validEncoding(p.Encoding); err != nil {
+			return nil, &Error{CodeInvalidParams, err.Error()}
+		}
+		if err := ss.declareArgs(req.ctx, p); err != nil {
+			return nil, err
+		}
+		v, err := ss.interp.EvalWithContext(req.ctx, p.Src)
+		if err != nil {
+			return nil, evalError(req.ctx, err)
+		}
+		res := EvalResult{}
+		if v.IsValid() && v.CanInterface() {
+			res.Value = fmt.Sprintf("%v", v)
+			res.Type = v.Type().String()
+			if p.Encoding != "" {
+				if res.Data, err = encodeValue(p.Encoding, v); err != nil {
+					return nil, &Error{CodeEvalError, err.Error()}
+				}
+			}
+		}
+		return res, nil
+
+	case "Import":
+		var p PathParams
+		if err := json.Unmarshal(req.Params, &p); err != nil || p.Path == "" {
+			return nil, &Error{CodeInvalidParams, "invalid params"}
+		}
+		if _, err := ss.interp.EvalWithContext(req.ctx, fmt.Sprintf("import %q", p.Path)); err != nil {
+			return nil, evalError(req.ctx, err)
+		}
+		return struct{}{}, nil
+
+	case "ListSymbols":
+		var p PathParams
+		if err := json.Unmarshal(req.Params, &p); err != nil || p.Path == "" {
+			return nil, &Error{CodeInvalidParams, "invalid params"}
+		}
+		syms, err := ss.interp.PackageSymbols(p.Path)
+		if err != nil {
+			return nil, &Error{CodeEvalError, err.Error()}
+		}
+		res := make([]Symbol, 0, len(syms))
+		for _, s := range syms {
+			res = append(res, Symbol{Name: s.Name, Kind: s.Kind, Type: symbolType(s)})
+		}
+		return res, nil
+	}
+	return nil, &Error{CodeMethodNotFound, "method not found: " + req.Method}
+}
+
+// argsPath is the import path of the binary package holding the arguments
+// of Eval, imported with a dot in the sessions.
+const argsPath = "github.com/traefik/yaegi/server/args"
+
+// declareArgs declares the arguments of the evaluation p as variables of the
+// session.
+func (ss *session) declareArgs(ctx context.Context, p EvalParams) *Error {
+	if len(p.Args) == 0 {
+		return nil
+	}
+	syms := make(map[string]reflect.Value, len(p.Args))
+	for name, data := range p.Args {
+		if !token.IsIdentifier(name) || name == "_" {
+			return &Error{CodeInvalidParams, fmt.Sprintf("invalid argument name %q", name)}
+		}
+		v, err := decodeArg(p.Encoding, data)
+		if err != nil {
+			return &Error{CodeInvalidParams, fmt.Sprintf("argument %s: %v", name, err)}
+		}
+		syms[name] = reflect.ValueOf(&v).Elem()
+	}
+	ss.interp.Use(interp.Exports{argsPath: syms})
+	if _, err := ss.interp.EvalWithContext(ctx, fmt.Sprintf("import . %q", argsPath)); err != nil {
+		return evalError(ctx, err)
+	}
+	return nil
+}
+
+func evalError(ctx context.Context, err error) *Error {
+	if ctx.Err() != nil {
+		return &Error{CodeCancelled, err.Error()}
+	}
+	return &Error{CodeEvalError, err.Error()}
+}
+
+// symbolType returns the type of the symbol s: for a type, the value is a
+// pointer to a zero value.
+func symbolType(s interp.SymbolInfo) string {
+	if !s.Value.IsValid() {
+		return ""
+	}
+	t := s.Value.Type()
+	if s.Kind == interp.KindType && t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return t.String()
+}
+
+// reply sends the response to req, unless req is a notification.
+func (ss *session) reply(req *request, res interface{}, err *Error) {
+	if req.ID == nil {
+		return
+	}
+	if err != nil {
+		res = nil
+	}
+	ss.send(response{ID: req.ID, Result: res, Error: err})
+}
+
+func (ss *session) send(r response) {
+	r.Version = "2.0"
+	ss.mu.Lock()
+	defer ss.mu.Unlock()
+	_ = ss.enc.Encode(r)
+}
+
+// output writes the output of interpreted code as notifications.
+type output struct {
+	ss     *session
+	stream string
+}
+
+func (o *output) Write(p []byte) (int, error) {
+	o.ss.mu.Lock()
+	defer o.ss.mu.Unlock()
+	out := Output{ID: o.ss.current, Stream: o.stream, Data: string(p)}
+	if o.ss.store != nil {
+		o.ss.output = append(o.ss.output, out)
+	}
+	n := notification{Version: "2.0", Method: "output", Params: out}
+	if err := o.ss.enc.Encode(n); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
@@ -0,0 +1,222 @@
+package server
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"reflect"
+	"testing"
+
+	"github.com/traefik/yaegi/interp"
+)
+
+type client struct {
+	t   *testing.T
+	enc *json.Encoder
+	dec *json.Decoder
+	id  int
+}
+
+func newClient(t *testing.T, s *Server) *client {
+	t.Helper()
+	c, conn := net.Pipe()
+	go s.ServeConn(conn)
+	t.Cleanup(func() { _ = c.Close() })
+	return &client{t: t, enc: json.NewEncoder(c), dec: json.NewDecoder(c)}
+}
+
+type message struct {
+	ID     json.RawMessage `json:"id"`
+	Method string          `json:"method"`
+	Params json.RawMessage `json:"params"`
+	Result json.RawMessage `json:"result"`
+	Error  *Error          `json:"error"`
+}
+
+// send sends a request and returns its id.
+func (c *client) send(method string, params interface{}) int {
+	c.t.Helper()
+	c.id++
+	req := map[string]interface{}{"jsonrpc": "2.0", "id": c.id, "method": method, "params": params}
+	if err := c.enc.Encode(req); err != nil {
+		c.t.Fatal(err)
+	}
+	return c.id
+}
+
+// read returns the next message.
+func (c *client) read() message {
+	c.t.Helper()
+	var m message
+	if err := c.dec.Decode(&m); err != nil {
+		c.t.Fatal(err)
+	}
+	return m
+}
+
+// call sends a request, and returns the response and the output notifications
+// received before.
+func (c *client) call(method string, params interface{}) (message, string) {
+	c.t.Helper()
+	id := c.send(method, params)
+	var out string
+	for {
+		m := c.read()
+		if m.Method == "output" {
+			var o Output
+			if err := json.Unmarshal(m.Params, &o); err != nil {
+				c.t.Fatal(err)
+			}
+			if string(o.ID) != fmt.Sprint(id) {
+				c.t.Errorf("output of request %s, want %d", o.ID, id)
+			}
+			out += o.Stream + ":" + o.Data
+			continue
+		}
+		if string(m.ID) != fmt.Sprint(id) {
+			c.t.Fatalf("got response %s, want %d", m.ID, id)
+		}
+		return m, out
+	}
+}
+
+func newInterp(stdout, stderr io.Writer) *interp.Interpreter {
+	i := interp.New(interp.Options{Stdout: stdout, Stderr: stderr})
+	i.Use(interp.Exports{"fmt": {"Sprint": reflect.ValueOf(fmt.Sprint)}})
+	return i
+}
+
+func TestServer(t *testing.T) {
+	s := &Server{New: newInterp}
+	c := newClient(t, s)
+
+	tests := []struct {
+		method string
+		params interface{}
+		result string
+		code   int
+		output string
+	}{
+		{method: "Eval", params: EvalParams{Src: "x := 2"}, result: `{"value":"2","type":"int"}`},
+		{method: "Import", params: PathParams{Path: "fmt"}, result: `{}`},
+		{method: "Eval", params: EvalParams{Src: `fmt.Println("hello", x)`}, result: `{"value":"8","type":"int"}`, output: "stdout:hello 2\n"},
+		{method: "ListSymbols", params: PathParams{Path: "fmt"}, result: `[{"name":"Print","kind":"func","type":"func(...interface {}) (int, error)"},` +
+			`{"name":"Printf","kind":"func","type":"func(string, ...interface {}) (int, error)"},` +
+			`{"name":"Println","kind":"func","type":"func(...interface {}) (int, error)"},` +
+			`{"name":"Scan","kind":"func","type":"func(...interface {}) (int, error)"},` +
+			`{"name":"Scanf","kind":"func","type":"func(string, ...interface {}) (int, error)"},` +
+			`{"name":"Scanln","kind":"func","type":"func(...interface {}) (int, error)"},` +
+			`{"name":"Sprint","kind":"func","type":"func(...interface {}) string"}]`},
+		{method: "ListSymbols", params: PathParams{Path: "missing"}, code: CodeEvalError},
+		{method: "Eval", params: EvalParams{Src: "y"}, code: CodeEvalError},
+		{method: "Import", params: PathParams{}, code: CodeInvalidParams},
+		{method: "Run", params: EvalParams{}, code: CodeMethodNotFound},
+	}
+	for _, test := range tests {
+		m, out := c.call(test.method, test.params)
+		if test.code != 0 {
+			if m.Error == nil || m.Error.Code != test.code {
+				t.Errorf("%s %v: got error %v, want code %d", test.method, test.params, m.Error, test.code)
+			}
+			continue
+		}
+		if m.Error != nil {
+			t.Errorf("%s %v: got error %v", test.method, test.params, m.Error)
+			continue
+		}
+		if string(m.Result) != test.result {
+			t.Errorf("%s %v: got %s, want %s", test.method, test.params, m.Result, test.result)
+		}
+		if out != test.output {
+			t.Errorf("%s %v: got output %q, want %q", test.method, test.params, out, test.output)
+		}
+	}
+
+	// Sessions are isolated.
+	c2 := newClient(t, s)
+	if m, _ := c2.call("Eval", EvalParams{Src: "x"}); m.Error == nil {
+		t.Errorf("got %s, want an error", m.Result)
+	}
+}
+
+func TestServerCancel(t *testing.T) {
+	c := newClient(t, &Server{New: newInterp})
+
+	id := c.send("Eval", EvalParams{Src: "for {}"})
+	m, _ := c.call("Cancel", CancelParams{ID: json.RawMessage(fmt.Sprint(id))})
+	if string(m.Result) != "true" {
+		t.Errorf("got %s, want true", m.Result)
+	}
+	m = c.read()
+	if string(m.ID) != fmt.Sprint(id) || m.Error == nil || m.Error.Code != CodeCancelled {
+		t.Errorf("got response %s %v, want cancellation of %d", m.ID, m.Error, id)
+	}
+
+	m, _ = c.call("Cancel", CancelParams{ID: json.RawMessage(fmt.Sprint(id))})
+	if string(m.Result) != "false" {
+		t.Errorf("got %s, want false", m.Result)
+	}
+	if m, _ := c.call("Eval", EvalParams{Src: "1 + 2"}); string(m.Result) != `{"value":"3","type":"int"}` {
+		t.Errorf("got %s %v", m.Result, m.Error)
+	}
+}
+
+func TestServerEncodings(t *testing.T) {
+	c := newClient(t, &Server{New: newInterp})
+	if m, _ := c.call("Eval", EvalParams{Src: "type Point struct{ X, Y int; Tag []byte }"}); m.Error != nil {
+		t.Fatal(m.Error)
+	}
+
+	var gobArg bytes.Buffer
+	var arg interface{} = 3
+	if err := gob.NewEncoder(&gobArg).Encode(&arg); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		params EvalParams
+		data   string
+		code   int
+	}{
+		{params: EvalParams{Src: "Point{1, 2, []byte(s.(string))}", Encoding: EncodingJSON, Args: map[string][]byte{"s": []byte(`"ab"`)}},
+			data: `{"X":1,"Y":2,"Tag":"YWI="}`},
+		{params: EvalParams{Src: "Point{int(n.(int64)), 2, []byte{0xff}}", Encoding: EncodingCBOR, Args: map[string][]byte{"n": {0x01}}},
+			data: "\xa3aX\x01aY\x02cTagA\xff"},
+		{params: EvalParams{Src: "s"}, data: ""},
+		{params: EvalParams{Src: "n.(int) + 1", Encoding: EncodingGob, Args: map[string][]byte{"n": gobArg.Bytes()}}},
+		{params: EvalParams{Src: "1", Encoding: "xml"}, code: CodeInvalidParams},
+		{params: EvalParams{Src: "1", Args: map[string][]byte{"a b": []byte("1")}}, code: CodeInvalidParams},
+		{params: EvalParams{Src: "1", Encoding: EncodingCBOR, Args: map[string][]byte{"a": {0x18}}}, code: CodeInvalidParams},
+		{params: EvalParams{Src: "make(chan int)", Encoding: EncodingCBOR}, code: CodeEvalError},
+	}
+	for _, test := range tests {
+		m, _ := c.call("Eval", test.params)
+		if test.code != 0 {
+			if m.Error == nil || m.Error.Code != test.code {
+				t.Errorf("%v: got error %v, want code %d", test.params, m.Error, test.code)
+			}
+			continue
+		}
+		if m.Error != nil {
+			t.Errorf("%v: got error %v", test.params, m.Error)
+			continue
+		}
+		var res EvalResult
+		if err := json.Unmarshal(m.Result, &res); err != nil {
+			t.Fatal(err)
+		}
+		if test.params.Encoding == EncodingGob {
+			var n int
+			if err := gob.NewDecoder(bytes.NewReader(res.Data)).Decode(&n); err != nil || n != 4 {
+				t.Errorf("%v: got %d, error %v, want 4", test.params, n, err)
+			}
+			continue
+		}
+		if string(res.Data) != test.data {
+			t.Errorf("%v: got data %q, want %q", test.params, res.Data, test.data)
+		}
+	}
+}
@@ -0,0 +1,64 @@
+package generic
+
+import (
+	"reflect"
+
+	"github.com/traefik/yaegi/interp"
+)
+
+func init() {
+	addGenerics("cmp", map[string]interp.Generic{
+		"Compare": cmpCompare,
+		"Less":    cmpLess,
+		"Or":      cmpOr,
+	})
+}
+
+// cmpCompare instantiates func Compare[T Ordered](x, y T) int.
+func cmpCompare(args []reflect.Type) (reflect.Value, error) {
+	if err := checkArgs(args, 1); err != nil {
+		return reflect.Value{}, err
+	}
+	t := args[0]
+	if err := elemType(t, true, false); err != nil {
+		return reflect.Value{}, err
+	}
+	return makeFunc([]reflect.Type{t, t}, []reflect.Type{intType}, false, func(in []reflect.Value) []reflect.Value {
+		return []reflect.Value{reflect.ValueOf(compare(in[0], in[1]))}
+	}), nil
+}
+
+// cmpLess instantiates func Less[T Ordered](x, y T) bool.
+func cmpLess(args []reflect.Type) (reflect.Value, error) {
+	if err := checkArgs(args, 1); err != nil {
+		return reflect.Value{}, err
+	}
+	t := args[0]
+	if err := elemType(t, true, false); err != nil {
+		return reflect.Value{}, err
+	}
+	return makeFunc([]reflect.Type{t, t}, []reflect.Type{boolType}, false, func(in []reflect.Value) []reflect.Value {
+		return []reflect.Value{reflect.ValueOf(compare(in[0], in[1]) < 0)}
+	}), nil
+}
+
+// cmpOr instantiates func Or[T comparable](vals ...T) T. T is the type of
+// the first argument.
+func cmpOr(args []reflect.Type) (reflect.Value, error) {
+	if err := checkArgs(args, 1); err != nil {
+		return reflect.Value{}, err
+	}
+	t := args[0]
+	if err := elemType(t, false, true); err != nil {
+		return reflect.Value{}, err
+	}
+	zero := reflect.Zero(t)
+	return makeFunc([]reflect.Type{reflect.SliceOf(t)}, []reflect.Type{t}, true, func(in []reflect.Value) []reflect.Value {
+		for i := 0; i < in[0].Len(); i++ {
+			if v := in[0].Index(i); !equal(v, zero) {
+				return []reflect.Value{v}
+			}
+		}
+		return []reflect.Value{zero}
+	}), nil
+}
@@ -0,0 +1,140 @@
+// Package generic provides wrappers of the standard library packages based on
+// generics (cmp, log/slog, maps, math/rand/v2 and slices), to be imported
+// natively in Yaegi.
+//
+// Generic functions can not be exported as values. They are provided as
+// interp.Generic symbols, implemented with reflect, and instantiated with the
+// types of the arguments of each call in interpreted code.
+//
+// The other symbols of log/slog and math/rand/v2 are extracted for each Go
+// release since the one introducing the package, Go 1.21 and Go 1.22
+// respectively, up to Go 1.27.
+package generic
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+
+	"github.com/traefik/yaegi/interp"
+)
+
+// Symbols variable stores the map of symbols per package.
+var Symbols = map[string]map[string]reflect.Value{}
+
+func init() {
+	Symbols["github.com/traefik/yaegi/stdlib/generic"] = map[string]reflect.Value{
+		"Symbols": reflect.ValueOf(Symbols),
+	}
+}
+
+// addGenerics adds the generic functions funcs to the symbols of the package
+// path, keeping the extracted ones.
+func addGenerics(path string, funcs map[string]interp.Generic) {
+	if Symbols[path] == nil {
+		Symbols[path] = map[string]reflect.Value{}
+	}
+	for name, f := range funcs {
+		Symbols[path][name] = reflect.ValueOf(f)
+	}
+}
+
+// makeFunc returns a new function of parameters in and results out, variadic
+// if required, implemented by f.
+func makeFunc(in, out []reflect.Type, variadic bool, f func([]reflect.Value) []reflect.Value) reflect.Value {
+	return reflect.MakeFunc(reflect.FuncOf(in, out, variadic), f)
+}
+
+// checkArgs returns an error if the number of arguments args is less than n.
+func checkArgs(args []reflect.Type, n int) error {
+	if len(args) < n {
+		return fmt.Errorf("not enough arguments: have %d, want %d", len(args), n)
+	}
+	return nil
+}
+
+// sliceType checks that t is a slice type, of ordered or comparable elements
+// if required.
+func sliceType(t reflect.Type, ordered, comparable bool) error {
+	if t.Kind() != reflect.Slice {
+		return fmt.Errorf("%v is not a slice type", t)
+	}
+	return elemType(t.Elem(), ordered, comparable)
+}
+
+// mapType checks that t is a map type, of comparable values if required.
+func mapType(t reflect.Type, comparable bool) error {
+	if t.Kind() != reflect.Map {
+		return fmt.Errorf("%v is not a map type", t)
+	}
+	return elemType(t.Elem(), false, comparable)
+}
+
+func elemType(t reflect.Type, ordered, comparable bool) error {
+	switch {
+	case ordered && !isOrdered(t):
+		return fmt.Errorf("%v does not satisfy cmp.Ordered", t)
+	case comparable && !t.Comparable():
+		return fmt.Errorf("%v does not satisfy comparable", t)
+	}
+	return nil
+}
+
+// isOrdered returns true if t satisfies cmp.Ordered.
+func isOrdered(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64, reflect.String:
+		return true
+	}
+	return false
+}
+
+// compare compares the values x and y of an ordered type, as cmp.Compare: a
+// NaN is less than any other value, and equal to another NaN.
+func compare(x, y reflect.Value) int {
+	switch x.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return sign(x.Int() < y.Int(), x.Int() > y.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return sign(x.Uint() < y.Uint(), x.Uint() > y.Uint())
+	case reflect.Float32, reflect.Float64:
+		a, b := x.Float(), y.Float()
+		if math.IsNaN(a) || math.IsNaN(b) {
+			return sign(!math.IsNaN(b), !math.IsNaN(a))
+		}
+		return sign(a < b, a > b)
+	default:
+		return sign(x.String() < y.String(), x.String() > y.String())
+	}
+}
+
+func sign(less, greater bool) int {
+	switch {
+	case less:
+		return -1
+	case greater:
+		return +1
+	}
+	return 0
+}
+
+// isNaN returns true if v is a floating-point NaN.
+func isNaN(v reflect.Value) bool {
+	k := v.Kind()
+	return (k == reflect.Float32 || k == reflect.Float64) && math.IsNaN(v.Float())
+}
+
+// equal returns true if the values x and y of a comparable type are equal.
+func equal(x, y reflect.Value) bool { return x.Interface() == y.Interface() }
+
+// call calls f with args, and returns its first result.
+func call(f reflect.Value, args ...reflect.Value) reflect.Value { return f.Call(args)[0] }
+
+var (
+	boolType = reflect.TypeOf(false)
+	intType  = reflect.TypeOf(0)
+)
+
+//go:generate ../../internal/cmd/extract/extract log/slog math/rand/v2
@@ -0,0 +1,97 @@
+package generic_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/traefik/yaegi/interp"
+	"github.com/traefik/yaegi/stdlib/generic"
+)
+
+func TestGeneric(t *testing.T) {
+	for _, test := range []struct {
+		setup, src, want string
+	}{
+		{src: `slices.Contains([]string{"a", "b"}, "b")`, want: "true"},
+		{src: `slices.Index([]int64{1, 2, 3}, 3)`, want: "2"},
+		{src: `slices.IndexFunc([]int{1, 2, 3}, func(i int) bool { return i > 1 })`, want: "1"},
+		{src: `slices.Max([]float64{1, 3.5, 2})`, want: "3.5"},
+		{src: `slices.Min([]uint8{3, 1, 2})`, want: "1"},
+		{src: `s := []int{3, 1, 2}; slices.Sort(s); s`, want: "[1 2 3]"},
+		{src: `s := []string{"bb", "a", "ccc"}; slices.SortFunc(s, func(a, b string) int { return len(b) - len(a) }); s`, want: "[ccc bb a]"},
+		{src: `slices.BinarySearch([]int{1, 3, 5}, 3)`, want: "1"},
+		{src: `slices.Insert([]int{1, 4}, 1, 2, 3)`, want: "[1 2 3 4]"},
+		{src: `slices.Delete([]int{1, 2, 3, 4}, 1, 3)`, want: "[1 4]"},
+		{src: `slices.Compact([]int{1, 1, 2, 2, 2, 3})`, want: "[1 2 3]"},
+		{src: `s := []int{1, 2, 3}; slices.Reverse(s); s`, want: "[3 2 1]"},
+		{src: `slices.Equal([]int{1, 2}, []int{1, 2})`, want: "true"},
+		{src: `slices.Compare([]int{1, 2}, []int{1, 2, 0})`, want: "-1"},
+		{setup: `type T struct{ A int }`, src: `slices.Index([]T{{1}, {2}}, T{2})`, want: "1"},
+		{src: `m := map[string]int{"a": 1, "b": 2}; c := maps.Clone(m); m["a"] = 3; c["a"]`, want: "1"},
+		{src: `m := map[string]int{"a": 1, "b": 2}; maps.DeleteFunc(m, func(k string, v int) bool { return v > 1 }); len(m)`, want: "1"},
+		{src: `maps.Equal(map[int]bool{1: true}, map[int]bool{1: true})`, want: "true"},
+		{src: `cmp.Compare(2.5, 1)`, want: "1"},
+		{src: `cmp.Less("a", "b")`, want: "true"},
+		{src: `cmp.Or("", "", "c")`, want: "c"},
+	} {
+		i := interp.New(interp.Options{})
+		i.Use(generic.Symbols)
+		if _, err := i.Eval(`import ("cmp"; "maps"; "slices")` + "\n" + test.setup); err != nil {
+			t.Fatal(err)
+		}
+		v, err := i.Eval(test.src)
+		if err != nil {
+			t.Errorf("%s: %v", test.src, err)
+			continue
+		}
+		if got := fmt.Sprint(v); got != test.want {
+			t.Errorf("%s: got %s, want %s", test.src, got, test.want)
+		}
+	}
+}
+
+func TestGenericErrors(t *testing.T) {
+	for _, test := range []struct {
+		src, err string
+	}{
+		{src: `slices.Max([]bool{true})`, err: "cannot instantiate slices.Max: bool does not satisfy cmp.Ordered"},
+		{src: `slices.Contains(1, 1)`, err: "cannot instantiate slices.Contains: int is not a slice type"},
+		{src: `slices.Contains([]int{1}, "a")`, err: "cannot convert"},
+		{src: `f := slices.Contains`, err: "cannot use generic function slices.Contains without instantiation"},
+	} {
+		i := interp.New(interp.Options{})
+		i.Use(generic.Symbols)
+		if _, err := i.Eval(`import "slices"`); err != nil {
+			t.Fatal(err)
+		}
+		_, err := i.Eval(test.src)
+		if err == nil || !strings.Contains(err.Error(), test.err) {
+			t.Errorf("%s: got error %v, want %q", test.src, err, test.err)
+		}
+	}
+}
+
+func TestExtracted(t *testing.T) {
+	if generic.Symbols["log/slog"] == nil || generic.Symbols["math/rand/v2"] == nil {
+		t.Skip("no symbols extracted for this Go version")
+	}
+	i := interp.New(interp.Options{})
+	i.Use(generic.Symbols)
+	if _, err := i.Eval(`import ("log/slog"; "math/rand/v2")`); err != nil {
+		t.Fatal(err)
+	}
+	if v, err := i.Eval(`slog.LevelWarn.String()`); err != nil || v.String() != "WARN" {
+		t.Errorf("got %v, %v, want WARN", v, err)
+	}
+
+	// Package math/rand/v2 is named rand, and its generic function N is
+	// merged with the extracted symbols.
+	v, err := i.Eval(`rand.N(uint8(10)) + uint8(rand.IntN(10))`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n := v.Interface().(uint8); n >= 20 {
+		t.Errorf("got %d, want less than 20", n)
+	}
+}
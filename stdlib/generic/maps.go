@@ -0,0 +1,114 @@
+package generic
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/traefik/yaegi/interp"
+)
+
+func init() {
+	addGenerics("maps", map[string]interp.Generic{
+		"Clone":      mapsClone,
+		"Copy":       mapsCopy,
+		"DeleteFunc": mapsDeleteFunc,
+		"Equal":      mapsEqual,
+	})
+}
+
+// mapsClone instantiates func Clone[M ~map[K]V, K comparable, V any](m M) M.
+func mapsClone(args []reflect.Type) (reflect.Value, error) {
+	if err := checkArgs(args, 1); err != nil {
+		return reflect.Value{}, err
+	}
+	m := args[0]
+	if err := mapType(m, false); err != nil {
+		return reflect.Value{}, err
+	}
+	return makeFunc([]reflect.Type{m}, []reflect.Type{m}, false, func(in []reflect.Value) []reflect.Value {
+		if in[0].IsNil() {
+			return in
+		}
+		r := reflect.MakeMapWithSize(m, in[0].Len())
+		for it := in[0].MapRange(); it.Next(); {
+			r.SetMapIndex(it.Key(), it.Value())
+		}
+		return []reflect.Value{r}
+	}), nil
+}
+
+// mapsCopy instantiates func Copy[M1 ~map[K]V, M2 ~map[K]V, K comparable, V any](dst M1, src M2).
+func mapsCopy(args []reflect.Type) (reflect.Value, error) {
+	m1, m2, err := mapPair(args)
+	if err != nil {
+		return reflect.Value{}, err
+	}
+	return makeFunc([]reflect.Type{m1, m2}, nil, false, func(in []reflect.Value) []reflect.Value {
+		for it := in[1].MapRange(); it.Next(); {
+			in[0].SetMapIndex(it.Key(), it.Value())
+		}
+		return nil
+	}), nil
+}
+
+// mapsDeleteFunc instantiates func DeleteFunc[M ~map[K]V, K comparable, V any](m M, del func(K, V) bool).
+func mapsDeleteFunc(args []reflect.Type) (reflect.Value, error) {
+	if err := checkArgs(args, 1); err != nil {
+		return reflect.Value{}, err
+	}
+	m := args[0]
+	if err := mapType(m, false); err != nil {
+		return reflect.Value{}, err
+	}
+	del := reflect.FuncOf([]reflect.Type{m.Key(), m.Elem()}, []reflect.Type{boolType}, false)
+	return makeFunc([]reflect.Type{m, del}, nil, false, func(in []reflect.Value) []reflect.Value {
+		for it := in[0].MapRange(); it.Next(); {
+			if call(in[1], it.Key(), it.Value()).Bool() {
+				in[0].SetMapIndex(it.Key(), reflect.Value{})
+			}
+		}
+		return nil
+	}), nil
+}
+
+// mapsEqual instantiates func Equal[M1, M2 ~map[K]V, K, V comparable](m1 M1, m2 M2) bool.
+func mapsEqual(args []reflect.Type) (reflect.Value, error) {
+	m1, m2, err := mapPair(args)
+	if err != nil {
+		return reflect.Value{}, err
+	}
+	if err := mapType(m1, true); err != nil {
+		return reflect.Value{}, err
+	}
+	return makeFunc([]reflect.Type{m1, m2}, []reflect.Type{boolType}, false, func(in []reflect.Value) []reflect.Value {
+		if in[0].Len() != in[1].Len() {
+			return []reflect.Value{reflect.ValueOf(false)}
+		}
+		for it := in[0].MapRange(); it.Next(); {
+			v := in[1].MapIndex(it.Key())
+			if !v.IsValid() || !equal(it.Value(), v) {
+				return []reflect.Value{reflect.ValueOf(false)}
+			}
+		}
+		return []reflect.Value{reflect.ValueOf(true)}
+	}), nil
+}
+
+// mapPair checks that the two first arguments args are map types of the same
+// key and value types, and returns them.
+func mapPair(args []reflect.Type) (m1, m2 reflect.Type, err error) {
+	if err := checkArgs(args, 2); err != nil {
+		return nil, nil, err
+	}
+	m1, m2 = args[0], args[1]
+	if err := mapType(m1, false); err != nil {
+		return nil, nil, err
+	}
+	if err := mapType(m2, false); err != nil {
+		return nil, nil, err
+	}
+	if m1.Key() != m2.Key() || m1.Elem() != m2.Elem() {
+		return nil, nil, fmt.Errorf("%v and %v have different key or value types", m1, m2)
+	}
+	return m1, m2, nil
+}
@@ -0,0 +1,40 @@
+//go:build go1.22
+// +build go1.22
+
+package generic
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"reflect"
+
+	"github.com/traefik/yaegi/interp"
+)
+
+// N is added to the symbols of math/rand/v2 extracted in go1_*_math_rand_v2.go,
+// whose init function runs first, files being initialized in name order.
+func init() {
+	addGenerics("math/rand/v2", map[string]interp.Generic{
+		"N": randN,
+	})
+}
+
+// randN instantiates func N[Int intType](n Int) Int.
+func randN(args []reflect.Type) (reflect.Value, error) {
+	if err := checkArgs(args, 1); err != nil {
+		return reflect.Value{}, err
+	}
+	t := args[0]
+	var n func(v reflect.Value) reflect.Value
+	switch t.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n = func(v reflect.Value) reflect.Value { return reflect.ValueOf(rand.Int64N(v.Int())).Convert(t) }
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		n = func(v reflect.Value) reflect.Value { return reflect.ValueOf(rand.Uint64N(v.Uint())).Convert(t) }
+	default:
+		return reflect.Value{}, fmt.Errorf("%v is not an integer type", t)
+	}
+	return makeFunc([]reflect.Type{t}, []reflect.Type{t}, false, func(in []reflect.Value) []reflect.Value {
+		return []reflect.Value{n(in[0])}
+	}), nil
+}
@@ -0,0 +1,366 @@
+package generic
+
+import (
+	"reflect"
+	"sort"
+
+	"github.com/traefik/yaegi/interp"
+)
+
+func init() {
+	addGenerics("slices", map[string]interp.Generic{
+		"BinarySearch":   slicesBinarySearch,
+		"Clone":          slicesClone,
+		"Compact":        slicesCompact,
+		"Compare":        slicesCompare,
+		"Contains":       slicesContains,
+		"ContainsFunc":   slicesContainsFunc,
+		"Delete":         slicesDelete,
+		"Equal":          slicesEqual,
+		"Index":          slicesIndex,
+		"IndexFunc":      slicesIndexFunc,
+		"Insert":         slicesInsert,
+		"IsSorted":       slicesIsSorted,
+		"Max":            slicesMax,
+		"Min":            slicesMin,
+		"Reverse":        slicesReverse,
+		"Sort":           slicesSort,
+		"SortFunc":       slicesSortFunc,
+		"SortStableFunc": slicesSortStableFunc,
+	})
+}
+
+// slicesBinarySearch instantiates func BinarySearch[S ~[]E, E cmp.Ordered](x S, target E) (int, bool).
+func slicesBinarySearch(args []reflect.Type) (reflect.Value, error) {
+	if err := checkArgs(args, 1); err != nil {
+		return reflect.Value{}, err
+	}
+	s := args[0]
+	if err := sliceType(s, true, false); err != nil {
+		return reflect.Value{}, err
+	}
+	return makeFunc([]reflect.Type{s, s.Elem()}, []reflect.Type{intType, boolType}, false, func(in []reflect.Value) []reflect.Value {
+		x, target := in[0], in[1]
+		n := x.Len()
+		i := sort.Search(n, func(i int) bool { return compare(x.Index(i), target) >= 0 })
+		found := i < n && compare(x.Index(i), target) == 0
+		return []reflect.Value{reflect.ValueOf(i), reflect.ValueOf(found)}
+	}), nil
+}
+
+// slicesClone instantiates func Clone[S ~[]E, E any](s S) S.
+func slicesClone(args []reflect.Type) (reflect.Value, error) {
+	if err := checkArgs(args, 1); err != nil {
+		return reflect.Value{}, err
+	}
+	s := args[0]
+	if err := sliceType(s, false, false); err != nil {
+		return reflect.Value{}, err
+	}
+	return makeFunc([]reflect.Type{s}, []reflect.Type{s}, false, func(in []reflect.Value) []reflect.Value {
+		if in[0].IsNil() {
+			return in
+		}
+		r := reflect.MakeSlice(s, in[0].Len(), in[0].Len())
+		reflect.Copy(r, in[0])
+		return []reflect.Value{r}
+	}), nil
+}
+
+// slicesCompact instantiates func Compact[S ~[]E, E comparable](s S) S.
+func slicesCompact(args []reflect.Type) (reflect.Value, error) {
+	if err := checkArgs(args, 1); err != nil {
+		return reflect.Value{}, err
+	}
+	s := args[0]
+	if err := sliceType(s, false, true); err != nil {
+		return reflect.Value{}, err
+	}
+	return makeFunc([]reflect.Type{s}, []reflect.Type{s}, false, func(in []reflect.Value) []reflect.Value {
+		x := in[0]
+		n := x.Len()
+		if n < 2 {
+			return in
+		}
+		k := 1
+		for i := 1; i < n; i++ {
+			if !equal(x.Index(i), x.Index(k-1)) {
+				x.Index(k).Set(x.Index(i))
+				k++
+			}
+		}
+		// Clear the obsolete elements, as in Go 1.22.
+		zero := reflect.Zero(s.Elem())
+		for i := k; i < n; i++ {
+			x.Index(i).Set(zero)
+		}
+		return []reflect.Value{x.Slice(0, k)}
+	}), nil
+}
+
+// slicesCompare instantiates func Compare[S ~[]E, E cmp.Ordered](s1, s2 S) int.
+func slicesCompare(args []reflect.Type) (reflect.Value, error) {
+	if err := checkArgs(args, 1); err != nil {
+		return reflect.Value{}, err
+	}
+	s := args[0]
+	if err := sliceType(s, true, false); err != nil {
+		return reflect.Value{}, err
+	}
+	return makeFunc([]reflect.Type{s, s}, []reflect.Type{intType}, false, func(in []reflect.Value) []reflect.Value {
+		s1, s2 := in[0], in[1]
+		for i := 0; i < s1.Len(); i++ {
+			if i >= s2.Len() {
+				return []reflect.Value{reflect.ValueOf(+1)}
+			}
+			if c := compare(s1.Index(i), s2.Index(i)); c != 0 {
+				return []reflect.Value{reflect.ValueOf(c)}
+			}
+		}
+		return []reflect.Value{reflect.ValueOf(sign(s1.Len() < s2.Len(), false))}
+	}), nil
+}
+
+// slicesContains instantiates func Contains[S ~[]E, E comparable](s S, v E) bool.
+func slicesContains(args []reflect.Type) (reflect.Value, error) {
+	index, err := slicesIndex(args)
+	if err != nil {
+		return reflect.Value{}, err
+	}
+	return makeFunc([]reflect.Type{args[0], args[0].Elem()}, []reflect.Type{boolType}, false, func(in []reflect.Value) []reflect.Value {
+		return []reflect.Value{reflect.ValueOf(index.Call(in)[0].Int() >= 0)}
+	}), nil
+}
+
+// slicesContainsFunc instantiates func ContainsFunc[S ~[]E, E any](s S, f func(E) bool) bool.
+func slicesContainsFunc(args []reflect.Type) (reflect.Value, error) {
+	index, err := slicesIndexFunc(args)
+	if err != nil {
+		return reflect.Value{}, err
+	}
+	return makeFunc([]reflect.Type{args[0], index.Type().In(1)}, []reflect.Type{boolType}, false, func(in []reflect.Value) []reflect.Value {
+		return []reflect.Value{reflect.ValueOf(index.Call(in)[0].Int() >= 0)}
+	}), nil
+}
+
+// slicesDelete instantiates func Delete[S ~[]E, E any](s S, i, j int) S.
+func slicesDelete(args []reflect.Type) (reflect.Value, error) {
+	if err := checkArgs(args, 1); err != nil {
+		return reflect.Value{}, err
+	}
+	s := args[0]
+	if err := sliceType(s, false, false); err != nil {
+		return reflect.Value{}, err
+	}
+	return makeFunc([]reflect.Type{s, intType, intType}, []reflect.Type{s}, false, func(in []reflect.Value) []reflect.Value {
+		x, i, j := in[0], int(in[1].Int()), int(in[2].Int())
+		n := x.Len()
+		_ = x.Slice3(0, n, n).Slice(i, j) // bounds check
+		if i == j {
+			return in[:1]
+		}
+		reflect.Copy(x.Slice(i, n), x.Slice(j, n))
+		// Clear the obsolete elements, as in Go 1.22.
+		zero := reflect.Zero(s.Elem())
+		for k := n - (j - i); k < n; k++ {
+			x.Index(k).Set(zero)
+		}
+		return []reflect.Value{x.Slice(0, n-(j-i))}
+	}), nil
+}
+
+// slicesEqual instantiates func Equal[S ~[]E, E comparable](s1, s2 S) bool.
+func slicesEqual(args []reflect.Type) (reflect.Value, error) {
+	if err := checkArgs(args, 1); err != nil {
+		return reflect.Value{}, err
+	}
+	s := args[0]
+	if err := sliceType(s, false, true); err != nil {
+		return reflect.Value{}, err
+	}
+	return makeFunc([]reflect.Type{s, s}, []reflect.Type{boolType}, false, func(in []reflect.Value) []reflect.Value {
+		s1, s2 := in[0], in[1]
+		if s1.Len() != s2.Len() {
+			return []reflect.Value{reflect.ValueOf(false)}
+		}
+		for i := 0; i < s1.Len(); i++ {
+			if !equal(s1.Index(i), s2.Index(i)) {
+				return []reflect.Value{reflect.ValueOf(false)}
+			}
+		}
+		return []reflect.Value{reflect.ValueOf(true)}
+	}), nil
+}
+
+// slicesIndex instantiates func Index[S ~[]E, E comparable](s S, v E) int.
+func slicesIndex(args []reflect.Type) (reflect.Value, error) {
+	if err := checkArgs(args, 1); err != nil {
+		return reflect.Value{}, err
+	}
+	s := args[0]
+	if err := sliceType(s, false, true); err != nil {
+		return reflect.Value{}, err
+	}
+	return makeFunc([]reflect.Type{s, s.Elem()}, []reflect.Type{intType}, false, func(in []reflect.Value) []reflect.Value {
+		x, v := in[0], in[1]
+		for i := 0; i < x.Len(); i++ {
+			if equal(x.Index(i), v) {
+				return []reflect.Value{reflect.ValueOf(i)}
+			}
+		}
+		return []reflect.Value{reflect.ValueOf(-1)}
+	}), nil
+}
+
+// slicesIndexFunc instantiates func IndexFunc[S ~[]E, E any](s S, f func(E) bool) int.
+func slicesIndexFunc(args []reflect.Type) (reflect.Value, error) {
+	if err := checkArgs(args, 1); err != nil {
+		return reflect.Value{}, err
+	}
+	s := args[0]
+	if err := sliceType(s, false, false); err != nil {
+		return reflect.Value{}, err
+	}
+	f := reflect.FuncOf([]reflect.Type{s.Elem()}, []reflect.Type{boolType}, false)
+	return makeFunc([]reflect.Type{s, f}, []reflect.Type{intType}, false, func(in []reflect.Value) []reflect.Value {
+		x, f := in[0], in[1]
+		for i := 0; i < x.Len(); i++ {
+			if call(f, x.Index(i)).Bool() {
+				return []reflect.Value{reflect.ValueOf(i)}
+			}
+		}
+		return []reflect.Value{reflect.ValueOf(-1)}
+	}), nil
+}
+
+// slicesInsert instantiates func Insert[S ~[]E, E any](s S, i int, v ...E) S.
+func slicesInsert(args []reflect.Type) (reflect.Value, error) {
+	if err := checkArgs(args, 1); err != nil {
+		return reflect.Value{}, err
+	}
+	s := args[0]
+	if err := sliceType(s, false, false); err != nil {
+		return reflect.Value{}, err
+	}
+	return makeFunc([]reflect.Type{s, intType, reflect.SliceOf(s.Elem())}, []reflect.Type{s}, true, func(in []reflect.Value) []reflect.Value {
+		x, i, v := in[0], int(in[1].Int()), in[2]
+		n := x.Len()
+		_ = x.Slice(i, n) // bounds check
+		r := reflect.MakeSlice(s, 0, n+v.Len())
+		r = reflect.AppendSlice(r, x.Slice(0, i))
+		r = reflect.AppendSlice(r, v)
+		r = reflect.AppendSlice(r, x.Slice(i, n))
+		return []reflect.Value{r}
+	}), nil
+}
+
+// slicesIsSorted instantiates func IsSorted[S ~[]E, E cmp.Ordered](x S) bool.
+func slicesIsSorted(args []reflect.Type) (reflect.Value, error) {
+	if err := checkArgs(args, 1); err != nil {
+		return reflect.Value{}, err
+	}
+	s := args[0]
+	if err := sliceType(s, true, false); err != nil {
+		return reflect.Value{}, err
+	}
+	return makeFunc([]reflect.Type{s}, []reflect.Type{boolType}, false, func(in []reflect.Value) []reflect.Value {
+		x := in[0]
+		for i := 1; i < x.Len(); i++ {
+			if compare(x.Index(i), x.Index(i-1)) < 0 {
+				return []reflect.Value{reflect.ValueOf(false)}
+			}
+		}
+		return []reflect.Value{reflect.ValueOf(true)}
+	}), nil
+}
+
+// slicesMax instantiates func Max[S ~[]E, E cmp.Ordered](x S) E.
+func slicesMax(args []reflect.Type) (reflect.Value, error) { return slicesExtremum(args, "Max", +1) }
+
+// slicesMin instantiates func Min[S ~[]E, E cmp.Ordered](x S) E.
+func slicesMin(args []reflect.Type) (reflect.Value, error) { return slicesExtremum(args, "Min", -1) }
+
+// slicesExtremum instantiates Max for a positive order, or Min for a negative
+// one. A NaN is returned if present, as by the built-in functions max and min.
+func slicesExtremum(args []reflect.Type, name string, order int) (reflect.Value, error) {
+	if err := checkArgs(args, 1); err != nil {
+		return reflect.Value{}, err
+	}
+	s := args[0]
+	if err := sliceType(s, true, false); err != nil {
+		return reflect.Value{}, err
+	}
+	return makeFunc([]reflect.Type{s}, []reflect.Type{s.Elem()}, false, func(in []reflect.Value) []reflect.Value {
+		x := in[0]
+		if x.Len() == 0 {
+			panic("slices." + name + ": empty list")
+		}
+		m := x.Index(0)
+		for i := 1; i < x.Len() && !isNaN(m); i++ {
+			if e := x.Index(i); isNaN(e) || compare(e, m) == order {
+				m = e
+			}
+		}
+		return []reflect.Value{m}
+	}), nil
+}
+
+// slicesReverse instantiates func Reverse[S ~[]E, E any](s S).
+func slicesReverse(args []reflect.Type) (reflect.Value, error) {
+	if err := checkArgs(args, 1); err != nil {
+		return reflect.Value{}, err
+	}
+	s := args[0]
+	if err := sliceType(s, false, false); err != nil {
+		return reflect.Value{}, err
+	}
+	return makeFunc([]reflect.Type{s}, nil, false, func(in []reflect.Value) []reflect.Value {
+		swap := reflect.Swapper(in[0].Interface())
+		for i, j := 0, in[0].Len()-1; i < j; i, j = i+1, j-1 {
+			swap(i, j)
+		}
+		return nil
+	}), nil
+}
+
+// slicesSort instantiates func Sort[S ~[]E, E cmp.Ordered](x S).
+func slicesSort(args []reflect.Type) (reflect.Value, error) {
+	if err := checkArgs(args, 1); err != nil {
+		return reflect.Value{}, err
+	}
+	s := args[0]
+	if err := sliceType(s, true, false); err != nil {
+		return reflect.Value{}, err
+	}
+	return makeFunc([]reflect.Type{s}, nil, false, func(in []reflect.Value) []reflect.Value {
+		x := in[0]
+		sort.Slice(x.Interface(), func(i, j int) bool { return compare(x.Index(i), x.Index(j)) < 0 })
+		return nil
+	}), nil
+}
+
+// slicesSortFunc instantiates func SortFunc[S ~[]E, E any](x S, cmp func(a, b E) int).
+func slicesSortFunc(args []reflect.Type) (reflect.Value, error) {
+	return slicesSortWith(args, sort.Slice)
+}
+
+// slicesSortStableFunc instantiates func SortStableFunc[S ~[]E, E any](x S, cmp func(a, b E) int).
+func slicesSortStableFunc(args []reflect.Type) (reflect.Value, error) {
+	return slicesSortWith(args, sort.SliceStable)
+}
+
+func slicesSortWith(args []reflect.Type, sortSlice func(interface{}, func(i, j int) bool)) (reflect.Value, error) {
+	if err := checkArgs(args, 1); err != nil {
+		return reflect.Value{}, err
+	}
+	s := args[0]
+	if err := sliceType(s, false, false); err != nil {
+		return reflect.Value{}, err
+	}
+	cmp := reflect.FuncOf([]reflect.Type{s.Elem(), s.Elem()}, []reflect.Type{intType}, false)
+	return makeFunc([]reflect.Type{s, cmp}, nil, false, func(in []reflect.Value) []reflect.Value {
+		x, cmp := in[0], in[1]
+		sortSlice(x.Interface(), func(i, j int) bool { return call(cmp, x.Index(i), x.Index(j)).Int() < 0 })
+		return nil
+	}), nil
+}
@@ -0,0 +1,22 @@
+// Code generated by 'yaegi extract container/list'. DO NOT EDIT.
+
+//go:build go1.27 && !go1.28
+// +build go1.27,!go1.28
+
+package stdlib
+
+import (
+	"container/list"
+	"reflect"
+)
+
+func init() {
+	Symbols["container/list"] = map[string]reflect.Value{
+		// function, constant and variable definitions
+		"New": reflect.ValueOf(list.New),
+
+		// type definitions
+		"Element": reflect.ValueOf((*list.Element)(nil)),
+		"List":    reflect.ValueOf((*list.List)(nil)),
+	}
+}
@@ -0,0 +1,24 @@
+// Code generated by 'yaegi extract crypto/aes'. DO NOT EDIT.
+
+//go:build go1.27 && !go1.28
+// +build go1.27,!go1.28
+
+package stdlib
+
+import (
+	"crypto/aes"
+	"go/constant"
+	"go/token"
+	"reflect"
+)
+
+func init() {
+	Symbols["crypto/aes"] = map[string]reflect.Value{
+		// function, constant and variable definitions
+		"BlockSize": reflect.ValueOf(constant.MakeFromLiteral("16", token.INT, 0)),
+		"NewCipher": reflect.ValueOf(aes.NewCipher),
+
+		// type definitions
+		"KeySizeError": reflect.ValueOf((*aes.KeySizeError)(nil)),
+	}
+}
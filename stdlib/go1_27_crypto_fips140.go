@@ -0,0 +1,21 @@
+// Code generated by 'yaegi extract crypto/fips140'. DO NOT EDIT.
+
+//go:build go1.27 && !go1.28
+// +build go1.27,!go1.28
+
+package stdlib
+
+import (
+	"crypto/fips140"
+	"reflect"
+)
+
+func init() {
+	Symbols["crypto/fips140"] = map[string]reflect.Value{
+		// function, constant and variable definitions
+		"Enabled":            reflect.ValueOf(fips140.Enabled),
+		"Enforced":           reflect.ValueOf(fips140.Enforced),
+		"Version":            reflect.ValueOf(fips140.Version),
+		"WithoutEnforcement": reflect.ValueOf(fips140.WithoutEnforcement),
+	}
+}
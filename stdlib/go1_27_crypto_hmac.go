@@ -0,0 +1,19 @@
+// Code generated by 'yaegi extract crypto/hmac'. DO NOT EDIT.
+
+//go:build go1.27 && !go1.28
+// +build go1.27,!go1.28
+
+package stdlib
+
+import (
+	"crypto/hmac"
+	"reflect"
+)
+
+func init() {
+	Symbols["crypto/hmac"] = map[string]reflect.Value{
+		// function, constant and variable definitions
+		"Equal": reflect.ValueOf(hmac.Equal),
+		"New":   reflect.ValueOf(hmac.New),
+	}
+}
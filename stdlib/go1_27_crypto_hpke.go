@@ -0,0 +1,114 @@
+// Code generated by 'yaegi extract crypto/hpke'. DO NOT EDIT.
+
+//go:build go1.27 && !go1.28
+// +build go1.27,!go1.28
+
+package stdlib
+
+import (
+	"crypto/hpke"
+	"reflect"
+)
+
+func init() {
+	Symbols["crypto/hpke"] = map[string]reflect.Value{
+		// function, constant and variable definitions
+		"AES128GCM":           reflect.ValueOf(hpke.AES128GCM),
+		"AES256GCM":           reflect.ValueOf(hpke.AES256GCM),
+		"ChaCha20Poly1305":    reflect.ValueOf(hpke.ChaCha20Poly1305),
+		"DHKEM":               reflect.ValueOf(hpke.DHKEM),
+		"ExportOnly":          reflect.ValueOf(hpke.ExportOnly),
+		"HKDFSHA256":          reflect.ValueOf(hpke.HKDFSHA256),
+		"HKDFSHA384":          reflect.ValueOf(hpke.HKDFSHA384),
+		"HKDFSHA512":          reflect.ValueOf(hpke.HKDFSHA512),
+		"MLKEM1024":           reflect.ValueOf(hpke.MLKEM1024),
+		"MLKEM1024P384":       reflect.ValueOf(hpke.MLKEM1024P384),
+		"MLKEM768":            reflect.ValueOf(hpke.MLKEM768),
+		"MLKEM768P256":        reflect.ValueOf(hpke.MLKEM768P256),
+		"MLKEM768X25519":      reflect.ValueOf(hpke.MLKEM768X25519),
+		"NewAEAD":             reflect.ValueOf(hpke.NewAEAD),
+		"NewDHKEMPrivateKey":  reflect.ValueOf(hpke.NewDHKEMPrivateKey),
+		"NewDHKEMPublicKey":   reflect.ValueOf(hpke.NewDHKEMPublicKey),
+		"NewHybridPrivateKey": reflect.ValueOf(hpke.NewHybridPrivateKey),
+		"NewHybridPublicKey":  reflect.ValueOf(hpke.NewHybridPublicKey),
+		"NewKDF":              reflect.ValueOf(hpke.NewKDF),
+		"NewKEM":              reflect.ValueOf(hpke.NewKEM),
+		"NewMLKEMPrivateKey":  reflect.ValueOf(hpke.NewMLKEMPrivateKey),
+		"NewMLKEMPublicKey":   reflect.ValueOf(hpke.NewMLKEMPublicKey),
+		"NewRecipient":        reflect.ValueOf(hpke.NewRecipient),
+		"NewSender":           reflect.ValueOf(hpke.NewSender),
+		"Open":                reflect.ValueOf(hpke.Open),
+		"SHAKE128":            reflect.ValueOf(hpke.SHAKE128),
+		"SHAKE256":            reflect.ValueOf(hpke.SHAKE256),
+		"Seal":                reflect.ValueOf(hpke.Seal),
+
+		// type definitions
+		"AEAD":       reflect.ValueOf((*hpke.AEAD)(nil)),
+		"KDF":        reflect.ValueOf((*hpke.KDF)(nil)),
+		"KEM":        reflect.ValueOf((*hpke.KEM)(nil)),
+		"PrivateKey": reflect.ValueOf((*hpke.PrivateKey)(nil)),
+		"PublicKey":  reflect.ValueOf((*hpke.PublicKey)(nil)),
+		"Recipient":  reflect.ValueOf((*hpke.Recipient)(nil)),
+		"Sender":     reflect.ValueOf((*hpke.Sender)(nil)),
+
+		// interface wrapper definitions
+		"_AEAD":       reflect.ValueOf((*_crypto_hpke_AEAD)(nil)),
+		"_KDF":        reflect.ValueOf((*_crypto_hpke_KDF)(nil)),
+		"_KEM":        reflect.ValueOf((*_crypto_hpke_KEM)(nil)),
+		"_PrivateKey": reflect.ValueOf((*_crypto_hpke_PrivateKey)(nil)),
+		"_PublicKey":  reflect.ValueOf((*_crypto_hpke_PublicKey)(nil)),
+	}
+}
+
+// _crypto_hpke_AEAD is an interface wrapper for AEAD type
+type _crypto_hpke_AEAD struct {
+	WID func() uint16
+}
+
+func (W _crypto_hpke_AEAD) ID() uint16 { return W.WID() }
+
+// _crypto_hpke_KDF is an interface wrapper for KDF type
+type _crypto_hpke_KDF struct {
+	WID func() uint16
+}
+
+func (W _crypto_hpke_KDF) ID() uint16 { return W.WID() }
+
+// _crypto_hpke_KEM is an interface wrapper for KEM type
+type _crypto_hpke_KEM struct {
+	WDeriveKeyPair func(ikm []byte) (hpke.PrivateKey, error)
+	WGenerateKey   func() (hpke.PrivateKey, error)
+	WID            func() uint16
+	WNewPrivateKey func(a0 []byte) (hpke.PrivateKey, error)
+	WNewPublicKey  func(a0 []byte) (hpke.PublicKey, error)
+}
+
+func (W _crypto_hpke_KEM) DeriveKeyPair(ikm []byte) (hpke.PrivateKey, error) {
+	return W.WDeriveKeyPair(ikm)
+}
+func (W _crypto_hpke_KEM) GenerateKey() (hpke.PrivateKey, error) { return W.WGenerateKey() }
+func (W _crypto_hpke_KEM) ID() uint16                            { return W.WID() }
+func (W _crypto_hpke_KEM) NewPrivateKey(a0 []byte) (hpke.PrivateKey, error) {
+	return W.WNewPrivateKey(a0)
+}
+func (W _crypto_hpke_KEM) NewPublicKey(a0 []byte) (hpke.PublicKey, error) { return W.WNewPublicKey(a0) }
+
+// _crypto_hpke_PrivateKey is an interface wrapper for PrivateKey type
+type _crypto_hpke_PrivateKey struct {
+	WBytes     func() ([]byte, error)
+	WKEM       func() hpke.KEM
+	WPublicKey func() hpke.PublicKey
+}
+
+func (W _crypto_hpke_PrivateKey) Bytes() ([]byte, error)    { return W.WBytes() }
+func (W _crypto_hpke_PrivateKey) KEM() hpke.KEM             { return W.WKEM() }
+func (W _crypto_hpke_PrivateKey) PublicKey() hpke.PublicKey { return W.WPublicKey() }
+
+// _crypto_hpke_PublicKey is an interface wrapper for PublicKey type
+type _crypto_hpke_PublicKey struct {
+	WBytes func() []byte
+	WKEM   func() hpke.KEM
+}
+
+func (W _crypto_hpke_PublicKey) Bytes() []byte { return W.WBytes() }
+func (W _crypto_hpke_PublicKey) KEM() hpke.KEM { return W.WKEM() }
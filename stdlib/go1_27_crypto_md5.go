@@ -0,0 +1,23 @@
+// Code generated by 'yaegi extract crypto/md5'. DO NOT EDIT.
+
+//go:build go1.27 && !go1.28
+// +build go1.27,!go1.28
+
+package stdlib
+
+import (
+	"crypto/md5"
+	"go/constant"
+	"go/token"
+	"reflect"
+)
+
+func init() {
+	Symbols["crypto/md5"] = map[string]reflect.Value{
+		// function, constant and variable definitions
+		"BlockSize": reflect.ValueOf(constant.MakeFromLiteral("64", token.INT, 0)),
+		"New":       reflect.ValueOf(md5.New),
+		"Size":      reflect.ValueOf(constant.MakeFromLiteral("16", token.INT, 0)),
+		"Sum":       reflect.ValueOf(md5.Sum),
+	}
+}
@@ -0,0 +1,39 @@
+// Code generated by 'yaegi extract crypto/mldsa'. DO NOT EDIT.
+
+//go:build go1.27 && !go1.28
+// +build go1.27,!go1.28
+
+package stdlib
+
+import (
+	"crypto/mldsa"
+	"go/constant"
+	"go/token"
+	"reflect"
+)
+
+func init() {
+	Symbols["crypto/mldsa"] = map[string]reflect.Value{
+		// function, constant and variable definitions
+		"GenerateKey":          reflect.ValueOf(mldsa.GenerateKey),
+		"MLDSA44":              reflect.ValueOf(mldsa.MLDSA44),
+		"MLDSA44PublicKeySize": reflect.ValueOf(constant.MakeFromLiteral("1312", token.INT, 0)),
+		"MLDSA44SignatureSize": reflect.ValueOf(constant.MakeFromLiteral("2420", token.INT, 0)),
+		"MLDSA65":              reflect.ValueOf(mldsa.MLDSA65),
+		"MLDSA65PublicKeySize": reflect.ValueOf(constant.MakeFromLiteral("1952", token.INT, 0)),
+		"MLDSA65SignatureSize": reflect.ValueOf(constant.MakeFromLiteral("3309", token.INT, 0)),
+		"MLDSA87":              reflect.ValueOf(mldsa.MLDSA87),
+		"MLDSA87PublicKeySize": reflect.ValueOf(constant.MakeFromLiteral("2592", token.INT, 0)),
+		"MLDSA87SignatureSize": reflect.ValueOf(constant.MakeFromLiteral("4627", token.INT, 0)),
+		"NewPrivateKey":        reflect.ValueOf(mldsa.NewPrivateKey),
+		"NewPublicKey":         reflect.ValueOf(mldsa.NewPublicKey),
+		"PrivateKeySize":       reflect.ValueOf(constant.MakeFromLiteral("32", token.INT, 0)),
+		"Verify":               reflect.ValueOf(mldsa.Verify),
+
+		// type definitions
+		"Options":    reflect.ValueOf((*mldsa.Options)(nil)),
+		"Parameters": reflect.ValueOf((*mldsa.Parameters)(nil)),
+		"PrivateKey": reflect.ValueOf((*mldsa.PrivateKey)(nil)),
+		"PublicKey":  reflect.ValueOf((*mldsa.PublicKey)(nil)),
+	}
+}
@@ -0,0 +1,19 @@
+// Code generated by 'yaegi extract crypto/mlkem/mlkemtest'. DO NOT EDIT.
+
+//go:build go1.27 && !go1.28
+// +build go1.27,!go1.28
+
+package stdlib
+
+import (
+	"crypto/mlkem/mlkemtest"
+	"reflect"
+)
+
+func init() {
+	Symbols["crypto/mlkem/mlkemtest"] = map[string]reflect.Value{
+		// function, constant and variable definitions
+		"Encapsulate1024": reflect.ValueOf(mlkemtest.Encapsulate1024),
+		"Encapsulate768":  reflect.ValueOf(mlkemtest.Encapsulate768),
+	}
+}
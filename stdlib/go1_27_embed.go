@@ -0,0 +1,18 @@
+// Code generated by 'yaegi extract embed'. DO NOT EDIT.
+
+//go:build go1.27 && !go1.28
+// +build go1.27,!go1.28
+
+package stdlib
+
+import (
+	"embed"
+	"reflect"
+)
+
+func init() {
+	Symbols["embed"] = map[string]reflect.Value{
+		// type definitions
+		"FS": reflect.ValueOf((*embed.FS)(nil)),
+	}
+}
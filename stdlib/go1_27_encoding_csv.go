@@ -0,0 +1,28 @@
+// Code generated by 'yaegi extract encoding/csv'. DO NOT EDIT.
+
+//go:build go1.27 && !go1.28
+// +build go1.27,!go1.28
+
+package stdlib
+
+import (
+	"encoding/csv"
+	"reflect"
+)
+
+func init() {
+	Symbols["encoding/csv"] = map[string]reflect.Value{
+		// function, constant and variable definitions
+		"ErrBareQuote":     reflect.ValueOf(&csv.ErrBareQuote).Elem(),
+		"ErrFieldCount":    reflect.ValueOf(&csv.ErrFieldCount).Elem(),
+		"ErrQuote":         reflect.ValueOf(&csv.ErrQuote).Elem(),
+		"ErrTrailingComma": reflect.ValueOf(&csv.ErrTrailingComma).Elem(),
+		"NewReader":        reflect.ValueOf(csv.NewReader),
+		"NewWriter":        reflect.ValueOf(csv.NewWriter),
+
+		// type definitions
+		"ParseError": reflect.ValueOf((*csv.ParseError)(nil)),
+		"Reader":     reflect.ValueOf((*csv.Reader)(nil)),
+		"Writer":     reflect.ValueOf((*csv.Writer)(nil)),
+	}
+}
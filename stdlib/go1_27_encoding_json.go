@@ -0,0 +1,81 @@
+// Code generated by 'yaegi extract encoding/json'. DO NOT EDIT.
+
+//go:build go1.27 && !go1.28
+// +build go1.27,!go1.28
+
+package stdlib
+
+import (
+	"encoding/json"
+	"reflect"
+)
+
+func init() {
+	Symbols["encoding/json"] = map[string]reflect.Value{
+		// function, constant and variable definitions
+		"CallMethodsWithLegacySemantics":  reflect.ValueOf(json.CallMethodsWithLegacySemantics),
+		"Compact":                         reflect.ValueOf(json.Compact),
+		"DefaultOptionsV1":                reflect.ValueOf(json.DefaultOptionsV1),
+		"FormatByteArrayAsArray":          reflect.ValueOf(json.FormatByteArrayAsArray),
+		"FormatBytesWithLegacySemantics":  reflect.ValueOf(json.FormatBytesWithLegacySemantics),
+		"FormatDurationAsNano":            reflect.ValueOf(json.FormatDurationAsNano),
+		"HTMLEscape":                      reflect.ValueOf(json.HTMLEscape),
+		"Indent":                          reflect.ValueOf(json.Indent),
+		"Marshal":                         reflect.ValueOf(json.Marshal),
+		"MarshalIndent":                   reflect.ValueOf(json.MarshalIndent),
+		"MatchCaseSensitiveDelimiter":     reflect.ValueOf(json.MatchCaseSensitiveDelimiter),
+		"MergeWithLegacySemantics":        reflect.ValueOf(json.MergeWithLegacySemantics),
+		"NewDecoder":                      reflect.ValueOf(json.NewDecoder),
+		"NewEncoder":                      reflect.ValueOf(json.NewEncoder),
+		"OmitEmptyWithLegacySemantics":    reflect.ValueOf(json.OmitEmptyWithLegacySemantics),
+		"ParseBytesWithLooseRFC4648":      reflect.ValueOf(json.ParseBytesWithLooseRFC4648),
+		"ParseTimeWithLooseRFC3339":       reflect.ValueOf(json.ParseTimeWithLooseRFC3339),
+		"ReportErrorsWithLegacySemantics": reflect.ValueOf(json.ReportErrorsWithLegacySemantics),
+		"StringifyWithLegacySemantics":    reflect.ValueOf(json.StringifyWithLegacySemantics),
+		"Unmarshal":                       reflect.ValueOf(json.Unmarshal),
+		"UnmarshalArrayFromAnyLength":     reflect.ValueOf(json.UnmarshalArrayFromAnyLength),
+		"Valid":                           reflect.ValueOf(json.Valid),
+
+		// type definitions
+		"Decoder":               reflect.ValueOf((*json.Decoder)(nil)),
+		"Delim":                 reflect.ValueOf((*json.Delim)(nil)),
+		"Encoder":               reflect.ValueOf((*json.Encoder)(nil)),
+		"InvalidUTF8Error":      reflect.ValueOf((*json.InvalidUTF8Error)(nil)),
+		"InvalidUnmarshalError": reflect.ValueOf((*json.InvalidUnmarshalError)(nil)),
+		"Marshaler":             reflect.ValueOf((*json.Marshaler)(nil)),
+		"MarshalerError":        reflect.ValueOf((*json.MarshalerError)(nil)),
+		"Number":                reflect.ValueOf((*json.Number)(nil)),
+		"Options":               reflect.ValueOf((*json.Options)(nil)),
+		"RawMessage":            reflect.ValueOf((*json.RawMessage)(nil)),
+		"SyntaxError":           reflect.ValueOf((*json.SyntaxError)(nil)),
+		"Token":                 reflect.ValueOf((*json.Token)(nil)),
+		"UnmarshalFieldError":   reflect.ValueOf((*json.UnmarshalFieldError)(nil)),
+		"UnmarshalTypeError":    reflect.ValueOf((*json.UnmarshalTypeError)(nil)),
+		"Unmarshaler":           reflect.ValueOf((*json.Unmarshaler)(nil)),
+		"UnsupportedTypeError":  reflect.ValueOf((*json.UnsupportedTypeError)(nil)),
+		"UnsupportedValueError": reflect.ValueOf((*json.UnsupportedValueError)(nil)),
+
+		// interface wrapper definitions
+		"_Marshaler":   reflect.ValueOf((*_encoding_json_Marshaler)(nil)),
+		"_Token":       reflect.ValueOf((*_encoding_json_Token)(nil)),
+		"_Unmarshaler": reflect.ValueOf((*_encoding_json_Unmarshaler)(nil)),
+	}
+}
+
+// _encoding_json_Marshaler is an interface wrapper for Marshaler type
+type _encoding_json_Marshaler struct {
+	WMarshalJSON func() ([]byte, error)
+}
+
+func (W _encoding_json_Marshaler) MarshalJSON() ([]byte, error) { return W.WMarshalJSON() }
+
+// _encoding_json_Token is an interface wrapper for Token type
+type _encoding_json_Token struct {
+}
+
+// _encoding_json_Unmarshaler is an interface wrapper for Unmarshaler type
+type _encoding_json_Unmarshaler struct {
+	WUnmarshalJSON func(a0 []byte) error
+}
+
+func (W _encoding_json_Unmarshaler) UnmarshalJSON(a0 []byte) error { return W.WUnmarshalJSON(a0) }
@@ -0,0 +1,69 @@
+// Code generated by 'yaegi extract encoding/json/jsontext'. DO NOT EDIT.
+
+//go:build go1.27 && !go1.28
+// +build go1.27,!go1.28
+
+package stdlib
+
+import (
+	"encoding/json/jsontext"
+	"reflect"
+)
+
+func init() {
+	Symbols["encoding/json/jsontext"] = map[string]reflect.Value{
+		// function, constant and variable definitions
+		"AllowDuplicateNames":   reflect.ValueOf(jsontext.AllowDuplicateNames),
+		"AllowInvalidUTF8":      reflect.ValueOf(jsontext.AllowInvalidUTF8),
+		"AppendFloat":           reflect.ValueOf(jsontext.AppendFloat),
+		"BeginArray":            reflect.ValueOf(&jsontext.BeginArray).Elem(),
+		"BeginObject":           reflect.ValueOf(&jsontext.BeginObject).Elem(),
+		"Bool":                  reflect.ValueOf(jsontext.Bool),
+		"CanonicalizeRawFloats": reflect.ValueOf(jsontext.CanonicalizeRawFloats),
+		"CanonicalizeRawInts":   reflect.ValueOf(jsontext.CanonicalizeRawInts),
+		"EndArray":              reflect.ValueOf(&jsontext.EndArray).Elem(),
+		"EndObject":             reflect.ValueOf(&jsontext.EndObject).Elem(),
+		"ErrDuplicateName":      reflect.ValueOf(&jsontext.ErrDuplicateName).Elem(),
+		"ErrNonStringName":      reflect.ValueOf(&jsontext.ErrNonStringName).Elem(),
+		"EscapeForHTML":         reflect.ValueOf(jsontext.EscapeForHTML),
+		"EscapeForJS":           reflect.ValueOf(jsontext.EscapeForJS),
+		"False":                 reflect.ValueOf(&jsontext.False).Elem(),
+		"Float":                 reflect.ValueOf(jsontext.Float),
+		"Float32":               reflect.ValueOf(jsontext.Float32),
+		"Int":                   reflect.ValueOf(jsontext.Int),
+		"Internal":              reflect.ValueOf(&jsontext.Internal).Elem(),
+		"KindBeginArray":        reflect.ValueOf(jsontext.KindBeginArray),
+		"KindBeginObject":       reflect.ValueOf(jsontext.KindBeginObject),
+		"KindEndArray":          reflect.ValueOf(jsontext.KindEndArray),
+		"KindEndObject":         reflect.ValueOf(jsontext.KindEndObject),
+		"KindFalse":             reflect.ValueOf(jsontext.KindFalse),
+		"KindInvalid":           reflect.ValueOf(jsontext.KindInvalid),
+		"KindNull":              reflect.ValueOf(jsontext.KindNull),
+		"KindNumber":            reflect.ValueOf(jsontext.KindNumber),
+		"KindString":            reflect.ValueOf(jsontext.KindString),
+		"KindTrue":              reflect.ValueOf(jsontext.KindTrue),
+		"Multiline":             reflect.ValueOf(jsontext.Multiline),
+		"NewDecoder":            reflect.ValueOf(jsontext.NewDecoder),
+		"NewEncoder":            reflect.ValueOf(jsontext.NewEncoder),
+		"Null":                  reflect.ValueOf(&jsontext.Null).Elem(),
+		"PreserveRawStrings":    reflect.ValueOf(jsontext.PreserveRawStrings),
+		"ReorderRawObjects":     reflect.ValueOf(jsontext.ReorderRawObjects),
+		"SpaceAfterColon":       reflect.ValueOf(jsontext.SpaceAfterColon),
+		"SpaceAfterComma":       reflect.ValueOf(jsontext.SpaceAfterComma),
+		"String":                reflect.ValueOf(jsontext.String),
+		"True":                  reflect.ValueOf(&jsontext.True).Elem(),
+		"Uint":                  reflect.ValueOf(jsontext.Uint),
+		"WithIndent":            reflect.ValueOf(jsontext.WithIndent),
+		"WithIndentPrefix":      reflect.ValueOf(jsontext.WithIndentPrefix),
+
+		// type definitions
+		"Decoder":        reflect.ValueOf((*jsontext.Decoder)(nil)),
+		"Encoder":        reflect.ValueOf((*jsontext.Encoder)(nil)),
+		"Kind":           reflect.ValueOf((*jsontext.Kind)(nil)),
+		"Options":        reflect.ValueOf((*jsontext.Options)(nil)),
+		"Pointer":        reflect.ValueOf((*jsontext.Pointer)(nil)),
+		"SyntacticError": reflect.ValueOf((*jsontext.SyntacticError)(nil)),
+		"Token":          reflect.ValueOf((*jsontext.Token)(nil)),
+		"Value":          reflect.ValueOf((*jsontext.Value)(nil)),
+	}
+}
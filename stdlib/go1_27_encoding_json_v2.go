@@ -0,0 +1,86 @@
+// Code generated by 'yaegi extract encoding/json/v2'. DO NOT EDIT.
+
+//go:build go1.27 && !go1.28
+// +build go1.27,!go1.28
+
+package stdlib
+
+import (
+	"encoding/json/jsontext"
+	"encoding/json/v2"
+	"reflect"
+)
+
+func init() {
+	Symbols["encoding/json/v2"] = map[string]reflect.Value{
+		// function, constant and variable definitions
+		"DefaultOptionsV2":          reflect.ValueOf(json.DefaultOptionsV2),
+		"Deterministic":             reflect.ValueOf(json.Deterministic),
+		"ErrUnknownName":            reflect.ValueOf(&json.ErrUnknownName).Elem(),
+		"FormatNilMapAsNull":        reflect.ValueOf(json.FormatNilMapAsNull),
+		"FormatNilSliceAsNull":      reflect.ValueOf(json.FormatNilSliceAsNull),
+		"JoinMarshalers":            reflect.ValueOf(json.JoinMarshalers),
+		"JoinOptions":               reflect.ValueOf(json.JoinOptions),
+		"JoinUnmarshalers":          reflect.ValueOf(json.JoinUnmarshalers),
+		"Marshal":                   reflect.ValueOf(json.Marshal),
+		"MarshalEncode":             reflect.ValueOf(json.MarshalEncode),
+		"MarshalWrite":              reflect.ValueOf(json.MarshalWrite),
+		"MatchCaseInsensitiveNames": reflect.ValueOf(json.MatchCaseInsensitiveNames),
+		"OmitZeroStructFields":      reflect.ValueOf(json.OmitZeroStructFields),
+		"RejectUnknownMembers":      reflect.ValueOf(json.RejectUnknownMembers),
+		"StringifyNumbers":          reflect.ValueOf(json.StringifyNumbers),
+		"Unmarshal":                 reflect.ValueOf(json.Unmarshal),
+		"UnmarshalDecode":           reflect.ValueOf(json.UnmarshalDecode),
+		"UnmarshalRead":             reflect.ValueOf(json.UnmarshalRead),
+		"WithMarshalers":            reflect.ValueOf(json.WithMarshalers),
+		"WithUnmarshalers":          reflect.ValueOf(json.WithUnmarshalers),
+
+		// type definitions
+		"Marshaler":       reflect.ValueOf((*json.Marshaler)(nil)),
+		"MarshalerTo":     reflect.ValueOf((*json.MarshalerTo)(nil)),
+		"Marshalers":      reflect.ValueOf((*json.Marshalers)(nil)),
+		"Options":         reflect.ValueOf((*json.Options)(nil)),
+		"SemanticError":   reflect.ValueOf((*json.SemanticError)(nil)),
+		"Unmarshaler":     reflect.ValueOf((*json.Unmarshaler)(nil)),
+		"UnmarshalerFrom": reflect.ValueOf((*json.UnmarshalerFrom)(nil)),
+		"Unmarshalers":    reflect.ValueOf((*json.Unmarshalers)(nil)),
+
+		// interface wrapper definitions
+		"_Marshaler":       reflect.ValueOf((*_encoding_json_v2_Marshaler)(nil)),
+		"_MarshalerTo":     reflect.ValueOf((*_encoding_json_v2_MarshalerTo)(nil)),
+		"_Unmarshaler":     reflect.ValueOf((*_encoding_json_v2_Unmarshaler)(nil)),
+		"_UnmarshalerFrom": reflect.ValueOf((*_encoding_json_v2_UnmarshalerFrom)(nil)),
+	}
+}
+
+// _encoding_json_v2_Marshaler is an interface wrapper for Marshaler type
+type _encoding_json_v2_Marshaler struct {
+	WMarshalJSON func() ([]byte, error)
+}
+
+func (W _encoding_json_v2_Marshaler) MarshalJSON() ([]byte, error) { return W.WMarshalJSON() }
+
+// _encoding_json_v2_MarshalerTo is an interface wrapper for MarshalerTo type
+type _encoding_json_v2_MarshalerTo struct {
+	WMarshalJSONTo func(a0 *jsontext.Encoder) error
+}
+
+func (W _encoding_json_v2_MarshalerTo) MarshalJSONTo(a0 *jsontext.Encoder) error {
+	return W.WMarshalJSONTo(a0)
+}
+
+// _encoding_json_v2_Unmarshaler is an interface wrapper for Unmarshaler type
+type _encoding_json_v2_Unmarshaler struct {
+	WUnmarshalJSON func(a0 []byte) error
+}
+
+func (W _encoding_json_v2_Unmarshaler) UnmarshalJSON(a0 []byte) error { return W.WUnmarshalJSON(a0) }
+
+// _encoding_json_v2_UnmarshalerFrom is an interface wrapper for UnmarshalerFrom type
+type _encoding_json_v2_UnmarshalerFrom struct {
+	WUnmarshalJSONFrom func(a0 *jsontext.Decoder) error
+}
+
+func (W _encoding_json_v2_UnmarshalerFrom) UnmarshalJSONFrom(a0 *jsontext.Decoder) error {
+	return W.WUnmarshalJSONFrom(a0)
+}
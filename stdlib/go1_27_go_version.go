@@ -0,0 +1,20 @@
+// Code generated by 'yaegi extract go/version'. DO NOT EDIT.
+
+//go:build go1.27 && !go1.28
+// +build go1.27,!go1.28
+
+package stdlib
+
+import (
+	"go/version"
+	"reflect"
+)
+
+func init() {
+	Symbols["go/version"] = map[string]reflect.Value{
+		// function, constant and variable definitions
+		"Compare": reflect.ValueOf(version.Compare),
+		"IsValid": reflect.ValueOf(version.IsValid),
+		"Lang":    reflect.ValueOf(version.Lang),
+	}
+}
@@ -0,0 +1,19 @@
+// Code generated by 'yaegi extract html'. DO NOT EDIT.
+
+//go:build go1.27 && !go1.28
+// +build go1.27,!go1.28
+
+package stdlib
+
+import (
+	"html"
+	"reflect"
+)
+
+func init() {
+	Symbols["html"] = map[string]reflect.Value{
+		// function, constant and variable definitions
+		"EscapeString":   reflect.ValueOf(html.EscapeString),
+		"UnescapeString": reflect.ValueOf(html.UnescapeString),
+	}
+}
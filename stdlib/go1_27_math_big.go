@@ -0,0 +1,50 @@
+// Code generated by 'yaegi extract math/big'. DO NOT EDIT.
+
+//go:build go1.27 && !go1.28
+// +build go1.27,!go1.28
+
+package stdlib
+
+import (
+	"go/constant"
+	"go/token"
+	"math/big"
+	"reflect"
+)
+
+func init() {
+	Symbols["math/big"] = map[string]reflect.Value{
+		// function, constant and variable definitions
+		"Above":         reflect.ValueOf(big.Above),
+		"AwayFromZero":  reflect.ValueOf(big.AwayFromZero),
+		"Below":         reflect.ValueOf(big.Below),
+		"Ceil":          reflect.ValueOf(big.Ceil),
+		"Exact":         reflect.ValueOf(big.Exact),
+		"Floor":         reflect.ValueOf(big.Floor),
+		"Jacobi":        reflect.ValueOf(big.Jacobi),
+		"MaxBase":       reflect.ValueOf(constant.MakeFromLiteral("62", token.INT, 0)),
+		"MaxExp":        reflect.ValueOf(constant.MakeFromLiteral("2147483647", token.INT, 0)),
+		"MaxPrec":       reflect.ValueOf(constant.MakeFromLiteral("4294967295", token.INT, 0)),
+		"MinExp":        reflect.ValueOf(constant.MakeFromLiteral("-2147483648", token.INT, 0)),
+		"NewFloat":      reflect.ValueOf(big.NewFloat),
+		"NewInt":        reflect.ValueOf(big.NewInt),
+		"NewRat":        reflect.ValueOf(big.NewRat),
+		"ParseFloat":    reflect.ValueOf(big.ParseFloat),
+		"Round":         reflect.ValueOf(big.Round),
+		"ToNearestAway": reflect.ValueOf(big.ToNearestAway),
+		"ToNearestEven": reflect.ValueOf(big.ToNearestEven),
+		"ToNegativeInf": reflect.ValueOf(big.ToNegativeInf),
+		"ToPositiveInf": reflect.ValueOf(big.ToPositiveInf),
+		"ToZero":        reflect.ValueOf(big.ToZero),
+		"Trunc":         reflect.ValueOf(big.Trunc),
+
+		// type definitions
+		"Accuracy":     reflect.ValueOf((*big.Accuracy)(nil)),
+		"ErrNaN":       reflect.ValueOf((*big.ErrNaN)(nil)),
+		"Float":        reflect.ValueOf((*big.Float)(nil)),
+		"Int":          reflect.ValueOf((*big.Int)(nil)),
+		"Rat":          reflect.ValueOf((*big.Rat)(nil)),
+		"RoundingMode": reflect.ValueOf((*big.RoundingMode)(nil)),
+		"Word":         reflect.ValueOf((*big.Word)(nil)),
+	}
+}
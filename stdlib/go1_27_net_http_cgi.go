@@ -0,0 +1,23 @@
+// Code generated by 'yaegi extract net/http/cgi'. DO NOT EDIT.
+
+//go:build go1.27 && !go1.28
+// +build go1.27,!go1.28
+
+package stdlib
+
+import (
+	"net/http/cgi"
+	"reflect"
+)
+
+func init() {
+	Symbols["net/http/cgi"] = map[string]reflect.Value{
+		// function, constant and variable definitions
+		"Request":        reflect.ValueOf(cgi.Request),
+		"RequestFromMap": reflect.ValueOf(cgi.RequestFromMap),
+		"Serve":          reflect.ValueOf(cgi.Serve),
+
+		// type definitions
+		"Handler": reflect.ValueOf((*cgi.Handler)(nil)),
+	}
+}
@@ -0,0 +1,18 @@
+// Code generated by 'yaegi extract structs'. DO NOT EDIT.
+
+//go:build go1.27 && !go1.28
+// +build go1.27,!go1.28
+
+package stdlib
+
+import (
+	"reflect"
+	"structs"
+)
+
+func init() {
+	Symbols["structs"] = map[string]reflect.Value{
+		// type definitions
+		"HostLayout": reflect.ValueOf((*structs.HostLayout)(nil)),
+	}
+}
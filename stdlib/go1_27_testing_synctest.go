@@ -0,0 +1,20 @@
+// Code generated by 'yaegi extract testing/synctest'. DO NOT EDIT.
+
+//go:build go1.27 && !go1.28
+// +build go1.27,!go1.28
+
+package stdlib
+
+import (
+	"reflect"
+	"testing/synctest"
+)
+
+func init() {
+	Symbols["testing/synctest"] = map[string]reflect.Value{
+		// function, constant and variable definitions
+		"Sleep": reflect.ValueOf(synctest.Sleep),
+		"Test":  reflect.ValueOf(synctest.Test),
+		"Wait":  reflect.ValueOf(synctest.Wait),
+	}
+}
@@ -0,0 +1,27 @@
+// Code generated by 'yaegi extract uuid'. DO NOT EDIT.
+
+//go:build go1.27 && !go1.28
+// +build go1.27,!go1.28
+
+package stdlib
+
+import (
+	"reflect"
+	"uuid"
+)
+
+func init() {
+	Symbols["uuid"] = map[string]reflect.Value{
+		// function, constant and variable definitions
+		"Max":       reflect.ValueOf(uuid.Max),
+		"MustParse": reflect.ValueOf(uuid.MustParse),
+		"New":       reflect.ValueOf(uuid.New),
+		"NewV4":     reflect.ValueOf(uuid.NewV4),
+		"NewV7":     reflect.ValueOf(uuid.NewV7),
+		"Nil":       reflect.ValueOf(uuid.Nil),
+		"Parse":     reflect.ValueOf(uuid.Parse),
+
+		// type definitions
+		"UUID": reflect.ValueOf((*uuid.UUID)(nil)),
+	}
+}
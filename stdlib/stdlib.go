@@ -1,9 +1,14 @@
-// +build go1.14,!go1.16
+//go:build (go1.14 && !go1.16) || (go1.27 && !go1.28)
+// +build go1.14,!go1.16 go1.27,!go1.28
 
 // Package stdlib provides wrappers of standard library packages to be imported natively in Yaegi.
 package stdlib
 
-import "reflect"
+import (
+	"reflect"
+
+	"github.com/traefik/yaegi/stdlib/generic"
+)
 
 // Symbols variable stores the map of stdlib symbols per package.
 var Symbols = map[string]map[string]reflect.Value{}
@@ -14,6 +19,21 @@ func init() {
 	}
 }
 
+// All returns the symbols of all the standard library packages provided to
+// interpreted code, in a new map to be passed to Interpreter.Use: the ones of
+// Symbols, and the ones of the packages based on generics of the generic
+// package. The symbols of the syscall, unsafe and unrestricted packages are
+// not included. The maps of symbols of each package are shared.
+func All() map[string]map[string]reflect.Value {
+	m := make(map[string]map[string]reflect.Value, len(Symbols)+len(generic.Symbols))
+	for _, syms := range []map[string]map[string]reflect.Value{Symbols, generic.Symbols} {
+		for path, s := range syms {
+			m[path] = s
+		}
+	}
+	return m
+}
+
 // Provide access to go standard library (http://golang.org/pkg/)
 // go list std | grep -v internal | grep -v '\.' | grep -v unsafe | grep -v syscall
 
@@ -50,3 +70,11 @@ func init() {
 //go:generate ../internal/cmd/extract/extract testing testing/iotest testing/quick
 //go:generate ../internal/cmd/extract/extract text/scanner text/tabwriter text/template text/template/parse
 //go:generate ../internal/cmd/extract/extract time unicode unicode/utf16 unicode/utf8
+
+// Packages added since Go 1.16, except the ones based on generics, see generic.
+
+//go:generate ../internal/cmd/extract/extract crypto/ecdh crypto/fips140 crypto/hpke crypto/mldsa crypto/mlkem crypto/mlkem/mlkemtest crypto/sha3
+//go:generate ../internal/cmd/extract/extract debug/buildinfo embed encoding/json/jsontext encoding/json/v2
+//go:generate ../internal/cmd/extract/extract go/build/constraint go/doc/comment go/version io/fs net/netip
+//go:generate ../internal/cmd/extract/extract runtime/coverage runtime/metrics structs
+//go:generate ../internal/cmd/extract/extract testing/cryptotest testing/fstest testing/slogtest testing/synctest uuid
@@ -1,4 +1,5 @@
-// +build go1.14,!go1.16
+//go:build (go1.14 && !go1.16) || (go1.27 && !go1.28)
+// +build go1.14,!go1.16 go1.27,!go1.28
 
 // Package syscall provide wrapper of standard library syscall package for native import in Yaegi.
 package syscall
@@ -0,0 +1,30 @@
+// Code generated by 'yaegi extract syscall'. DO NOT EDIT.
+
+//go:build go1.27 && !go1.28
+// +build go1.27,!go1.28
+
+package unrestricted
+
+import (
+	"reflect"
+	"syscall"
+)
+
+func init() {
+	Symbols["syscall"] = map[string]reflect.Value{
+		// function, constant and variable definitions
+		"Exec":         reflect.ValueOf(syscall.Exec),
+		"Exit":         reflect.ValueOf(syscall.Exit),
+		"ForkExec":     reflect.ValueOf(syscall.ForkExec),
+		"Kill":         reflect.ValueOf(syscall.Kill),
+		"PtraceAttach": reflect.ValueOf(syscall.PtraceAttach),
+		"PtraceDetach": reflect.ValueOf(syscall.PtraceDetach),
+		"RawSyscall":   reflect.ValueOf(syscall.RawSyscall),
+		"RawSyscall6":  reflect.ValueOf(syscall.RawSyscall6),
+		"Shutdown":     reflect.ValueOf(syscall.Shutdown),
+		"StartProcess": reflect.ValueOf(syscall.StartProcess),
+		"Syscall":      reflect.ValueOf(syscall.Syscall),
+		"Syscall6":     reflect.ValueOf(syscall.Syscall6),
+		"Syscall9":     reflect.ValueOf(syscall.Syscall9),
+	}
+}
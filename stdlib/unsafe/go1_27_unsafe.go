@@ -0,0 +1,18 @@
+// Code generated by 'yaegi extract unsafe'. DO NOT EDIT.
+
+//go:build go1.27 && !go1.28
+// +build go1.27,!go1.28
+
+package unsafe
+
+import (
+	"reflect"
+	"unsafe"
+)
+
+func init() {
+	Symbols["unsafe"] = map[string]reflect.Value{
+		// type definitions
+		"Pointer": reflect.ValueOf((*unsafe.Pointer)(nil)),
+	}
+}
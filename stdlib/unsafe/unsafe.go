@@ -1,4 +1,5 @@
-// +build go1.14,!go1.16
+//go:build (go1.14 && !go1.16) || (go1.27 && !go1.28)
+// +build go1.14,!go1.16 go1.27,!go1.28
 
 // Package unsafe provides wrapper of standard library unsafe package to be imported natively in Yaegi.
 package unsafe